@@ -164,7 +164,7 @@ func run(ctx context.Context, cfg *seedConfig, log logrus.FieldLogger) error {
 	defer pool.Close()
 
 	ctx = database.NewLoaderContext(ctx, pool)
-	ctx = activitylog.NewLoaderContext(ctx, pool)
+	ctx = activitylog.NewLoaderContext(ctx, pool, nil, log)
 	ctx = user.NewLoaderContext(ctx, pool)
 	ctx = team.NewLoaderContext(ctx, pool, nil)
 	ctx = authz.NewLoaderContext(ctx, pool)
@@ -0,0 +1,92 @@
+local user = User.new()
+local nonMember = User.new()
+
+local team = Team.new("export-team", "Export testing", "#export-team")
+team:addMember(user)
+
+Helper.SQLExec(string.format([[
+	INSERT INTO activity_log_entries (actor, action, resource_type, resource_name, team_slug, data, created_at)
+	VALUES
+		('%s', 'UPDATED', 'TEAM', '%s', '%s', '{"updatedFields":[{"field":"purpose","oldValue":"old","newValue":"new"}]}', '2025-01-15T10:00:00Z')
+]], user:email(), team:slug(), team:slug()))
+
+Test.rest("export activity log as ndjson", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("GET", "/api/v1/teams/export-team/activity-log?from=2025-01-01&to=2025-02-01")
+
+	t.check(200, {
+		id = NotNull(),
+		createdAt = "2025-01-15T10:00:00Z",
+		actor = user:email(),
+		action = "UPDATED",
+		resourceType = "TEAM",
+		resourceName = "export-team",
+		teamSlug = "export-team",
+		environmentName = "",
+		message = "Updated team",
+		data = {
+			["updatedFields.0.field"] = "purpose",
+			["updatedFields.0.oldValue"] = "old",
+			["updatedFields.0.newValue"] = "new",
+		},
+	})
+end)
+
+Test.rest("export activity log with unsupported format returns 400", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("GET", "/api/v1/teams/export-team/activity-log?format=xml")
+
+	t.check(400, {
+		status = 400,
+		errorMessage = Contains("unsupported format"),
+	})
+end)
+
+Test.rest("export activity log with invalid time returns 400", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("GET", "/api/v1/teams/export-team/activity-log?from=yesterday")
+
+	t.check(400, {
+		status = 400,
+		errorMessage = Contains("invalid from"),
+	})
+end)
+
+Test.rest("export activity log as non-member returns 403", function(t)
+	t.addHeader("x-user-email", nonMember:email())
+
+	t.send("GET", "/api/v1/teams/export-team/activity-log")
+
+	t.check(403, {
+		status = 403,
+		errorMessage = Contains("activity_logs:read"),
+	})
+end)
+
+Helper.SQLExec(string.format([[
+	INSERT INTO activity_log_entries_archive (id, actor, action, resource_type, resource_name, team_slug, created_at)
+	VALUES
+		(gen_random_uuid(), '%s', 'DELETED', 'SECRET', 'old-secret', '%s', '2023-06-01T12:00:00Z')
+]], user:email(), team:slug()))
+
+Test.rest("export archived activity log entries", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("GET", "/api/v1/teams/export-team/activity-log?from=2023-01-01&to=2024-01-01&archived=true")
+
+	t.check(200, {
+		id = NotNull(),
+		createdAt = "2023-06-01T12:00:00Z",
+		actor = user:email(),
+		action = "DELETED",
+		resourceType = "SECRET",
+		resourceName = "old-secret",
+		teamSlug = "export-team",
+		environmentName = "",
+		message = NotNull(),
+		data = {},
+	})
+end)
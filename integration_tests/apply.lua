@@ -47,6 +47,9 @@ Test.k8s("verify resource was created in fake environment", function(t)
 		metadata = {
 			name = "my-app",
 			namespace = "apply-team",
+			labels = {
+				["apply.nais.io/managed"] = "true",
+			},
 		},
 		spec = {
 			image = "example.com/my-app:v1",
@@ -118,6 +121,75 @@ Test.k8s("verify resource was updated in fake environment", function(t)
 		metadata = {
 			name = "my-app",
 			namespace = "apply-team",
+			labels = {
+				["apply.nais.io/managed"] = "true",
+			},
+		},
+		spec = {
+			image = "example.com/my-app:v2",
+			replicas = {
+				min = 2,
+				max = 4,
+			},
+		},
+	})
+end)
+
+Test.rest("dry run reports changes without applying them", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("POST", "/api/v1/teams/apply-team/environments/dev/apply", [[
+		{
+			"dryRun": true,
+			"resources": [
+				{
+					"apiVersion": "nais.io/v1alpha1",
+					"kind": "Application",
+					"metadata": {
+						"name": "my-app",
+						"namespace": "apply-team"
+					},
+					"spec": {
+						"image": "example.com/my-app:v3",
+						"replicas": {
+							"min": 2,
+							"max": 4
+						}
+					}
+				}
+			]
+		}
+	]])
+
+	t.check(200, {
+		dryRun = true,
+		results = {
+			{
+				resource = "Application/my-app",
+				environmentName = "dev",
+				status = "applied",
+				changedFields = {
+					{
+						field = "spec.image",
+						oldValue = "example.com/my-app:v2",
+						newValue = "example.com/my-app:v3",
+					},
+				},
+			},
+		},
+	})
+end)
+
+Test.k8s("verify dry run did not change resource in fake environment", function(t)
+	t.check("nais.io/v1alpha1", "applications", "dev", "apply-team", "my-app", {
+		apiVersion = "nais.io/v1alpha1",
+		kind = "Application",
+		metadata = {
+			name = "my-app",
+			namespace = "apply-team",
+			labels = {
+				["apply.nais.io/managed"] = "true",
+			},
 		},
 		spec = {
 			image = "example.com/my-app:v2",
@@ -129,6 +201,56 @@ Test.k8s("verify resource was updated in fake environment", function(t)
 	})
 end)
 
+Test.rest("atomic apply skips all resources when one fails validation", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("POST", "/api/v1/teams/apply-team/environments/dev/apply", [[
+		{
+			"atomic": true,
+			"resources": [
+				{
+					"apiVersion": "nais.io/v1alpha1",
+					"kind": "Application",
+					"metadata": {
+						"name": "atomic-app",
+						"namespace": "apply-team"
+					},
+					"spec": {
+						"image": "example.com/atomic-app:v1"
+					}
+				},
+				{
+					"apiVersion": "nais.io/v1alpha1",
+					"kind": "Application",
+					"metadata": {
+						"namespace": "apply-team"
+					},
+					"spec": {
+						"image": "example.com/unnamed:v1"
+					}
+				}
+			]
+		}
+	]])
+
+	t.check(200, {
+		results = {
+			{
+				resource = "Application/atomic-app",
+				environmentName = "dev",
+				status = "skipped",
+				error = "not applied: another resource in the request failed validation",
+			},
+			{
+				resource = "Application/",
+				environmentName = "dev",
+				status = "error",
+				error = "resource must have metadata.name",
+			},
+		},
+	})
+end)
+
 Test.rest("disallowed resource kind returns 400", function(t)
 	t.addHeader("x-user-email", user:email())
 
@@ -153,6 +275,131 @@ Test.rest("disallowed resource kind returns 400", function(t)
 	})
 end)
 
+Test.rest("resource denied in environment returns 400", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("POST", "/api/v1/teams/apply-team/environments/staging/apply", [[
+		{
+			"resources": [
+				{
+					"apiVersion": "v1",
+					"kind": "Secret",
+					"metadata": {
+						"name": "my-secret",
+						"namespace": "apply-team"
+					}
+				}
+			]
+		}
+	]])
+
+	t.check(400, {
+		error = "disallowed resource types: resources[0]: v1/Secret is not an allowed resource type",
+	})
+end)
+
+Test.gql("list resources allowed in environment", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		{
+			environment(name: "staging") {
+				allowedApplyResources {
+					apiVersion
+					kind
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			environment = {
+				allowedApplyResources = {
+					{ apiVersion = "aiven.io/v1alpha1", kind = "OpenSearch" },
+					{ apiVersion = "aiven.io/v1alpha1", kind = "ServiceIntegration" },
+					{ apiVersion = "aiven.io/v1alpha1", kind = "Valkey" },
+					{ apiVersion = "aiven.nais.io/v1", kind = "AivenApplication" },
+					{ apiVersion = "autoscaling/v2", kind = "HorizontalPodAutoscaler" },
+					{ apiVersion = "batch/v1", kind = "Job" },
+					{ apiVersion = "bigquery.cnrm.cloud.google.com/v1beta1", kind = "BigQueryTable" },
+					{ apiVersion = "data.nais.io/v1", kind = "Postgres" },
+					{ apiVersion = "example.com/v1", kind = "Widget" },
+					{ apiVersion = "iam.cnrm.cloud.google.com/v1beta1", kind = "IAMPolicyMember" },
+					{ apiVersion = "kafka.nais.io/v1", kind = "Topic" },
+					{ apiVersion = "krakend.nais.io/v1", kind = "ApiEndpoints" },
+					{ apiVersion = "krakend.nais.io/v1", kind = "Krakend" },
+					{ apiVersion = "logging.cnrm.cloud.google.com/v1beta1", kind = "LoggingLogSink" },
+					{ apiVersion = "monitoring.coreos.com/v1", kind = "Probe" },
+					{ apiVersion = "monitoring.coreos.com/v1", kind = "PrometheusRule" },
+					{ apiVersion = "monitoring.coreos.com/v1", kind = "ServiceMonitor" },
+					{ apiVersion = "monitoring.coreos.com/v1alpha1", kind = "AlertmanagerConfig" },
+					{ apiVersion = "nais.io/v1", kind = "AzureAdApplication" },
+					{ apiVersion = "nais.io/v1", kind = "Image" },
+					{ apiVersion = "nais.io/v1", kind = "Naisjob" },
+					{ apiVersion = "nais.io/v1", kind = "OpenSearch" },
+					{ apiVersion = "nais.io/v1", kind = "Valkey" },
+					{ apiVersion = "nais.io/v1alpha1", kind = "Alerts" },
+					{ apiVersion = "nais.io/v1alpha1", kind = "Application" },
+					{ apiVersion = "nais.io/v1alpha1", kind = "Naisjob" },
+					{ apiVersion = "networking.k8s.io/v1", kind = "Ingress" },
+					{ apiVersion = "networking.k8s.io/v1", kind = "NetworkPolicy" },
+					{ apiVersion = "pubsub.cnrm.cloud.google.com/v1beta1", kind = "PubSubTopic" },
+					{ apiVersion = "rbac.authorization.k8s.io/v1", kind = "ClusterRole" },
+					{ apiVersion = "rbac.authorization.k8s.io/v1", kind = "ClusterRoleBinding" },
+					{ apiVersion = "rbac.authorization.k8s.io/v1", kind = "Role" },
+					{ apiVersion = "rbac.authorization.k8s.io/v1", kind = "RoleBinding" },
+					{ apiVersion = "unleash.nais.io/v1", kind = "ApiToken" },
+					{ apiVersion = "v1", kind = "ConfigMap" },
+					{ apiVersion = "v1", kind = "Endpoints" },
+					{ apiVersion = "v1", kind = "PersistentVolumeClaim" },
+					{ apiVersion = "v1", kind = "Service" },
+					{ apiVersion = "v1", kind = "ServiceAccount" },
+				},
+			},
+		},
+	}
+end)
+
+Test.rest("policy violation is returned as a resource error", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("POST", "/api/v1/teams/apply-team/environments/dev/apply", [[
+		{
+			"resources": [
+				{
+					"apiVersion": "nais.io/v1alpha1",
+					"kind": "Application",
+					"metadata": {
+						"name": "latest-app",
+						"namespace": "apply-team"
+					},
+					"spec": {
+						"image": "example.com/latest-app:latest"
+					}
+				}
+			]
+		}
+	]])
+
+	t.check(200, {
+		results = {
+			{
+				resource = "Application/latest-app",
+				environmentName = "dev",
+				status = "error",
+				error = "policy violations: disallow-latest-tag: image must be pinned to a digest or a tag other than latest",
+				violations = {
+					{
+						rule = "disallow-latest-tag",
+						message = "image must be pinned to a digest or a tag other than latest",
+					},
+				},
+			},
+		},
+	})
+end)
+
 Test.rest("non-member gets authorization error", function(t)
 	t.addHeader("x-user-email", nonMember:email())
 
@@ -449,3 +696,164 @@ Test.gql("activity log contains JobCreatedActivityLogEntry after apply", functio
 		},
 	})
 end)
+
+Test.rest("prune dry run lists resources missing from the request", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("POST", "/api/v1/teams/apply-team/environments/dev/apply", [[
+		{
+			"dryRun": true,
+			"prune": true,
+			"resources": [
+				{
+					"apiVersion": "nais.io/v1alpha1",
+					"kind": "Application",
+					"metadata": {
+						"name": "my-app",
+						"namespace": "apply-team"
+					},
+					"spec": {
+						"image": "example.com/my-app:v2",
+						"replicas": {
+							"min": 2,
+							"max": 4
+						}
+					}
+				}
+			]
+		}
+	]])
+
+	t.check(200, {
+		dryRun = true,
+		results = {
+			{
+				resource = "Application/my-app",
+				environmentName = "dev",
+				status = "applied",
+			},
+		},
+		prune = {
+			resources = {
+				{
+					resource = "Naisjob/my-job",
+					environmentName = "dev",
+					status = "deleted",
+				},
+			},
+		},
+	})
+end)
+
+Test.rest("prune is refused when exceeding the prune limit", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("POST", "/api/v1/teams/apply-team/environments/dev/apply", [[
+		{
+			"prune": true,
+			"pruneLimit": 1,
+			"resources": [
+				{
+					"apiVersion": "v1",
+					"kind": "ConfigMap",
+					"metadata": {
+						"name": "my-config",
+						"namespace": "apply-team"
+					},
+					"data": {
+						"key": "value"
+					}
+				}
+			]
+		}
+	]])
+
+	t.check(200, {
+		results = {
+			{
+				resource = "ConfigMap/my-config",
+				environmentName = "dev",
+				status = "created",
+			},
+		},
+		prune = {
+			resources = {
+				{
+					resource = "Application/my-app",
+					environmentName = "dev",
+					status = "skipped",
+					error = "not applied: prune limit exceeded",
+				},
+				{
+					resource = "Naisjob/my-job",
+					environmentName = "dev",
+					status = "skipped",
+					error = "not applied: prune limit exceeded",
+				},
+			},
+			error = "not pruning: 2 resources would be pruned, which exceeds the limit of 1",
+		},
+	})
+end)
+
+Test.rest("prune deletes resources missing from the request", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.send("POST", "/api/v1/teams/apply-team/environments/dev/apply", [[
+		{
+			"prune": true,
+			"resources": [
+				{
+					"apiVersion": "nais.io/v1alpha1",
+					"kind": "Application",
+					"metadata": {
+						"name": "my-app",
+						"namespace": "apply-team"
+					},
+					"spec": {
+						"image": "example.com/my-app:v2",
+						"replicas": {
+							"min": 2,
+							"max": 4
+						}
+					}
+				},
+				{
+					"apiVersion": "v1",
+					"kind": "ConfigMap",
+					"metadata": {
+						"name": "my-config",
+						"namespace": "apply-team"
+					},
+					"data": {
+						"key": "value"
+					}
+				}
+			]
+		}
+	]])
+
+	t.check(200, {
+		results = {
+			{
+				resource = "Application/my-app",
+				environmentName = "dev",
+				status = "applied",
+			},
+			{
+				resource = "ConfigMap/my-config",
+				environmentName = "dev",
+				status = "applied",
+			},
+		},
+		prune = {
+			resources = {
+				{
+					resource = "Naisjob/my-job",
+					environmentName = "dev",
+					status = "deleted",
+				},
+			},
+		},
+	})
+end)
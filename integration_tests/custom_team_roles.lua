@@ -0,0 +1,526 @@
+local owner = User.new("role-owner", "role-owner@example.com", "role-owner")
+local member = User.new("role-member", "role-member@example.com", "role-member")
+
+local team = Team.new("roleteam", "some purpose", "#channel")
+team:addOwner(owner)
+team:addMember(member)
+
+Test.gql("List authorizations that can be granted by custom roles", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		query {
+			customTeamRoleAuthorizations {
+				name
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			customTeamRoleAuthorizations = NotNull(),
+		},
+	}
+end)
+
+Test.gql("Member can not create custom role", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			createCustomTeamRole(input: {
+				teamSlug: "roleteam"
+				name: "deployer"
+				description: "Deploy without reading secret values"
+				authorizations: ["teams:secrets:create"]
+			}) {
+				customRole {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = {
+					"createCustomTeamRole",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Create custom role with authorization that can not be granted", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			createCustomTeamRole(input: {
+				teamSlug: "roleteam"
+				name: "admin"
+				description: "Not allowed"
+				authorizations: ["teams:delete"]
+			}) {
+				customRole {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				extensions = {
+					field = "authorizations",
+				},
+				message = "\"teams:delete\" can not be granted by a custom role.",
+				path = {
+					"createCustomTeamRole",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Create custom role", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			createCustomTeamRole(input: {
+				teamSlug: "roleteam"
+				name: "deployer"
+				description: "Deploy without reading secret values"
+				authorizations: ["teams:secrets:create", "teams:secrets:list"]
+			}) {
+				customRole {
+					id
+					name
+					description
+					authorizations
+					team {
+						slug
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createCustomTeamRole = {
+				customRole = {
+					id = Save("customRoleID"),
+					name = "deployer",
+					description = "Deploy without reading secret values",
+					authorizations = { "teams:secrets:create", "teams:secrets:list" },
+					team = {
+						slug = "roleteam",
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Create custom role with name that already exists", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			createCustomTeamRole(input: {
+				teamSlug: "roleteam"
+				name: "deployer"
+				description: "Duplicate"
+				authorizations: ["teams:secrets:create"]
+			}) {
+				customRole {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "The team already has a custom role named \"deployer\".",
+				path = {
+					"createCustomTeamRole",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Member can update team before custom role is assigned", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			updateTeam(input: {
+				slug: "roleteam"
+				purpose: "updated by member"
+			}) {
+				team {
+					purpose
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			updateTeam = {
+				team = {
+					purpose = "updated by member",
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Assign custom role to member", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			setTeamMemberCustomRole(input: {
+				teamSlug: "roleteam"
+				userEmail: "role-member@example.com"
+				customRoleName: "deployer"
+			}) {
+				member {
+					role
+					customRole {
+						name
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			setTeamMemberCustomRole = {
+				member = {
+					role = "MEMBER",
+					customRole = {
+						name = "deployer",
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Member with custom role can create secret", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			createSecret(input: {
+				name: "deployer-secret"
+				environment: "dev"
+				team: "roleteam"
+			}) {
+				secret {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createSecret = {
+				secret = {
+					name = "deployer-secret",
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Member with custom role can not update team", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			updateTeam(input: {
+				slug: "roleteam"
+				purpose: "not allowed"
+			}) {
+				team {
+					purpose
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = {
+					"updateTeam",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Member with custom role can not read secret values", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			viewSecretValues(input: {
+				name: "deployer-secret"
+				environment: "dev"
+				team: "roleteam"
+				reason: "The custom role does not grant access to secret values"
+			}) {
+				values {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = {
+					"viewSecretValues",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Update custom role", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			updateCustomTeamRole(input: {
+				teamSlug: "roleteam"
+				name: "deployer"
+				authorizations: ["teams:metadata:update"]
+			}) {
+				customRole {
+					description
+					authorizations
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			updateCustomTeamRole = {
+				customRole = {
+					description = "Deploy without reading secret values",
+					authorizations = { "teams:metadata:update" },
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Member with updated custom role can update team", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			updateTeam(input: {
+				slug: "roleteam"
+				purpose: "allowed again"
+			}) {
+				team {
+					purpose
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			updateTeam = {
+				team = {
+					purpose = "allowed again",
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("List custom roles and members", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		query {
+			team(slug: "roleteam") {
+				customRoles {
+					nodes {
+						name
+					}
+				}
+				member(email: "role-member@example.com") {
+					customRole {
+						id
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				customRoles = {
+					nodes = {
+						{ name = "deployer" },
+					},
+				},
+				member = {
+					customRole = {
+						id = State.customRoleID,
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Changing the role of a member keeps the custom role", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			owner: setTeamMemberRole(input: {
+				teamSlug: "roleteam"
+				userEmail: "role-member@example.com"
+				role: OWNER
+			}) {
+				member {
+					role
+					customRole {
+						name
+					}
+				}
+			}
+			member: setTeamMemberRole(input: {
+				teamSlug: "roleteam"
+				userEmail: "role-member@example.com"
+				role: MEMBER
+			}) {
+				member {
+					role
+					customRole {
+						name
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			owner = {
+				member = {
+					role = "OWNER",
+					customRole = {
+						name = "deployer",
+					},
+				},
+			},
+			member = {
+				member = {
+					role = "MEMBER",
+					customRole = {
+						name = "deployer",
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Delete custom role", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			deleteCustomTeamRole(input: {
+				teamSlug: "roleteam"
+				name: "deployer"
+			}) {
+				customRoleDeleted
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			deleteCustomTeamRole = {
+				customRoleDeleted = true,
+			},
+		},
+	}
+end)
+
+Test.gql("Member falls back to team role after custom role is deleted", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		query {
+			team(slug: "roleteam") {
+				member(email: "role-member@example.com") {
+					role
+					customRole {
+						name
+					}
+				}
+				activityLog(filter: { activityTypes: [TEAM_CUSTOM_ROLE_CREATED, TEAM_MEMBER_SET_CUSTOM_ROLE, TEAM_CUSTOM_ROLE_DELETED] }) {
+					nodes {
+						message
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				member = {
+					role = "MEMBER",
+					customRole = Null,
+				},
+				activityLog = {
+					nodes = {
+						{ message = "Delete custom role" },
+						{ message = "Set member custom role" },
+						{ message = "Create custom role" },
+					},
+				},
+			},
+		},
+	}
+end)
@@ -0,0 +1,140 @@
+local user = User.new("diff-user", "diff@example.com", "diff")
+Team.new("diffteam", "purpose", "#slack-channel")
+
+local function createDeployment(commitSha, image, createdAt)
+	local row = Helper.SQLQueryRow([[
+		INSERT INTO deployments (team_slug, repository, commit_sha, environment_name, created_at)
+		VALUES ('diffteam', 'org/repo', $1, 'dev', NOW() - $2::INTERVAL)
+		RETURNING id::text
+	]], commitSha, createdAt)
+
+	Helper.SQLExec([[
+		INSERT INTO deployment_k8s_resources(deployment_id, "group", version, kind, name, namespace, spec)
+		VALUES ($1, 'nais.io', 'v1alpha1', 'Application', 'diff-app', 'diffteam', JSONB_BUILD_OBJECT('image', $2::TEXT));
+	]], row.id, image)
+
+	return row.id
+end
+
+createDeployment("aaa", "app:1", "2 hours")
+local second = createDeployment("bbb", "app:2", "1 hour")
+
+Helper.SQLExec([[
+	INSERT INTO deployment_k8s_resources(deployment_id, "group", version, kind, name, namespace)
+	VALUES ($1, 'monitoring.coreos.com', 'v1', 'PrometheusRule', 'alerts', 'diffteam');
+]], second)
+
+local other = Helper.SQLQueryRow([[
+	INSERT INTO deployments (team_slug, repository, environment_name)
+	VALUES ('diffteam', 'org/other', 'dev')
+	RETURNING id::text
+]])
+
+Helper.SQLExec([[
+	INSERT INTO deployment_k8s_resources(deployment_id, "group", version, kind, name, namespace)
+	VALUES ($1, 'nais.io', 'v1alpha1', 'Application', 'other-app', 'diffteam');
+]], other.id)
+
+Test.gql("list deployments", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query([[
+		{
+			team(slug: "diffteam") {
+				deployments {
+					nodes {
+						id
+						commitSha
+					}
+				}
+			}
+		}
+	]])
+
+	t.check {
+		data = {
+			team = {
+				deployments = {
+					nodes = {
+						{ id = Save("otherID"), commitSha = Null },
+						{ id = Save("toID"), commitSha = "bbb" },
+						{ id = Save("fromID"), commitSha = "aaa" },
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("diff deployments of the same workload", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		{
+			deploymentDiff(fromDeploymentID: "%s", toDeploymentID: "%s") {
+				from {
+					commitSha
+				}
+				to {
+					commitSha
+				}
+				commitRange {
+					repository
+					fromCommitSha
+					toCommitSha
+					compareURL
+				}
+				changedResourceKinds
+				specAvailable
+				specChanges {
+					field
+					oldValue
+					newValue
+				}
+			}
+		}
+	]], State.fromID, State.toID))
+
+	t.check {
+		data = {
+			deploymentDiff = {
+				from = { commitSha = "aaa" },
+				to = { commitSha = "bbb" },
+				commitRange = {
+					repository = "org/repo",
+					fromCommitSha = "aaa",
+					toCommitSha = "bbb",
+					compareURL = "https://github.com/org/repo/compare/aaa...bbb",
+				},
+				changedResourceKinds = { "Application", "PrometheusRule" },
+				specAvailable = true,
+				specChanges = {
+					{ field = "spec.image", oldValue = "app:1", newValue = "app:2" },
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("diff deployments of different workloads", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		{
+			deploymentDiff(fromDeploymentID: "%s", toDeploymentID: "%s") {
+				specAvailable
+			}
+		}
+	]], State.fromID, State.otherID))
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "The deployments must be of the same workload.",
+				path = { "deploymentDiff" },
+			},
+		},
+		data = Null,
+	}
+end)
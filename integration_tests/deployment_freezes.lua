@@ -0,0 +1,389 @@
+local owner = User.new("freeze-owner", "freeze-owner@example.com", "freeze-owner")
+local member = User.new("freeze-member", "freeze-member@example.com", "freeze-member")
+
+local team = Team.new("freezeteam", "purpose", "#slack-channel")
+team:addOwner(owner)
+team:addMember(member)
+
+local function apply(t, justification)
+	local override = ""
+	if justification then
+		override = string.format([["freezeOverrideJustification": "%s",]], justification)
+	end
+
+	t.send("POST", "/api/v1/teams/freezeteam/environments/dev/apply", string.format([[
+		{
+			%s
+			"resources": [
+				{
+					"apiVersion": "nais.io/v1alpha1",
+					"kind": "Application",
+					"metadata": {
+						"name": "frozen-app",
+						"namespace": "freezeteam"
+					},
+					"spec": {
+						"image": "example.com/frozen-app:v1"
+					}
+				}
+			]
+		}
+	]], override))
+end
+
+Test.gql("create deployment freeze in unknown environment", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			createDeploymentFreeze(input: {
+				teamSlug: "freezeteam"
+				environmentName: "unknown"
+				reason: "Christmas"
+			}) {
+				deploymentFreeze {
+					id
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				extensions = {
+					field = "environmentName",
+				},
+				message = "Environment \"unknown\" does not exist.",
+				path = {
+					"createDeploymentFreeze",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("create deployment freeze", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			createDeploymentFreeze(input: {
+				teamSlug: "freezeteam"
+				environmentName: "dev"
+				reason: "Christmas"
+			}) {
+				deploymentFreeze {
+					id
+					reason
+					endsAt
+					active
+					createdBy
+					teamEnvironment {
+						environment {
+							name
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createDeploymentFreeze = {
+				deploymentFreeze = {
+					id = Save("freezeID"),
+					reason = "Christmas",
+					endsAt = Null,
+					active = true,
+					createdBy = member:email(),
+					teamEnvironment = {
+						environment = {
+							name = "dev",
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.rest("apply is rejected during freeze", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	apply(t)
+
+	t.check(423, {
+		error = Contains("are frozen: Christmas"),
+	})
+end)
+
+Test.rest("member can not override freeze when applying", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	apply(t, "Critical security fix")
+
+	t.check(403, {
+		error = Contains("overriding deployment freeze failed"),
+	})
+end)
+
+Test.gql("member can not override freeze", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query(string.format([[
+		mutation {
+			overrideDeploymentFreeze(input: {
+				id: "%s"
+				justification: "Critical security fix"
+			}) {
+				deploymentFreeze {
+					id
+				}
+			}
+		}
+	]], State.freezeID))
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = {
+					"overrideDeploymentFreeze",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("override requires a justification", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query(string.format([[
+		mutation {
+			overrideDeploymentFreeze(input: {
+				id: "%s"
+				justification: "fix"
+			}) {
+				deploymentFreeze {
+					id
+				}
+			}
+		}
+	]], State.freezeID))
+
+	t.check {
+		errors = {
+			{
+				extensions = {
+					field = "justification",
+				},
+				message = "The justification must be at least 10 characters.",
+				path = {
+					"overrideDeploymentFreeze",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.rest("rejected apply does not override freeze", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.send("POST", "/api/v1/teams/freezeteam/environments/dev/apply", [[
+		{
+			"freezeOverrideJustification": "Critical security fix",
+			"resources": [
+				{
+					"apiVersion": "apps/v1",
+					"kind": "Deployment",
+					"metadata": {
+						"name": "frozen-deploy",
+						"namespace": "freezeteam"
+					},
+					"spec": {}
+				}
+			]
+		}
+	]])
+
+	t.check(400, {
+		error = Contains("disallowed resource types"),
+	})
+end)
+
+Test.gql("owner overrides freeze", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query(string.format([[
+		mutation {
+			overrideDeploymentFreeze(input: {
+				id: "%s"
+				justification: "Critical security fix"
+			}) {
+				deploymentFreeze {
+					overrides {
+						justification
+						createdBy
+					}
+				}
+			}
+		}
+	]], State.freezeID))
+
+	t.check {
+		data = {
+			overrideDeploymentFreeze = {
+				deploymentFreeze = {
+					overrides = {
+						{
+							justification = "Critical security fix",
+							createdBy = owner:email(),
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.rest("apply is allowed while freeze is overridden", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	apply(t)
+
+	t.check(200, {
+		results = {
+			{
+				resource = "Application/frozen-app",
+				environmentName = "dev",
+				status = "created",
+			},
+		},
+	})
+end)
+
+Test.gql("list deployment freezes", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		{
+			team(slug: "freezeteam") {
+				deploymentFreezes(environmentName: "dev") {
+					nodes {
+						id
+						reason
+						active
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				deploymentFreezes = {
+					nodes = {
+						{
+							id = State.freezeID,
+							reason = "Christmas",
+							active = true,
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("end deployment freeze", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query(string.format([[
+		mutation {
+			endDeploymentFreeze(input: {
+				id: "%s"
+			}) {
+				deploymentFreeze {
+					endsAt
+					active
+				}
+			}
+		}
+	]], State.freezeID))
+
+	t.check {
+		data = {
+			endDeploymentFreeze = {
+				deploymentFreeze = {
+					endsAt = NotNull(),
+					active = false,
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("ended freezes are not listed", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		{
+			team(slug: "freezeteam") {
+				deploymentFreezes {
+					nodes {
+						id
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				deploymentFreezes = {
+					nodes = {},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("activity log contains deployment freeze entries", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		{
+			team(slug: "freezeteam") {
+				activityLog(filter: { activityTypes: [DEPLOYMENT_FREEZE_CREATED, DEPLOYMENT_FREEZE_OVERRIDDEN, DEPLOYMENT_FREEZE_ENDED] }) {
+					nodes {
+						message
+						actor
+						environmentName
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				activityLog = {
+					nodes = {
+						{ message = "Ended deployment freeze", actor = member:email(), environmentName = "dev" },
+						{ message = "Overrode deployment freeze", actor = owner:email(), environmentName = "dev" },
+						{ message = "Created deployment freeze", actor = member:email(), environmentName = "dev" },
+					},
+				},
+			},
+		},
+	}
+end)
@@ -0,0 +1,121 @@
+local user = User.new("dora-user", "dora@example.com", "dora")
+Team.new("dorateam", "purpose", "#slack-channel")
+
+local function createDeployment(createdAt, state, statusAt)
+	local row = Helper.SQLQueryRow([[
+		INSERT INTO deployments (team_slug, repository, environment_name, created_at)
+		VALUES ('dorateam', 'org/repo', 'dev', NOW() - $1::INTERVAL)
+		RETURNING id::text
+	]], createdAt)
+
+	Helper.SQLExec([[
+		INSERT INTO deployment_k8s_resources(deployment_id, "group", version, kind, name, namespace)
+		VALUES ($1, 'nais.io', 'v1alpha1', 'Application', 'app-name', 'dorateam');
+	]], row.id)
+
+	Helper.SQLExec([[
+		INSERT INTO deployment_statuses (deployment_id, state, message, created_at)
+		VALUES ($1, $2::deployment_state, 'Deployment status', NOW() - $3::INTERVAL);
+	]], row.id, state, statusAt)
+end
+
+createDeployment("5 hours", "success", "4 hours")
+createDeployment("3 hours", "failure", "3 hours")
+createDeployment("2 hours", "success", "1 hour")
+
+Test.gql("team with no deployments in environment", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query([[
+		{
+			team(slug: "dorateam") {
+				doraMetrics(environmentName: "staging") {
+					successfulDeployments
+					failedDeployments
+					deploymentFrequency
+					leadTimeSeconds
+					changeFailureRate
+					timeToRestoreSeconds
+				}
+			}
+		}
+	]])
+
+	t.check {
+		data = {
+			team = {
+				doraMetrics = {
+					successfulDeployments = 0,
+					failedDeployments = 0,
+					deploymentFrequency = 0,
+					leadTimeSeconds = Null,
+					changeFailureRate = Null,
+					timeToRestoreSeconds = Null,
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("team with deployments", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query([[
+		{
+			team(slug: "dorateam") {
+				doraMetrics {
+					from
+					to
+					successfulDeployments
+					failedDeployments
+					deploymentFrequency
+					leadTimeSeconds
+					changeFailureRate
+					timeToRestoreSeconds
+				}
+			}
+		}
+	]])
+
+	t.check {
+		data = {
+			team = {
+				doraMetrics = {
+					from = NotNull(),
+					to = NotNull(),
+					successfulDeployments = 2,
+					failedDeployments = 1,
+					deploymentFrequency = NotNull(),
+					leadTimeSeconds = 3600,
+					changeFailureRate = NotNull(),
+					timeToRestoreSeconds = 7200,
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("window that is too long", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query([[
+		{
+			team(slug: "dorateam") {
+				doraMetrics(window: { from: "2020-01-01T00:00:00Z", to: "2022-01-01T00:00:00Z" }) {
+					successfulDeployments
+				}
+			}
+		}
+	]])
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "The time window can not be longer than 365 days.",
+				path = { "team", "doraMetrics" },
+			},
+		},
+		data = Null,
+	}
+end)
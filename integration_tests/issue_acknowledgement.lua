@@ -0,0 +1,364 @@
+local user = User.new("name", "auth@user.com", "sdf")
+local team = Team.new("ackteam", "purpose", "#slack_channel")
+team:addMember(user)
+
+local otherUser = User.new("other", "other@user.com", "other")
+
+Helper.SQLExec([[
+	INSERT INTO issues (issue_type, resource_name, resource_type, team, env, severity, message)
+	VALUES
+		('NO_RUNNING_INSTANCES', 'app-a', 'APPLICATION', 'ackteam', 'dev', 'CRITICAL', 'No running instances'),
+		('DEPRECATED_REGISTRY', 'batch-1', 'APPLICATION', 'ackteam', 'dev', 'WARNING', 'Deprecated registry'),
+		('DEPRECATED_REGISTRY', 'batch-2', 'APPLICATION', 'ackteam', 'dev', 'WARNING', 'Deprecated registry')
+]])
+
+Test.gql("Get issue to acknowledge", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "ackteam") {
+				issues(filter: { issueType: NO_RUNNING_INSTANCES }) {
+					nodes {
+						id
+						acknowledgement {
+							kind
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issues = {
+					nodes = {
+						{
+							id = Save("issueID"),
+							acknowledgement = Null,
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Acknowledge issue as non-member", function(t)
+	t.addHeader("x-user-email", otherUser:email())
+
+	t.query(string.format([[
+		mutation {
+			acknowledgeIssue(input: { issueID: "%s", reason: "Known" }) {
+				issue {
+					id
+				}
+			}
+		}
+	]], State.issueID))
+
+	t.check {
+		errors = {
+			{
+				message = Contains("You are authenticated"),
+				path = { "acknowledgeIssue" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Acknowledge issue", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		mutation {
+			acknowledgeIssue(input: { issueID: "%s", reason: "Scaled down on purpose" }) {
+				issue {
+					acknowledgement {
+						kind
+						reason
+						actor
+						until
+					}
+				}
+			}
+		}
+	]], State.issueID))
+
+	t.check {
+		data = {
+			acknowledgeIssue = {
+				issue = {
+					acknowledgement = {
+						kind = "ACKNOWLEDGED",
+						reason = "Scaled down on purpose",
+						actor = user:email(),
+						["until"] = Null,
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Snooze issue in the past", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		mutation {
+			snoozeIssue(input: { issueID: "%s", until: "2020-01-01T00:00:00Z", reason: "Later" }) {
+				issue {
+					id
+				}
+			}
+		}
+	]], State.issueID))
+
+	t.check {
+		errors = {
+			{
+				message = Contains("in the future"),
+				path = { "snoozeIssue" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Create suppression rule", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			createIssueSuppressionRule(input: {
+				teamSlug: "ackteam"
+				issueType: DEPRECATED_REGISTRY
+				resourceNamePattern: "batch-*"
+				reason: "Batch jobs are being migrated"
+			}) {
+				suppressionRule {
+					issueType
+					resourceNamePattern
+					reason
+					actor
+					team {
+						slug
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createIssueSuppressionRule = {
+				suppressionRule = {
+					issueType = "DEPRECATED_REGISTRY",
+					resourceNamePattern = "batch-*",
+					reason = "Batch jobs are being migrated",
+					actor = user:email(),
+					team = {
+						slug = "ackteam",
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Create duplicate suppression rule", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			createIssueSuppressionRule(input: {
+				teamSlug: "ackteam"
+				issueType: DEPRECATED_REGISTRY
+				resourceNamePattern: "batch-*"
+				reason: "Again"
+			}) {
+				suppressionRule {
+					id
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				message = Contains("already exists"),
+				path = { "createIssueSuppressionRule" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("List acknowledged issues", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "ackteam") {
+				issues(
+					filter: { acknowledged: true }
+					orderBy: { field: RESOURCE_NAME, direction: ASC }
+				) {
+					nodes {
+						resourceName
+						acknowledgement {
+							kind
+							reason
+							suppressionRule {
+								resourceNamePattern
+							}
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issues = {
+					nodes = {
+						{
+							resourceName = "app-a",
+							acknowledgement = {
+								kind = "ACKNOWLEDGED",
+								reason = "Scaled down on purpose",
+								suppressionRule = Null,
+							},
+						},
+						{
+							resourceName = "batch-1",
+							acknowledgement = {
+								kind = "SUPPRESSED",
+								reason = "Batch jobs are being migrated",
+								suppressionRule = {
+									resourceNamePattern = "batch-*",
+								},
+							},
+						},
+						{
+							resourceName = "batch-2",
+							acknowledgement = {
+								kind = "SUPPRESSED",
+								reason = "Batch jobs are being migrated",
+								suppressionRule = {
+									resourceNamePattern = "batch-*",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Remove acknowledgement", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		mutation {
+			removeIssueAcknowledgement(input: { issueID: "%s" }) {
+				issue {
+					acknowledgement {
+						kind
+					}
+				}
+			}
+		}
+	]], State.issueID))
+
+	t.check {
+		data = {
+			removeIssueAcknowledgement = {
+				issue = {
+					acknowledgement = Null,
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("List unacknowledged issues", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "ackteam") {
+				issues(filter: { acknowledged: false }) {
+					nodes {
+						resourceName
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issues = {
+					nodes = {
+						{
+							resourceName = "app-a",
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Activity log for issues", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "ackteam") {
+				activityLog(filter: { activityTypes: [ISSUE_ACKNOWLEDGED, ISSUE_ACKNOWLEDGEMENT_REMOVED, ISSUE_SUPPRESSION_RULE_CREATED] }) {
+					nodes {
+						message
+						resourceType
+						resourceName
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				activityLog = {
+					nodes = {
+						{
+							message = "Removed issue acknowledgement",
+							resourceType = "ISSUE",
+							resourceName = "app-a",
+						},
+						{
+							message = "Created issue suppression rule",
+							resourceType = "ISSUE",
+							resourceName = "batch-*",
+						},
+						{
+							message = "Acknowledged issue",
+							resourceType = "ISSUE",
+							resourceName = "app-a",
+						},
+					},
+				},
+			},
+		},
+	}
+end)
@@ -0,0 +1,102 @@
+local user = User.new("name", "auth@user.com", "sdf")
+Team.new("historyteam", "purpose", "#slack_channel")
+
+Helper.SQLExec([[
+	INSERT INTO issue_history (id, issue_type, resource_name, resource_type, team, env, severity, message, first_seen, last_seen, resolved_at)
+	VALUES
+		(gen_random_uuid(), 'NO_RUNNING_INSTANCES', 'app-a', 'APPLICATION', 'historyteam', 'dev', 'CRITICAL', 'No running instances', '2025-01-01T10:00:00Z', '2025-01-01T10:59:00Z', '2025-01-01T11:00:00Z'),
+		(gen_random_uuid(), 'NO_RUNNING_INSTANCES', 'app-b', 'APPLICATION', 'historyteam', 'dev', 'CRITICAL', 'No running instances', '2025-01-02T10:00:00Z', '2025-01-02T12:59:00Z', '2025-01-02T13:00:00Z'),
+		(gen_random_uuid(), 'DEPRECATED_REGISTRY', 'app-a', 'APPLICATION', 'historyteam', 'dev', 'WARNING', 'Deprecated registry', '2025-01-03T10:00:00Z', '2025-01-03T10:00:00Z', NULL)
+]])
+
+Test.gql("Issue history with mean time to resolve", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "historyteam") {
+				issueHistory {
+					nodes {
+						issueType
+						resourceName
+						firstSeen
+						resolvedAt
+					}
+					meanTimeToResolve {
+						issueType
+						resolvedCount
+						meanTimeToResolve
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issueHistory = {
+					nodes = {
+						{
+							issueType = "DEPRECATED_REGISTRY",
+							resourceName = "app-a",
+							firstSeen = "2025-01-03T10:00:00Z",
+							resolvedAt = Null,
+						},
+						{
+							issueType = "NO_RUNNING_INSTANCES",
+							resourceName = "app-b",
+							firstSeen = "2025-01-02T10:00:00Z",
+							resolvedAt = "2025-01-02T13:00:00Z",
+						},
+						{
+							issueType = "NO_RUNNING_INSTANCES",
+							resourceName = "app-a",
+							firstSeen = "2025-01-01T10:00:00Z",
+							resolvedAt = "2025-01-01T11:00:00Z",
+						},
+					},
+					meanTimeToResolve = {
+						{
+							issueType = "NO_RUNNING_INSTANCES",
+							resolvedCount = 2,
+							meanTimeToResolve = 7200,
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Issue history filtered by resolved", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "historyteam") {
+				issueHistory(filter: { resolved: false }) {
+					nodes {
+						issueType
+						resourceName
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issueHistory = {
+					nodes = {
+						{
+							issueType = "DEPRECATED_REGISTRY",
+							resourceName = "app-a",
+						},
+					},
+				},
+			},
+		},
+	}
+end)
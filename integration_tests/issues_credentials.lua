@@ -0,0 +1,275 @@
+Helper.readK8sResources("k8s_resources/credential_issues")
+local user = User.new("name", "auth@user.com", "sdf")
+local team = Team.new("credteam", "purpose", "#slack_channel")
+team:addMember(user)
+
+Helper.SQLExec([[
+	INSERT INTO service_accounts (name, description, team_slug)
+	VALUES ('deployer', 'description', 'credteam')
+]])
+
+Helper.SQLExec([[
+	INSERT INTO service_account_tokens (name, description, token, expires_at, last_used_at, service_account_id)
+	SELECT 'expiring', 'description', 'token-expiring', CURRENT_DATE + 3, NOW(), id FROM service_accounts WHERE name = 'deployer'
+]])
+
+Helper.SQLExec([[
+	INSERT INTO service_account_tokens (name, description, token, last_used_at, service_account_id)
+	SELECT 'unused', 'description', 'token-unused', NOW() - INTERVAL '100 days', id FROM service_accounts WHERE name = 'deployer'
+]])
+
+Helper.SQLExec([[
+	INSERT INTO service_account_tokens (name, description, token, expires_at, last_used_at, service_account_id)
+	SELECT 'healthy', 'description', 'token-healthy', CURRENT_DATE + 60, NOW(), id FROM service_accounts WHERE name = 'deployer'
+]])
+
+local checker = IssueChecker.new()
+checker:runChecks()
+
+Test.gql("Service account token issues", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "credteam") {
+				serviceAccountTokenIssues {
+					nodes {
+						__typename
+						severity
+						serviceAccount {
+							name
+						}
+						serviceAccountToken {
+							name
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				serviceAccountTokenIssues = {
+					nodes = {
+						{
+							__typename = "ServiceAccountTokenExpiringIssue",
+							severity = "WARNING",
+							serviceAccount = { name = "deployer" },
+							serviceAccountToken = { name = "expiring" },
+						},
+						{
+							__typename = "ServiceAccountTokenUnusedIssue",
+							severity = "TODO",
+							serviceAccount = { name = "deployer" },
+							serviceAccountToken = { name = "unused" },
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Issues do not include service account token issues", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "credteam") {
+				issues(filter: { issueType: SERVICE_ACCOUNT_TOKEN_EXPIRING }) {
+					nodes {
+						__typename
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issues = {
+					nodes = {},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Get service account token issue ID", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "credteam") {
+				serviceAccountTokenIssues(first: 1) {
+					nodes {
+						id
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				serviceAccountTokenIssues = {
+					nodes = {
+						{ id = Save("tokenIssueID") },
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Service account token issues can not be acknowledged", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		mutation {
+			acknowledgeIssue(input: { issueID: "%s", reason: "known" }) {
+				issue {
+					id
+				}
+			}
+		}
+	]], State.tokenIssueID))
+
+	t.check {
+		errors = {
+			{
+				message = "Issues for service account tokens can not be acknowledged.",
+				path = { "acknowledgeIssue" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Stale Aiven credential issues", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "credteam") {
+				issues(filter: { issueType: STALE_AIVEN_CREDENTIAL }) {
+					nodes {
+						__typename
+						severity
+						teamEnvironment {
+							environment {
+								name
+							}
+						}
+						... on StaleAivenCredentialIssue {
+							name
+							service
+							createdBy
+							createdAt
+							expiresAt
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issues = {
+					nodes = {
+						{
+							__typename = "StaleAivenCredentialIssue",
+							severity = "WARNING",
+							teamEnvironment = { environment = { name = "dev-gcp" } },
+							name = "tmp-opensearch-abc123",
+							service = "OpenSearch",
+							createdBy = "authenticated@example.com",
+							createdAt = "2020-01-01T00:00:00Z",
+							expiresAt = "2020-02-01T00:00:00Z",
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Stale secret issues", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "credteam") {
+				issues(filter: { issueType: STALE_SECRET }) {
+					nodes {
+						__typename
+						severity
+						... on StaleSecretIssue {
+							secret {
+								name
+							}
+							lastModifiedAt
+							lastModifiedBy
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issues = {
+					nodes = {
+						{
+							__typename = "StaleSecretIssue",
+							severity = "TODO",
+							secret = { name = "stale-secret" },
+							lastModifiedAt = "2020-01-01T00:00:00Z",
+							lastModifiedBy = "authenticated@example.com",
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Environment facet excludes issues without an environment", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "credteam") {
+				issues {
+					facets {
+						environments {
+							value
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issues = {
+					facets = {
+						environments = {
+							{ value = "dev-gcp" },
+						},
+					},
+				},
+			},
+		},
+	}
+end)
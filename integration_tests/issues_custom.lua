@@ -0,0 +1,47 @@
+Helper.readK8sResources("k8s_resources/custom_issues")
+local user = User.new("name", "auth@user.com", "sdf")
+Team.new("customteam", "purpose", "#slack_channel")
+local checker = IssueChecker.new()
+checker:runChecks()
+
+Test.gql("Issues raised by custom checks", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			team(slug: "customteam") {
+				issues(filter: { issueType: CUSTOM }) {
+					nodes {
+						__typename
+						severity
+						message
+						... on CustomIssue {
+							checkName
+							resourceType
+							resourceName
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				issues = {
+					nodes = {
+						{
+							__typename = "CustomIssue",
+							severity = "WARNING",
+							message = "Application without-owner in dev-gcp has no owner label",
+							checkName = "missing-owner-label",
+							resourceType = "APPLICATION",
+							resourceName = "without-owner",
+						},
+					},
+				},
+			},
+		},
+	}
+end)
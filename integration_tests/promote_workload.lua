@@ -0,0 +1,258 @@
+Helper.readK8sResources("k8s_resources/promote")
+
+local user = User.new("promoter", "promoter@example.com", "promoter")
+local outsider = User.new("promote-outsider", "promote-outsider@example.com", "promote-outsider")
+
+local team = Team.new("promoteteam", "purpose", "#slack-channel")
+team:addMember(user)
+
+local source = Helper.SQLQueryRow([[
+	INSERT INTO deployments (team_slug, repository, commit_sha, environment_name)
+	VALUES ('promoteteam', 'org/promote-app', 'abc123', 'dev')
+	RETURNING id::text
+]])
+
+Helper.SQLExec([[
+	INSERT INTO deployment_k8s_resources(deployment_id, "group", version, kind, name, namespace)
+	VALUES ($1, 'nais.io', 'v1alpha1', 'Application', 'promote-app', 'promoteteam');
+]], source.id)
+
+Test.gql("get application to promote", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		{
+			team(slug: "promoteteam") {
+				environment(name: "dev") {
+					application(name: "promote-app") {
+						id
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				environment = {
+					application = {
+						id = Save("appID"),
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("non-member can not promote workload", function(t)
+	t.addHeader("x-user-email", outsider:email())
+
+	t.query(string.format([[
+		mutation {
+			promoteWorkload(input: {
+				workloadID: "%s"
+				targetEnvironmentName: "staging"
+			}) {
+				deployment {
+					id
+				}
+			}
+		}
+	]], State.appID))
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = {
+					"promoteWorkload",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("promote workload to the environment it is running in", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		mutation {
+			promoteWorkload(input: {
+				workloadID: "%s"
+				targetEnvironmentName: "dev"
+			}) {
+				deployment {
+					id
+				}
+			}
+		}
+	]], State.appID))
+
+	t.check {
+		errors = {
+			{
+				extensions = {
+					field = "targetEnvironmentName",
+				},
+				message = "The workload can not be promoted to the environment it is running in.",
+				path = {
+					"promoteWorkload",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("promote workload with invalid override", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		mutation {
+			promoteWorkload(input: {
+				workloadID: "%s"
+				targetEnvironmentName: "staging"
+				overrides: [{ field: "replicas.min", value: "two" }]
+			}) {
+				deployment {
+					id
+				}
+			}
+		}
+	]], State.appID))
+
+	t.check {
+		errors = {
+			{
+				extensions = {
+					field = "overrides",
+				},
+				message = "The value of field \"replicas.min\" must be valid JSON.",
+				path = {
+					"promoteWorkload",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("promote workload", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query(string.format([[
+		mutation {
+			promoteWorkload(input: {
+				workloadID: "%s"
+				targetEnvironmentName: "staging"
+				overrides: [
+					{ field: "ingresses", value: "[\"https://promote-app.staging.example.com\"]" }
+					{ field: "replicas.min", value: "2" }
+				]
+			}) {
+				deployment {
+					environmentName
+					repository
+					commitSha
+					deployerUsername
+					statuses {
+						nodes {
+							state
+							message
+						}
+					}
+					promotedFrom {
+						id
+						environmentName
+					}
+				}
+			}
+		}
+	]], State.appID))
+
+	t.check {
+		data = {
+			promoteWorkload = {
+				deployment = {
+					environmentName = "staging",
+					repository = "org/promote-app",
+					commitSha = "abc123",
+					deployerUsername = user:email(),
+					statuses = {
+						nodes = {
+							{
+								state = "IN_PROGRESS",
+								message = "Promoted from dev.",
+							},
+						},
+					},
+					promotedFrom = {
+						id = NotNull(),
+						environmentName = "dev",
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.k8s("promoted application is applied to target environment", function(t)
+	t.check("nais.io/v1alpha1", "applications", "staging", "promoteteam", "promote-app", {
+		apiVersion = "nais.io/v1alpha1",
+		kind = "Application",
+		metadata = {
+			name = "promote-app",
+			namespace = "promoteteam",
+			labels = {
+				["apply.nais.io/managed"] = "true",
+			},
+		},
+		spec = {
+			image = "ghcr.io/navikt/promote-app:v1.0.0",
+			ingresses = {
+				"https://promote-app.staging.example.com",
+			},
+			replicas = {
+				min = 2,
+				max = 2,
+			},
+		},
+	})
+end)
+
+Test.gql("activity log contains the promoted application", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		{
+			team(slug: "promoteteam") {
+				activityLog(filter: { activityTypes: [GENERIC_KUBERNETES_RESOURCE_CREATED] }) {
+					nodes {
+						message
+						environmentName
+						resourceName
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				activityLog = {
+					nodes = {
+						{
+							message = "Application promote-app created",
+							environmentName = "staging",
+							resourceName = "promote-app",
+						},
+					},
+				},
+			},
+		},
+	}
+end)
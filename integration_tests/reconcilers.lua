@@ -143,6 +143,43 @@ Test.gql("disable reconciler as non-admin", function(t)
 	}
 end)
 
+Helper.SQLExec [[
+	INSERT INTO reconciler_state_revisions (reconciler_name, team_slug, revision, value)
+	VALUES
+	('reconciler-1', 'slug-1', 1, '{"version":1}'::bytea),
+	('reconciler-1', 'slug-1', 2, '\x00ff'::bytea),
+	('reconciler-1', 'slug-2', 1, '{"version":1}'::bytea)
+]]
+
+Test.gql("list reconciler state revisions as non-admin", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			reconcilers(first: 1) {
+				nodes {
+					stateRevisions(teamSlug: "slug-1") {
+						nodes {
+							revision
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = Null,
+		errors = {
+			{
+				locations = NotNull(),
+				message = "You are authenticated, but your account is not authorized to perform this action.",
+				path = { "reconcilers", "nodes", 0, "stateRevisions" },
+			},
+		},
+	}
+end)
+
 -- Make the authenticated user an admin
 user:admin(true)
 
@@ -397,3 +434,59 @@ Test.gql("list reconciler errors", function(t)
 		},
 	}
 end)
+
+Test.gql("list reconciler state revisions as admin", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		query {
+			reconcilers(first: 1) {
+				nodes {
+					name
+					stateRevisions(teamSlug: "slug-1") {
+						nodes {
+							revision
+							createdAt
+							value
+							base64Encoded
+						}
+						pageInfo {
+							totalCount
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			reconcilers = {
+				nodes = {
+					{
+						name = "reconciler-1",
+						stateRevisions = {
+							nodes = {
+								{
+									revision = 2,
+									createdAt = NotNull(),
+									value = "AP8=",
+									base64Encoded = true,
+								},
+								{
+									revision = 1,
+									createdAt = NotNull(),
+									value = "{\"version\":1}",
+									base64Encoded = false,
+								},
+							},
+							pageInfo = {
+								totalCount = 2,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+end)
@@ -0,0 +1,464 @@
+local requester = User.new("access-requester", "access-requester@example.com", "access-requester")
+local approver = User.new("access-approver", "access-approver@example.com", "access-approver")
+
+local team = Team.new("accessteam", "some purpose", "#channel")
+team:addOwner(requester)
+team:addOwner(approver)
+
+Test.gql("Create secret in environment that requires approval", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query [[
+		mutation {
+			createSecret(input: { name: "protected", environment: "dev-fss", team: "accessteam" }) {
+				secret { name }
+			}
+			addSecretValue(input: {
+				name: "protected"
+				environment: "dev-fss"
+				team: "accessteam"
+				value: { name: "PASSWORD", value: "hunter2" }
+			}) {
+				secret { valuesRequireApproval }
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createSecret = { secret = { name = "protected" } },
+			addSecretValue = { secret = { valuesRequireApproval = true } },
+		},
+	}
+end)
+
+Test.gql("View secret values without approved access request", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query [[
+		mutation {
+			viewSecretValues(input: {
+				name: "protected"
+				environment: "dev-fss"
+				team: "accessteam"
+				reason: "Debugging a production incident"
+			}) {
+				values { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "Viewing secret values in environment \"dev-fss\" requires an approved access request.",
+				path = { "viewSecretValues" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Request access in environment that does not require approval", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query [[
+		mutation {
+			requestSecretAccess(input: {
+				name: "protected"
+				environment: "dev"
+				team: "accessteam"
+				reason: "Debugging a production incident"
+			}) {
+				accessRequest { state }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "Secret values in environment \"dev\" can be viewed without an access request.",
+				path = { "requestSecretAccess" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Request access to secret values", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query [[
+		mutation {
+			requestSecretAccess(input: {
+				name: "protected"
+				environment: "dev-fss"
+				team: "accessteam"
+				reason: "Debugging a production incident"
+			}) {
+				accessRequest {
+					id
+					secretName
+					requester
+					reason
+					state
+					handledBy
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			requestSecretAccess = {
+				accessRequest = {
+					id = Save("requestID"),
+					secretName = "protected",
+					requester = requester:email(),
+					reason = "Debugging a production incident",
+					state = "PENDING",
+					handledBy = Null,
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Request access again while request is pending", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query [[
+		mutation {
+			requestSecretAccess(input: {
+				name: "protected"
+				environment: "dev-fss"
+				team: "accessteam"
+				reason: "Debugging a production incident"
+			}) {
+				accessRequest { state }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "You already have a pending or approved access request for this secret.",
+				path = { "requestSecretAccess" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Approve own access request", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query(string.format([[
+		mutation {
+			approveSecretAccessRequest(input: { id: "%s" }) {
+				accessRequest { state }
+			}
+		}
+	]], State.requestID))
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "You can not handle your own access request.",
+				path = { "approveSecretAccessRequest" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("List pending access requests for team", function(t)
+	t.addHeader("x-user-email", approver:email())
+
+	t.query [[
+		{
+			team(slug: "accessteam") {
+				secretAccessRequests(state: PENDING) {
+					nodes {
+						secretName
+						requester
+						teamEnvironment { environment { name } }
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				secretAccessRequests = {
+					nodes = {
+						{
+							secretName = "protected",
+							requester = requester:email(),
+							teamEnvironment = { environment = { name = "dev-fss" } },
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Approve access request", function(t)
+	t.addHeader("x-user-email", approver:email())
+
+	t.query(string.format([[
+		mutation {
+			approveSecretAccessRequest(input: { id: "%s", comment: "Go ahead" }) {
+				accessRequest {
+					state
+					handledBy
+					handledAt
+					handlerComment
+					accessExpiresAt
+				}
+			}
+		}
+	]], State.requestID))
+
+	t.check {
+		data = {
+			approveSecretAccessRequest = {
+				accessRequest = {
+					state = "APPROVED",
+					handledBy = approver:email(),
+					handledAt = NotNull(),
+					handlerComment = "Go ahead",
+					accessExpiresAt = NotNull(),
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Deny access request that is already approved", function(t)
+	t.addHeader("x-user-email", approver:email())
+
+	t.query(string.format([[
+		mutation {
+			denySecretAccessRequest(input: { id: "%s" }) {
+				accessRequest { state }
+			}
+		}
+	]], State.requestID))
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "The access request has already been handled.",
+				path = { "denySecretAccessRequest" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("View secret values with approved access request", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query [[
+		mutation {
+			viewSecretValues(input: {
+				name: "protected"
+				environment: "dev-fss"
+				team: "accessteam"
+				reason: "Debugging a production incident"
+			}) {
+				values {
+					name
+					value
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			viewSecretValues = {
+				values = {
+					{ name = "PASSWORD", value = "hunter2" },
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Approver can not view secret values without own access request", function(t)
+	t.addHeader("x-user-email", approver:email())
+
+	t.query [[
+		mutation {
+			viewSecretValues(input: {
+				name: "protected"
+				environment: "dev-fss"
+				team: "accessteam"
+				reason: "Debugging a production incident"
+			}) {
+				values { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "Viewing secret values in environment \"dev-fss\" requires an approved access request.",
+				path = { "viewSecretValues" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Approver requests access", function(t)
+	t.addHeader("x-user-email", approver:email())
+
+	t.query [[
+		mutation {
+			requestSecretAccess(input: {
+				name: "protected"
+				environment: "dev-fss"
+				team: "accessteam"
+				reason: "Rotating the database password"
+			}) {
+				accessRequest { id }
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			requestSecretAccess = {
+				accessRequest = { id = Save("otherRequestID") },
+			},
+		},
+	}
+end)
+
+Test.gql("Deny access request", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query(string.format([[
+		mutation {
+			denySecretAccessRequest(input: { id: "%s", comment: "Not needed" }) {
+				accessRequest {
+					state
+					handledBy
+					handlerComment
+					accessExpiresAt
+				}
+			}
+		}
+	]], State.otherRequestID))
+
+	t.check {
+		data = {
+			denySecretAccessRequest = {
+				accessRequest = {
+					state = "DENIED",
+					handledBy = requester:email(),
+					handlerComment = "Not needed",
+					accessExpiresAt = Null,
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Access requests are recorded in the activity log", function(t)
+	t.addHeader("x-user-email", requester:email())
+
+	t.query [[
+		{
+			team(slug: "accessteam") {
+				environment(name: "dev-fss") {
+					secret(name: "protected") {
+						accessRequests {
+							nodes { state }
+						}
+						activityLog(filter: { activityTypes: [SECRET_ACCESS_REQUESTED, SECRET_ACCESS_APPROVED, SECRET_ACCESS_DENIED] }) {
+							nodes {
+								actor
+								message
+							}
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				environment = {
+					secret = {
+						accessRequests = {
+							nodes = {
+								{ state = "DENIED" },
+								{ state = "APPROVED" },
+							},
+						},
+						activityLog = {
+							nodes = {
+								{ actor = requester:email(), message = "Denied access to secret values for " .. approver:email() },
+								{ actor = approver:email(), message = "Requested access to secret values" },
+								{ actor = approver:email(), message = "Approved access to secret values for " .. requester:email() },
+								{ actor = requester:email(), message = "Requested access to secret values" },
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("View secret values after access has ended", function(t)
+	Helper.SQLExec([[
+		UPDATE secret_access_requests
+		SET access_expires_at = NOW() - INTERVAL '1 minute'
+		WHERE team_slug = 'accessteam' AND state = 'APPROVED'
+	]])
+
+	t.addHeader("x-user-email", requester:email())
+
+	t.query [[
+		mutation {
+			viewSecretValues(input: {
+				name: "protected"
+				environment: "dev-fss"
+				team: "accessteam"
+				reason: "Debugging a production incident"
+			}) {
+				values { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "Viewing secret values in environment \"dev-fss\" requires an approved access request.",
+				path = { "viewSecretValues" },
+			},
+		},
+		data = Null,
+	}
+end)
@@ -0,0 +1,344 @@
+local user = User.new("external-user", "external@example.com", "external")
+local otherUser = User.new("external-other", "external-other@example.com", "external-other")
+
+local team = Team.new("extteam", "some purpose", "#channel")
+team:addOwner(user)
+
+Test.gql("Create secret", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			createSecret(input: { name: "database", environment: "dev", team: "extteam" }) {
+				secret {
+					name
+					externalSource { source }
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createSecret = {
+				secret = {
+					name = "database",
+					externalSource = Null,
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Set external source as non-team member", function(t)
+	t.addHeader("x-user-email", otherUser:email())
+
+	t.query [[
+		mutation {
+			setSecretExternalSource(input: { name: "database", environment: "dev", team: "extteam", source: "file", reference: "database.json" }) {
+				secret { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = { "setSecretExternalSource" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Set unknown external source", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			setSecretExternalSource(input: { name: "database", environment: "dev", team: "extteam", source: "vault", reference: "database" }) {
+				secret { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "Unknown external source \"vault\". Available sources: file.",
+				path = { "setSecretExternalSource" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Set external source with reference to another team", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			setSecretExternalSource(input: { name: "database", environment: "dev", team: "extteam", source: "file", reference: "../extotherteam/database.json" }) {
+				secret { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "The reference must be relative to the team, and can not point to secrets of other teams.",
+				path = { "setSecretExternalSource" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Reference to a file of another team is resolved within the team", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			setSecretExternalSource(input: { name: "database", environment: "dev", team: "extteam", source: "file", reference: "extotherteam/database.json" }) {
+				secret {
+					keys
+					externalSource {
+						syncStatus
+						syncError
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			setSecretExternalSource = {
+				secret = {
+					keys = {},
+					externalSource = {
+						syncStatus = "FAILED",
+						syncError = Contains("extotherteam/database.json"),
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Set external source with reference that does not exist", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			setSecretExternalSource(input: { name: "database", environment: "dev", team: "extteam", source: "file", reference: "missing.json" }) {
+				secret {
+					externalSource {
+						source
+						reference
+						syncStatus
+						lastSyncedAt
+						syncError
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			setSecretExternalSource = {
+				secret = {
+					externalSource = {
+						source = "file",
+						reference = "missing.json",
+						syncStatus = "FAILED",
+						lastSyncedAt = Null,
+						syncError = Contains("missing.json"),
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Set external source", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			setSecretExternalSource(input: { name: "database", environment: "dev", team: "extteam", source: "file", reference: "database.json" }) {
+				secret {
+					keys
+					externalSource {
+						source
+						reference
+						syncStatus
+						lastSyncedAt
+						syncError
+					}
+					activityLog(first: 1, filter: { activityTypes: [SECRET_EXTERNAL_SOURCE_SET] }) {
+						nodes {
+							message
+							... on SecretExternalSourceSetActivityLogEntry {
+								data {
+									source
+									reference
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			setSecretExternalSource = {
+				secret = {
+					keys = { "DATABASE_PASSWORD", "DATABASE_USERNAME" },
+					externalSource = {
+						source = "file",
+						reference = "database.json",
+						syncStatus = "SYNCED",
+						lastSyncedAt = NotNull(),
+						syncError = Null,
+					},
+					activityLog = {
+						nodes = {
+							{
+								message = "Set external source file for secret",
+								data = { source = "file", reference = "database.json" },
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Secret has values from the external source", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			viewSecretValues(input: {
+				name: "database"
+				environment: "dev"
+				team: "extteam"
+				reason: "Verify the values from the external source"
+			}) {
+				values {
+					name
+					value
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			viewSecretValues = {
+				values = {
+					{ name = "DATABASE_PASSWORD", value = "from-the-vault" },
+					{ name = "DATABASE_USERNAME", value = "app" },
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Edit externally managed secret", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			updateSecretValue(input: {
+				name: "database"
+				environment: "dev"
+				team: "extteam"
+				value: { name: "DATABASE_PASSWORD", value: "changed" }
+			}) {
+				secret { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "The secret is synced from an external source, unable to modify. Remove the external source to edit the secret in Console.",
+				path = { "updateSecretValue" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Remove external source", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			removeSecretExternalSource(input: { name: "database", environment: "dev", team: "extteam" }) {
+				secret {
+					keys
+					externalSource { source }
+				}
+			}
+			updateSecretValue(input: {
+				name: "database"
+				environment: "dev"
+				team: "extteam"
+				value: { name: "DATABASE_PASSWORD", value: "changed" }
+			}) {
+				secret { keys }
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			removeSecretExternalSource = {
+				secret = {
+					keys = { "DATABASE_PASSWORD", "DATABASE_USERNAME" },
+					externalSource = Null,
+				},
+			},
+			updateSecretValue = {
+				secret = { keys = { "DATABASE_PASSWORD", "DATABASE_USERNAME" } },
+			},
+		},
+	}
+end)
+
+Test.gql("Remove external source from secret without one", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			removeSecretExternalSource(input: { name: "database", environment: "dev", team: "extteam" }) {
+				secret { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "The secret is not synced from an external source.",
+				path = { "removeSecretExternalSource" },
+			},
+		},
+		data = Null,
+	}
+end)
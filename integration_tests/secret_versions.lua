@@ -0,0 +1,205 @@
+local user = User.new("versions-user", "versions@example.com", "versions")
+local otherUser = User.new("versions-other", "versions-other@example.com", "versions-other")
+
+local team = Team.new("versionteam", "some purpose", "#channel")
+team:addOwner(user)
+
+Test.gql("Create secret with values", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			createSecret(input: { name: "versioned", environment: "dev", team: "versionteam" }) {
+				secret { name }
+			}
+			addSecretValue(input: {
+				name: "versioned"
+				environment: "dev"
+				team: "versionteam"
+				value: { name: "PASSWORD", value: "first" }
+			}) {
+				secret { keys }
+			}
+			updateSecretValue(input: {
+				name: "versioned"
+				environment: "dev"
+				team: "versionteam"
+				value: { name: "PASSWORD", value: "second" }
+			}) {
+				secret { keys }
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createSecret = { secret = { name = "versioned" } },
+			addSecretValue = { secret = { keys = { "PASSWORD" } } },
+			updateSecretValue = { secret = { keys = { "PASSWORD" } } },
+		},
+	}
+end)
+
+Test.gql("List secret versions", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		{
+			team(slug: "versionteam") {
+				environment(name: "dev") {
+					secret(name: "versioned") {
+						versions {
+							nodes {
+								version
+								createdAt
+								createdBy
+								changedKeys
+							}
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				environment = {
+					secret = {
+						versions = {
+							nodes = {
+								{ version = 2, createdAt = NotNull(), createdBy = user:email(), changedKeys = { "PASSWORD" } },
+								{ version = 1, createdAt = NotNull(), createdBy = user:email(), changedKeys = { "PASSWORD" } },
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Rollback secret as non-team member", function(t)
+	t.addHeader("x-user-email", otherUser:email())
+
+	t.query [[
+		mutation {
+			rollbackSecret(input: { name: "versioned", environment: "dev", team: "versionteam", version: 1 }) {
+				secret { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = { "rollbackSecret" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Rollback secret to version that does not exist", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			rollbackSecret(input: { name: "versioned", environment: "dev", team: "versionteam", version: 42 }) {
+				secret { name }
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = "The secret does not have a version 42.",
+				path = { "rollbackSecret" },
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Rollback secret", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			rollbackSecret(input: { name: "versioned", environment: "dev", team: "versionteam", version: 1 }) {
+				secret {
+					keys
+					versions(first: 1) {
+						nodes {
+							version
+							changedKeys
+						}
+					}
+					activityLog(first: 1, filter: { activityTypes: [SECRET_ROLLED_BACK] }) {
+						nodes {
+							message
+							... on SecretRolledBackActivityLogEntry {
+								data { version }
+							}
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			rollbackSecret = {
+				secret = {
+					keys = { "PASSWORD" },
+					versions = {
+						nodes = {
+							{ version = 3, changedKeys = { "PASSWORD" } },
+						},
+					},
+					activityLog = {
+						nodes = {
+							{ message = "Rolled back secret to version 1", data = { version = 1 } },
+						},
+					},
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Secret has values of the restored version", function(t)
+	t.addHeader("x-user-email", user:email())
+
+	t.query [[
+		mutation {
+			viewSecretValues(input: {
+				name: "versioned"
+				environment: "dev"
+				team: "versionteam"
+				reason: "Verify that the rollback restored the values"
+			}) {
+				values {
+					name
+					value
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			viewSecretValues = {
+				values = {
+					{ name = "PASSWORD", value = "first" },
+				},
+			},
+		},
+	}
+end)
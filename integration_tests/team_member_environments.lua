@@ -0,0 +1,348 @@
+local owner = User.new("env-owner", "env-owner@example.com", "env-owner")
+local member = User.new("env-member", "env-member@example.com", "env-member")
+
+local team = Team.new("envteam", "some purpose", "#channel")
+team:addOwner(owner)
+team:addMember(member)
+
+Test.gql("Member can not limit own environments", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			setTeamMemberEnvironments(input: {
+				teamSlug: "envteam"
+				userEmail: "env-member@example.com"
+				environments: ["dev"]
+			}) {
+				member {
+					environments
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = {
+					"setTeamMemberEnvironments",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Limit member to environment that does not exist", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			setTeamMemberEnvironments(input: {
+				teamSlug: "envteam"
+				userEmail: "env-member@example.com"
+				environments: ["does-not-exist"]
+			}) {
+				member {
+					environments
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				extensions = {
+					field = "environments",
+				},
+				message = "Environment \"does-not-exist\" does not exist.",
+				path = {
+					"setTeamMemberEnvironments",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Limit member to dev", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			setTeamMemberEnvironments(input: {
+				teamSlug: "envteam"
+				userEmail: "env-member@example.com"
+				environments: ["dev"]
+			}) {
+				member {
+					role
+					environments
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			setTeamMemberEnvironments = {
+				member = {
+					role = "MEMBER",
+					environments = { "dev" },
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Member can create secret in dev", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			createSecret(input: {
+				name: "scoped-secret"
+				environment: "dev"
+				team: "envteam"
+			}) {
+				secret {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createSecret = {
+				secret = {
+					name = "scoped-secret",
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Member can not create secret in staging", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			createSecret(input: {
+				name: "scoped-secret"
+				environment: "staging"
+				team: "envteam"
+			}) {
+				secret {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = {
+					"createSecret",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Owner can create secret in staging", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			createSecret(input: {
+				name: "scoped-secret"
+				environment: "staging"
+				team: "envteam"
+			}) {
+				secret {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			createSecret = {
+				secret = {
+					name = "scoped-secret",
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Member can not read secret values in staging", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			viewSecretValues(input: {
+				name: "scoped-secret"
+				environment: "staging"
+				team: "envteam"
+				reason: "The member is limited to the dev environment"
+			}) {
+				values {
+					name
+				}
+			}
+		}
+	]]
+
+	t.check {
+		errors = {
+			{
+				locations = NotNull(),
+				message = Contains("You are authenticated"),
+				path = {
+					"viewSecretValues",
+				},
+			},
+		},
+		data = Null,
+	}
+end)
+
+Test.gql("Environments are kept when the role changes", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			setTeamMemberRole(input: {
+				teamSlug: "envteam"
+				userEmail: "env-member@example.com"
+				role: OWNER
+			}) {
+				member {
+					role
+					environments
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			setTeamMemberRole = {
+				member = {
+					role = "OWNER",
+					environments = { "dev" },
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Remove environment limit", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		mutation {
+			setTeamMemberEnvironments(input: {
+				teamSlug: "envteam"
+				userEmail: "env-member@example.com"
+				environments: null
+			}) {
+				member {
+					environments
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			setTeamMemberEnvironments = {
+				member = {
+					environments = Null,
+				},
+			},
+		},
+	}
+end)
+
+Test.gql("Member can delete secret in staging", function(t)
+	t.addHeader("x-user-email", member:email())
+
+	t.query [[
+		mutation {
+			deleteSecret(input: {
+				name: "scoped-secret"
+				environment: "staging"
+				team: "envteam"
+			}) {
+				secretDeleted
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			deleteSecret = {
+				secretDeleted = true,
+			},
+		},
+	}
+end)
+
+Test.gql("Activity log", function(t)
+	t.addHeader("x-user-email", owner:email())
+
+	t.query [[
+		query {
+			team(slug: "envteam") {
+				activityLog(filter: { activityTypes: [TEAM_MEMBER_SET_ENVIRONMENTS] }) {
+					nodes {
+						message
+						... on TeamMemberSetEnvironmentsActivityLogEntry {
+							data {
+								environments
+								userEmail
+							}
+						}
+					}
+				}
+			}
+		}
+	]]
+
+	t.check {
+		data = {
+			team = {
+				activityLog = {
+					nodes = {
+						{
+							message = "Set member environments",
+							data = {
+								environments = Null,
+								userEmail = "env-member@example.com",
+							},
+						},
+						{
+							message = "Set member environments",
+							data = {
+								environments = { "dev" },
+								userEmail = "env-member@example.com",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+end)
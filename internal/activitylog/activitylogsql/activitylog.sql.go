@@ -11,6 +11,41 @@ import (
 	"github.com/nais/api/internal/slug"
 )
 
+const archiveEntries = `-- name: ArchiveEntries :exec
+INSERT INTO
+	activity_log_entries_archive (
+		id,
+		created_at,
+		actor,
+		action,
+		resource_type,
+		resource_name,
+		team_slug,
+		data,
+		environment
+	)
+SELECT
+	id,
+	created_at,
+	actor,
+	action,
+	resource_type,
+	resource_name,
+	team_slug,
+	data,
+	environment
+FROM
+	activity_log_entries
+WHERE
+	id = ANY ($1::UUID[])
+ON CONFLICT (id) DO NOTHING
+`
+
+func (q *Queries) ArchiveEntries(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, archiveEntries, ids)
+	return err
+}
+
 const create = `-- name: Create :exec
 INSERT INTO
 	activity_log_entries (
@@ -57,6 +92,52 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) error {
 	return err
 }
 
+const createArchiveFile = `-- name: CreateArchiveFile :exec
+INSERT INTO
+	activity_log_archive_files (location, team_slug, oldest, newest, entry_count)
+VALUES
+	(
+		$1,
+		$2,
+		$3,
+		$4,
+		$5
+	)
+`
+
+type CreateArchiveFileParams struct {
+	Location   string
+	TeamSlug   *slug.Slug
+	Oldest     pgtype.Timestamptz
+	Newest     pgtype.Timestamptz
+	EntryCount int32
+}
+
+func (q *Queries) CreateArchiveFile(ctx context.Context, arg CreateArchiveFileParams) error {
+	_, err := q.db.Exec(ctx, createArchiveFile,
+		arg.Location,
+		arg.TeamSlug,
+		arg.Oldest,
+		arg.Newest,
+		arg.EntryCount,
+	)
+	return err
+}
+
+const deleteEntries = `-- name: DeleteEntries :execrows
+DELETE FROM activity_log_entries
+WHERE
+	id = ANY ($1::UUID[])
+`
+
+func (q *Queries) DeleteEntries(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEntries, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const facetsForActivityTypes = `-- name: FacetsForActivityTypes :many
 SELECT
 	resource_type,
@@ -209,6 +290,154 @@ func (q *Queries) Get(ctx context.Context, id uuid.UUID) (*ActivityLogCombinedVi
 	return &i, err
 }
 
+const listArchiveFilesForTeam = `-- name: ListArchiveFilesForTeam :many
+SELECT
+	id, location, team_slug, oldest, newest, entry_count, created_at
+FROM
+	activity_log_archive_files
+WHERE
+	team_slug = $1
+	AND (
+		$2::TIMESTAMPTZ IS NULL
+		OR newest >= $2::TIMESTAMPTZ
+	)
+	AND (
+		$3::TIMESTAMPTZ IS NULL
+		OR oldest < $3::TIMESTAMPTZ
+	)
+ORDER BY
+	oldest ASC
+`
+
+type ListArchiveFilesForTeamParams struct {
+	TeamSlug *slug.Slug
+	From     pgtype.Timestamptz
+	To       pgtype.Timestamptz
+}
+
+func (q *Queries) ListArchiveFilesForTeam(ctx context.Context, arg ListArchiveFilesForTeamParams) ([]*ActivityLogArchiveFile, error) {
+	rows, err := q.db.Query(ctx, listArchiveFilesForTeam, arg.TeamSlug, arg.From, arg.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ActivityLogArchiveFile{}
+	for rows.Next() {
+		var i ActivityLogArchiveFile
+		if err := rows.Scan(
+			&i.ID,
+			&i.Location,
+			&i.TeamSlug,
+			&i.Oldest,
+			&i.Newest,
+			&i.EntryCount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArchivedForTeamExport = `-- name: ListArchivedForTeamExport :many
+SELECT
+	id,
+	created_at,
+	actor,
+	action,
+	resource_type,
+	resource_name,
+	team_slug,
+	data,
+	environment
+FROM
+	activity_log_entries_archive
+WHERE
+	team_slug = $1
+	AND (
+		$2::TIMESTAMPTZ IS NULL
+		OR created_at >= $2::TIMESTAMPTZ
+	)
+	AND (
+		$3::TIMESTAMPTZ IS NULL
+		OR created_at < $3::TIMESTAMPTZ
+	)
+	AND (
+		$4::TIMESTAMPTZ IS NULL
+		OR (created_at, id) > (
+			$4::TIMESTAMPTZ,
+			$5::UUID
+		)
+	)
+ORDER BY
+	created_at ASC,
+	id ASC
+LIMIT
+	$6
+`
+
+type ListArchivedForTeamExportParams struct {
+	TeamSlug       *slug.Slug
+	From           pgtype.Timestamptz
+	To             pgtype.Timestamptz
+	AfterCreatedAt pgtype.Timestamptz
+	AfterID        *uuid.UUID
+	Limit          int32
+}
+
+type ListArchivedForTeamExportRow struct {
+	ID           uuid.UUID
+	CreatedAt    pgtype.Timestamptz
+	Actor        string
+	Action       string
+	ResourceType string
+	ResourceName string
+	TeamSlug     *slug.Slug
+	Data         []byte
+	Environment  *string
+}
+
+func (q *Queries) ListArchivedForTeamExport(ctx context.Context, arg ListArchivedForTeamExportParams) ([]*ListArchivedForTeamExportRow, error) {
+	rows, err := q.db.Query(ctx, listArchivedForTeamExport,
+		arg.TeamSlug,
+		arg.From,
+		arg.To,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListArchivedForTeamExportRow{}
+	for rows.Next() {
+		var i ListArchivedForTeamExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Actor,
+			&i.Action,
+			&i.ResourceType,
+			&i.ResourceName,
+			&i.TeamSlug,
+			&i.Data,
+			&i.Environment,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listByIDs = `-- name: ListByIDs :many
 SELECT
 	id, created_at, actor, action, resource_type, resource_name, team_slug, data, environment
@@ -250,6 +479,66 @@ func (q *Queries) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*ActivityLo
 	return items, nil
 }
 
+const listExpired = `-- name: ListExpired :many
+SELECT
+	id, created_at, actor, action, resource_type, resource_name, team_slug, data, environment
+FROM
+	activity_log_entries
+WHERE
+	created_at < $1
+	AND (
+		$2::TEXT IS NULL
+		OR resource_type = $2::TEXT
+	)
+	AND NOT (resource_type = ANY ($3::TEXT[]))
+ORDER BY
+	created_at ASC
+LIMIT
+	$4
+`
+
+type ListExpiredParams struct {
+	Cutoff                pgtype.Timestamptz
+	ResourceType          *string
+	ExcludedResourceTypes []string
+	Limit                 int32
+}
+
+func (q *Queries) ListExpired(ctx context.Context, arg ListExpiredParams) ([]*ActivityLogEntry, error) {
+	rows, err := q.db.Query(ctx, listExpired,
+		arg.Cutoff,
+		arg.ResourceType,
+		arg.ExcludedResourceTypes,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ActivityLogEntry{}
+	for rows.Next() {
+		var i ActivityLogEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Actor,
+			&i.Action,
+			&i.ResourceType,
+			&i.ResourceName,
+			&i.TeamSlug,
+			&i.Data,
+			&i.Environment,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listForResource = `-- name: ListForResource :many
 SELECT
 	activity_log_combined_view.id, activity_log_combined_view.created_at, activity_log_combined_view.actor, activity_log_combined_view.action, activity_log_combined_view.resource_type, activity_log_combined_view.resource_name, activity_log_combined_view.team_slug, activity_log_combined_view.data, activity_log_combined_view.environment,
@@ -538,6 +827,81 @@ func (q *Queries) ListForTeam(ctx context.Context, arg ListForTeamParams) ([]*Li
 	return items, nil
 }
 
+const listForTeamExport = `-- name: ListForTeamExport :many
+SELECT
+	id, created_at, actor, action, resource_type, resource_name, team_slug, data, environment
+FROM
+	activity_log_combined_view
+WHERE
+	team_slug = $1
+	AND (
+		$2::TIMESTAMPTZ IS NULL
+		OR created_at >= $2::TIMESTAMPTZ
+	)
+	AND (
+		$3::TIMESTAMPTZ IS NULL
+		OR created_at < $3::TIMESTAMPTZ
+	)
+	AND (
+		$4::TIMESTAMPTZ IS NULL
+		OR (created_at, id) > (
+			$4::TIMESTAMPTZ,
+			$5::UUID
+		)
+	)
+ORDER BY
+	created_at ASC,
+	id ASC
+LIMIT
+	$6
+`
+
+type ListForTeamExportParams struct {
+	TeamSlug       *slug.Slug
+	From           pgtype.Timestamptz
+	To             pgtype.Timestamptz
+	AfterCreatedAt pgtype.Timestamptz
+	AfterID        *uuid.UUID
+	Limit          int32
+}
+
+func (q *Queries) ListForTeamExport(ctx context.Context, arg ListForTeamExportParams) ([]*ActivityLogCombinedView, error) {
+	rows, err := q.db.Query(ctx, listForTeamExport,
+		arg.TeamSlug,
+		arg.From,
+		arg.To,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ActivityLogCombinedView{}
+	for rows.Next() {
+		var i ActivityLogCombinedView
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Actor,
+			&i.Action,
+			&i.ResourceType,
+			&i.ResourceName,
+			&i.TeamSlug,
+			&i.Data,
+			&i.Environment,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listForTenant = `-- name: ListForTenant :many
 SELECT
 	activity_log_combined_view.id, activity_log_combined_view.created_at, activity_log_combined_view.actor, activity_log_combined_view.action, activity_log_combined_view.resource_type, activity_log_combined_view.resource_name, activity_log_combined_view.team_slug, activity_log_combined_view.data, activity_log_combined_view.environment,
@@ -8,6 +8,16 @@ import (
 	"github.com/nais/api/internal/slug"
 )
 
+type ActivityLogArchiveFile struct {
+	ID         uuid.UUID
+	Location   string
+	TeamSlug   *slug.Slug
+	Oldest     pgtype.Timestamptz
+	Newest     pgtype.Timestamptz
+	EntryCount int32
+	CreatedAt  pgtype.Timestamptz
+}
+
 type ActivityLogCombinedView struct {
 	ID           uuid.UUID
 	CreatedAt    pgtype.Timestamptz
@@ -19,3 +29,15 @@ type ActivityLogCombinedView struct {
 	Data         []byte
 	Environment  *string
 }
+
+type ActivityLogEntry struct {
+	ID           uuid.UUID
+	CreatedAt    pgtype.Timestamptz
+	Actor        string
+	Action       string
+	ResourceType string
+	ResourceName string
+	TeamSlug     *slug.Slug
+	Data         []byte
+	Environment  *string
+}
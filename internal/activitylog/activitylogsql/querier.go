@@ -9,13 +9,20 @@ import (
 )
 
 type Querier interface {
+	ArchiveEntries(ctx context.Context, ids []uuid.UUID) error
 	Create(ctx context.Context, arg CreateParams) error
+	CreateArchiveFile(ctx context.Context, arg CreateArchiveFileParams) error
+	DeleteEntries(ctx context.Context, ids []uuid.UUID) (int64, error)
 	FacetsForActivityTypes(ctx context.Context, arg FacetsForActivityTypesParams) ([]*FacetsForActivityTypesRow, error)
 	Get(ctx context.Context, id uuid.UUID) (*ActivityLogCombinedView, error)
+	ListArchiveFilesForTeam(ctx context.Context, arg ListArchiveFilesForTeamParams) ([]*ActivityLogArchiveFile, error)
+	ListArchivedForTeamExport(ctx context.Context, arg ListArchivedForTeamExportParams) ([]*ListArchivedForTeamExportRow, error)
 	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*ActivityLogCombinedView, error)
+	ListExpired(ctx context.Context, arg ListExpiredParams) ([]*ActivityLogEntry, error)
 	ListForResource(ctx context.Context, arg ListForResourceParams) ([]*ListForResourceRow, error)
 	ListForResourceTeamAndEnvironment(ctx context.Context, arg ListForResourceTeamAndEnvironmentParams) ([]*ListForResourceTeamAndEnvironmentRow, error)
 	ListForTeam(ctx context.Context, arg ListForTeamParams) ([]*ListForTeamRow, error)
+	ListForTeamExport(ctx context.Context, arg ListForTeamExportParams) ([]*ActivityLogCombinedView, error)
 	ListForTenant(ctx context.Context, arg ListForTenantParams) ([]*ListForTenantRow, error)
 	RefreshMaterializedView(ctx context.Context) error
 }
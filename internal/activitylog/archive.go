@@ -0,0 +1,331 @@
+package activitylog
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/activitylog/activitylogsql"
+	"github.com/nais/api/internal/slug"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/storage/v1"
+)
+
+// archiver moves expired entries out of the activity log. It is called within the transaction deleting the entries,
+// using q. The returned undo function, if any, removes what was archived outside of the database, and is called when
+// the transaction fails.
+type archiver interface {
+	archive(ctx context.Context, q *activitylogsql.Queries, entries []*activitylogsql.ActivityLogEntry) (undo func(context.Context), err error)
+}
+
+func newArchiver(ctx context.Context, archive string, log logrus.FieldLogger) (archiver, error) {
+	if archive == "" {
+		return tableArchiver{}, nil
+	}
+
+	store, err := openFileStore(ctx, archive)
+	if err != nil {
+		return nil, err
+	}
+	return &fileArchiver{store: store, log: log}, nil
+}
+
+// tableArchiver moves entries to the activity_log_entries_archive table.
+type tableArchiver struct{}
+
+func (tableArchiver) archive(ctx context.Context, q *activitylogsql.Queries, entries []*activitylogsql.ActivityLogEntry) (func(context.Context), error) {
+	ids := make([]uuid.UUID, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+	return nil, q.ArchiveEntries(ctx, ids)
+}
+
+// fileArchiver writes entries to gzip-compressed JSON lines files, one file per team per batch, and indexes the files
+// in the activity_log_archive_files table. Files are named after the first and last entry in them, so archiving the
+// same batch again overwrites the file instead of creating a duplicate.
+type fileArchiver struct {
+	store fileStore
+	log   logrus.FieldLogger
+}
+
+// archivedEntry is the format of entries in archive files.
+type archivedEntry struct {
+	ID           uuid.UUID       `json:"id"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	Actor        string          `json:"actor"`
+	Action       string          `json:"action"`
+	ResourceType string          `json:"resourceType"`
+	ResourceName string          `json:"resourceName"`
+	TeamSlug     *slug.Slug      `json:"teamSlug,omitempty"`
+	Data         json.RawMessage `json:"data,omitempty"`
+	Environment  *string         `json:"environment,omitempty"`
+}
+
+func (a *fileArchiver) archive(ctx context.Context, q *activitylogsql.Queries, entries []*activitylogsql.ActivityLogEntry) (func(context.Context), error) {
+	var written []string
+	undo := func(ctx context.Context) {
+		for _, location := range written {
+			if err := a.store.delete(ctx, location); err != nil {
+				a.log.WithError(err).WithField("location", location).Error("delete archive file")
+			}
+		}
+	}
+
+	if err := a.archiveByTeam(ctx, q, entries, func(location string) { written = append(written, location) }); err != nil {
+		undo(context.WithoutCancel(ctx))
+		return nil, err
+	}
+	return undo, nil
+}
+
+// archiveByTeam writes and indexes one archive file per team, calling onWrite with the location of every written file.
+func (a *fileArchiver) archiveByTeam(ctx context.Context, q *activitylogsql.Queries, entries []*activitylogsql.ActivityLogEntry, onWrite func(location string)) error {
+	byTeam := map[string][]*activitylogsql.ActivityLogEntry{}
+	for _, entry := range entries {
+		key := ""
+		if entry.TeamSlug != nil {
+			key = entry.TeamSlug.String()
+		}
+		byTeam[key] = append(byTeam[key], entry)
+	}
+
+	for team, entries := range byTeam {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		enc := json.NewEncoder(gz)
+		for _, entry := range entries {
+			var data json.RawMessage
+			if json.Valid(entry.Data) {
+				data = entry.Data
+			}
+
+			if err := enc.Encode(archivedEntry{
+				ID:           entry.ID,
+				CreatedAt:    entry.CreatedAt.Time,
+				Actor:        entry.Actor,
+				Action:       entry.Action,
+				ResourceType: entry.ResourceType,
+				ResourceName: entry.ResourceName,
+				TeamSlug:     entry.TeamSlug,
+				Data:         data,
+				Environment:  entry.Environment,
+			}); err != nil {
+				return fmt.Errorf("encode entry: %w", err)
+			}
+		}
+		if err := gz.Close(); err != nil {
+			return fmt.Errorf("compress entries: %w", err)
+		}
+
+		// Entries are ordered by creation time, so the first and last entries are the oldest and newest.
+		oldest, newest := entries[0].CreatedAt.Time, entries[len(entries)-1].CreatedAt.Time
+
+		dir := team
+		if dir == "" {
+			dir = "_global"
+		}
+		name := path.Join(dir, fmt.Sprintf("%s-%s-%s.ndjson.gz", oldest.UTC().Format("20060102T150405Z"), entries[0].ID, entries[len(entries)-1].ID))
+
+		location, err := a.store.write(ctx, name, buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("write archive file: %w", err)
+		}
+		onWrite(location)
+
+		var teamSlug *slug.Slug
+		if team != "" {
+			teamSlug = new(slug.Slug(team))
+		}
+
+		if err := q.CreateArchiveFile(ctx, activitylogsql.CreateArchiveFileParams{
+			Location:   location,
+			TeamSlug:   teamSlug,
+			Oldest:     pgtype.Timestamptz{Time: oldest, Valid: true},
+			Newest:     pgtype.Timestamptz{Time: newest, Valid: true},
+			EntryCount: int32(len(entries)), // #nosec G115
+		}); err != nil {
+			return fmt.Errorf("index archive file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readArchiveFile calls fn for every entry in the archive file at location.
+func readArchiveFile(ctx context.Context, store fileStore, location string, fn func(*activitylogsql.ActivityLogCombinedView) error) error {
+	r, err := store.read(ctx, location)
+	if err != nil {
+		return fmt.Errorf("read archive file %q: %w", location, err)
+	}
+	defer r.Close()
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("decompress archive file %q: %w", location, err)
+	}
+	defer gz.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(gz))
+	for {
+		var entry archivedEntry
+		if err := dec.Decode(&entry); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("decode archive file %q: %w", location, err)
+		}
+
+		if err := fn(&activitylogsql.ActivityLogCombinedView{
+			ID:           entry.ID,
+			CreatedAt:    pgtype.Timestamptz{Time: entry.CreatedAt, Valid: true},
+			Actor:        entry.Actor,
+			Action:       entry.Action,
+			ResourceType: entry.ResourceType,
+			ResourceName: entry.ResourceName,
+			TeamSlug:     entry.TeamSlug,
+			Data:         entry.Data,
+			Environment:  entry.Environment,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// fileStore stores archive files. Files are addressed by the location returned when writing them.
+type fileStore interface {
+	write(ctx context.Context, name string, data []byte) (location string, err error)
+	read(ctx context.Context, location string) (io.ReadCloser, error)
+	delete(ctx context.Context, location string) error
+}
+
+// fileStores opens the stores for reading archive files on demand, and reuses them for files with the same URL scheme,
+// as opening a store may create a client.
+type fileStores map[string]fileStore
+
+func (s fileStores) forLocation(ctx context.Context, location string) (fileStore, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("parse archive file location %q: %w", location, err)
+	}
+
+	if store, ok := s[u.Scheme]; ok {
+		return store, nil
+	}
+
+	store, err := openFileStore(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	s[u.Scheme] = store
+	return store, nil
+}
+
+// openFileStore returns the store for the given file:// or gs:// URL.
+func openFileStore(ctx context.Context, rawURL string) (fileStore, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse archive URL %q: %w", rawURL, err)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return localFileStore{dir: u.Path}, nil
+	case "gs":
+		svc, err := storage.NewService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("create storage client: %w", err)
+		}
+		return &gcsFileStore{objects: svc.Objects, bucket: u.Host, prefix: strings.TrimPrefix(u.Path, "/")}, nil
+	default:
+		return nil, fmt.Errorf("unsupported archive URL %q", rawURL)
+	}
+}
+
+type localFileStore struct {
+	dir string
+}
+
+func (s localFileStore) write(_ context.Context, name string, data []byte) (string, error) {
+	p := filepath.Join(s.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file first, so a partially written file is never indexed.
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		return "", err
+	}
+
+	return (&url.URL{Scheme: "file", Path: p}).String(), nil
+}
+
+func (s localFileStore) read(_ context.Context, location string) (io.ReadCloser, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(u.Path)
+}
+
+func (s localFileStore) delete(_ context.Context, location string) error {
+	u, err := url.Parse(location)
+	if err != nil {
+		return err
+	}
+	return os.Remove(u.Path)
+}
+
+type gcsFileStore struct {
+	objects *storage.ObjectsService
+	bucket  string
+	prefix  string
+}
+
+func (s *gcsFileStore) write(ctx context.Context, name string, data []byte) (string, error) {
+	obj, err := s.objects.Insert(s.bucket, &storage.Object{
+		Name:        path.Join(s.prefix, name),
+		ContentType: "application/gzip",
+	}).Media(bytes.NewReader(data)).Context(ctx).Do()
+	if err != nil {
+		return "", err
+	}
+
+	return "gs://" + obj.Bucket + "/" + obj.Name, nil
+}
+
+func (s *gcsFileStore) read(ctx context.Context, location string) (io.ReadCloser, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := s.objects.Get(u.Host, strings.TrimPrefix(u.Path, "/")).Context(ctx).Download()
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Body, nil
+}
+
+func (s *gcsFileStore) delete(ctx context.Context, location string) error {
+	u, err := url.Parse(location)
+	if err != nil {
+		return err
+	}
+	return s.objects.Delete(u.Host, strings.TrimPrefix(u.Path, "/")).Context(ctx).Do()
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nais/api/internal/activitylog/activitylogsql"
 	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/database/notify"
 	"github.com/nais/api/internal/graph/loader"
+	"github.com/sirupsen/logrus"
 	"github.com/vikstrous/dataloadgen"
 )
 
@@ -15,8 +17,8 @@ type ctxKey int
 
 const loadersKey ctxKey = iota
 
-func NewLoaderContext(ctx context.Context, dbConn *pgxpool.Pool) context.Context {
-	return context.WithValue(ctx, loadersKey, newLoaders(dbConn))
+func NewLoaderContext(ctx context.Context, dbConn *pgxpool.Pool, notifier *notify.Notifier, log logrus.FieldLogger) context.Context {
+	return context.WithValue(ctx, loadersKey, newLoaders(dbConn, notifier, log))
 }
 
 func fromContext(ctx context.Context) *loaders {
@@ -26,9 +28,11 @@ func fromContext(ctx context.Context) *loaders {
 type loaders struct {
 	internalQuerier   *activitylogsql.Queries
 	activityLogLoader *dataloadgen.Loader[uuid.UUID, ActivityLogEntry]
+	notifier          *notify.Notifier
+	log               logrus.FieldLogger
 }
 
-func newLoaders(dbConn *pgxpool.Pool) *loaders {
+func newLoaders(dbConn *pgxpool.Pool, notifier *notify.Notifier, log logrus.FieldLogger) *loaders {
 	db := activitylogsql.New(dbConn)
 
 	activityLogLoader := &dataloader{db: db}
@@ -36,6 +40,8 @@ func newLoaders(dbConn *pgxpool.Pool) *loaders {
 	return &loaders{
 		internalQuerier:   db,
 		activityLogLoader: dataloadgen.NewLoader(activityLogLoader.get, loader.DefaultDataLoaderOptions...),
+		notifier:          notifier,
+		log:               log,
 	}
 }
 
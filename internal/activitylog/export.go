@@ -0,0 +1,256 @@
+package activitylog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/activitylog/activitylogsql"
+	"github.com/nais/api/internal/slug"
+)
+
+// exportBatchSize is the number of entries read from the database at a time when exporting
+const exportBatchSize = 500
+
+// ExportEntry is an activity log entry with its data flattened, suitable for exporting.
+type ExportEntry struct {
+	ID              uuid.UUID `json:"id"`
+	CreatedAt       time.Time `json:"createdAt"`
+	Actor           string    `json:"actor"`
+	Action          string    `json:"action"`
+	ResourceType    string    `json:"resourceType"`
+	ResourceName    string    `json:"resourceName"`
+	TeamSlug        string    `json:"teamSlug"`
+	EnvironmentName string    `json:"environmentName"`
+	Message         string    `json:"message"`
+
+	// Data is the typed data of the entry, flattened to a single level. Nested fields are joined with ".", and list
+	// items are keyed by their index, e.g. "updatedFields.0.field".
+	Data map[string]any `json:"data"`
+}
+
+// ExportForTeam calls fn with every activity log entry for the team created in the given time range, oldest first.
+// From and to are optional, and to is exclusive. Entries are read in batches, so the full log is never held in
+// memory.
+func ExportForTeam(ctx context.Context, teamSlug slug.Slug, from, to *time.Time, fn func(*ExportEntry) error) error {
+	q := db(ctx)
+
+	params := activitylogsql.ListForTeamExportParams{
+		TeamSlug: &teamSlug,
+		From:     withFrom(&ActivityLogFilter{From: from}),
+		To:       withTo(&ActivityLogFilter{To: to}),
+		Limit:    exportBatchSize,
+	}
+
+	for {
+		rows, err := q.ListForTeamExport(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			entry, err := toExportEntry(row)
+			if err != nil {
+				return err
+			}
+
+			if err := fn(entry); err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < exportBatchSize {
+			return nil
+		}
+
+		last := rows[len(rows)-1]
+		params.AfterCreatedAt = pgtype.Timestamptz{Time: last.CreatedAt.Time, Valid: true}
+		params.AfterID = &last.ID
+	}
+}
+
+func toExportEntry(row *activitylogsql.ActivityLogCombinedView) (*ExportEntry, error) {
+	entry, err := toGraphActivityLogEntry(row)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := entryData(entry)
+	if err != nil {
+		return nil, fmt.Errorf("activity log entry %s: %w", row.ID, err)
+	}
+
+	ret := &ExportEntry{
+		ID:           row.ID,
+		CreatedAt:    row.CreatedAt.Time.UTC(),
+		Actor:        row.Actor,
+		Action:       row.Action,
+		ResourceType: row.ResourceType,
+		ResourceName: row.ResourceName,
+		Data:         map[string]any{},
+	}
+	if row.TeamSlug != nil {
+		ret.TeamSlug = row.TeamSlug.String()
+	}
+	if row.Environment != nil {
+		ret.EnvironmentName = *row.Environment
+	}
+	if generic, ok := genericEntry(entry); ok {
+		ret.Message = generic.Message
+	}
+
+	flatten("", data, ret.Data)
+	return ret, nil
+}
+
+// entryData returns the typed data of the entry, as decoded JSON. Entries without typed data fall back to the raw
+// data stored with the entry.
+func entryData(entry ActivityLogEntry) (any, error) {
+	var b []byte
+	if e, ok := entry.(ActivityLogEntryWithData); ok {
+		var err error
+		if b, err = json.Marshal(e.GetData()); err != nil {
+			return nil, fmt.Errorf("marshal data: %w", err)
+		}
+	} else if generic, ok := genericEntry(entry); ok {
+		b = generic.Data
+	}
+
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	var ret any
+	if err := json.Unmarshal(b, &ret); err != nil {
+		return nil, fmt.Errorf("unmarshal data: %w", err)
+	}
+	return ret, nil
+}
+
+// embedsGeneric is implemented by GenericActivityLogEntry, and by all entries embedding it.
+type embedsGeneric interface {
+	generic() GenericActivityLogEntry
+}
+
+// genericEntry returns the GenericActivityLogEntry of the entry.
+func genericEntry(entry ActivityLogEntry) (GenericActivityLogEntry, bool) {
+	e, ok := entry.(embedsGeneric)
+	if !ok {
+		return GenericActivityLogEntry{}, false
+	}
+	return e.generic(), true
+}
+
+func flatten(prefix string, value any, into map[string]any) {
+	key := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for k, child := range v {
+			flatten(key(k), child, into)
+		}
+	case []any:
+		for i, child := range v {
+			flatten(key(strconv.Itoa(i)), child, into)
+		}
+	case nil:
+		if prefix != "" {
+			into[prefix] = nil
+		}
+	default:
+		into[prefix] = v
+	}
+}
+
+// ExportArchivedForTeam calls fn with every archived activity log entry for the team created in the given time range.
+// Entries in the archive table are exported first, followed by entries in archive files. Entries are ordered oldest
+// first within each.
+func ExportArchivedForTeam(ctx context.Context, teamSlug slug.Slug, from, to *time.Time, fn func(*ExportEntry) error) error {
+	q := db(ctx)
+
+	params := activitylogsql.ListArchivedForTeamExportParams{
+		TeamSlug: &teamSlug,
+		From:     withFrom(&ActivityLogFilter{From: from}),
+		To:       withTo(&ActivityLogFilter{To: to}),
+		Limit:    exportBatchSize,
+	}
+
+	for {
+		rows, err := q.ListArchivedForTeamExport(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			entry, err := toExportEntry(&activitylogsql.ActivityLogCombinedView{
+				ID:           row.ID,
+				CreatedAt:    row.CreatedAt,
+				Actor:        row.Actor,
+				Action:       row.Action,
+				ResourceType: row.ResourceType,
+				ResourceName: row.ResourceName,
+				TeamSlug:     row.TeamSlug,
+				Data:         row.Data,
+				Environment:  row.Environment,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := fn(entry); err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < exportBatchSize {
+			break
+		}
+
+		last := rows[len(rows)-1]
+		params.AfterCreatedAt = pgtype.Timestamptz{Time: last.CreatedAt.Time, Valid: true}
+		params.AfterID = &last.ID
+	}
+
+	files, err := q.ListArchiveFilesForTeam(ctx, activitylogsql.ListArchiveFilesForTeamParams{
+		TeamSlug: &teamSlug,
+		From:     params.From,
+		To:       params.To,
+	})
+	if err != nil {
+		return err
+	}
+
+	stores := fileStores{}
+	for _, file := range files {
+		store, err := stores.forLocation(ctx, file.Location)
+		if err != nil {
+			return err
+		}
+
+		err = readArchiveFile(ctx, store, file.Location, func(row *activitylogsql.ActivityLogCombinedView) error {
+			if (from != nil && row.CreatedAt.Time.Before(*from)) || (to != nil && !row.CreatedAt.Time.Before(*to)) {
+				return nil
+			}
+
+			entry, err := toExportEntry(row)
+			if err != nil {
+				return err
+			}
+			return fn(entry)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
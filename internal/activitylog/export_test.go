@@ -0,0 +1,72 @@
+package activitylog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type exportTestEntry struct {
+	GenericActivityLogEntry
+	Data *exportTestEntryData
+}
+
+func (e exportTestEntry) GetData() any {
+	return e.Data
+}
+
+type exportTestEntryData struct {
+	Name   string   `json:"name"`
+	Fields []string `json:"fields"`
+}
+
+func TestEntryData(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry ActivityLogEntry
+		want  any
+	}{
+		{
+			name: "typed data is used when available",
+			entry: exportTestEntry{
+				GenericActivityLogEntry: GenericActivityLogEntry{Data: []byte(`{"raw":true}`)},
+				Data:                    &exportTestEntryData{Name: "name", Fields: []string{"a"}},
+			},
+			want: map[string]any{"name": "name", "fields": []any{"a"}},
+		},
+		{
+			name:  "entries without typed data fall back to the raw data",
+			entry: GenericActivityLogEntry{Data: []byte(`{"raw":true}`)},
+			want:  map[string]any{"raw": true},
+		},
+		{
+			name:  "nil typed data",
+			entry: exportTestEntry{},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := entryData(tt.entry)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("diff -want +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenericEntry(t *testing.T) {
+	entry := exportTestEntry{GenericActivityLogEntry: GenericActivityLogEntry{Message: "message"}}
+
+	got, ok := genericEntry(entry)
+	if !ok {
+		t.Fatal("expected entry to embed GenericActivityLogEntry")
+	}
+	if got.Message != "message" {
+		t.Errorf("expected message %q, got %q", "message", got.Message)
+	}
+}
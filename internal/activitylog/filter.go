@@ -4,6 +4,7 @@ import (
 	"slices"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/activitylog/activitylogsql"
 )
 
 type filter struct {
@@ -108,3 +109,23 @@ func withTo(filter *ActivityLogFilter) pgtype.Timestamptz {
 
 	return pgtype.Timestamptz{Time: *filter.To, Valid: true}
 }
+
+// matchesFilter reports whether the row matches the filter, using the same rules as the list queries.
+func matchesFilter(row *activitylogsql.ActivityLogCombinedView, filter *ActivityLogFilter) bool {
+	if f := withFilters(filter); f != nil && !slices.Contains(f, row.ResourceType+":"+row.Action) {
+		return false
+	}
+	if rt := withResourceTypes(filter); rt != nil && !slices.Contains(rt, row.ResourceType) {
+		return false
+	}
+	if envs := withEnvironments(filter); envs != nil && (row.Environment == nil || !slices.Contains(envs, *row.Environment)) {
+		return false
+	}
+	if from := withFrom(filter); from.Valid && row.CreatedAt.Time.Before(from.Time) {
+		return false
+	}
+	if to := withTo(filter); to.Valid && !row.CreatedAt.Time.Before(to.Time) {
+		return false
+	}
+	return true
+}
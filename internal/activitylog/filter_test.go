@@ -0,0 +1,177 @@
+package activitylog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/activitylog/activitylogsql"
+	"github.com/nais/api/internal/slug"
+)
+
+func TestMatchesFilter(t *testing.T) {
+	const (
+		testResourceType      ActivityLogEntryResourceType = "FILTER_TEST"
+		testOtherResourceType ActivityLogEntryResourceType = "FILTER_TEST_OTHER"
+		testCreated           ActivityLogActivityType      = "FILTER_TEST_CREATED"
+		testDeleted           ActivityLogActivityType      = "FILTER_TEST_DELETED"
+		testUnknown           ActivityLogActivityType      = "FILTER_TEST_UNKNOWN"
+	)
+	RegisterFilter(testCreated, ActivityLogEntryActionCreated, testResourceType)
+	RegisterFilter(testDeleted, ActivityLogEntryActionDeleted, testResourceType)
+
+	createdAt := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	row := &activitylogsql.ActivityLogCombinedView{
+		CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+		Action:       string(ActivityLogEntryActionCreated),
+		ResourceType: string(testResourceType),
+		TeamSlug:     new(slug.Slug("team")),
+		Environment:  new("dev"),
+	}
+	teamRow := &activitylogsql.ActivityLogCombinedView{
+		CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+		Action:       string(ActivityLogEntryActionCreated),
+		ResourceType: string(testResourceType),
+		TeamSlug:     new(slug.Slug("team")),
+	}
+	globalRow := &activitylogsql.ActivityLogCombinedView{
+		CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+		Action:       string(ActivityLogEntryActionCreated),
+		ResourceType: string(testResourceType),
+	}
+
+	tests := []struct {
+		name   string
+		row    *activitylogsql.ActivityLogCombinedView
+		filter *ActivityLogFilter
+		want   bool
+	}{
+		{
+			name: "no filter",
+			row:  row,
+			want: true,
+		},
+		{
+			name:   "empty filter",
+			row:    row,
+			filter: &ActivityLogFilter{},
+			want:   true,
+		},
+		{
+			name:   "no filter matches entries without team",
+			row:    globalRow,
+			filter: &ActivityLogFilter{},
+			want:   true,
+		},
+		{
+			name:   "matching activity type",
+			row:    row,
+			filter: &ActivityLogFilter{ActivityTypes: []ActivityLogActivityType{testDeleted, testCreated}},
+			want:   true,
+		},
+		{
+			name:   "activity type with other action",
+			row:    row,
+			filter: &ActivityLogFilter{ActivityTypes: []ActivityLogActivityType{testDeleted}},
+			want:   false,
+		},
+		{
+			name:   "unknown activity type",
+			row:    row,
+			filter: &ActivityLogFilter{ActivityTypes: []ActivityLogActivityType{testUnknown}},
+			want:   true,
+		},
+		{
+			name:   "matching resource type",
+			row:    row,
+			filter: &ActivityLogFilter{ResourceTypes: []ActivityLogEntryResourceType{testOtherResourceType, testResourceType}},
+			want:   true,
+		},
+		{
+			name:   "other resource type",
+			row:    row,
+			filter: &ActivityLogFilter{ResourceTypes: []ActivityLogEntryResourceType{testOtherResourceType}},
+			want:   false,
+		},
+		{
+			name:   "matching environment",
+			row:    row,
+			filter: &ActivityLogFilter{Environments: []string{"prod", "dev"}},
+			want:   true,
+		},
+		{
+			name:   "other environment",
+			row:    row,
+			filter: &ActivityLogFilter{Environments: []string{"prod"}},
+			want:   false,
+		},
+		{
+			name:   "team entry without environment is excluded by environment filter",
+			row:    teamRow,
+			filter: &ActivityLogFilter{Environments: []string{"dev"}},
+			want:   false,
+		},
+		{
+			name:   "team entry without environment matches other filters",
+			row:    teamRow,
+			filter: &ActivityLogFilter{ActivityTypes: []ActivityLogActivityType{testCreated}},
+			want:   true,
+		},
+		{
+			name:   "from is inclusive",
+			row:    row,
+			filter: &ActivityLogFilter{From: new(createdAt)},
+			want:   true,
+		},
+		{
+			name:   "created before from",
+			row:    row,
+			filter: &ActivityLogFilter{From: new(createdAt.Add(time.Second))},
+			want:   false,
+		},
+		{
+			name:   "to is exclusive",
+			row:    row,
+			filter: &ActivityLogFilter{To: new(createdAt)},
+			want:   false,
+		},
+		{
+			name:   "created before to",
+			row:    row,
+			filter: &ActivityLogFilter{To: new(createdAt.Add(time.Second))},
+			want:   true,
+		},
+		{
+			name: "all filters match",
+			row:  row,
+			filter: &ActivityLogFilter{
+				ActivityTypes: []ActivityLogActivityType{testCreated},
+				ResourceTypes: []ActivityLogEntryResourceType{testResourceType},
+				Environments:  []string{"dev"},
+				From:          new(createdAt.Add(-time.Hour)),
+				To:            new(createdAt.Add(time.Hour)),
+			},
+			want: true,
+		},
+		{
+			name: "one filter does not match",
+			row:  row,
+			filter: &ActivityLogFilter{
+				ActivityTypes: []ActivityLogActivityType{testCreated},
+				ResourceTypes: []ActivityLogEntryResourceType{testResourceType},
+				Environments:  []string{"prod"},
+				From:          new(createdAt.Add(-time.Hour)),
+				To:            new(createdAt.Add(time.Hour)),
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesFilter(tt.row, tt.filter); got != tt.want {
+				t.Errorf("matchesFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -33,6 +33,12 @@ type ActivityLogEntry interface {
 	ID() ident.Ident
 }
 
+// ActivityLogEntryWithData is implemented by activity log entries with typed data.
+type ActivityLogEntryWithData interface {
+	ActivityLogEntry
+	GetData() any
+}
+
 type ActivityLogEntryConnection struct {
 	pagination.Connection[ActivityLogEntry]
 
@@ -92,6 +98,11 @@ func (a GenericActivityLogEntry) GetUUID() uuid.UUID {
 	return a.UUID
 }
 
+// generic returns the entry itself, and lets entries embedding it be used as a GenericActivityLogEntry.
+func (a GenericActivityLogEntry) generic() GenericActivityLogEntry {
+	return a
+}
+
 func (a GenericActivityLogEntry) WithMessage(message string) GenericActivityLogEntry {
 	a.Message = message
 	return a
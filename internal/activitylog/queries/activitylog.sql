@@ -257,3 +257,155 @@ ORDER BY
 -- name: RefreshMaterializedView :exec
 REFRESH MATERIALIZED VIEW CONCURRENTLY activity_log_subset_mat_view
 ;
+
+-- name: ListForTeamExport :many
+SELECT
+	*
+FROM
+	activity_log_combined_view
+WHERE
+	team_slug = @team_slug
+	AND (
+		sqlc.narg('from')::TIMESTAMPTZ IS NULL
+		OR created_at >= sqlc.narg('from')::TIMESTAMPTZ
+	)
+	AND (
+		sqlc.narg('to')::TIMESTAMPTZ IS NULL
+		OR created_at < sqlc.narg('to')::TIMESTAMPTZ
+	)
+	AND (
+		sqlc.narg('after_created_at')::TIMESTAMPTZ IS NULL
+		OR (created_at, id) > (
+			sqlc.narg('after_created_at')::TIMESTAMPTZ,
+			sqlc.narg('after_id')::UUID
+		)
+	)
+ORDER BY
+	created_at ASC,
+	id ASC
+LIMIT
+	sqlc.arg('limit')
+;
+
+-- name: ListExpired :many
+SELECT
+	*
+FROM
+	activity_log_entries
+WHERE
+	created_at < @cutoff
+	AND (
+		sqlc.narg('resource_type')::TEXT IS NULL
+		OR resource_type = sqlc.narg('resource_type')::TEXT
+	)
+	AND NOT (resource_type = ANY (@excluded_resource_types::TEXT[]))
+ORDER BY
+	created_at ASC
+LIMIT
+	sqlc.arg('limit')
+;
+
+-- name: ArchiveEntries :exec
+INSERT INTO
+	activity_log_entries_archive (
+		id,
+		created_at,
+		actor,
+		action,
+		resource_type,
+		resource_name,
+		team_slug,
+		data,
+		environment
+	)
+SELECT
+	id,
+	created_at,
+	actor,
+	action,
+	resource_type,
+	resource_name,
+	team_slug,
+	data,
+	environment
+FROM
+	activity_log_entries
+WHERE
+	id = ANY (@ids::UUID[])
+ON CONFLICT (id) DO NOTHING
+;
+
+-- name: DeleteEntries :execrows
+DELETE FROM activity_log_entries
+WHERE
+	id = ANY (@ids::UUID[])
+;
+
+-- name: CreateArchiveFile :exec
+INSERT INTO
+	activity_log_archive_files (location, team_slug, oldest, newest, entry_count)
+VALUES
+	(
+		@location,
+		@team_slug,
+		@oldest,
+		@newest,
+		@entry_count
+	)
+;
+
+-- name: ListArchiveFilesForTeam :many
+SELECT
+	*
+FROM
+	activity_log_archive_files
+WHERE
+	team_slug = @team_slug
+	AND (
+		sqlc.narg('from')::TIMESTAMPTZ IS NULL
+		OR newest >= sqlc.narg('from')::TIMESTAMPTZ
+	)
+	AND (
+		sqlc.narg('to')::TIMESTAMPTZ IS NULL
+		OR oldest < sqlc.narg('to')::TIMESTAMPTZ
+	)
+ORDER BY
+	oldest ASC
+;
+
+-- name: ListArchivedForTeamExport :many
+SELECT
+	id,
+	created_at,
+	actor,
+	action,
+	resource_type,
+	resource_name,
+	team_slug,
+	data,
+	environment
+FROM
+	activity_log_entries_archive
+WHERE
+	team_slug = @team_slug
+	AND (
+		sqlc.narg('from')::TIMESTAMPTZ IS NULL
+		OR created_at >= sqlc.narg('from')::TIMESTAMPTZ
+	)
+	AND (
+		sqlc.narg('to')::TIMESTAMPTZ IS NULL
+		OR created_at < sqlc.narg('to')::TIMESTAMPTZ
+	)
+	AND (
+		sqlc.narg('after_created_at')::TIMESTAMPTZ IS NULL
+		OR (created_at, id) > (
+			sqlc.narg('after_created_at')::TIMESTAMPTZ,
+			sqlc.narg('after_id')::UUID
+		)
+	)
+ORDER BY
+	created_at ASC,
+	id ASC
+LIMIT
+	sqlc.arg('limit')
+;
@@ -97,6 +97,10 @@ type GenericKubernetesResourceActivityLogEntry struct {
 	Data *GenericKubernetesResourceActivityLogEntryData `json:"data"`
 }
 
+func (e GenericKubernetesResourceActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 var fallbackTransformer Transformer
 
 // RegisterFallbackTransformer registers a transformer that is called when no
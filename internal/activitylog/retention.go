@@ -0,0 +1,202 @@
+package activitylog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/activitylog/activitylogsql"
+	"github.com/nais/api/internal/duration"
+	"github.com/nais/api/internal/leaderelection"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	retentionSchedule  = time.Hour
+	retentionBatchSize = 1000
+)
+
+// RetentionConfig configures how long activity log entries are kept in the activity log before they are archived.
+type RetentionConfig struct {
+	// Default is the retention of resource types without a specific retention. Entries are kept forever when zero.
+	Default duration.Duration `json:"default"`
+
+	// ResourceTypes holds retentions per resource type, overriding the default. A zero retention keeps entries of
+	// the resource type forever.
+	ResourceTypes map[ActivityLogEntryResourceType]duration.Duration `json:"resourceTypes"`
+
+	// Archive is where expired entries are moved. When empty, entries are moved to the activity_log_entries_archive
+	// table. A file:// or gs:// URL writes entries as gzip-compressed JSON lines files to the local directory or the
+	// Cloud Storage bucket and prefix.
+	Archive string `json:"archive"`
+}
+
+var _ json.Unmarshaler = (*RetentionConfig)(nil)
+
+func (c *RetentionConfig) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	type retentionConfig RetentionConfig
+	var cfg retentionConfig
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return fmt.Errorf("unmarshalling activity log retention config: %w", err)
+	}
+
+	if cfg.Archive != "" && !strings.HasPrefix(cfg.Archive, "file://") && !strings.HasPrefix(cfg.Archive, "gs://") {
+		return fmt.Errorf("activity log archive must be a file:// or gs:// URL: %q", cfg.Archive)
+	}
+
+	*c = RetentionConfig(cfg)
+	return nil
+}
+
+// Enabled reports whether any retention is configured.
+func (c RetentionConfig) Enabled() bool {
+	if c.Default > 0 {
+		return true
+	}
+	for _, d := range c.ResourceTypes {
+		if d > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+type retention struct {
+	pool    *pgxpool.Pool
+	cfg     RetentionConfig
+	archive archiver
+	log     logrus.FieldLogger
+}
+
+// RunRetention periodically archives activity log entries older than their retention, while this instance is the
+// leader. It returns when ctx is done, or immediately if no retention is configured.
+func RunRetention(ctx context.Context, pool *pgxpool.Pool, cfg RetentionConfig, log logrus.FieldLogger) error {
+	if !cfg.Enabled() {
+		log.Debug("no activity log retention configured")
+		return nil
+	}
+
+	a, err := newArchiver(ctx, cfg.Archive, log)
+	if err != nil {
+		return err
+	}
+
+	r := &retention{
+		pool:    pool,
+		cfg:     cfg,
+		archive: a,
+		log:     log,
+	}
+
+	for {
+		if leaderelection.IsLeader() {
+			if err := r.run(ctx); err != nil && ctx.Err() == nil {
+				log.WithError(err).Error("error archiving activity log entries")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retentionSchedule):
+		}
+	}
+}
+
+func (r *retention) run(ctx context.Context) error {
+	now := time.Now()
+	resourceTypes := slices.Sorted(maps.Keys(r.cfg.ResourceTypes))
+
+	for _, resourceType := range resourceTypes {
+		if d := r.cfg.ResourceTypes[resourceType]; d > 0 {
+			if err := r.archiveExpired(ctx, activitylogsql.ListExpiredParams{
+				Cutoff:       pgtype.Timestamptz{Time: now.Add(-time.Duration(d)), Valid: true},
+				ResourceType: new(string(resourceType)),
+			}); err != nil {
+				return fmt.Errorf("resource type %q: %w", resourceType, err)
+			}
+		}
+	}
+
+	if r.cfg.Default > 0 {
+		excluded := make([]string, len(resourceTypes))
+		for i, resourceType := range resourceTypes {
+			excluded[i] = string(resourceType)
+		}
+
+		if err := r.archiveExpired(ctx, activitylogsql.ListExpiredParams{
+			Cutoff:                pgtype.Timestamptz{Time: now.Add(-time.Duration(r.cfg.Default)), Valid: true},
+			ExcludedResourceTypes: excluded,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// archiveExpired moves expired entries to the archive in batches, until there are no more expired entries.
+func (r *retention) archiveExpired(ctx context.Context, params activitylogsql.ListExpiredParams) error {
+	params.Limit = retentionBatchSize
+	if params.ExcludedResourceTypes == nil {
+		params.ExcludedResourceTypes = []string{}
+	}
+
+	for {
+		var n int
+		var undo func(context.Context)
+		err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+			q := activitylogsql.New(tx)
+
+			entries, err := q.ListExpired(ctx, params)
+			if err != nil {
+				return err
+			}
+			n = len(entries)
+			if n == 0 {
+				return nil
+			}
+
+			undo, err = r.archive.archive(ctx, q, entries)
+			if err != nil {
+				return err
+			}
+
+			ids := make([]uuid.UUID, n)
+			for i, entry := range entries {
+				ids[i] = entry.ID
+			}
+			_, err = q.DeleteEntries(ctx, ids)
+			return err
+		})
+		if err != nil {
+			// The entries are kept, so they must not stay in the archive as well
+			if undo != nil {
+				undo(context.WithoutCancel(ctx))
+			}
+			return err
+		}
+
+		if n > 0 {
+			r.log.WithField("count", n).Info("archived activity log entries")
+		}
+		if n < retentionBatchSize {
+			return nil
+		}
+	}
+}
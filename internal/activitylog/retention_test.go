@@ -0,0 +1,250 @@
+package activitylog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/activitylog/activitylogsql"
+	"github.com/nais/api/internal/duration"
+	"github.com/nais/api/internal/slug"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestRetentionConfig_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    RetentionConfig
+		wantErr bool
+	}{
+		{
+			name:  "days and durations",
+			input: `{"default":"365d","resourceTypes":{"SECRET":"720h"}}`,
+			want: RetentionConfig{
+				Default:       duration.Duration(365 * 24 * time.Hour),
+				ResourceTypes: map[ActivityLogEntryResourceType]duration.Duration{"SECRET": duration.Duration(720 * time.Hour)},
+			},
+		},
+		{
+			name:  "file archive",
+			input: `{"default":"30d","archive":"file:///var/lib/archive"}`,
+			want:  RetentionConfig{Default: duration.Duration(30 * 24 * time.Hour), Archive: "file:///var/lib/archive"},
+		},
+		{
+			name:    "unsupported archive",
+			input:   `{"default":"30d","archive":"s3://bucket"}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative duration",
+			input:   `{"default":"-1d"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid duration",
+			input:   `{"default":"forever"}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			input:   `{"defualt":"30d"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got RetentionConfig
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Default != tt.want.Default || got.Archive != tt.want.Archive || len(got.ResourceTypes) != len(tt.want.ResourceTypes) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+			for k, v := range tt.want.ResourceTypes {
+				if got.ResourceTypes[k] != v {
+					t.Errorf("resource type %q: expected %v, got %v", k, v, got.ResourceTypes[k])
+				}
+			}
+		})
+	}
+}
+
+func TestRetentionConfig_Enabled(t *testing.T) {
+	if (RetentionConfig{}).Enabled() {
+		t.Error("expected empty config to be disabled")
+	}
+	if !(RetentionConfig{ResourceTypes: map[ActivityLogEntryResourceType]duration.Duration{"SECRET": duration.Duration(time.Hour)}}).Enabled() {
+		t.Error("expected config with resource type retention to be enabled")
+	}
+}
+
+func TestLocalFileStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := openFileStore(ctx, "file://"+t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := uuid.New()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(gz).Encode(archivedEntry{
+		ID:           id,
+		CreatedAt:    time.Now(),
+		Actor:        "user@example.com",
+		Action:       "CREATED",
+		ResourceType: "SECRET",
+		ResourceName: "my-secret",
+		Data:         json.RawMessage(`{"key":"value"}`),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	location, err := store.write(ctx, "team/file.ndjson.gz", buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []*activitylogsql.ActivityLogCombinedView
+	if err := readArchiveFile(ctx, store, location, func(row *activitylogsql.ActivityLogCombinedView) error {
+		got = append(got, row)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(got))
+	}
+	if got[0].ID != id || got[0].ResourceName != "my-secret" || string(got[0].Data) != `{"key":"value"}` {
+		t.Errorf("unexpected entry: %+v", got[0])
+	}
+}
+
+// fakeArchiveDB fails indexing archive files after the given number of files have been indexed
+type fakeArchiveDB struct {
+	activitylogsql.DBTX
+	indexed   int
+	failAfter int
+}
+
+func (f *fakeArchiveDB) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	if f.indexed >= f.failAfter {
+		return pgconn.CommandTag{}, errors.New("index failed")
+	}
+	f.indexed++
+	return pgconn.CommandTag{}, nil
+}
+
+func TestFileArchiver(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	newEntry := func(team string, createdAt time.Time) *activitylogsql.ActivityLogEntry {
+		return &activitylogsql.ActivityLogEntry{
+			ID:           uuid.New(),
+			CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+			Actor:        "user@example.com",
+			Action:       "CREATED",
+			ResourceType: "SECRET",
+			ResourceName: "my-secret",
+			TeamSlug:     new(slug.Slug(team)),
+		}
+	}
+	now := time.Now()
+	entries := []*activitylogsql.ActivityLogEntry{
+		newEntry("team-a", now.Add(-2*time.Hour)),
+		newEntry("team-b", now.Add(-time.Hour)),
+		newEntry("team-a", now),
+	}
+
+	newArchiver := func(t *testing.T) (*fileArchiver, string) {
+		dir := t.TempDir()
+		store, err := openFileStore(ctx, "file://"+dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &fileArchiver{store: store, log: log}, dir
+	}
+
+	t.Run("archiving the same batch again overwrites the files", func(t *testing.T) {
+		a, dir := newArchiver(t)
+
+		for range 2 {
+			if _, err := a.archive(ctx, activitylogsql.New(&fakeArchiveDB{failAfter: 2}), entries); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if got := archiveFiles(t, dir); len(got) != 2 {
+			t.Errorf("expected one file per team, got %v", got)
+		}
+	})
+
+	t.Run("files are removed when indexing fails", func(t *testing.T) {
+		a, dir := newArchiver(t)
+
+		if _, err := a.archive(ctx, activitylogsql.New(&fakeArchiveDB{failAfter: 1}), entries); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if got := archiveFiles(t, dir); len(got) != 0 {
+			t.Errorf("expected no files, got %v", got)
+		}
+	})
+
+	t.Run("undo removes the files", func(t *testing.T) {
+		a, dir := newArchiver(t)
+
+		undo, err := a.archive(ctx, activitylogsql.New(&fakeArchiveDB{failAfter: 2}), entries)
+		if err != nil {
+			t.Fatal(err)
+		}
+		undo(ctx)
+
+		if got := archiveFiles(t, dir); len(got) != 0 {
+			t.Errorf("expected no files, got %v", got)
+		}
+	})
+}
+
+func archiveFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var ret []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			ret = append(ret, p)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ret
+}
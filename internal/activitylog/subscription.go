@@ -0,0 +1,90 @@
+package activitylog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nais/api/internal/activitylog/activitylogsql"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database/notify"
+)
+
+// Subscribe returns a channel receiving activity log entries as they are created. Only entries matching the filter,
+// and that the actor is authorized to read, are sent. The channel is closed when ctx is done, or when entries were pruned before they could
+// be sent.
+func Subscribe(ctx context.Context, filter *ActivityLogFilter) (<-chan ActivityLogEntry, error) {
+	l := fromContext(ctx)
+	if l.notifier == nil {
+		return nil, fmt.Errorf("activity log subscriptions are not available")
+	}
+
+	after, err := l.notifier.LastPosition(ctx, "activity_log_entries")
+	if err != nil {
+		return nil, err
+	}
+
+	payloads := l.notifier.Subscribe(ctx, "activity_log_entries", after)
+	ch := make(chan ActivityLogEntry, 10)
+
+	go func() {
+		defer close(ch)
+
+		for payload := range payloads {
+			if payload.Op == notify.Reset {
+				// Entries were pruned before they could be sent. End the subscription so the client notices the gap
+				// instead of silently missing entries.
+				l.log.WithField("seq", payload.Seq).Warn("activity log notifications were pruned before being delivered, ending subscription")
+				return
+			}
+
+			id, err := uuid.Parse(fmt.Sprint(payload.Data["id"]))
+			if err != nil {
+				l.log.WithError(err).WithField("seq", payload.Seq).Warn("invalid activity log entry id in notification")
+				continue
+			}
+
+			entry, err := subscriptionEntry(ctx, l.internalQuerier, id, filter)
+			if err != nil {
+				l.log.WithError(err).WithField("id", id).Warn("get activity log entry for subscription")
+				continue
+			} else if entry == nil {
+				continue
+			}
+
+			select {
+			case ch <- entry:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// subscriptionEntry returns the entry with the given id, or nil if it does not match the filter or the actor is not
+// authorized to read it.
+func subscriptionEntry(ctx context.Context, q activitylogsql.Querier, id uuid.UUID, filter *ActivityLogFilter) (ActivityLogEntry, error) {
+	rows, err := q.ListByIDs(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	row := rows[0]
+	if !matchesFilter(row, filter) {
+		return nil, nil
+	}
+
+	if err := authz.CanReadActivityLogs(ctx, row.TeamSlug); errors.As(err, &authz.ErrMissingAuthorization{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return toGraphActivityLogEntry(row)
+}
@@ -0,0 +1,150 @@
+//go:build integration_test
+
+package activitylog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/database/notify"
+	"github.com/nais/api/internal/user"
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("team member only receives entries of their own team", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		member := &user.User{Email: "member@example.com", Name: "Member", ExternalID: "member"}
+		if err := pool.QueryRow(ctx, "INSERT INTO users (name, email, external_id) VALUES ($1, $2, $3) RETURNING id", member.Name, member.Email, member.ExternalID).Scan(&member.UUID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := pool.Exec(ctx, "INSERT INTO user_roles (role_name, user_id, target_team_slug) VALUES ('Team member', $1, 'team-a')", member.UUID); err != nil {
+			t.Fatal(err)
+		}
+
+		notifier := notify.New(pool, log, notify.WithPollInterval(10*time.Millisecond))
+		ctx = database.NewLoaderContext(ctx, pool)
+		ctx = authz.NewLoaderContext(ctx, pool)
+		ctx = NewLoaderContext(ctx, pool, notifier, log)
+		ctx = authz.ContextWithActor(ctx, member, nil)
+
+		ch, err := Subscribe(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		insertEntry(ctx, t, pool, "team-b")
+		first := insertEntry(ctx, t, pool, "team-a")
+		insertEntry(ctx, t, pool, "team-b")
+		second := insertEntry(ctx, t, pool, "team-a")
+
+		for _, want := range []uuid.UUID{first, second} {
+			select {
+			case entry, ok := <-ch:
+				if !ok {
+					t.Fatal("subscription closed")
+				}
+				if entry.GetUUID() != want {
+					t.Fatalf("expected entry %v, got %v", want, entry.GetUUID())
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for entry")
+			}
+		}
+
+		// Wait a few poll intervals to make sure the entries of the other team are not sent
+		select {
+		case entry := <-ch:
+			t.Fatalf("unexpected entry %v", entry.GetUUID())
+		case <-time.After(200 * time.Millisecond):
+		}
+	})
+}
+
+func insertEntry(ctx context.Context, t *testing.T, pool *pgxpool.Pool, teamSlug string) uuid.UUID {
+	t.Helper()
+
+	var id uuid.UUID
+	stmt := `
+		INSERT INTO activity_log_entries (actor, action, resource_type, resource_name, team_slug, data, environment)
+		VALUES ('actor@example.com', 'UPDATED', 'APP', 'app', $1, '{}', 'dev')
+		RETURNING id`
+	if err := pool.QueryRow(ctx, stmt, teamSlug).Scan(&id); err != nil {
+		t.Fatalf("failed to insert activity log entry: %v", err)
+	}
+
+	return id
+}
+
+func startPostgresql(ctx context.Context, t *testing.T, log logrus.FieldLogger) (container *postgres.PostgresContainer, dsn string, err error) {
+	container, err = postgres.Run(
+		ctx,
+		"docker.io/postgres:16-alpine",
+		postgres.WithDatabase("test"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		postgres.WithSQLDriver("pgx"),
+		postgres.BasicWaitStrategies(),
+	)
+	defer testcontainers.CleanupContainer(t, container)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to start container: %w", err)
+	}
+
+	dsn, err = container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get connection string: %w", err)
+	}
+
+	pool, err := database.NewPool(ctx, dsn, log, true)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create pool: %w", err)
+	}
+
+	if _, err := pool.Exec(ctx, "INSERT INTO teams (slug, purpose, slack_channel) VALUES ('team-a', 'purpose', '#channel'), ('team-b', 'purpose', '#channel')"); err != nil {
+		pool.Close()
+		return nil, "", fmt.Errorf("failed to insert teams: %w", err)
+	}
+	pool.Close()
+
+	if err := container.Snapshot(ctx); err != nil {
+		return nil, "", fmt.Errorf("failed to snapshot: %w", err)
+	}
+
+	return container, dsn, nil
+}
+
+func getConnection(ctx context.Context, t *testing.T, container *postgres.PostgresContainer, dsn string, log logrus.FieldLogger) *pgxpool.Pool {
+	pool, _ := database.NewPool(ctx, dsn, log, false)
+
+	t.Cleanup(func() {
+		pool.Close()
+		if err := container.Restore(ctx); err != nil {
+			t.Fatalf("failed to restore database: %v", err)
+		}
+	})
+
+	return pool
+}
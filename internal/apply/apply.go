@@ -3,6 +3,7 @@ package apply
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,10 +12,13 @@ import (
 	"github.com/nais/api/internal/activitylog"
 	"github.com/nais/api/internal/auth/authz"
 	"github.com/nais/api/internal/auth/middleware"
+	"github.com/nais/api/internal/deployment/freeze"
 	"github.com/nais/api/internal/environmentmapper"
 	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/validate"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 )
 
@@ -22,15 +26,22 @@ const maxBodySize = 5 * 1024 * 1024 // 5 MB
 
 type Handler struct {
 	dynamicClientFn DynamicClientFactory
+	whitelist       *Whitelist
+	validators      ValidatorChain
 	log             logrus.FieldLogger
 }
 
 type DynamicClientFactory func(environmentName string, teamSlug slug.Slug) (dynamic.Interface, error)
 
-func NewHandler(dynamicClientFn DynamicClientFactory, log logrus.FieldLogger) *Handler {
+func NewHandler(dynamicClientFn DynamicClientFactory, whitelist *Whitelist, log logrus.FieldLogger, opts ...HandlerOption) *Handler {
 	h := &Handler{
 		log:             log,
 		dynamicClientFn: dynamicClientFn,
+		whitelist:       whitelist,
+	}
+
+	for _, opt := range opts {
+		opt(h)
 	}
 
 	return h
@@ -42,7 +53,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	teamSlug := slug.Slug(r.PathValue("teamSlug"))
 	environmentName := r.PathValue("environment")
 
-	if err := authz.CanApplyKubernetesResource(ctx, teamSlug); err != nil {
+	if err := authz.CanApplyKubernetesResource(ctx, teamSlug, environmentName); err != nil {
 		writeError(w, http.StatusForbidden, fmt.Sprintf("authorization failed: %s", err))
 		return
 	}
@@ -68,13 +79,31 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var disallowed []string
-	for i, res := range req.Resources {
-		if !IsAllowed(res) {
-			disallowed = append(disallowed, fmt.Sprintf("resources[%d]: %s/%s is not an allowed resource type", i, res.GetAPIVersion(), res.GetKind()))
+	var freezeOverride *freeze.ApplyOverride
+	if !req.DryRun {
+		freezeOverride, err = freeze.CheckApply(ctx, teamSlug, environmentName, req.FreezeOverrideJustification)
+		if err != nil {
+			var (
+				frozen     *freeze.FrozenError
+				missing    authz.ErrMissingAuthorization
+				validation *validate.ValidationErrors
+			)
+			switch {
+			case errors.As(err, &frozen):
+				writeError(w, http.StatusLocked, fmt.Sprintf("%s. Set freezeOverrideJustification to deploy anyway", frozen))
+			case errors.Is(err, authz.ErrUnauthorized), errors.As(err, &missing):
+				writeError(w, http.StatusForbidden, fmt.Sprintf("overriding deployment freeze failed: %s", err))
+			case errors.As(err, &validation):
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("overriding deployment freeze failed: %s", err))
+			default:
+				h.log.WithError(err).Error("checking deployment freezes")
+				writeError(w, http.StatusInternalServerError, "unable to check deployment freezes")
+			}
+			return
 		}
 	}
-	if len(disallowed) > 0 {
+
+	if disallowed := h.disallowed(environmentName, req.Resources); len(disallowed) > 0 {
 		writeError(w, http.StatusBadRequest, fmt.Sprintf("disallowed resource types: %s", strings.Join(disallowed, "; ")))
 		return
 	}
@@ -87,12 +116,32 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	for _, res := range req.Resources {
-		result := h.applyOne(ctx, client, teamSlug, environmentName, &res)
-		results = append(results, result)
+	if req.Atomic {
+		results = h.applyAtomic(ctx, client, teamSlug, environmentName, req.Resources, req.DryRun)
+	} else {
+		for _, res := range req.Resources {
+			result := h.applyOne(ctx, client, teamSlug, environmentName, &res, req.DryRun)
+			results = append(results, result)
+		}
+	}
+
+	resp := Response{DryRun: req.DryRun, Results: results}
+	if req.Prune {
+		resp.Prune = h.pruneAfterApply(ctx, client, teamSlug, environmentName, req, results)
 	}
 
-	writeJSON(w, http.StatusOK, Response{Results: results})
+	if changed(resp) {
+		if err := freezeOverride.Record(ctx); err != nil {
+			h.log.WithError(err).WithFields(logrus.Fields{
+				"team":        teamSlug,
+				"environment": environmentName,
+			}).Error("recording deployment freeze override")
+			writeError(w, http.StatusInternalServerError, "resources were applied, but the deployment freeze override could not be recorded")
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, resp)
 }
 
 func (h *Handler) applyOne(
@@ -101,62 +150,120 @@ func (h *Handler) applyOne(
 	teamSlug slug.Slug,
 	environmentName string,
 	res *unstructured.Unstructured,
+	dryRun bool,
 ) ResourceResult {
-	apiVersion := res.GetAPIVersion()
-	kind := res.GetKind()
-	name := res.GetName()
-	resourceID := kind + "/" + name
+	gvr, errResult := h.prepare(teamSlug, environmentName, res)
+	if errResult != nil {
+		return *errResult
+	}
 
-	log := h.log.WithFields(logrus.Fields{
-		"environment": environmentName,
-		"team":        teamSlug,
-		"name":        name,
-		"kind":        kind,
-	})
+	if errResult := h.validate(ctx, environmentName, res); errResult != nil {
+		return *errResult
+	}
 
-	if name == "" {
-		return ResourceResult{
-			Resource:        resourceID,
-			EnvironmentName: environmentName,
-			Status:          StatusError,
-			Error:           "resource must have metadata.name",
+	applyResult, err := ApplyResource(ctx, client, gvr, res, ApplyOptions{DryRun: dryRun})
+	if err != nil {
+		h.logFor(teamSlug, environmentName, res).WithError(err).Error("applying resource")
+		return errorResult(environmentName, res, fmt.Sprintf("apply failed: %s", err))
+	}
+
+	return h.recordResult(ctx, teamSlug, environmentName, res, applyResult, dryRun)
+}
+
+// changed reports whether any resource was created, applied or pruned.
+func changed(resp Response) bool {
+	for _, r := range resp.Results {
+		if r.Status == StatusCreated || r.Status == StatusApplied {
+			return true
 		}
 	}
 
-	gvr, ok := GVRFor(apiVersion, kind)
-	if !ok {
-		return ResourceResult{
-			Resource:        resourceID,
-			EnvironmentName: environmentName,
-			Status:          StatusError,
-			Error:           fmt.Sprintf("no GVR mapping for %s/%s", apiVersion, kind),
+	if resp.Prune != nil {
+		for _, r := range resp.Prune.Resources {
+			if r.Status == StatusDeleted {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// disallowed returns a description of each resource that is not allowed in the environment.
+func (h *Handler) disallowed(environmentName string, resources []unstructured.Unstructured) []string {
+	var ret []string
+	for i, res := range resources {
+		if !h.whitelist.IsAllowed(environmentName, res) {
+			ret = append(ret, fmt.Sprintf("resources[%d]: %s/%s is not an allowed resource type", i, res.GetAPIVersion(), res.GetKind()))
 		}
 	}
+	return ret
+}
+
+// prepare validates a single resource and targets it at the team namespace. It returns the
+// GroupVersionResource to apply the resource with, or a result describing why it can't be applied.
+func (h *Handler) prepare(teamSlug slug.Slug, environmentName string, res *unstructured.Unstructured) (schema.GroupVersionResource, *ResourceResult) {
+	if res.GetName() == "" {
+		r := errorResult(environmentName, res, "resource must have metadata.name")
+		return schema.GroupVersionResource{}, &r
+	}
+
+	gvr, ok := h.whitelist.GVRFor(environmentName, res.GetAPIVersion(), res.GetKind())
+	if !ok {
+		r := errorResult(environmentName, res, fmt.Sprintf("no GVR mapping for %s/%s", res.GetAPIVersion(), res.GetKind()))
+		return schema.GroupVersionResource{}, &r
+	}
 
 	res.SetNamespace(string(teamSlug))
 
-	applyResult, err := ApplyResource(ctx, client, gvr, res)
-	if err != nil {
-		log.WithError(err).Error("applying resource")
+	labels := res.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[managedLabelKey] = managedLabelValue
+	res.SetLabels(labels)
+
+	return gvr, nil
+}
+
+// outcome returns the status and changed fields for a completed apply.
+func outcome(applyResult *ApplyResult) (string, []activitylog.ResourceChangedField) {
+	if applyResult.Created {
+		return StatusCreated, nil
+	}
+	return StatusApplied, Diff(applyResult.Before, applyResult.After)
+}
+
+// recordResult builds the result for an applied resource and writes an activity log entry
+// for it. Dry runs and applies without any changes are not recorded in the activity log.
+func (h *Handler) recordResult(
+	ctx context.Context,
+	teamSlug slug.Slug,
+	environmentName string,
+	res *unstructured.Unstructured,
+	applyResult *ApplyResult,
+	dryRun bool,
+) ResourceResult {
+	status, changes := outcome(applyResult)
+
+	if dryRun {
+		// Nothing was persisted, so report the full diff against the live object
+		// (all fields for a create) and skip the activity log.
+		if applyResult.Created {
+			changes = Diff(nil, applyResult.After)
+		}
 		return ResourceResult{
-			Resource:        resourceID,
+			Resource:        resourceID(res),
 			EnvironmentName: environmentName,
-			Status:          StatusError,
-			Error:           fmt.Sprintf("apply failed: %s", err),
+			Status:          status,
+			ChangedFields:   changes,
 		}
 	}
 
-	var changes []activitylog.ResourceChangedField
-	status := StatusCreated
-	if !applyResult.Created {
-		status = StatusApplied
-		changes = Diff(applyResult.Before, applyResult.After)
-	}
-
 	if len(changes) == 0 && status == StatusApplied {
 		// No changes were made, so we can skip creating an activity log entry.
 		return ResourceResult{
-			Resource:        resourceID,
+			Resource:        resourceID(res),
 			EnvironmentName: environmentName,
 			Status:          StatusApplied,
 		}
@@ -167,11 +274,31 @@ func (h *Handler) applyOne(
 		action = activitylog.ActivityLogEntryActionUpdated
 	}
 
-	resourceType, _ := activitylog.ResourceTypeForKind(kind)
+	h.createActivityLogEntry(ctx, teamSlug, environmentName, res, action, changes)
+
+	return ResourceResult{
+		Resource:        resourceID(res),
+		EnvironmentName: environmentName,
+		Status:          status,
+		ChangedFields:   changes,
+	}
+}
+
+// createActivityLogEntry records a change to a resource made through the apply endpoint.
+// Failures are logged, but do not fail the request as the change has already been made.
+func (h *Handler) createActivityLogEntry(
+	ctx context.Context,
+	teamSlug slug.Slug,
+	environmentName string,
+	res *unstructured.Unstructured,
+	action activitylog.ActivityLogEntryAction,
+	changes []activitylog.ResourceChangedField,
+) {
+	resourceType, _ := activitylog.ResourceTypeForKind(res.GetKind())
 
 	logData := activitylog.GenericKubernetesResourceActivityLogEntryData{
-		APIVersion:    apiVersion,
-		Kind:          kind,
+		APIVersion:    res.GetAPIVersion(),
+		Kind:          res.GetKind(),
 		ChangedFields: changes,
 	}
 
@@ -185,24 +312,60 @@ func (h *Handler) applyOne(
 		Action:          action,
 		Actor:           actor.User,
 		ResourceType:    resourceType,
-		ResourceName:    name,
+		ResourceName:    res.GetName(),
 		TeamSlug:        &teamSlug,
 		EnvironmentName: &environmentName,
 		Data:            logData,
 	}); err != nil {
-		log.WithError(err).Error("creating activity log entry")
+		h.logFor(teamSlug, environmentName, res).WithError(err).Error("creating activity log entry")
 	}
+}
+
+func (h *Handler) logFor(teamSlug slug.Slug, environmentName string, res *unstructured.Unstructured) logrus.FieldLogger {
+	return h.log.WithFields(logrus.Fields{
+		"environment": environmentName,
+		"team":        teamSlug,
+		"name":        res.GetName(),
+		"kind":        res.GetKind(),
+	})
+}
+
+// resourceID returns a human-readable identifier for the resource, e.g. "Application/my-app".
+func resourceID(res *unstructured.Unstructured) string {
+	return res.GetKind() + "/" + res.GetName()
+}
 
+func errorResult(environmentName string, res *unstructured.Unstructured, message string) ResourceResult {
 	return ResourceResult{
-		Resource:        resourceID,
+		Resource:        resourceID(res),
 		EnvironmentName: environmentName,
-		Status:          status,
-		ChangedFields:   changes,
+		Status:          StatusError,
+		Error:           message,
 	}
 }
 
 type request struct {
 	Resources []unstructured.Unstructured `json:"resources"`
+
+	// DryRun validates and diffs the resources against the live objects without
+	// persisting anything or writing activity log entries.
+	DryRun bool `json:"dryRun"`
+
+	// Atomic applies the resources as a unit: all resources are validated up front, and
+	// if any of them fails to apply the ones already applied are rolled back.
+	Atomic bool `json:"atomic"`
+
+	// Prune deletes apply-managed resources in the team namespace that are not part of
+	// Resources. Only resource kinds in the whitelist are considered.
+	Prune bool `json:"prune"`
+
+	// PruneLimit is the maximum number of resources a single request may prune. If more
+	// resources would be pruned, nothing is deleted. Defaults to defaultPruneLimit.
+	PruneLimit int `json:"pruneLimit"`
+
+	// FreezeOverrideJustification overrides an active deployment freeze for this request. The override is recorded
+	// in the activity log together with the justification.
+	FreezeOverrideJustification string `json:"freezeOverrideJustification"`
 }
 
 func writeJSON(w http.ResponseWriter, statusCode int, v any) {
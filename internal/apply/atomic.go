@@ -0,0 +1,146 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nais/api/internal/slug"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// applyAtomic applies all resources as a single unit. Every resource is validated, checked
+// against policy, and dry-run applied before anything is persisted. If a resource then fails
+// to apply, the resources already applied by the request are restored to their previous
+// state, and the remaining resources are skipped.
+//
+// Activity log entries are only written once all resources have been applied, or for
+// resources that could not be rolled back.
+func (h *Handler) applyAtomic(
+	ctx context.Context,
+	client dynamic.Interface,
+	teamSlug slug.Slug,
+	environmentName string,
+	resources []unstructured.Unstructured,
+	dryRun bool,
+) []ResourceResult {
+	results := make([]ResourceResult, len(resources))
+	gvrs := make([]schema.GroupVersionResource, len(resources))
+
+	valid := true
+	for i := range resources {
+		res := &resources[i]
+
+		gvr, errResult := h.prepare(teamSlug, environmentName, res)
+		if errResult != nil {
+			results[i] = *errResult
+			valid = false
+			continue
+		}
+		gvrs[i] = gvr
+
+		if errResult := h.validate(ctx, environmentName, res); errResult != nil {
+			results[i] = *errResult
+			valid = false
+			continue
+		}
+
+		preview, err := ApplyResource(ctx, client, gvr, res, ApplyOptions{DryRun: true})
+		if err != nil {
+			results[i] = errorResult(environmentName, res, fmt.Sprintf("dry run failed: %s", err))
+			valid = false
+			continue
+		}
+
+		results[i] = h.recordResult(ctx, teamSlug, environmentName, res, preview, true)
+	}
+
+	if !valid {
+		for i := range resources {
+			if results[i].Status != StatusError {
+				results[i] = skippedResult(environmentName, &resources[i], "another resource in the request failed validation")
+			}
+		}
+		return results
+	}
+
+	if dryRun {
+		return results
+	}
+
+	applied := make([]*ApplyResult, 0, len(resources))
+	for i := range resources {
+		res := &resources[i]
+
+		applyResult, err := ApplyResource(ctx, client, gvrs[i], res, ApplyOptions{})
+		if err != nil {
+			h.logFor(teamSlug, environmentName, res).WithError(err).Error("applying resource, rolling back")
+			results[i] = errorResult(environmentName, res, fmt.Sprintf("apply failed: %s", err))
+
+			h.rollback(ctx, client, teamSlug, environmentName, resources, gvrs, applied, results)
+
+			for j := i + 1; j < len(resources); j++ {
+				results[j] = skippedResult(environmentName, &resources[j], fmt.Sprintf("%s failed to apply", resourceID(res)))
+			}
+			return results
+		}
+
+		applied = append(applied, applyResult)
+	}
+
+	for i := range resources {
+		results[i] = h.recordResult(ctx, teamSlug, environmentName, &resources[i], applied[i], false)
+	}
+
+	return results
+}
+
+// rollback restores the applied resources in reverse order, and records the outcome of each
+// rollback in results. applied[i] is the result of applying resources[i].
+func (h *Handler) rollback(
+	ctx context.Context,
+	client dynamic.Interface,
+	teamSlug slug.Slug,
+	environmentName string,
+	resources []unstructured.Unstructured,
+	gvrs []schema.GroupVersionResource,
+	applied []*ApplyResult,
+	results []ResourceResult,
+) {
+	for i := len(applied) - 1; i >= 0; i-- {
+		res := &resources[i]
+
+		if err := RestoreResource(ctx, client, gvrs[i], applied[i]); err != nil {
+			h.logFor(teamSlug, environmentName, res).WithError(err).Error("rolling back resource")
+
+			// The change is still in effect, so it must be recorded like any other apply.
+			results[i] = h.recordResult(ctx, teamSlug, environmentName, res, applied[i], false)
+			results[i].Rollback = &RollbackResult{
+				Status: RollbackStatusFailed,
+				Error:  err.Error(),
+			}
+			continue
+		}
+
+		_, changes := outcome(applied[i])
+		results[i] = ResourceResult{
+			Resource:        resourceID(res),
+			EnvironmentName: environmentName,
+			Status:          StatusRolledBack,
+			ChangedFields:   changes,
+			Rollback: &RollbackResult{
+				Status: RollbackStatusSucceeded,
+			},
+		}
+	}
+}
+
+func skippedResult(environmentName string, res *unstructured.Unstructured, reason string) ResourceResult {
+	return ResourceResult{
+		Resource:        resourceID(res),
+		EnvironmentName: environmentName,
+		Status:          StatusSkipped,
+		Error:           "not applied: " + reason,
+	}
+}
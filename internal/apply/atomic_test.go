@@ -0,0 +1,113 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/nais/api/internal/kubernetes"
+	"github.com/nais/api/internal/kubernetes/fake"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestApplyAtomic_RollsBackOnFailure(t *testing.T) {
+	ctx := context.Background()
+	scheme, err := kubernetes.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewDynamicClient(scheme)
+
+	if _, err := ApplyResource(ctx, client, applicationGVR, newApplication("example.com/my-app:v1"), ApplyOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Fail the real (non dry run) apply of the "broken" application.
+	client.PrependReactor("patch", "applications", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchActionImpl)
+		if patch.GetName() == "broken" && !slices.Contains(patch.GetPatchOptions().DryRun, metav1.DryRunAll) {
+			return true, nil, fmt.Errorf("boom")
+		}
+		return false, nil, nil
+	})
+
+	created := newApplication("example.com/other:v1")
+	created.SetName("other")
+	broken := newApplication("example.com/broken:v1")
+	broken.SetName("broken")
+	skipped := newApplication("example.com/skipped:v1")
+	skipped.SetName("skipped")
+
+	log, _ := logrustest.NewNullLogger()
+	h := NewHandler(nil, DefaultWhitelist(), log)
+	results := h.applyAtomic(ctx, client, "my-team", "dev", []unstructured.Unstructured{
+		*newApplication("example.com/my-app:v2"),
+		*created,
+		*broken,
+		*skipped,
+	}, false)
+
+	expected := []string{StatusRolledBack, StatusRolledBack, StatusError, StatusSkipped}
+	for i, r := range results {
+		if r.Status != expected[i] {
+			t.Errorf("results[%d]: expected status %q, got %q (%s)", i, expected[i], r.Status, r.Error)
+		}
+	}
+	for _, r := range results[:2] {
+		if r.Rollback == nil || r.Rollback.Status != RollbackStatusSucceeded {
+			t.Errorf("%s: expected successful rollback, got %+v", r.Resource, r.Rollback)
+		}
+	}
+
+	live, err := client.Resource(applicationGVR).Namespace("my-team").Get(ctx, "my-app", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if image, _, _ := unstructured.NestedString(live.Object, "spec", "image"); image != "example.com/my-app:v1" {
+		t.Errorf("expected my-app to be restored to v1, got %q", image)
+	}
+
+	for _, name := range []string{"other", "broken", "skipped"} {
+		_, err := client.Resource(applicationGVR).Namespace("my-team").Get(ctx, name, metav1.GetOptions{})
+		if !apierrors.IsNotFound(err) {
+			t.Errorf("expected %q to not exist, got err=%v", name, err)
+		}
+	}
+}
+
+func TestApplyAtomic_ValidationFailureAppliesNothing(t *testing.T) {
+	ctx := context.Background()
+	scheme, err := kubernetes.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewDynamicClient(scheme)
+
+	unnamed := newApplication("example.com/unnamed:v1")
+	unnamed.SetName("")
+
+	log, _ := logrustest.NewNullLogger()
+	h := NewHandler(nil, DefaultWhitelist(), log)
+	results := h.applyAtomic(ctx, client, "my-team", "dev", []unstructured.Unstructured{
+		*newApplication("example.com/my-app:v1"),
+		*unnamed,
+	}, false)
+
+	if results[0].Status != StatusSkipped {
+		t.Errorf("expected first resource to be skipped, got %q", results[0].Status)
+	}
+	if results[1].Status != StatusError {
+		t.Errorf("expected second resource to fail validation, got %q", results[1].Status)
+	}
+
+	_, err = client.Resource(applicationGVR).Namespace("my-team").Get(ctx, "my-app", metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected my-app to not exist, got err=%v", err)
+	}
+}
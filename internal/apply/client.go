@@ -25,6 +25,13 @@ type ApplyResult struct {
 	Created bool
 }
 
+// ApplyOptions controls how a resource is applied.
+type ApplyOptions struct {
+	// DryRun makes the API server process the apply without persisting the result.
+	// The returned After state is the object as it would have been stored.
+	DryRun bool
+}
+
 // ApplyResource performs a Kubernetes server-side apply for a single resource.
 // It fetches the current state (before), applies the resource, and returns both
 // before and after states so the caller can diff them.
@@ -33,6 +40,7 @@ func ApplyResource(
 	client dynamic.Interface,
 	gvr schema.GroupVersionResource,
 	obj *unstructured.Unstructured,
+	opts ApplyOptions,
 ) (*ApplyResult, error) {
 	namespace := obj.GetNamespace()
 	name := obj.GetName()
@@ -61,16 +69,21 @@ func ApplyResource(
 		return nil, fmt.Errorf("marshaling resource to JSON: %w", err)
 	}
 
+	patchOptions := metav1.PatchOptions{
+		FieldManager: fieldManager,
+		Force:        new(true),
+	}
+	if opts.DryRun {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	// Step 3: Server-side apply using PATCH with ApplyPatchType.
 	after, err := resourceClient.Patch(
 		ctx,
 		name,
 		types.ApplyPatchType,
 		data,
-		metav1.PatchOptions{
-			FieldManager: fieldManager,
-			Force:        new(true),
-		},
+		patchOptions,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("applying %s/%s: %w", namespace, name, err)
@@ -82,3 +95,34 @@ func ApplyResource(
 		Created: before == nil,
 	}, nil
 }
+
+// RestoreResource reverts a server-side apply described by result. Objects that were
+// created by the apply are deleted, and updated objects are replaced with their
+// previous state.
+func RestoreResource(
+	ctx context.Context,
+	client dynamic.Interface,
+	gvr schema.GroupVersionResource,
+	result *ApplyResult,
+) error {
+	namespace := result.After.GetNamespace()
+	name := result.After.GetName()
+	resourceClient := client.Resource(gvr).Namespace(namespace)
+
+	if result.Created {
+		if err := resourceClient.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting %s/%s: %w", namespace, name, err)
+		}
+		return nil
+	}
+
+	// Replace the object with its previous state. The resource version must match the
+	// current object, otherwise the update is rejected as a conflict.
+	previous := result.Before.DeepCopy()
+	previous.SetResourceVersion(result.After.GetResourceVersion())
+
+	if _, err := resourceClient.Update(ctx, previous, metav1.UpdateOptions{FieldManager: fieldManager}); err != nil {
+		return fmt.Errorf("restoring %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
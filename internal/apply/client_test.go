@@ -0,0 +1,139 @@
+package apply
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nais/api/internal/kubernetes"
+	"github.com/nais/api/internal/kubernetes/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var applicationGVR = schema.GroupVersionResource{Group: "nais.io", Version: "v1alpha1", Resource: "applications"}
+
+func newApplication(image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "nais.io/v1alpha1",
+			"kind":       "Application",
+			"metadata": map[string]any{
+				"name":      "my-app",
+				"namespace": "my-team",
+			},
+			"spec": map[string]any{
+				"image": image,
+			},
+		},
+	}
+}
+
+func TestApplyResource_DryRunDoesNotCreate(t *testing.T) {
+	ctx := context.Background()
+	scheme, err := kubernetes.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewDynamicClient(scheme)
+
+	res, err := ApplyResource(ctx, client, applicationGVR, newApplication("example.com/my-app:v1"), ApplyOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !res.Created {
+		t.Fatal("expected dry run to report the object as created")
+	}
+
+	_, err = client.Resource(applicationGVR).Namespace("my-team").Get(ctx, "my-app", metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected object to not exist after dry run, got err=%v", err)
+	}
+}
+
+func TestApplyResource_DryRunDoesNotUpdate(t *testing.T) {
+	ctx := context.Background()
+	scheme, err := kubernetes.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewDynamicClient(scheme)
+
+	if _, err := ApplyResource(ctx, client, applicationGVR, newApplication("example.com/my-app:v1"), ApplyOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res, err := ApplyResource(ctx, client, applicationGVR, newApplication("example.com/my-app:v2"), ApplyOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changes := toFieldMap(Diff(res.Before, res.After))
+	if c, ok := changes["spec.image"]; !ok || *c.NewValue != "example.com/my-app:v2" {
+		t.Fatalf("expected spec.image to change to v2 in dry run diff, got %v", changes)
+	}
+
+	live, err := client.Resource(applicationGVR).Namespace("my-team").Get(ctx, "my-app", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	image, _, _ := unstructured.NestedString(live.Object, "spec", "image")
+	if image != "example.com/my-app:v1" {
+		t.Fatalf("expected live object to be unchanged, got image %q", image)
+	}
+}
+
+func TestRestoreResource_DeletesCreatedObject(t *testing.T) {
+	ctx := context.Background()
+	scheme, err := kubernetes.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewDynamicClient(scheme)
+
+	res, err := ApplyResource(ctx, client, applicationGVR, newApplication("example.com/my-app:v1"), ApplyOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := RestoreResource(ctx, client, applicationGVR, res); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = client.Resource(applicationGVR).Namespace("my-team").Get(ctx, "my-app", metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected object to be deleted, got err=%v", err)
+	}
+}
+
+func TestRestoreResource_RestoresUpdatedObject(t *testing.T) {
+	ctx := context.Background()
+	scheme, err := kubernetes.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewDynamicClient(scheme)
+
+	if _, err := ApplyResource(ctx, client, applicationGVR, newApplication("example.com/my-app:v1"), ApplyOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res, err := ApplyResource(ctx, client, applicationGVR, newApplication("example.com/my-app:v2"), ApplyOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := RestoreResource(ctx, client, applicationGVR, res); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	live, err := client.Resource(applicationGVR).Namespace("my-team").Get(ctx, "my-app", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	image, _, _ := unstructured.NestedString(live.Object, "spec", "image")
+	if image != "example.com/my-app:v1" {
+		t.Fatalf("expected image to be restored to v1, got %q", image)
+	}
+}
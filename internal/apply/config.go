@@ -0,0 +1,94 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WhitelistConfig describes the resources that can be applied through the API, in addition
+// to or instead of the built-in resources.
+type WhitelistConfig struct {
+	// ReplaceDefaults removes the built-in resources from the whitelist.
+	ReplaceDefaults bool `json:"replaceDefaults"`
+
+	// Resources are allowed in all environments.
+	Resources []ResourceConfig `json:"resources"`
+
+	// Environments holds per-environment overrides, keyed by environment name.
+	Environments map[string]EnvironmentWhitelistConfig `json:"environments"`
+}
+
+// EnvironmentWhitelistConfig overrides the whitelist for a single environment.
+type EnvironmentWhitelistConfig struct {
+	// Allow are allowed in the environment only.
+	Allow []ResourceConfig `json:"allow"`
+
+	// Deny are removed from the whitelist of the environment.
+	Deny []AllowedResource `json:"deny"`
+}
+
+// ResourceConfig is a configured whitelist entry.
+type ResourceConfig struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// Resource is the plural resource name, e.g. "applications". When empty, the resource name
+	// is looked up using discovery.
+	Resource string `json:"resource"`
+}
+
+var _ json.Unmarshaler = (*WhitelistConfig)(nil)
+
+func (c *WhitelistConfig) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	type whitelistConfig WhitelistConfig
+	var cfg whitelistConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("unmarshalling apply whitelist config: %w", err)
+	}
+
+	validate := func(apiVersion, kind string) error {
+		if apiVersion == "" || kind == "" {
+			return fmt.Errorf("whitelist entry is missing apiVersion or kind: %q/%q", apiVersion, kind)
+		}
+		return nil
+	}
+
+	for _, r := range cfg.Resources {
+		if err := validate(r.APIVersion, r.Kind); err != nil {
+			return err
+		}
+	}
+	for _, env := range cfg.Environments {
+		for _, r := range env.Allow {
+			if err := validate(r.APIVersion, r.Kind); err != nil {
+				return err
+			}
+		}
+		for _, r := range env.Deny {
+			if err := validate(r.APIVersion, r.Kind); err != nil {
+				return err
+			}
+		}
+	}
+
+	*c = WhitelistConfig(cfg)
+	return nil
+}
+
+func (r ResourceConfig) allowedResource() AllowedResource {
+	return AllowedResource{APIVersion: r.APIVersion, Kind: r.Kind}
+}
+
+func (r ResourceConfig) gvr() (schema.GroupVersionResource, error) {
+	gv, err := schema.ParseGroupVersion(r.APIVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("parse apiVersion %q: %w", r.APIVersion, err)
+	}
+	return gv.WithResource(r.Resource), nil
+}
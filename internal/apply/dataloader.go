@@ -0,0 +1,27 @@
+package apply
+
+import (
+	"context"
+)
+
+type ctxKey int
+
+const loadersKey ctxKey = iota
+
+func NewLoaderContext(ctx context.Context, handler *Handler) context.Context {
+	return context.WithValue(ctx, loadersKey, newLoaders(handler))
+}
+
+func fromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey).(*loaders)
+}
+
+type loaders struct {
+	handler *Handler
+}
+
+func newLoaders(handler *Handler) *loaders {
+	return &loaders{
+		handler: handler,
+	}
+}
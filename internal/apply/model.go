@@ -4,7 +4,25 @@ import "github.com/nais/api/internal/activitylog"
 
 // Response is the top-level response returned by the apply endpoint.
 type Response struct {
+	// DryRun is true if the request was processed without persisting any changes.
+	DryRun bool `json:"dryRun,omitempty"`
+
 	Results []ResourceResult `json:"results"`
+
+	// Prune holds the outcome of pruning resources missing from the request. Only set
+	// when pruning was requested.
+	Prune *PruneResult `json:"prune,omitempty"`
+}
+
+// PruneResult represents the outcome of pruning resources that are no longer part of the
+// submitted set.
+type PruneResult struct {
+	// Resources lists the resources that were deleted, or would be deleted for a dry run.
+	Resources []ResourceResult `json:"resources"`
+
+	// Error contains the reason nothing was pruned, e.g. because the number of resources to
+	// delete exceeds the prune limit.
+	Error string `json:"error,omitempty"`
 }
 
 // ResourceResult represents the outcome of applying a single resource.
@@ -15,14 +33,42 @@ type ResourceResult struct {
 	// EnvironmentName is the target environment the resource was applied to.
 	EnvironmentName string `json:"environmentName"`
 
-	// Status is one of "created", "applied", or "error".
+	// Status is one of "created", "applied", "deleted", "rolledBack", "skipped", or "error".
 	Status string `json:"status"`
 
 	// ChangedFields lists the fields that were changed during the apply.
-	// Only populated when Status is "applied" (i.e. an update, not a create).
+	// Only populated when Status is "applied" (i.e. an update, not a create),
+	// or for any status when the request is a dry run.
 	ChangedFields []activitylog.ResourceChangedField `json:"changedFields,omitempty"`
 
-	// Error contains the error message if Status is "error".
+	// Error contains the error message if Status is "error", or the reason the
+	// resource was not applied if Status is "skipped".
+	Error string `json:"error,omitempty"`
+
+	// Rollback describes the attempt to restore the resource after another resource in
+	// the same atomic request failed to apply. Only set for resources that were rolled back.
+	Rollback *RollbackResult `json:"rollback,omitempty"`
+
+	// Violations lists the policy rules the resource violates. Only set when the resource was
+	// rejected by policy validation.
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Violation describes a policy rule violated by a resource.
+type Violation struct {
+	// Rule is the name of the violated rule.
+	Rule string `json:"rule"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// RollbackResult represents the outcome of rolling back a single resource.
+type RollbackResult struct {
+	// Status is either "succeeded" or "failed".
+	Status string `json:"status"`
+
+	// Error contains the error message if Status is "failed".
 	Error string `json:"error,omitempty"`
 }
 
@@ -30,4 +76,20 @@ const (
 	StatusCreated = "created"
 	StatusApplied = "applied"
 	StatusError   = "error"
+
+	// StatusDeleted is used for resources removed by pruning.
+	StatusDeleted = "deleted"
+
+	// StatusRolledBack is used in atomic requests for resources that were applied,
+	// and then restored to their previous state because another resource failed.
+	StatusRolledBack = "rolledBack"
+
+	// StatusSkipped is used in atomic requests for resources that were not applied
+	// because another resource in the request failed.
+	StatusSkipped = "skipped"
+)
+
+const (
+	RollbackStatusSucceeded = "succeeded"
+	RollbackStatusFailed    = "failed"
 )
@@ -0,0 +1,187 @@
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PolicyRule is a rule that resources must satisfy to be applied.
+type PolicyRule struct {
+	// Name identifies the rule in violations. A rule with the name of a built-in rule and
+	// no expression enables the built-in rule.
+	Name string `json:"name"`
+
+	// Resources limits the rule to the given resource types. The rule applies to all resource
+	// types when empty.
+	Resources []AllowedResource `json:"resources,omitempty"`
+
+	// Environments limits the rule to the given environments. The rule applies to all
+	// environments when empty.
+	Environments []string `json:"environments,omitempty"`
+
+	// Expression is a CEL expression evaluated with the resource as `object` and the target
+	// environment name as `environment`. The resource violates the rule unless the expression
+	// evaluates to true.
+	Expression string `json:"expression,omitempty"`
+
+	// Message describes the violation.
+	Message string `json:"message,omitempty"`
+}
+
+// PolicyRules is the set of rules configured for the apply endpoint.
+type PolicyRules []PolicyRule
+
+var _ json.Unmarshaler = (*PolicyRules)(nil)
+
+func (p *PolicyRules) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	rules := make([]PolicyRule, 0)
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(&rules); err != nil {
+		return fmt.Errorf("unmarshalling apply policy rules: %w", err)
+	}
+
+	names := map[string]struct{}{}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return fmt.Errorf("policy rule is missing a name")
+		}
+		if _, ok := names[rule.Name]; ok {
+			return fmt.Errorf("duplicate policy rule: %q", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		if _, ok := builtinPolicyRules[rule.Name]; !ok && rule.Expression == "" {
+			return fmt.Errorf("policy rule is missing an expression: %q", rule.Name)
+		}
+	}
+
+	*p = rules
+	return nil
+}
+
+var naisWorkloads = []AllowedResource{
+	{APIVersion: "nais.io/v1alpha1", Kind: "Application"},
+	{APIVersion: "nais.io/v1", Kind: "Naisjob"},
+	{APIVersion: "nais.io/v1alpha1", Kind: "Naisjob"},
+}
+
+// builtinPolicyRules can be enabled by name in the policy configuration.
+var builtinPolicyRules = map[string]PolicyRule{
+	"disallow-latest-tag": {
+		Resources: naisWorkloads,
+		Expression: `!object.?spec.?image.hasValue() ||
+			(object.spec.image.contains("@") || object.spec.image.matches(":[^/]+$")) && !object.spec.image.endsWith(":latest")`,
+		Message: "image must be pinned to a digest or a tag other than latest",
+	},
+	"require-memory-limit": {
+		Resources:  naisWorkloads,
+		Expression: `object.?spec.?resources.?limits.?memory.hasValue()`,
+		Message:    "memory limit must be set in spec.resources.limits.memory",
+	},
+	"disallow-host-network": {
+		Resources:  []AllowedResource{{APIVersion: "batch/v1", Kind: "Job"}},
+		Expression: `!object.?spec.?template.?spec.?hostNetwork.orValue(false)`,
+		Message:    "hostNetwork must not be enabled",
+	},
+}
+
+// PolicyValidator validates resources against a set of CEL policy rules.
+type PolicyValidator struct {
+	rules []compiledRule
+}
+
+type compiledRule struct {
+	PolicyRule
+	program cel.Program
+}
+
+var _ Validator = (*PolicyValidator)(nil)
+
+// NewPolicyValidator compiles the given rules. Rules referring to built-in rules by name are
+// replaced by the built-in rule, restricted to the configured environments.
+func NewPolicyValidator(rules PolicyRules) (*PolicyValidator, error) {
+	env, err := cel.NewEnv(
+		cel.OptionalTypes(),
+		cel.Variable("object", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("environment", cel.StringType),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create CEL environment: %w", err)
+	}
+
+	v := &PolicyValidator{}
+	for _, rule := range rules {
+		if builtin, ok := builtinPolicyRules[rule.Name]; ok && rule.Expression == "" {
+			builtin.Name = rule.Name
+			builtin.Environments = rule.Environments
+			rule = builtin
+		}
+
+		ast, issues := env.Compile(rule.Expression)
+		if issues.Err() != nil {
+			return nil, fmt.Errorf("compile policy rule %q: %w", rule.Name, issues.Err())
+		}
+		if t := ast.OutputType(); !t.IsExactType(cel.BoolType) && !t.IsExactType(cel.DynType) {
+			return nil, fmt.Errorf("policy rule %q must evaluate to a bool, got %s", rule.Name, t)
+		}
+
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("create program for policy rule %q: %w", rule.Name, err)
+		}
+
+		if rule.Message == "" {
+			rule.Message = fmt.Sprintf("resource does not satisfy %q", rule.Expression)
+		}
+
+		v.rules = append(v.rules, compiledRule{PolicyRule: rule, program: program})
+	}
+
+	return v, nil
+}
+
+// Validate evaluates all rules matching the resource. Rules that fail to evaluate are reported
+// as violations, so a broken rule never lets a resource through.
+func (v *PolicyValidator) Validate(ctx context.Context, environmentName string, res *unstructured.Unstructured) []Violation {
+	var ret []Violation
+	for _, rule := range v.rules {
+		if !rule.matches(environmentName, res) {
+			continue
+		}
+
+		out, _, err := rule.program.ContextEval(ctx, map[string]any{
+			"object":      res.Object,
+			"environment": environmentName,
+		})
+		if err != nil {
+			ret = append(ret, Violation{Rule: rule.Name, Message: fmt.Sprintf("evaluating rule: %s", err)})
+			continue
+		}
+
+		if ok, isBool := out.Value().(bool); !isBool {
+			ret = append(ret, Violation{Rule: rule.Name, Message: fmt.Sprintf("rule evaluated to %s, expected bool", out.Type().TypeName())})
+		} else if !ok {
+			ret = append(ret, Violation{Rule: rule.Name, Message: rule.Message})
+		}
+	}
+	return ret
+}
+
+func (r compiledRule) matches(environmentName string, res *unstructured.Unstructured) bool {
+	if len(r.Environments) > 0 && !slices.Contains(r.Environments, environmentName) {
+		return false
+	}
+	if len(r.Resources) > 0 && !slices.Contains(r.Resources, AllowedResource{APIVersion: res.GetAPIVersion(), Kind: res.GetKind()}) {
+		return false
+	}
+	return true
+}
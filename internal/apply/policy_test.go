@@ -0,0 +1,177 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nais/api/internal/kubernetes"
+	"github.com/nais/api/internal/kubernetes/fake"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPolicyValidator_BuiltinRules(t *testing.T) {
+	v, err := NewPolicyValidator(PolicyRules{
+		{Name: "disallow-latest-tag"},
+		{Name: "require-memory-limit"},
+		{Name: "disallow-host-network"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	withLimits := func(res *unstructured.Unstructured) *unstructured.Unstructured {
+		_ = unstructured.SetNestedField(res.Object, "256Mi", "spec", "resources", "limits", "memory")
+		return res
+	}
+
+	job := func(hostNetwork bool) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"metadata":   map[string]any{"name": "my-job"},
+			"spec": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{"hostNetwork": hostNetwork},
+				},
+			},
+		}}
+	}
+
+	tests := map[string]struct {
+		res        *unstructured.Unstructured
+		violations []string
+	}{
+		"pinned image with limits": {
+			res: withLimits(newApplication("example.com/my-app:v1")),
+		},
+		"image digest": {
+			res: withLimits(newApplication("example.com/my-app@sha256:abc")),
+		},
+		"latest tag": {
+			res:        withLimits(newApplication("example.com/my-app:latest")),
+			violations: []string{"disallow-latest-tag"},
+		},
+		"registry port without tag": {
+			res:        withLimits(newApplication("example.com:5000/my-app")),
+			violations: []string{"disallow-latest-tag"},
+		},
+		"missing limits": {
+			res:        newApplication("example.com/my-app:v1"),
+			violations: []string{"require-memory-limit"},
+		},
+		"job without host network": {
+			res: job(false),
+		},
+		"job with host network": {
+			res:        job(true),
+			violations: []string{"disallow-host-network"},
+		},
+		"job without pod spec": {
+			res: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+			}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations := v.Validate(context.Background(), "dev", tt.res)
+			if len(violations) != len(tt.violations) {
+				t.Fatalf("expected violations %v, got %v", tt.violations, violations)
+			}
+			for i, rule := range tt.violations {
+				if violations[i].Rule != rule {
+					t.Errorf("expected violation of %q, got %v", rule, violations[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyValidator_CustomRule(t *testing.T) {
+	v, err := NewPolicyValidator(PolicyRules{
+		{
+			Name:         "single-replica",
+			Resources:    []AllowedResource{{APIVersion: "nais.io/v1alpha1", Kind: "Application"}},
+			Environments: []string{"prod"},
+			Expression:   `object.?spec.?replicas.?max.orValue(1) <= 1`,
+			Message:      "at most one replica",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := newApplication("example.com/my-app:v1")
+	_ = unstructured.SetNestedField(res.Object, int64(2), "spec", "replicas", "max")
+
+	if violations := v.Validate(context.Background(), "dev", res); len(violations) != 0 {
+		t.Errorf("expected rule to be limited to prod, got %v", violations)
+	}
+
+	violations := v.Validate(context.Background(), "prod", res)
+	if len(violations) != 1 || violations[0].Message != "at most one replica" {
+		t.Errorf("expected one violation, got %v", violations)
+	}
+}
+
+func TestNewPolicyValidator_InvalidRule(t *testing.T) {
+	if _, err := NewPolicyValidator(PolicyRules{{Name: "broken", Expression: "object."}}); err == nil {
+		t.Error("expected compile error")
+	}
+	if _, err := NewPolicyValidator(PolicyRules{{Name: "not-bool", Expression: `"string"`}}); err == nil {
+		t.Error("expected error for non-bool expression")
+	}
+}
+
+func TestPolicyRules_UnmarshalJSON(t *testing.T) {
+	var rules PolicyRules
+	if err := json.Unmarshal([]byte(`[{"name": "disallow-latest-tag", "environments": ["prod"]}]`), &rules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rules) != 1 || rules[0].Environments[0] != "prod" {
+		t.Errorf("unexpected rules: %v", rules)
+	}
+
+	if err := json.Unmarshal([]byte(`[{"name": "custom"}]`), &rules); err == nil {
+		t.Error("expected error for custom rule without expression")
+	}
+	if err := json.Unmarshal([]byte(`[{"name": "a", "expression": "true"}, {"name": "a", "expression": "true"}]`), &rules); err == nil {
+		t.Error("expected error for duplicate rule")
+	}
+}
+
+func TestApplyOne_PolicyViolation(t *testing.T) {
+	ctx := context.Background()
+	scheme, err := kubernetes.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewDynamicClient(scheme)
+
+	v, err := NewPolicyValidator(PolicyRules{{Name: "disallow-latest-tag"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log, _ := logrustest.NewNullLogger()
+	h := NewHandler(nil, DefaultWhitelist(), log, WithValidators(v))
+	result := h.applyOne(ctx, client, "my-team", "dev", newApplication("example.com/my-app:latest"), false)
+
+	if result.Status != StatusError {
+		t.Fatalf("expected status %q, got %q", StatusError, result.Status)
+	}
+	if len(result.Violations) != 1 || result.Violations[0].Rule != "disallow-latest-tag" {
+		t.Fatalf("expected disallow-latest-tag violation, got %v", result.Violations)
+	}
+
+	_, err = client.Resource(applicationGVR).Namespace("my-team").Get(ctx, "my-app", metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected object to not be applied, got err=%v", err)
+	}
+}
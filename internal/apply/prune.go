@@ -0,0 +1,173 @@
+package apply
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/slug"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// managedLabelKey is set on every resource applied through the apply endpoint. Only
+	// resources carrying this label are considered when pruning.
+	managedLabelKey   = "apply.nais.io/managed"
+	managedLabelValue = "true"
+
+	// defaultPruneLimit is the maximum number of resources pruned by a single request,
+	// unless the request specifies a limit.
+	defaultPruneLimit = 10
+)
+
+// pruneKey identifies a resource independently of the API version it was applied with.
+type pruneKey struct {
+	schema.GroupResource
+	Name string
+}
+
+type pruneCandidate struct {
+	gvr schema.GroupVersionResource
+	obj *unstructured.Unstructured
+}
+
+// pruneAfterApply prunes resources missing from the request, unless any of the submitted
+// resources failed to apply.
+func (h *Handler) pruneAfterApply(
+	ctx context.Context,
+	client dynamic.Interface,
+	teamSlug slug.Slug,
+	environmentName string,
+	req request,
+	results []ResourceResult,
+) *PruneResult {
+	for _, r := range results {
+		if r.Status != StatusCreated && r.Status != StatusApplied {
+			return &PruneResult{
+				Resources: []ResourceResult{},
+				Error:     "not pruning: one or more resources failed to apply",
+			}
+		}
+	}
+
+	limit := req.PruneLimit
+	if limit <= 0 {
+		limit = defaultPruneLimit
+	}
+
+	return h.prune(ctx, client, teamSlug, environmentName, req.Resources, limit, req.DryRun)
+}
+
+// prune deletes the apply-managed resources in the team namespace that are not part of
+// the submitted resources. If more than limit resources would be deleted, nothing is
+// deleted and the candidates are returned as skipped. For dry runs the candidates are
+// returned without being deleted.
+func (h *Handler) prune(
+	ctx context.Context,
+	client dynamic.Interface,
+	teamSlug slug.Slug,
+	environmentName string,
+	resources []unstructured.Unstructured,
+	limit int,
+	dryRun bool,
+) *PruneResult {
+	submitted := make(map[pruneKey]struct{}, len(resources))
+	for _, res := range resources {
+		if gvr, ok := h.whitelist.GVRFor(environmentName, res.GetAPIVersion(), res.GetKind()); ok {
+			submitted[pruneKey{GroupResource: gvr.GroupResource(), Name: res.GetName()}] = struct{}{}
+		}
+	}
+
+	var candidates []pruneCandidate
+	seen := map[pruneKey]struct{}{}
+	for _, gvr := range h.whitelist.prunableResources(environmentName) {
+		list, err := client.Resource(gvr).Namespace(string(teamSlug)).List(ctx, metav1.ListOptions{
+			LabelSelector: managedLabelKey + "=" + managedLabelValue,
+		})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// The resource type is not served by this cluster.
+				continue
+			}
+			h.log.WithError(err).WithField("resource", gvr.String()).Error("listing resources to prune")
+			return &PruneResult{
+				Resources: []ResourceResult{},
+				Error:     fmt.Sprintf("not pruning: listing %s: %s", gvr.GroupResource(), err),
+			}
+		}
+
+		for _, item := range list.Items {
+			key := pruneKey{GroupResource: gvr.GroupResource(), Name: item.GetName()}
+			if _, ok := submitted[key]; ok {
+				continue
+			}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			candidates = append(candidates, pruneCandidate{gvr: gvr, obj: &item})
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b pruneCandidate) int {
+		return cmp.Compare(resourceID(a.obj), resourceID(b.obj))
+	})
+
+	ret := &PruneResult{Resources: make([]ResourceResult, 0, len(candidates))}
+
+	if len(candidates) > limit {
+		ret.Error = fmt.Sprintf("not pruning: %d resources would be pruned, which exceeds the limit of %d", len(candidates), limit)
+		for _, c := range candidates {
+			ret.Resources = append(ret.Resources, skippedResult(environmentName, c.obj, "prune limit exceeded"))
+		}
+		return ret
+	}
+
+	for _, c := range candidates {
+		if dryRun {
+			ret.Resources = append(ret.Resources, ResourceResult{
+				Resource:        resourceID(c.obj),
+				EnvironmentName: environmentName,
+				Status:          StatusDeleted,
+			})
+			continue
+		}
+
+		err := client.Resource(c.gvr).Namespace(string(teamSlug)).Delete(ctx, c.obj.GetName(), metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			h.logFor(teamSlug, environmentName, c.obj).WithError(err).Error("pruning resource")
+			ret.Resources = append(ret.Resources, errorResult(environmentName, c.obj, fmt.Sprintf("delete failed: %s", err)))
+			continue
+		}
+
+		h.createActivityLogEntry(ctx, teamSlug, environmentName, c.obj, activitylog.ActivityLogEntryActionDeleted, nil)
+		ret.Resources = append(ret.Resources, ResourceResult{
+			Resource:        resourceID(c.obj),
+			EnvironmentName: environmentName,
+			Status:          StatusDeleted,
+		})
+	}
+
+	return ret
+}
+
+// prunableResources returns all resources whitelisted in the environment in a stable order.
+// Resources served in several versions are listed once per version, so the same object may
+// be returned more than once when listing them.
+func (w *Whitelist) prunableResources(environmentName string) []schema.GroupVersionResource {
+	resources := w.resourcesFor(environmentName)
+	ret := make([]schema.GroupVersionResource, 0, len(resources))
+	for _, gvr := range resources {
+		ret = append(ret, gvr)
+	}
+	slices.SortFunc(ret, func(a, b schema.GroupVersionResource) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+	return slices.Compact(ret)
+}
@@ -0,0 +1,67 @@
+package apply
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nais/api/internal/kubernetes"
+	"github.com/nais/api/internal/kubernetes/fake"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	scheme, err := kubernetes.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.NewDynamicClient(scheme)
+
+	log, _ := logrustest.NewNullLogger()
+	h := NewHandler(nil, DefaultWhitelist(), log)
+
+	kept := newApplication("example.com/kept:v1")
+	kept.SetName("kept")
+	removed := newApplication("example.com/removed:v1")
+	removed.SetName("removed")
+	unmanaged := newApplication("example.com/unmanaged:v1")
+	unmanaged.SetName("unmanaged")
+
+	for _, res := range []*unstructured.Unstructured{kept, removed} {
+		gvr, errResult := h.prepare("my-team", "dev", res)
+		if errResult != nil {
+			t.Fatalf("unexpected error preparing %s: %s", errResult.Resource, errResult.Error)
+		}
+		if _, err := ApplyResource(ctx, client, gvr, res, ApplyOptions{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := ApplyResource(ctx, client, applicationGVR, unmanaged, ApplyOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	submitted := []unstructured.Unstructured{*newApplication("example.com/kept:v1")}
+	submitted[0].SetName("kept")
+
+	t.Run("limit exceeded", func(t *testing.T) {
+		ret := h.prune(ctx, client, "my-team", "dev", submitted, 0, false)
+		if ret.Error == "" {
+			t.Fatal("expected error when exceeding the prune limit")
+		}
+		if len(ret.Resources) != 1 || ret.Resources[0].Status != StatusSkipped {
+			t.Fatalf("expected a single skipped resource, got %+v", ret.Resources)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		ret := h.prune(ctx, client, "my-team", "dev", submitted, defaultPruneLimit, true)
+		if len(ret.Resources) != 1 || ret.Resources[0].Resource != "Application/removed" || ret.Resources[0].Status != StatusDeleted {
+			t.Fatalf("expected removed to be listed for pruning, got %+v", ret.Resources)
+		}
+		if _, err := client.Resource(applicationGVR).Namespace("my-team").Get(ctx, "removed", metav1.GetOptions{}); err != nil {
+			t.Fatalf("expected removed to still exist after dry run, got err=%v", err)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nais/api/internal/environmentmapper"
+	"github.com/nais/api/internal/slug"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ListAllowedResources returns the resources that can be applied to the environment.
+func ListAllowedResources(ctx context.Context, environmentName string) []*AllowedResource {
+	return fromContext(ctx).handler.whitelist.AllowedResources(environmentName)
+}
+
+// Apply applies the resources to a team environment as a single unit, the same way as an atomic request to the apply
+// endpoint. The caller is responsible for checking that the actor can apply resources to the environment, and for
+// checking deployment freezes.
+func Apply(ctx context.Context, teamSlug slug.Slug, environmentName string, resources []unstructured.Unstructured) ([]ResourceResult, error) {
+	h := fromContext(ctx).handler
+
+	if disallowed := h.disallowed(environmentName, resources); len(disallowed) > 0 {
+		return nil, fmt.Errorf("disallowed resource types: %s", strings.Join(disallowed, "; "))
+	}
+
+	client, err := h.dynamicClientFn(environmentmapper.ClusterName(environmentName), teamSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for environment %q: %w", environmentName, err)
+	}
+
+	return h.applyAtomic(ctx, client, teamSlug, environmentName, resources, false), nil
+}
@@ -0,0 +1,57 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Validator validates a resource before it is applied. Validators run after the resource has
+// been checked against the whitelist and targeted at the team namespace.
+type Validator interface {
+	// Validate returns the violations found in the resource. A resource without violations
+	// is applied.
+	Validate(ctx context.Context, environmentName string, res *unstructured.Unstructured) []Violation
+}
+
+// ValidatorChain runs all validators and combines their violations.
+type ValidatorChain []Validator
+
+var _ Validator = ValidatorChain(nil)
+
+func (c ValidatorChain) Validate(ctx context.Context, environmentName string, res *unstructured.Unstructured) []Violation {
+	var ret []Violation
+	for _, v := range c {
+		ret = append(ret, v.Validate(ctx, environmentName, res)...)
+	}
+	return ret
+}
+
+type HandlerOption func(*Handler)
+
+// WithValidators adds validators that every resource must pass before it is applied.
+func WithValidators(validators ...Validator) HandlerOption {
+	return func(h *Handler) {
+		h.validators = append(h.validators, validators...)
+	}
+}
+
+// validate runs the validator chain on the resource, and returns a result describing the
+// violations if the resource is rejected.
+func (h *Handler) validate(ctx context.Context, environmentName string, res *unstructured.Unstructured) *ResourceResult {
+	violations := h.validators.Validate(ctx, environmentName, res)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", v.Rule, v.Message))
+	}
+
+	r := errorResult(environmentName, res, "policy violations: "+strings.Join(msgs, "; "))
+	r.Violations = violations
+	return &r
+}
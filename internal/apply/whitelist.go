@@ -1,20 +1,27 @@
 package apply
 
 import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 )
 
 // AllowedResource identifies a Kubernetes resource by its apiVersion and kind.
 type AllowedResource struct {
-	APIVersion string
-	Kind       string
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
 }
 
-// allowedResources is the single source of truth for which resources can be applied
-// through the API. Each entry maps an apiVersion+kind pair to its GroupVersionResource,
-// avoiding the need for a discovery client.
-var allowedResources = map[AllowedResource]schema.GroupVersionResource{
+// defaultAllowedResources are the resources that can be applied through the API unless the
+// whitelist configuration says otherwise. Each entry maps an apiVersion+kind pair to its
+// GroupVersionResource, avoiding the need for a discovery client.
+var defaultAllowedResources = map[AllowedResource]schema.GroupVersionResource{
 	// Core workloads
 	{APIVersion: "nais.io/v1alpha1", Kind: "Application"}: {
 		Group: "nais.io", Version: "v1alpha1", Resource: "applications",
@@ -171,15 +178,151 @@ var allowedResources = map[AllowedResource]schema.GroupVersionResource{
 	},
 }
 
-// IsAllowed returns true if the given apiVersion and kind are in the whitelist.
-func IsAllowed(res unstructured.Unstructured) bool {
-	_, ok := allowedResources[AllowedResource{APIVersion: res.GetAPIVersion(), Kind: res.GetKind()}]
+// DiscoveryClientFactory returns a discovery client for the given environment. It is used to
+// look up the resource name of configured resources that do not specify one.
+type DiscoveryClientFactory func(environmentName string) (discovery.DiscoveryInterface, error)
+
+// Whitelist holds the resources that can be applied through the API, per environment.
+type Whitelist struct {
+	// defaults are used for environments without an entry in environments.
+	defaults     map[AllowedResource]schema.GroupVersionResource
+	environments map[string]map[AllowedResource]schema.GroupVersionResource
+}
+
+// DefaultWhitelist returns a whitelist containing the built-in resources only.
+func DefaultWhitelist() *Whitelist {
+	return &Whitelist{
+		defaults:     maps.Clone(defaultAllowedResources),
+		environments: map[string]map[AllowedResource]schema.GroupVersionResource{},
+	}
+}
+
+// NewWhitelist builds the whitelist for the given environments from the built-in resources
+// and cfg. Configured resources without a resource name are resolved through discovery in
+// each environment. discoveryClientFn may be nil, in which case every configured resource
+// must specify its resource name.
+func NewWhitelist(cfg WhitelistConfig, environmentNames []string, discoveryClientFn DiscoveryClientFactory) (*Whitelist, error) {
+	for name := range cfg.Environments {
+		if !slices.Contains(environmentNames, name) {
+			return nil, fmt.Errorf("whitelist configured for unknown environment %q", name)
+		}
+	}
+
+	w := &Whitelist{
+		defaults:     map[AllowedResource]schema.GroupVersionResource{},
+		environments: make(map[string]map[AllowedResource]schema.GroupVersionResource, len(environmentNames)),
+	}
+
+	if !cfg.ReplaceDefaults {
+		maps.Copy(w.defaults, defaultAllowedResources)
+	}
+
+	for _, r := range cfg.Resources {
+		if r.Resource == "" {
+			// Only known once resolved in an environment.
+			continue
+		}
+		gvr, err := r.gvr()
+		if err != nil {
+			return nil, err
+		}
+		w.defaults[r.allowedResource()] = gvr
+	}
+
+	for _, environmentName := range environmentNames {
+		envCfg := cfg.Environments[environmentName]
+		resources := maps.Clone(w.defaults)
+
+		var client discovery.DiscoveryInterface
+		for _, r := range slices.Concat(cfg.Resources, envCfg.Allow) {
+			if r.Resource != "" {
+				gvr, err := r.gvr()
+				if err != nil {
+					return nil, err
+				}
+				resources[r.allowedResource()] = gvr
+				continue
+			}
+
+			if client == nil {
+				if discoveryClientFn == nil {
+					return nil, fmt.Errorf("resource for %s/%s must be set when discovery is unavailable", r.APIVersion, r.Kind)
+				}
+				var err error
+				client, err = discoveryClientFn(environmentName)
+				if err != nil {
+					return nil, fmt.Errorf("create discovery client for environment %q: %w", environmentName, err)
+				}
+			}
+
+			gvr, err := discoverGVR(client, r.APIVersion, r.Kind)
+			if err != nil {
+				return nil, fmt.Errorf("environment %q: %w", environmentName, err)
+			}
+			resources[r.allowedResource()] = gvr
+		}
+
+		for _, r := range envCfg.Deny {
+			delete(resources, r)
+		}
+
+		w.environments[environmentName] = resources
+	}
+
+	return w, nil
+}
+
+// discoverGVR looks up the GroupVersionResource for the given apiVersion and kind.
+func discoverGVR(client discovery.DiscoveryInterface, apiVersion, kind string) (schema.GroupVersionResource, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("parse apiVersion %q: %w", apiVersion, err)
+	}
+
+	list, err := client.ServerResourcesForGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("discover resources for %q: %w", apiVersion, err)
+	}
+
+	for _, r := range list.APIResources {
+		// Subresources, such as deployments/scale, share the kind of their parent.
+		if r.Kind == kind && !strings.Contains(r.Name, "/") {
+			return gv.WithResource(r.Name), nil
+		}
+	}
+
+	return schema.GroupVersionResource{}, fmt.Errorf("no resource found for %s/%s", apiVersion, kind)
+}
+
+func (w *Whitelist) resourcesFor(environmentName string) map[AllowedResource]schema.GroupVersionResource {
+	if resources, ok := w.environments[environmentName]; ok {
+		return resources
+	}
+	return w.defaults
+}
+
+// IsAllowed returns true if the apiVersion and kind of the resource can be applied to the environment.
+func (w *Whitelist) IsAllowed(environmentName string, res unstructured.Unstructured) bool {
+	_, ok := w.resourcesFor(environmentName)[AllowedResource{APIVersion: res.GetAPIVersion(), Kind: res.GetKind()}]
 	return ok
 }
 
-// GVRFor returns the GroupVersionResource for the given apiVersion and kind.
+// GVRFor returns the GroupVersionResource for the given apiVersion and kind in the environment.
 // The second return value is false if the resource is not in the whitelist.
-func GVRFor(apiVersion, kind string) (schema.GroupVersionResource, bool) {
-	gvr, ok := allowedResources[AllowedResource{APIVersion: apiVersion, Kind: kind}]
+func (w *Whitelist) GVRFor(environmentName, apiVersion, kind string) (schema.GroupVersionResource, bool) {
+	gvr, ok := w.resourcesFor(environmentName)[AllowedResource{APIVersion: apiVersion, Kind: kind}]
 	return gvr, ok
 }
+
+// AllowedResources returns the resources that can be applied to the environment, ordered by
+// apiVersion and kind.
+func (w *Whitelist) AllowedResources(environmentName string) []*AllowedResource {
+	ret := make([]*AllowedResource, 0, len(w.resourcesFor(environmentName)))
+	for r := range w.resourcesFor(environmentName) {
+		ret = append(ret, &r)
+	}
+	slices.SortFunc(ret, func(a, b *AllowedResource) int {
+		return cmp.Or(cmp.Compare(a.APIVersion, b.APIVersion), cmp.Compare(a.Kind, b.Kind))
+	})
+	return ret
+}
@@ -0,0 +1,167 @@
+package apply
+
+import (
+	"encoding/json"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func resource(apiVersion, kind string) unstructured.Unstructured {
+	res := unstructured.Unstructured{}
+	res.SetAPIVersion(apiVersion)
+	res.SetKind(kind)
+	return res
+}
+
+func TestNewWhitelist(t *testing.T) {
+	cfg := WhitelistConfig{
+		Resources: []ResourceConfig{
+			{APIVersion: "example.com/v1", Kind: "Widget", Resource: "widgets"},
+		},
+		Environments: map[string]EnvironmentWhitelistConfig{
+			"prod": {
+				Allow: []ResourceConfig{
+					{APIVersion: "example.com/v1", Kind: "Gadget", Resource: "gadgets"},
+				},
+				Deny: []AllowedResource{
+					{APIVersion: "v1", Kind: "Secret"},
+				},
+			},
+		},
+	}
+
+	w, err := NewWhitelist(cfg, []string{"dev", "prod"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		environment string
+		apiVersion  string
+		kind        string
+		allowed     bool
+	}{
+		{"dev", "nais.io/v1alpha1", "Application", true},
+		{"dev", "example.com/v1", "Widget", true},
+		{"dev", "example.com/v1", "Gadget", false},
+		{"dev", "v1", "Secret", true},
+		{"prod", "nais.io/v1alpha1", "Application", true},
+		{"prod", "example.com/v1", "Widget", true},
+		{"prod", "example.com/v1", "Gadget", true},
+		{"prod", "v1", "Secret", false},
+		{"unknown", "example.com/v1", "Widget", true},
+		{"unknown", "example.com/v1", "Gadget", false},
+	}
+
+	for _, tt := range tests {
+		if got := w.IsAllowed(tt.environment, resource(tt.apiVersion, tt.kind)); got != tt.allowed {
+			t.Errorf("IsAllowed(%q, %s/%s) = %v, want %v", tt.environment, tt.apiVersion, tt.kind, got, tt.allowed)
+		}
+	}
+
+	gvr, ok := w.GVRFor("prod", "example.com/v1", "Gadget")
+	if !ok {
+		t.Fatal("expected GVR for Gadget in prod")
+	}
+	if expected := (schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "gadgets"}); gvr != expected {
+		t.Errorf("expected %v, got %v", expected, gvr)
+	}
+}
+
+func TestNewWhitelist_ReplaceDefaults(t *testing.T) {
+	w, err := NewWhitelist(WhitelistConfig{
+		ReplaceDefaults: true,
+		Resources: []ResourceConfig{
+			{APIVersion: "nais.io/v1alpha1", Kind: "Application", Resource: "applications"},
+		},
+	}, []string{"dev"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := w.AllowedResources("dev")
+	if len(got) != 1 || *got[0] != (AllowedResource{APIVersion: "nais.io/v1alpha1", Kind: "Application"}) {
+		t.Fatalf("expected only Application to be allowed, got %v", got)
+	}
+}
+
+func TestNewWhitelist_Discovery(t *testing.T) {
+	discoveryClientFn := func(environmentName string) (discovery.DiscoveryInterface, error) {
+		return &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "example.com/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "widgets/status", Kind: "Widget"},
+						{Name: "widgets", Kind: "Widget"},
+					},
+				},
+			},
+		}}, nil
+	}
+
+	cfg := WhitelistConfig{
+		Resources: []ResourceConfig{
+			{APIVersion: "example.com/v1", Kind: "Widget"},
+		},
+	}
+
+	w, err := NewWhitelist(cfg, []string{"dev"}, discoveryClientFn)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gvr, ok := w.GVRFor("dev", "example.com/v1", "Widget")
+	if !ok {
+		t.Fatal("expected GVR for Widget in dev")
+	}
+	if expected := (schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}); gvr != expected {
+		t.Errorf("expected %v, got %v", expected, gvr)
+	}
+
+	cfg.Resources = append(cfg.Resources, ResourceConfig{APIVersion: "example.com/v1", Kind: "Gadget"})
+	if _, err := NewWhitelist(cfg, []string{"dev"}, discoveryClientFn); err == nil {
+		t.Error("expected error for resource missing from discovery")
+	}
+
+	if _, err := NewWhitelist(cfg, []string{"dev"}, nil); err == nil {
+		t.Error("expected error when discovery is unavailable")
+	}
+}
+
+func TestNewWhitelist_UnknownEnvironment(t *testing.T) {
+	_, err := NewWhitelist(WhitelistConfig{
+		Environments: map[string]EnvironmentWhitelistConfig{"prod": {}},
+	}, []string{"dev"}, nil)
+	if err == nil {
+		t.Fatal("expected error for unknown environment")
+	}
+}
+
+func TestWhitelistConfig_UnmarshalJSON(t *testing.T) {
+	var cfg WhitelistConfig
+	err := json.Unmarshal([]byte(`{
+		"resources": [{"apiVersion": "example.com/v1", "kind": "Widget", "resource": "widgets"}],
+		"environments": {"prod": {"deny": [{"apiVersion": "v1", "kind": "Secret"}]}}
+	}`), &cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Resources) != 1 || cfg.Resources[0].Resource != "widgets" {
+		t.Errorf("unexpected resources: %v", cfg.Resources)
+	}
+	if deny := cfg.Environments["prod"].Deny; len(deny) != 1 || deny[0].Kind != "Secret" {
+		t.Errorf("unexpected deny list: %v", deny)
+	}
+
+	err = json.Unmarshal([]byte(`{"resources": [{"apiVersion": "example.com/v1"}]}`), &cfg)
+	if err == nil {
+		t.Error("expected error for entry missing kind")
+	}
+}
@@ -20,6 +20,7 @@ type Querier interface {
 	GetRolesForUsers(ctx context.Context, userIds []uuid.UUID) ([]*GetRolesForUsersRow, error)
 	GitHubAuthorizationRoleCheck(ctx context.Context, arg GitHubAuthorizationRoleCheckParams) (bool, error)
 	HasGlobalAuthorization(ctx context.Context, arg HasGlobalAuthorizationParams) (bool, error)
+	// When environment is set, roles limited to other environments do not grant the authorization.
 	HasTeamAuthorization(ctx context.Context, arg HasTeamAuthorizationParams) (bool, error)
 	// Strict team membership check WITHOUT admin bypass
 	// Used for security-sensitive operations like elevations and reading secret values
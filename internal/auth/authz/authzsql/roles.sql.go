@@ -287,10 +287,32 @@ SELECT
 			WHERE
 				ur.user_id = $1
 				AND a.name = $2
+				AND ur.custom_role_id IS NULL
 				AND (
 					ur.target_team_slug = $3::slug
 					OR ur.target_team_slug IS NULL
 				)
+				AND (
+					$4::TEXT IS NULL
+					OR ur.environments IS NULL
+					OR $4::TEXT = ANY (ur.environments)
+				)
+		)
+		OR EXISTS (
+			SELECT
+				1
+			FROM
+				team_custom_role_authorizations cra
+				INNER JOIN user_roles ur ON ur.custom_role_id = cra.custom_role_id
+			WHERE
+				ur.user_id = $1
+				AND cra.authorization_name = $2
+				AND ur.target_team_slug = $3::slug
+				AND (
+					$4::TEXT IS NULL
+					OR ur.environments IS NULL
+					OR $4::TEXT = ANY (ur.environments)
+				)
 		)
 		OR EXISTS (
 			SELECT
@@ -308,10 +330,17 @@ type HasTeamAuthorizationParams struct {
 	UserID            uuid.UUID
 	AuthorizationName string
 	TeamSlug          slug.Slug
+	Environment       *string
 }
 
+// When environment is set, roles limited to other environments do not grant the authorization.
 func (q *Queries) HasTeamAuthorization(ctx context.Context, arg HasTeamAuthorizationParams) (bool, error) {
-	row := q.db.QueryRow(ctx, hasTeamAuthorization, arg.UserID, arg.AuthorizationName, arg.TeamSlug)
+	row := q.db.QueryRow(ctx, hasTeamAuthorization,
+		arg.UserID,
+		arg.AuthorizationName,
+		arg.TeamSlug,
+		arg.Environment,
+	)
 	var column_1 bool
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -319,17 +348,41 @@ func (q *Queries) HasTeamAuthorization(ctx context.Context, arg HasTeamAuthoriza
 
 const hasTeamMembership = `-- name: HasTeamMembership :one
 SELECT
-	EXISTS (
-		SELECT
-			1
-		FROM
-			authorizations a
-			INNER JOIN role_authorizations ra ON ra.authorization_name = a.name
-			INNER JOIN user_roles ur ON ur.role_name = ra.role_name
-		WHERE
-			ur.user_id = $1
-			AND a.name = $2
-			AND ur.target_team_slug = $3::slug
+	(
+		EXISTS (
+			SELECT
+				1
+			FROM
+				authorizations a
+				INNER JOIN role_authorizations ra ON ra.authorization_name = a.name
+				INNER JOIN user_roles ur ON ur.role_name = ra.role_name
+			WHERE
+				ur.user_id = $1
+				AND a.name = $2
+				AND ur.custom_role_id IS NULL
+				AND ur.target_team_slug = $3::slug
+				AND (
+					$4::TEXT IS NULL
+					OR ur.environments IS NULL
+					OR $4::TEXT = ANY (ur.environments)
+				)
+		)
+		OR EXISTS (
+			SELECT
+				1
+			FROM
+				team_custom_role_authorizations cra
+				INNER JOIN user_roles ur ON ur.custom_role_id = cra.custom_role_id
+			WHERE
+				ur.user_id = $1
+				AND cra.authorization_name = $2
+				AND ur.target_team_slug = $3::slug
+				AND (
+					$4::TEXT IS NULL
+					OR ur.environments IS NULL
+					OR $4::TEXT = ANY (ur.environments)
+				)
+		)
 	)::BOOLEAN
 `
 
@@ -337,12 +390,18 @@ type HasTeamMembershipParams struct {
 	UserID            uuid.UUID
 	AuthorizationName string
 	TeamSlug          slug.Slug
+	Environment       *string
 }
 
 // Strict team membership check WITHOUT admin bypass
 // Used for security-sensitive operations like elevations and reading secret values
 func (q *Queries) HasTeamMembership(ctx context.Context, arg HasTeamMembershipParams) (bool, error) {
-	row := q.db.QueryRow(ctx, hasTeamMembership, arg.UserID, arg.AuthorizationName, arg.TeamSlug)
+	row := q.db.QueryRow(ctx, hasTeamMembership,
+		arg.UserID,
+		arg.AuthorizationName,
+		arg.TeamSlug,
+		arg.Environment,
+	)
 	var column_1 bool
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -637,16 +696,31 @@ WITH
 	),
 	user_authorizations AS (
 		SELECT
-			ARRAY_AGG(ra.authorization_name) AS authorizations
+			ARRAY_AGG(granted.authorization_name) AS authorizations
 		FROM
-			role_authorizations ra
-			JOIN user_roles ur ON ur.role_name = ra.role_name
-		WHERE
-			ur.user_id = $1
-			AND (
-				ur.target_team_slug IS NULL
-				OR ur.target_team_slug = $3
-			)
+			(
+				SELECT
+					ra.authorization_name
+				FROM
+					role_authorizations ra
+					JOIN user_roles ur ON ur.role_name = ra.role_name
+				WHERE
+					ur.user_id = $1
+					AND ur.custom_role_id IS NULL
+					AND (
+						ur.target_team_slug IS NULL
+						OR ur.target_team_slug = $3
+					)
+				UNION
+				SELECT
+					cra.authorization_name
+				FROM
+					team_custom_role_authorizations cra
+					JOIN user_roles ur ON ur.custom_role_id = cra.custom_role_id
+				WHERE
+					ur.user_id = $1
+					AND ur.target_team_slug = $3
+			) AS granted
 	)
 SELECT
 	(
@@ -182,6 +182,12 @@ func CanDeleteServiceAccounts(ctx context.Context, teamSlug *slug.Slug) error {
 	return requireAuthorization(ctx, "service_accounts:delete", teamSlug)
 }
 
+// CanReadActivityLogs checks if the actor can read activity log entries for the team, or entries not belonging to a
+// team when teamSlug is nil.
+func CanReadActivityLogs(ctx context.Context, teamSlug *slug.Slug) error {
+	return requireAuthorization(ctx, "activity_logs:read", teamSlug)
+}
+
 func CanReadDeployKey(ctx context.Context, teamSlug slug.Slug) error {
 	return requireTeamAuthorization(ctx, teamSlug, "deploy_key:read")
 }
@@ -190,24 +196,24 @@ func CanUpdateDeployKey(ctx context.Context, teamSlug slug.Slug) error {
 	return requireTeamAuthorization(ctx, teamSlug, "deploy_key:update")
 }
 
-func CanDeleteApplications(ctx context.Context, teamSlug slug.Slug) error {
-	return requireTeamAuthorization(ctx, teamSlug, "applications:delete")
+func CanDeleteApplications(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "applications:delete")
 }
 
-func CanUpdateApplications(ctx context.Context, teamSlug slug.Slug) error {
-	return requireTeamAuthorization(ctx, teamSlug, "applications:update")
+func CanUpdateApplications(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "applications:update")
 }
 
-func CanDeleteJobs(ctx context.Context, teamSlug slug.Slug) error {
-	return requireTeamAuthorization(ctx, teamSlug, "jobs:delete")
+func CanDeleteJobs(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "jobs:delete")
 }
 
-func CanUpdateJobs(ctx context.Context, teamSlug slug.Slug) error {
-	return requireTeamAuthorization(ctx, teamSlug, "jobs:update")
+func CanUpdateJobs(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "jobs:update")
 }
 
-func CanApplyKubernetesResource(ctx context.Context, teamSlug slug.Slug) error {
-	return requireStrictTeamAuthorization(ctx, teamSlug, "k8s_resources:apply")
+func CanApplyKubernetesResource(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireStrictTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "k8s_resources:apply")
 }
 
 func CanCreateRepositories(ctx context.Context, teamSlug slug.Slug) error {
@@ -230,26 +236,40 @@ func CanDeleteConfigs(ctx context.Context, teamSlug slug.Slug) error {
 	return requireTeamAuthorization(ctx, teamSlug, "teams:configs:delete")
 }
 
-func CanCreateSecrets(ctx context.Context, teamSlug slug.Slug) error {
-	return requireTeamAuthorization(ctx, teamSlug, "teams:secrets:create")
+func CanCreateSecrets(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "teams:secrets:create")
 }
 
 func CanReadSecrets(ctx context.Context, teamSlug slug.Slug) error {
 	return requireTeamAuthorization(ctx, teamSlug, "teams:secrets:read")
 }
 
-func CanUpdateSecrets(ctx context.Context, teamSlug slug.Slug) error {
-	return requireTeamAuthorization(ctx, teamSlug, "teams:secrets:update")
+func CanUpdateSecrets(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "teams:secrets:update")
 }
 
-func CanDeleteSecrets(ctx context.Context, teamSlug slug.Slug) error {
-	return requireTeamAuthorization(ctx, teamSlug, "teams:secrets:delete")
+func CanDeleteSecrets(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "teams:secrets:delete")
 }
 
-// CanReadSecretValues checks if the user can read secret values for the team.
+// CanReadSecretValues checks if the user can read secret values for the team in the environment.
 // This enforces strict team membership WITHOUT admin bypass for security reasons.
-func CanReadSecretValues(ctx context.Context, teamSlug slug.Slug) error {
-	return requireStrictTeamAuthorization(ctx, teamSlug, "teams:secrets:read-values")
+func CanReadSecretValues(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireStrictTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "teams:secrets:read-values")
+}
+
+// CanApproveSecretAccess checks if the user can approve and deny requests to view secret values for the team.
+// Like reading secret values, this requires team membership WITHOUT admin bypass.
+func CanApproveSecretAccess(ctx context.Context, teamSlug slug.Slug) error {
+	return requireStrictTeamAuthorization(ctx, teamSlug, "teams:secrets:approve-access")
+}
+
+func CanManageDeploymentFreezes(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "teams:deployment-freezes:manage")
+}
+
+func CanOverrideDeploymentFreezes(ctx context.Context, teamSlug slug.Slug, environmentName string) error {
+	return requireStrictTeamEnvironmentAuthorization(ctx, teamSlug, &environmentName, "teams:deployment-freezes:override")
 }
 
 func CanCreateTeam(ctx context.Context) error {
@@ -284,6 +304,10 @@ func CanUpdateVulnerability(ctx context.Context, teamSlug slug.Slug) error {
 	return requireTeamAuthorization(ctx, teamSlug, "vulnerability:update")
 }
 
+func CanUpdateIssues(ctx context.Context, teamSlug slug.Slug) error {
+	return requireTeamAuthorization(ctx, teamSlug, "issues:update")
+}
+
 func CanStartServiceMaintenance(ctx context.Context, teamSlug slug.Slug) error {
 	return requireTeamAuthorization(ctx, teamSlug, "service_maintenance:update:start")
 }
@@ -341,6 +365,12 @@ func RequireGlobalAdmin(ctx context.Context) error {
 // This also allows global roles (target_team_slug IS NULL) to apply.
 // Use this for normal team operations like updating apps, managing resources, etc.
 func requireTeamAuthorization(ctx context.Context, teamSlug slug.Slug, authorizationName string) error {
+	return requireTeamEnvironmentAuthorization(ctx, teamSlug, nil, authorizationName)
+}
+
+// requireTeamEnvironmentAuthorization works like requireTeamAuthorization, but when environmentName is set, team roles
+// limited to other environments do not grant the authorization. Service accounts are not limited to environments.
+func requireTeamEnvironmentAuthorization(ctx context.Context, teamSlug slug.Slug, environmentName *string, authorizationName string) error {
 	actor := ActorFromContext(ctx)
 	user := actor.User
 	var (
@@ -367,6 +397,7 @@ func requireTeamAuthorization(ctx context.Context, teamSlug slug.Slug, authoriza
 			UserID:            user.GetID(),
 			AuthorizationName: authorizationName,
 			TeamSlug:          teamSlug,
+			Environment:       environmentName,
 		})
 	}
 	if err != nil {
@@ -388,6 +419,12 @@ func requireTeamAuthorization(ctx context.Context, teamSlug slug.Slug, authoriza
 // - Access sensitive data (secret values)
 // - Should never bypass team membership for security reasons
 func requireStrictTeamAuthorization(ctx context.Context, teamSlug slug.Slug, authorizationName string) error {
+	return requireStrictTeamEnvironmentAuthorization(ctx, teamSlug, nil, authorizationName)
+}
+
+// requireStrictTeamEnvironmentAuthorization works like requireStrictTeamAuthorization, but when environmentName is
+// set, team roles limited to other environments do not grant the authorization.
+func requireStrictTeamEnvironmentAuthorization(ctx context.Context, teamSlug slug.Slug, environmentName *string, authorizationName string) error {
 	actor := ActorFromContext(ctx)
 	user := actor.User
 	var (
@@ -414,6 +451,7 @@ func requireStrictTeamAuthorization(ctx context.Context, teamSlug slug.Slug, aut
 			UserID:            user.GetID(),
 			AuthorizationName: authorizationName,
 			TeamSlug:          teamSlug,
+			Environment:       environmentName,
 		})
 	}
 	if err != nil {
@@ -153,6 +153,7 @@ WHERE
 ;
 
 -- name: HasTeamAuthorization :one
+-- When environment is set, roles limited to other environments do not grant the authorization.
 SELECT
 	(
 		EXISTS (
@@ -165,10 +166,32 @@ SELECT
 			WHERE
 				ur.user_id = @user_id
 				AND a.name = @authorization_name
+				AND ur.custom_role_id IS NULL
 				AND (
 					ur.target_team_slug = @team_slug::slug
 					OR ur.target_team_slug IS NULL
 				)
+				AND (
+					sqlc.narg(environment)::TEXT IS NULL
+					OR ur.environments IS NULL
+					OR sqlc.narg(environment)::TEXT = ANY (ur.environments)
+				)
+		)
+		OR EXISTS (
+			SELECT
+				1
+			FROM
+				team_custom_role_authorizations cra
+				INNER JOIN user_roles ur ON ur.custom_role_id = cra.custom_role_id
+			WHERE
+				ur.user_id = @user_id
+				AND cra.authorization_name = @authorization_name
+				AND ur.target_team_slug = @team_slug::slug
+				AND (
+					sqlc.narg(environment)::TEXT IS NULL
+					OR ur.environments IS NULL
+					OR sqlc.narg(environment)::TEXT = ANY (ur.environments)
+				)
 		)
 		OR EXISTS (
 			SELECT
@@ -186,17 +209,41 @@ SELECT
 -- Strict team membership check WITHOUT admin bypass
 -- Used for security-sensitive operations like elevations and reading secret values
 SELECT
-	EXISTS (
-		SELECT
-			1
-		FROM
-			authorizations a
-			INNER JOIN role_authorizations ra ON ra.authorization_name = a.name
-			INNER JOIN user_roles ur ON ur.role_name = ra.role_name
-		WHERE
-			ur.user_id = @user_id
-			AND a.name = @authorization_name
-			AND ur.target_team_slug = @team_slug::slug
+	(
+		EXISTS (
+			SELECT
+				1
+			FROM
+				authorizations a
+				INNER JOIN role_authorizations ra ON ra.authorization_name = a.name
+				INNER JOIN user_roles ur ON ur.role_name = ra.role_name
+			WHERE
+				ur.user_id = @user_id
+				AND a.name = @authorization_name
+				AND ur.custom_role_id IS NULL
+				AND ur.target_team_slug = @team_slug::slug
+				AND (
+					sqlc.narg(environment)::TEXT IS NULL
+					OR ur.environments IS NULL
+					OR sqlc.narg(environment)::TEXT = ANY (ur.environments)
+				)
+		)
+		OR EXISTS (
+			SELECT
+				1
+			FROM
+				team_custom_role_authorizations cra
+				INNER JOIN user_roles ur ON ur.custom_role_id = cra.custom_role_id
+			WHERE
+				ur.user_id = @user_id
+				AND cra.authorization_name = @authorization_name
+				AND ur.target_team_slug = @team_slug::slug
+				AND (
+					sqlc.narg(environment)::TEXT IS NULL
+					OR ur.environments IS NULL
+					OR sqlc.narg(environment)::TEXT = ANY (ur.environments)
+				)
+		)
 	)::BOOLEAN
 ;
 
@@ -291,16 +338,31 @@ WITH
 	),
 	user_authorizations AS (
 		SELECT
-			ARRAY_AGG(ra.authorization_name) AS authorizations
+			ARRAY_AGG(granted.authorization_name) AS authorizations
 		FROM
-			role_authorizations ra
-			JOIN user_roles ur ON ur.role_name = ra.role_name
-		WHERE
-			ur.user_id = @user_id
-			AND (
-				ur.target_team_slug IS NULL
-				OR ur.target_team_slug = @target_team_slug
-			)
+			(
+				SELECT
+					ra.authorization_name
+				FROM
+					role_authorizations ra
+					JOIN user_roles ur ON ur.role_name = ra.role_name
+				WHERE
+					ur.user_id = @user_id
+					AND ur.custom_role_id IS NULL
+					AND (
+						ur.target_team_slug IS NULL
+						OR ur.target_team_slug = @target_team_slug
+					)
+				UNION
+				SELECT
+					cra.authorization_name
+				FROM
+					team_custom_role_authorizations cra
+					JOIN user_roles ur ON ur.custom_role_id = cra.custom_role_id
+				WHERE
+					ur.user_id = @user_id
+					AND ur.target_team_slug = @target_team_slug
+			) AS granted
 	)
 SELECT
 	(
@@ -24,6 +24,7 @@ import (
 	"github.com/nais/api/internal/graph"
 	"github.com/nais/api/internal/graph/gengql"
 	"github.com/nais/api/internal/grpc"
+	"github.com/nais/api/internal/grpc/grpcevents"
 	"github.com/nais/api/internal/issue/checker"
 	"github.com/nais/api/internal/kubernetes"
 	"github.com/nais/api/internal/kubernetes/event"
@@ -33,6 +34,7 @@ import (
 	"github.com/nais/api/internal/leaderelection"
 	"github.com/nais/api/internal/logger"
 	"github.com/nais/api/internal/loki"
+	"github.com/nais/api/internal/persistence/aivencredentials"
 	"github.com/nais/api/internal/persistence/sqlinstance"
 	restserver "github.com/nais/api/internal/rest"
 	"github.com/nais/api/internal/servicemaintenance"
@@ -40,11 +42,16 @@ import (
 	"github.com/nais/api/internal/thirdparty/aiven"
 	"github.com/nais/api/internal/thirdparty/hookd"
 	fakehookd "github.com/nais/api/internal/thirdparty/hookd/fake"
+	"github.com/nais/api/internal/thirdparty/promclient"
 	"github.com/nais/api/internal/unleash"
+	"github.com/nais/api/internal/utilization"
 	"github.com/nais/api/internal/vulnerability"
+	"github.com/nais/api/internal/workload/secret"
+	aiven_nais_io_v1 "github.com/nais/liberator/pkg/apis/aiven.nais.io/v1"
 	"github.com/sethvargo/go-envconfig"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	k8s "k8s.io/client-go/kubernetes"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -307,6 +314,58 @@ func run(ctx context.Context, cfg *Config, log logrus.FieldLogger) error {
 		return fmt.Errorf("create loki client: %w", err)
 	}
 
+	var discoveryClientFactory apply.DiscoveryClientFactory
+	if !cfg.Fakes.WithFakeKubernetes {
+		discoveryClientFactory = func(environmentName string) (discovery.DiscoveryInterface, error) {
+			restConfig, ok := clusterConfig[environmentmapper.ClusterName(environmentName)]
+			if !ok {
+				return nil, fmt.Errorf("unknown environment: %q", environmentName)
+			}
+			return discovery.NewDiscoveryClientForConfig(restConfig)
+		}
+	}
+
+	environmentNames := make([]string, 0, len(cfg.K8s.AllClusterNames()))
+	for _, cluster := range cfg.K8s.AllClusterNames() {
+		environmentNames = append(environmentNames, environmentmapper.EnvironmentName(cluster))
+	}
+
+	applyWhitelist, err := apply.NewWhitelist(cfg.ApplyAllowedResources, environmentNames, discoveryClientFactory)
+	if err != nil {
+		return fmt.Errorf("create apply whitelist: %w", err)
+	}
+
+	applyPolicy, err := apply.NewPolicyValidator(cfg.ApplyPolicyRules)
+	if err != nil {
+		return fmt.Errorf("create apply policy validator: %w", err)
+	}
+
+	var dynamicClientFactory apply.DynamicClientFactory
+	if cfg.Fakes.WithFakeKubernetes {
+		dynamicClients := watcherMgr.GetDynamicClients()
+		dynamicClientFactory = func(environmentName string, _ slug.Slug) (dynamic.Interface, error) {
+			client, ok := dynamicClients[environmentName]
+			if !ok {
+				return nil, fmt.Errorf("unknown environment: %q", environmentName)
+			}
+			return client, nil
+		}
+	} else {
+		dynamicClientFactory = clusterConfig.TeamClient
+	}
+
+	applyHandler := apply.NewHandler(dynamicClientFactory, applyWhitelist, log.WithField("subsystem", "apply"), apply.WithValidators(applyPolicy))
+
+	secretVersionCipher, err := secret.NewVersionCipher(cfg.SecretVersionEncryptionKey)
+	if err != nil {
+		return fmt.Errorf("create secret version cipher: %w", err)
+	}
+
+	externalSecretSources := secret.ExternalSources{}
+	if cfg.ExternalSecretSourceDirectory != "" {
+		externalSecretSources["file"] = secret.NewFileSource(cfg.ExternalSecretSourceDirectory)
+	}
+
 	contextDependencies, err := ConfigureGraph(
 		ctx,
 		cfg.Fakes,
@@ -329,6 +388,10 @@ func run(ctx context.Context, cfg *Config, log logrus.FieldLogger) error {
 		lokiClient,
 		cfg.AuditLog.ProjectID,
 		cfg.AuditLog.Location,
+		applyHandler,
+		secretVersionCipher,
+		cfg.SecretAccessApproval,
+		externalSecretSources,
 		log.WithField("subsystem", "http"),
 	)
 	if err != nil {
@@ -361,26 +424,12 @@ func run(ctx context.Context, cfg *Config, log logrus.FieldLogger) error {
 		)
 	})
 
-	var dynamicClientFactory apply.DynamicClientFactory
-	if cfg.Fakes.WithFakeKubernetes {
-		dynamicClients := watcherMgr.GetDynamicClients()
-		dynamicClientFactory = func(environmentName string, _ slug.Slug) (dynamic.Interface, error) {
-			client, ok := dynamicClients[environmentName]
-			if !ok {
-				return nil, fmt.Errorf("unknown environment: %q", environmentName)
-			}
-			return client, nil
-		}
-	} else {
-		dynamicClientFactory = clusterConfig.TeamClient
-	}
-
 	wg.Go(func() error {
 		return restserver.Run(ctx, restserver.Config{
 			ListenAddress:        cfg.RestListenAddress,
 			Pool:                 pool,
 			PreSharedKey:         cfg.RestPreSharedKey,
-			DynamicClient:        dynamicClientFactory,
+			ApplyHandler:         applyHandler,
 			ContextMiddleware:    contextDependencies,
 			JWTMiddleware:        jwtMiddleware,
 			GitHubOIDCMiddleware: githubOIDCMiddleware,
@@ -393,7 +442,7 @@ func run(ctx context.Context, cfg *Config, log logrus.FieldLogger) error {
 	})
 
 	wg.Go(func() error {
-		if err := grpc.Run(ctx, cfg.GRPCListenAddress, pool, log.WithField("subsystem", "grpc")); err != nil {
+		if err := grpc.Run(ctx, cfg.GRPCListenAddress, pool, notifier, log.WithField("subsystem", "grpc")); err != nil {
 			log.WithError(err).Errorf("error in GRPC server")
 			return err
 		}
@@ -413,11 +462,40 @@ func run(ctx context.Context, cfg *Config, log logrus.FieldLogger) error {
 		return nil
 	})
 
+	wg.Go(func() error {
+		grpcevents.RunPruner(ctx, pool, log.WithField("subsystem", "team_events_pruner"))
+		return nil
+	})
+
+	wg.Go(func() error {
+		deployment.RunSpecSnapshotter(ctx, pool, watchers.AppWatcher, watchers.JobWatcher, log.WithField("subsystem", "deployment_spec_snapshotter"))
+		return nil
+	})
+
+	wg.Go(func() error {
+		deployment.RunRolloutTracker(ctx, pool, watchers.AppWatcher, watchers.JobWatcher, log.WithField("subsystem", "deployment_rollout_tracker"))
+		return nil
+	})
+
+	wg.Go(func() error {
+		secret.RunAccessRequestExpirer(ctx, pool, cfg.SecretAccessApproval, log.WithField("subsystem", "secret_access_expirer"))
+		return nil
+	})
+
+	wg.Go(func() error {
+		secret.RunExternalSourceSyncer(ctx, watchers.SecretWatcher, externalSecretSources, log.WithField("subsystem", "secret_external_source_syncer"))
+		return nil
+	})
+
 	wg.Go(func() error {
 		activitylog.RunRefresher(ctx, pool, log.WithField("subsystem", "activitylog_refresher"))
 		return nil
 	})
 
+	wg.Go(func() error {
+		return activitylog.RunRetention(ctx, pool, cfg.ActivityLogRetention, log.WithField("subsystem", "activitylog_retention"))
+	})
+
 	sqlAdminService, err := sqlinstance.NewClient(ctx, log, sqlinstance.WithFakeClients(cfg.Fakes.WithFakeCloudSQL), sqlinstance.WithInstanceWatcher(watchers.SqlInstanceWatcher))
 	if err != nil {
 		return fmt.Errorf("create SQL Admin service: %w", err)
@@ -431,6 +509,22 @@ func run(ctx context.Context, cfg *Config, log logrus.FieldLogger) error {
 		bifrostClient = unleash.NewBifrostClient(cfg.Unleash.BifrostAPIURL, log.WithField("subsystem", "bifrost_client"))
 	}
 
+	// The fake Prometheus client depends on request scoped loaders, so utilization issues are only checked against
+	// a real Prometheus.
+	var utilizationClient utilization.ResourceUsageClient
+	if !cfg.Fakes.WithFakePrometheus {
+		utilizationClient, err = promclient.New(cfg.Tenant, log.WithField("subsystem", "issue_checker"))
+		if err != nil {
+			return fmt.Errorf("create utilization client for issue checker: %w", err)
+		}
+	}
+
+	// AivenApplications are only watched when the age of temporary Aiven credentials is checked.
+	var aivenApplicationWatcher *watcher.Watcher[*aiven_nais_io_v1.AivenApplication]
+	if cfg.IssueCredentialPolicy.AivenCredentialMaxAge > 0 {
+		aivenApplicationWatcher = aivencredentials.NewWatcher(ctx, watcherMgr)
+	}
+
 	issueChecker, err := checker.New(
 		checker.Config{
 			AivenClient:    aivenClient,
@@ -439,6 +533,12 @@ func run(ctx context.Context, cfg *Config, log logrus.FieldLogger) error {
 			Tenant:         cfg.Tenant,
 			Clusters:       cfg.K8s.AllClusterNames(),
 			BifrostClient:  bifrostClient,
+			CustomChecks:   cfg.IssueChecks,
+
+			UtilizationClient: utilizationClient,
+			CredentialPolicy:  cfg.IssueCredentialPolicy,
+
+			AivenApplicationWatcher: aivenApplicationWatcher,
 		},
 		pool,
 		watchers,
@@ -4,10 +4,14 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/apply"
 	"github.com/nais/api/internal/auth/middleware"
+	"github.com/nais/api/internal/issue/checker"
 	"github.com/nais/api/internal/kubernetes"
 	"github.com/nais/api/internal/thirdparty/aiven"
 	"github.com/nais/api/internal/workload/logging"
+	"github.com/nais/api/internal/workload/secret"
 	"github.com/sethvargo/go-envconfig"
 	"github.com/sirupsen/logrus"
 )
@@ -172,6 +176,38 @@ type Config struct {
 	// application starts. Refer to the README for the format.
 	StaticServiceAccounts StaticServiceAccounts `env:"STATIC_SERVICE_ACCOUNTS"`
 
+	// ApplyAllowedResources A JSON-encoded value describing the resources that can be applied through the apply
+	// endpoint, in addition to or instead of the built-in resources, with optional per-environment overrides.
+	ApplyAllowedResources apply.WhitelistConfig `env:"APPLY_ALLOWED_RESOURCES"`
+
+	// ApplyPolicyRules A JSON-encoded list of policy rules resources must satisfy to be applied through the
+	// apply endpoint. Rules are either CEL expressions, or refer to a built-in rule by name.
+	ApplyPolicyRules apply.PolicyRules `env:"APPLY_POLICY_RULES"`
+
+	// ActivityLogRetention A JSON-encoded value describing how long activity log entries are kept, per resource
+	// type, and where expired entries are archived. Entries are kept forever when unset.
+	ActivityLogRetention activitylog.RetentionConfig `env:"ACTIVITY_LOG_RETENTION"`
+
+	// IssueChecks A JSON-encoded list of issue checks evaluated by the issue checker in addition to the built-in
+	// checks. Each check is a CEL expression evaluated against the objects of a resource type.
+	IssueChecks checker.CustomChecks `env:"ISSUE_CHECKS"`
+
+	// IssueCredentialPolicy A JSON-encoded policy for when service account tokens, temporary Aiven credentials and
+	// secrets are considered expiring or stale. The credential checks are disabled when unset.
+	IssueCredentialPolicy checker.CredentialPolicy `env:"ISSUE_CREDENTIAL_POLICY"`
+
+	// SecretVersionEncryptionKey A base64 encoded 32 byte key used to encrypt previous versions of secrets managed
+	// through the API. Secret versions are not recorded, and secrets can not be rolled back, when unset.
+	SecretVersionEncryptionKey string `env:"SECRET_VERSION_ENCRYPTION_KEY"`
+
+	// SecretAccessApproval A JSON-encoded policy listing the environments where viewing secret values requires an
+	// access request approved by another team owner. Secret values can be viewed without approval when unset.
+	SecretAccessApproval secret.AccessApprovalPolicy `env:"SECRET_ACCESS_APPROVAL"`
+
+	// ExternalSecretSourceDirectory A directory of JSON files that secret values can be synced from, using the "file"
+	// external source. Intended for local development and testing.
+	ExternalSecretSourceDirectory string `env:"EXTERNAL_SECRET_SOURCE_DIRECTORY"`
+
 	// ListenAddress is host:port combination used by the http server
 	ListenAddress         string `env:"LISTEN_ADDRESS,default=127.0.0.1:3000"`
 	InternalListenAddress string `env:"INTERNAL_LISTEN_ADDRESS,default=127.0.0.1:3005"`
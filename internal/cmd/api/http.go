@@ -13,6 +13,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nais/api/internal/activitylog"
 	"github.com/nais/api/internal/alerts"
+	"github.com/nais/api/internal/apply"
 	"github.com/nais/api/internal/auth/authn"
 	"github.com/nais/api/internal/auth/authz"
 	"github.com/nais/api/internal/auth/middleware"
@@ -20,6 +21,7 @@ import (
 	"github.com/nais/api/internal/database"
 	"github.com/nais/api/internal/database/notify"
 	"github.com/nais/api/internal/deployment"
+	"github.com/nais/api/internal/deployment/freeze"
 	"github.com/nais/api/internal/environment"
 	"github.com/nais/api/internal/feature"
 	"github.com/nais/api/internal/github/repository"
@@ -198,6 +200,10 @@ func ConfigureGraph(
 	lokiClient loki.Client,
 	auditLogProjectID string,
 	auditLogLocation string,
+	applyHandler *apply.Handler,
+	secretVersionCipher *secret.VersionCipher,
+	secretAccessApproval secret.AccessApprovalPolicy,
+	externalSecretSources secret.ExternalSources,
 	log logrus.FieldLogger,
 ) (func(http.Handler) http.Handler, error) {
 	logStep := func(name string, fn func() error) error {
@@ -344,7 +350,7 @@ func ConfigureGraph(
 		ctx = job.NewLoaderContext(ctx, watchers.JobWatcher, watchers.RunWatcher)
 		ctx = kafkatopic.NewLoaderContext(ctx, watchers.KafkaTopicWatcher)
 		ctx = workload.NewLoaderContext(ctx, watchers.PodWatcher)
-		ctx = secret.NewLoaderContext(ctx, watchers.SecretWatcher, secretClientCreator, dynamicClients, clusters, log)
+		ctx = secret.NewLoaderContext(ctx, pool, watchers.SecretWatcher, secretClientCreator, dynamicClients, clusters, secretVersionCipher, secretAccessApproval, externalSecretSources, log)
 		ctx = config.NewLoaderContext(ctx, watchers.ConfigWatcher, log)
 		ctx = instancegroup.NewLoaderContext(ctx, watchers.ReplicaSetWatcher, watchers.PodWatcher, watchers.AppWatcher, dynamicClients, log)
 		ctx = aiven.NewLoaderContext(ctx, aivenProjects)
@@ -366,11 +372,12 @@ func ConfigureGraph(
 		ctx = cost.NewLoaderContext(ctx, pool, costOpts...)
 		ctx = repository.NewLoaderContext(ctx, pool)
 		ctx = authz.NewLoaderContext(ctx, pool)
-		ctx = activitylog.NewLoaderContext(ctx, pool)
+		ctx = activitylog.NewLoaderContext(ctx, pool, notifier, log)
 		ctx = vulnerability.NewLoaderContext(ctx, vulnMgr, log)
 		ctx = servicemaintenance.NewLoaderContext(ctx, serviceMaintenanceManager, log)
 		ctx = reconciler.NewLoaderContext(ctx, pool)
 		ctx = deployment.NewLoaderContext(ctx, pool, hookdClient)
+		ctx = freeze.NewLoaderContext(ctx, pool)
 		ctx = serviceaccount.NewLoaderContext(ctx, pool)
 		ctx = session.NewLoaderContext(ctx, pool)
 		ctx = search.NewLoaderContext(ctx, pool, searcher)
@@ -378,6 +385,7 @@ func ConfigureGraph(
 		ctx = tunnel.WithLoaders(ctx, tunnel.NewLoaders(watchers.TunnelWatcher))
 		ctx = logging.NewPackageContext(ctx, tenantName, defaultLogDestinations)
 		ctx = environment.NewLoaderContext(ctx, pool)
+		ctx = apply.NewLoaderContext(ctx, applyHandler)
 		ctx = feature.NewLoaderContext(
 			ctx,
 			watchers.UnleashWatcher.Enabled(),
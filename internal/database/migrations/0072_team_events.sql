@@ -0,0 +1,103 @@
+-- +goose Up
+-- Append-only log of team and team membership changes, streamed to gRPC clients. Events are ordered by the transaction
+-- that created them, and then by id, and the position of the last event is used as a cursor, so clients can resume
+-- the stream after the last event they received. Events are only streamed once every transaction that started before
+-- them has ended, so an event can never become visible before an event that was already streamed.
+CREATE TABLE team_events (
+	id BIGSERIAL PRIMARY KEY,
+	xact_id BIGINT DEFAULT pg_current_xact_id()::TEXT::BIGINT NOT NULL,
+	event_type TEXT NOT NULL,
+	team_slug slug NOT NULL,
+	user_id UUID,
+	created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+)
+;
+
+CREATE INDEX ON team_events (xact_id, id)
+;
+
+CREATE INDEX ON team_events (created_at)
+;
+
+-- The position of the last pruned event. Clients resuming from an older position have missed events.
+CREATE TABLE team_events_pruned (
+	xact_id BIGINT NOT NULL,
+	id BIGINT NOT NULL
+)
+;
+
+INSERT INTO
+	team_events_pruned (xact_id, id)
+VALUES
+	(0, 0)
+;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION team_events_insert (event_type TEXT, team_slug TEXT, user_id UUID) RETURNS VOID AS $$
+BEGIN
+	INSERT INTO team_events (event_type, team_slug, user_id) VALUES (event_type, team_slug, user_id);
+END;
+$$ LANGUAGE plpgsql
+;
+
+CREATE OR REPLACE FUNCTION team_events_teams () RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP = 'INSERT' THEN
+		PERFORM team_events_insert('TEAM_CREATED', NEW.slug, NULL);
+	ELSIF TG_OP = 'UPDATE' THEN
+		IF NEW IS DISTINCT FROM OLD THEN
+			PERFORM team_events_insert('TEAM_UPDATED', NEW.slug, NULL);
+		END IF;
+	ELSE
+		PERFORM team_events_insert('TEAM_DELETED', OLD.slug, NULL);
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql
+;
+
+CREATE OR REPLACE FUNCTION team_events_members () RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP = 'INSERT' THEN
+		IF NEW.target_team_slug IS NOT NULL THEN
+			PERFORM team_events_insert('MEMBER_ADDED', NEW.target_team_slug, NEW.user_id);
+		END IF;
+	ELSIF TG_OP = 'UPDATE' THEN
+		IF NEW.target_team_slug IS NOT NULL AND NEW IS DISTINCT FROM OLD THEN
+			PERFORM team_events_insert('MEMBER_UPDATED', NEW.target_team_slug, NEW.user_id);
+		END IF;
+	ELSE
+		IF OLD.target_team_slug IS NOT NULL THEN
+			PERFORM team_events_insert('MEMBER_REMOVED', OLD.target_team_slug, OLD.user_id);
+		END IF;
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql
+;
+
+-- The stream only uses notifications to wake up, and reads the events from team_events, so events are not stored in
+-- the notify outbox.
+CREATE OR REPLACE FUNCTION team_events_notify () RETURNS TRIGGER AS $$
+BEGIN
+	PERFORM pg_notify('api_notify', jsonb_build_object('table', TG_TABLE_NAME, 'op', TG_OP, 'data', '{}'::jsonb)::text);
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql
+;
+
+-- +goose StatementEnd
+CREATE TRIGGER teams_events
+AFTER INSERT OR UPDATE OR DELETE ON teams FOR EACH ROW
+EXECUTE PROCEDURE team_events_teams ()
+;
+
+CREATE TRIGGER user_roles_events
+AFTER INSERT OR UPDATE OR DELETE ON user_roles FOR EACH ROW
+EXECUTE PROCEDURE team_events_members ()
+;
+
+CREATE TRIGGER team_events_notify
+AFTER INSERT ON team_events FOR EACH ROW
+EXECUTE PROCEDURE team_events_notify ()
+;
@@ -0,0 +1,71 @@
+-- +goose Up
+-- Durable copy of every notification sent by api_notify. Subscribers that have been
+-- disconnected can replay everything after the last position they have seen. Notifications are ordered by the
+-- transaction that created them, and then by sequence number, and are only delivered once every transaction that
+-- started before them has ended, so a notification can never become visible before one that was already delivered.
+CREATE TABLE notify_outbox (
+	seq BIGSERIAL PRIMARY KEY,
+	xact_id BIGINT DEFAULT pg_current_xact_id()::TEXT::BIGINT NOT NULL,
+	table_name TEXT NOT NULL,
+	op TEXT NOT NULL,
+	data JSONB NOT NULL,
+	created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+)
+;
+
+CREATE INDEX ON notify_outbox (table_name, xact_id, seq)
+;
+
+CREATE INDEX ON notify_outbox (created_at)
+;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION api_notify () RETURNS trigger AS $$
+BEGIN
+  -- We accept a number of keys as arguments, and will read the values using NEW if it is set, or OLD if it is not.
+  -- The values are stored in notify_outbox, and a notification is sent to api_notify with a JSON object containing
+  -- the sequence number, the keys and values, as well as the table name and operation.
+  -- Earlier versions matched the operation against 'CREATE' instead of 'INSERT', so inserts were notified without
+  -- any values. Subscribers resuming from the outbox need the values of inserted rows as well.
+  DECLARE
+    values text[];
+    data jsonb;
+    seq bigint;
+    i integer := 0;
+    key text;
+  BEGIN
+    IF TG_NARGS > 0 AND TG_OP IN ('INSERT', 'UPDATE', 'DELETE') THEN
+      FOREACH key IN ARRAY TG_ARGV LOOP
+        IF TG_OP != 'DELETE' THEN
+          values := array_append(values, row_to_json(NEW)->>key);
+        ELSE
+          values := array_append(values, row_to_json(OLD)->>key);
+        END IF;
+        i := i + 1;
+      END LOOP;
+    END IF;
+
+    data := COALESCE(jsonb_object(TG_ARGV, values), '{}'::jsonb);
+
+    INSERT INTO notify_outbox (table_name, op, data) VALUES (TG_TABLE_NAME, TG_OP, data) RETURNING notify_outbox.seq INTO seq;
+
+    -- Construct the JSON object and send the notification. The JSON object will be of the form:
+    -- {
+    --   "seq": 123,
+    --   "table": "table_name",
+    --   "op": "operation",
+    --   "data": {
+    --     "key1": "value1",
+    --     "key2": "value2",
+    --     ...
+    --   }
+    -- }
+    PERFORM pg_notify('api_notify', jsonb_build_object('seq', seq, 'table', TG_TABLE_NAME, 'op', TG_OP, 'data', data)::text);
+    RETURN NULL;
+  END;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql
+;
+
+-- +goose StatementEnd
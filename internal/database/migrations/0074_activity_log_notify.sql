@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TRIGGER activity_log_entries_notify
+AFTER INSERT ON activity_log_entries FOR EACH ROW
+EXECUTE PROCEDURE api_notify ("id")
+;
+
+INSERT INTO
+	role_authorizations (role_name, authorization_name)
+VALUES
+	('Team member', 'activity_logs:read'),
+	('Team owner', 'activity_logs:read')
+ON CONFLICT DO NOTHING
+;
+
+-- +goose Down
+DELETE FROM role_authorizations
+WHERE
+	authorization_name = 'activity_logs:read'
+	AND role_name IN ('Team member', 'Team owner')
+;
+
+DROP TRIGGER activity_log_entries_notify ON activity_log_entries
+;
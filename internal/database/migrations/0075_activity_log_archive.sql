@@ -0,0 +1,36 @@
+-- +goose Up
+-- Activity log entries past their retention are moved out of activity_log_entries, either to this table or to files
+-- indexed in activity_log_archive_files.
+CREATE TABLE activity_log_entries_archive (
+	id UUID PRIMARY KEY,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	actor TEXT NOT NULL,
+	action TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	resource_name TEXT NOT NULL,
+	team_slug slug,
+	data BYTEA,
+	environment TEXT,
+	archived_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+)
+;
+
+CREATE INDEX ON activity_log_entries_archive (team_slug, created_at)
+;
+
+CREATE TABLE activity_log_archive_files (
+	id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+	location TEXT NOT NULL UNIQUE,
+	team_slug slug,
+	oldest TIMESTAMP WITH TIME ZONE NOT NULL,
+	newest TIMESTAMP WITH TIME ZONE NOT NULL,
+	entry_count INTEGER NOT NULL,
+	created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+)
+;
+
+CREATE INDEX ON activity_log_archive_files (team_slug, oldest)
+;
+
+CREATE INDEX ON activity_log_entries (created_at)
+;
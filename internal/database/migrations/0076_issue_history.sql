@@ -0,0 +1,53 @@
+-- +goose Up
+-- Issues are identified by their type, resource, team and environment, so they keep their id, and the time they
+-- were first seen, across checker runs. issue_key distinguishes issues of the same type for the same resource.
+DELETE FROM issues
+;
+
+ALTER TABLE issues
+ADD COLUMN issue_key TEXT NOT NULL DEFAULT '',
+ADD COLUMN first_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+ADD COLUMN last_seen TIMESTAMPTZ NOT NULL DEFAULT NOW()
+;
+
+CREATE UNIQUE INDEX issues_identity_key ON issues (issue_type, resource_type, resource_name, team, env, issue_key)
+;
+
+-- issue_history holds every issue that has been raised, including resolved issues. Unresolved entries share their
+-- id with the issue in the issues table.
+CREATE TABLE issue_history (
+	id UUID PRIMARY KEY,
+	issue_type TEXT NOT NULL,
+	issue_key TEXT NOT NULL DEFAULT '',
+	resource_name TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	team TEXT NOT NULL,
+	env TEXT NOT NULL,
+	severity severity_level NOT NULL,
+	message TEXT NOT NULL DEFAULT '',
+	first_seen TIMESTAMPTZ NOT NULL,
+	last_seen TIMESTAMPTZ NOT NULL,
+	resolved_at TIMESTAMPTZ
+)
+;
+
+CREATE INDEX ON issue_history (team, first_seen DESC)
+;
+
+CREATE INDEX ON issue_history (team, issue_type)
+WHERE
+	resolved_at IS NOT NULL
+;
+
+-- +goose Down
+DROP TABLE issue_history
+;
+
+DROP INDEX issues_identity_key
+;
+
+ALTER TABLE issues
+DROP COLUMN issue_key,
+DROP COLUMN first_seen,
+DROP COLUMN last_seen
+;
@@ -0,0 +1,118 @@
+-- +goose Up
+-- An issue is acknowledged until it is resolved, or snoozed until acknowledged_until. The acknowledgement is removed
+-- with the issue when it is resolved.
+ALTER TABLE issues
+ADD COLUMN acknowledged_by TEXT,
+ADD COLUMN acknowledged_at TIMESTAMPTZ,
+ADD COLUMN acknowledgement_reason TEXT,
+ADD COLUMN acknowledged_until TIMESTAMPTZ
+;
+
+-- Suppression rules acknowledge all issues of a type for resources with names matching a pattern, where * matches
+-- any sequence of characters.
+CREATE TABLE issue_suppression_rules (
+	id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+	team_slug slug NOT NULL REFERENCES teams (slug) ON DELETE CASCADE,
+	issue_type TEXT NOT NULL,
+	resource_name_pattern TEXT NOT NULL,
+	reason TEXT NOT NULL,
+	created_by TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE (team_slug, issue_type, resource_name_pattern)
+)
+;
+
+-- The columns of issues are listed explicitly, as the columns of a view are fixed when it is created.
+CREATE VIEW issues_with_acknowledgement AS
+SELECT
+	issues.id,
+	issues.issue_type,
+	issues.resource_name,
+	issues.resource_type,
+	issues.team,
+	issues.env,
+	issues.severity,
+	issues.message,
+	issues.issue_details,
+	issues.created_at,
+	issues.issue_key,
+	issues.first_seen,
+	issues.last_seen,
+	issues.acknowledged_by,
+	issues.acknowledged_at,
+	issues.acknowledgement_reason,
+	issues.acknowledged_until,
+	rule.id AS suppression_rule_id,
+	rule.reason AS suppression_reason,
+	rule.created_by AS suppressed_by,
+	rule.created_at AS suppressed_at,
+	(
+		rule.id IS NOT NULL
+		OR (
+			issues.acknowledged_at IS NOT NULL
+			AND (
+				issues.acknowledged_until IS NULL
+				OR issues.acknowledged_until > NOW()
+			)
+		)
+	)::BOOLEAN AS acknowledged
+FROM
+	issues
+	LEFT JOIN LATERAL (
+		SELECT
+			r.id,
+			r.reason,
+			r.created_by,
+			r.created_at
+		FROM
+			issue_suppression_rules r
+		WHERE
+			r.team_slug = issues.team
+			AND r.issue_type = issues.issue_type
+			AND issues.resource_name LIKE REPLACE(r.resource_name_pattern, '*', '%')
+		ORDER BY
+			r.created_at
+		LIMIT
+			1
+	) rule ON TRUE
+;
+
+INSERT INTO
+	authorizations (name, description)
+VALUES
+	(
+		'issues:update',
+		'Permission to acknowledge, snooze and suppress issues.'
+	)
+;
+
+INSERT INTO
+	role_authorizations (role_name, authorization_name)
+VALUES
+	('Team member', 'issues:update'),
+	('Team owner', 'issues:update')
+;
+
+-- +goose Down
+DELETE FROM role_authorizations
+WHERE
+	authorization_name = 'issues:update'
+;
+
+DELETE FROM authorizations
+WHERE
+	name = 'issues:update'
+;
+
+DROP VIEW issues_with_acknowledgement
+;
+
+DROP TABLE issue_suppression_rules
+;
+
+ALTER TABLE issues
+DROP COLUMN acknowledged_by,
+DROP COLUMN acknowledged_at,
+DROP COLUMN acknowledgement_reason,
+DROP COLUMN acknowledged_until
+;
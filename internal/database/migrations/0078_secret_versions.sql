@@ -0,0 +1,20 @@
+-- +goose Up
+-- Versions of secrets managed through the API. The data column contains the values and binary keys of the secret
+-- after the change, encrypted by the API before it is stored.
+CREATE TABLE secret_versions (
+	id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+	team_slug slug NOT NULL REFERENCES teams (slug) ON DELETE CASCADE,
+	environment TEXT NOT NULL,
+	secret_name TEXT NOT NULL,
+	version INTEGER NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	created_by TEXT,
+	changed_keys TEXT[] NOT NULL DEFAULT '{}'::TEXT[],
+	data BYTEA NOT NULL,
+	UNIQUE (team_slug, environment, secret_name, version)
+)
+;
+
+-- +goose Down
+DROP TABLE secret_versions
+;
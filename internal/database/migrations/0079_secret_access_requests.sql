@@ -0,0 +1,57 @@
+-- +goose Up
+-- Requests to view the values of secrets in environments where access must be approved by another team owner.
+-- Pending requests expire if they are not handled in time, and approved requests expire when the access ends.
+CREATE TABLE secret_access_requests (
+	id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+	team_slug slug NOT NULL REFERENCES teams (slug) ON DELETE CASCADE,
+	environment TEXT NOT NULL,
+	secret_name TEXT NOT NULL,
+	requester TEXT NOT NULL,
+	reason TEXT NOT NULL,
+	state TEXT NOT NULL DEFAULT 'PENDING' CHECK (state IN ('PENDING', 'APPROVED', 'DENIED', 'EXPIRED')),
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	handled_by TEXT,
+	handled_at TIMESTAMPTZ,
+	handler_comment TEXT,
+	access_expires_at TIMESTAMPTZ,
+	elevation_id TEXT
+)
+;
+
+-- A user can only have one open request for each secret.
+CREATE UNIQUE INDEX ON secret_access_requests (team_slug, environment, secret_name, requester)
+WHERE
+	state IN ('PENDING', 'APPROVED')
+;
+
+CREATE INDEX ON secret_access_requests (team_slug, state)
+;
+
+INSERT INTO
+	authorizations (name, description)
+VALUES
+	(
+		'teams:secrets:approve-access',
+		'Permission to approve and deny requests to view secret values.'
+	)
+;
+
+INSERT INTO
+	role_authorizations (role_name, authorization_name)
+VALUES
+	('Team owner', 'teams:secrets:approve-access')
+;
+
+-- +goose Down
+DELETE FROM role_authorizations
+WHERE
+	authorization_name = 'teams:secrets:approve-access'
+;
+
+DELETE FROM authorizations
+WHERE
+	name = 'teams:secrets:approve-access'
+;
+
+DROP TABLE secret_access_requests
+;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- Custom roles are defined per team and grant a subset of the authorizations of the team owner role. A team member with
+-- a custom role is granted the authorizations of the custom role instead of the authorizations of the member's role.
+CREATE TABLE team_custom_roles (
+	id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+	team_slug slug NOT NULL REFERENCES teams (slug) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE (team_slug, name)
+)
+;
+
+CREATE TABLE team_custom_role_authorizations (
+	custom_role_id UUID NOT NULL REFERENCES team_custom_roles (id) ON DELETE CASCADE,
+	authorization_name TEXT NOT NULL REFERENCES authorizations (name) ON DELETE CASCADE,
+	PRIMARY KEY (custom_role_id, authorization_name)
+)
+;
+
+CREATE TRIGGER team_custom_roles_set_updated
+BEFORE UPDATE ON team_custom_roles FOR EACH ROW
+EXECUTE PROCEDURE set_updated_at ()
+;
+
+-- Members fall back to the authorizations of their role when the custom role is deleted.
+ALTER TABLE user_roles
+ADD COLUMN custom_role_id UUID REFERENCES team_custom_roles (id) ON DELETE SET NULL
+;
+
+-- +goose Down
+ALTER TABLE user_roles
+DROP COLUMN custom_role_id
+;
+
+DROP TABLE team_custom_role_authorizations
+;
+
+DROP TABLE team_custom_roles
+;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Limits the environment bound authorizations of a team role to the listed environments. NULL means all environments.
+ALTER TABLE user_roles
+ADD COLUMN environments TEXT[]
+;
+
+-- +goose Down
+ALTER TABLE user_roles
+DROP COLUMN environments
+;
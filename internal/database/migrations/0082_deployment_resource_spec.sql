@@ -0,0 +1,10 @@
+-- +goose Up
+-- Snapshot of the spec of a deployed workload, captured from the cluster when the deployment succeeds.
+ALTER TABLE deployment_k8s_resources
+ADD COLUMN spec JSONB
+;
+
+-- +goose Down
+ALTER TABLE deployment_k8s_resources
+DROP COLUMN spec
+;
@@ -0,0 +1,72 @@
+-- +goose Up
+-- Time ranges where deployments to a team environment are blocked. A freeze without an end lasts until it is ended.
+CREATE TABLE deployment_freezes (
+	id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+	team_slug slug NOT NULL REFERENCES teams (slug) ON DELETE CASCADE,
+	environment_name TEXT NOT NULL,
+	reason TEXT NOT NULL,
+	starts_at TIMESTAMPTZ NOT NULL,
+	ends_at TIMESTAMPTZ,
+	created_by TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK (
+		ends_at IS NULL
+		OR ends_at > starts_at
+	)
+)
+;
+
+CREATE INDEX ON deployment_freezes (team_slug, environment_name)
+;
+
+-- Overrides allow deployments during a freeze until they expire.
+CREATE TABLE deployment_freeze_overrides (
+	id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+	freeze_id UUID NOT NULL REFERENCES deployment_freezes (id) ON DELETE CASCADE,
+	justification TEXT NOT NULL,
+	created_by TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL
+)
+;
+
+CREATE INDEX ON deployment_freeze_overrides (freeze_id)
+;
+
+INSERT INTO
+	authorizations (name, description)
+VALUES
+	(
+		'teams:deployment-freezes:manage',
+		'Permission to create and end deployment freezes.'
+	),
+	(
+		'teams:deployment-freezes:override',
+		'Permission to deploy during a deployment freeze.'
+	)
+;
+
+INSERT INTO
+	role_authorizations (role_name, authorization_name)
+VALUES
+	('Team member', 'teams:deployment-freezes:manage'),
+	('Team owner', 'teams:deployment-freezes:manage'),
+	('Team owner', 'teams:deployment-freezes:override')
+;
+
+-- +goose Down
+DELETE FROM role_authorizations
+WHERE
+	authorization_name IN ('teams:deployment-freezes:manage', 'teams:deployment-freezes:override')
+;
+
+DELETE FROM authorizations
+WHERE
+	name IN ('teams:deployment-freezes:manage', 'teams:deployment-freezes:override')
+;
+
+DROP TABLE deployment_freeze_overrides
+;
+
+DROP TABLE deployment_freezes
+;
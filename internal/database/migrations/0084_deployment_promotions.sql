@@ -0,0 +1,11 @@
+-- +goose Up
+-- Deployments created by promoting a workload from another environment reference the deployment they were promoted
+-- from.
+ALTER TABLE deployments
+ADD COLUMN promoted_from_deployment_id UUID REFERENCES deployments (id) ON DELETE SET NULL
+;
+
+-- +goose Down
+ALTER TABLE deployments
+DROP COLUMN promoted_from_deployment_id
+;
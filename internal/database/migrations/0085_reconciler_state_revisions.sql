@@ -0,0 +1,36 @@
+-- +goose Up
+-- Bounded history of reconciler states. A revision is created every time the state of a reconciler for a team changes.
+CREATE TABLE reconciler_state_revisions (
+	id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+	reconciler_name TEXT NOT NULL REFERENCES reconcilers (name) ON DELETE CASCADE,
+	team_slug slug NOT NULL REFERENCES teams (slug) ON DELETE CASCADE,
+	revision INTEGER NOT NULL,
+	value BYTEA NOT NULL,
+	created_at TIMESTAMPTZ DEFAULT CLOCK_TIMESTAMP() NOT NULL,
+	UNIQUE (reconciler_name, team_slug, revision)
+)
+;
+
+ALTER TABLE reconciler_states
+ADD COLUMN revision INTEGER NOT NULL DEFAULT 1
+;
+
+INSERT INTO
+	reconciler_state_revisions (reconciler_name, team_slug, revision, value, created_at)
+SELECT
+	reconciler_name,
+	team_slug,
+	revision,
+	value,
+	updated_at
+FROM
+	reconciler_states
+;
+
+-- +goose Down
+ALTER TABLE reconciler_states
+DROP COLUMN revision
+;
+
+DROP TABLE reconciler_state_revisions
+;
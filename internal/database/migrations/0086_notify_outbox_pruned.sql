@@ -0,0 +1,13 @@
+-- +goose Up
+-- The position of the last notification pruned from notify_outbox for each table. Subscribers that resume from an
+-- older position have missed notifications, and are told to reset instead of silently skipping them.
+CREATE TABLE notify_outbox_pruned (
+	table_name TEXT PRIMARY KEY,
+	xact_id BIGINT NOT NULL,
+	seq BIGINT NOT NULL
+)
+;
+
+-- +goose Down
+DROP TABLE notify_outbox_pruned
+;
@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/database/notify/notifysql"
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,14 +22,27 @@ const (
 	Insert Operation = "INSERT"
 	Update Operation = "UPDATE"
 	Delete Operation = "DELETE"
+
+	// Reset is sent to outbox subscribers when notifications they have not seen yet have been pruned
+	Reset Operation = "RESET"
 )
 
 // Payload is the payload of a notification
 // You should not change this struct, as it's used by all listeners
 type Payload struct {
-	Table string         `json:"table"`
-	Op    Operation      `json:"op"`
-	Data  map[string]any `json:"data"`
+	// Seq is the sequence number of the notification in the outbox
+	Seq int64 `json:"seq"`
+	// XactID is the ID of the transaction that created the notification. It is only set for notifications read from
+	// the outbox.
+	XactID int64          `json:"-"`
+	Table  string         `json:"table"`
+	Op     Operation      `json:"op"`
+	Data   map[string]any `json:"data"`
+}
+
+// Position returns the position of the notification in the outbox
+func (p Payload) Position() Position {
+	return Position{XactID: p.XactID, Seq: p.Seq}
 }
 
 type listener struct {
@@ -42,11 +57,28 @@ func WithRetries(num int) Option {
 	}
 }
 
+// WithPollInterval sets how often subscribers check the outbox when no notifications are received
+func WithPollInterval(d time.Duration) Option {
+	return func(n *Notifier) {
+		n.pollInterval = d
+	}
+}
+
+// WithOutboxRetention sets how long notifications are kept in the outbox
+func WithOutboxRetention(d time.Duration) Option {
+	return func(n *Notifier) {
+		n.outboxRetention = d
+	}
+}
+
 type Notifier struct {
-	db         *pgxpool.Pool
-	log        logrus.FieldLogger
-	channel    string
-	maxRetries int
+	db              *pgxpool.Pool
+	querier         *notifysql.Queries
+	log             logrus.FieldLogger
+	channel         string
+	maxRetries      int
+	pollInterval    time.Duration
+	outboxRetention time.Duration
 
 	lock      sync.RWMutex
 	listeners map[string][]listener
@@ -54,11 +86,14 @@ type Notifier struct {
 
 func New(db *pgxpool.Pool, log logrus.FieldLogger, opts ...Option) *Notifier {
 	n := &Notifier{
-		db:         db,
-		channel:    "api_notify",
-		log:        log,
-		listeners:  map[string][]listener{},
-		maxRetries: 100,
+		db:              db,
+		querier:         notifysql.New(db),
+		channel:         "api_notify",
+		log:             log,
+		listeners:       map[string][]listener{},
+		maxRetries:      100,
+		pollInterval:    10 * time.Second,
+		outboxRetention: 7 * 24 * time.Hour,
 	}
 
 	for _, opt := range opts {
@@ -69,6 +104,8 @@ func New(db *pgxpool.Pool, log logrus.FieldLogger, opts ...Option) *Notifier {
 }
 
 func (n *Notifier) Run(ctx context.Context) {
+	go n.pruneOutbox(ctx)
+
 	retries := 0
 	lastError := time.Now()
 
@@ -118,6 +155,25 @@ func (n *Notifier) Listen(table string) <-chan Payload {
 	return ch
 }
 
+// ListenContext is like Listen, but the listener is removed when ctx is done
+func (n *Notifier) ListenContext(ctx context.Context, table string) <-chan Payload {
+	ch := n.Listen(table)
+
+	go func() {
+		<-ctx.Done()
+
+		n.lock.Lock()
+		defer n.lock.Unlock()
+
+		n.log.WithField("table", table).Debug("removing listener")
+		n.listeners[table] = slices.DeleteFunc(n.listeners[table], func(l listener) bool {
+			return l.ch == ch
+		})
+	}()
+
+	return ch
+}
+
 func (n *Notifier) run(ctx context.Context) error {
 	conn, err := n.db.Acquire(ctx)
 	if err != nil {
@@ -0,0 +1,171 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/database/notify/notifysql"
+	"github.com/nais/api/internal/leaderelection"
+)
+
+// subscriptionBatchSize is the maximum number of notifications read from the outbox at once
+const subscriptionBatchSize = 100
+
+// Position is the position of a notification in the outbox. Notifications are ordered by the transaction that created
+// them, and then by sequence number.
+type Position struct {
+	XactID int64
+	Seq    int64
+}
+
+// Less reports whether p is before o
+func (p Position) Less(o Position) bool {
+	if p.XactID != o.XactID {
+		return p.XactID < o.XactID
+	}
+	return p.Seq < o.Seq
+}
+
+// LastPosition returns the position of the latest notification for the table that can be delivered, or the zero
+// Position if there is none. Notifications of transactions that are still running are delivered after it.
+func (n *Notifier) LastPosition(ctx context.Context, table string) (Position, error) {
+	row, err := n.querier.LastPosition(ctx, table)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Position{}, nil
+	} else if err != nil {
+		return Position{}, fmt.Errorf("get last position: %w", err)
+	}
+	return Position{XactID: row.XactID, Seq: row.Seq}, nil
+}
+
+// Subscribe returns a channel that will receive all notifications for the given table after the given position, in
+// order. Notifications are read from the outbox, so notifications sent while the subscriber, or the notifier, was
+// disconnected are delivered as well. Notifications are only delivered once every transaction that started before
+// them has ended, so they never become visible out of order. Delivery is at-least-once: subscribers that persist the
+// position of the last notification they processed, and resume from it, will see every notification.
+//
+// Notifications are only kept for the outbox retention. When notifications the subscriber has not seen yet have been
+// pruned, a payload with the Reset operation is sent instead, positioned at the last pruned notification. The
+// subscriber must then rebuild its state, as notifications up to that position are lost. Delivery continues after it.
+//
+// Unlike Listen, notifications are never dropped. A slow subscriber will block its own subscription only.
+// The channel is closed when ctx is done.
+func (n *Notifier) Subscribe(ctx context.Context, table string, after Position) <-chan Payload {
+	ch := make(chan Payload, 20)
+	wakeup := n.ListenContext(ctx, table)
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(n.pollInterval)
+		defer ticker.Stop()
+
+		log := n.log.WithField("table", table)
+		for {
+			for {
+				payloads, err := n.readOutbox(ctx, table, after)
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					log.WithError(err).Error("read outbox")
+					break
+				}
+
+				for _, payload := range payloads {
+					select {
+					case ch <- payload:
+						after = payload.Position()
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				if len(payloads) < subscriptionBatchSize {
+					break
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-wakeup:
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return ch
+}
+
+// readOutbox returns the next notifications for the table after the given position. When notifications after the
+// position have been pruned, a single Reset payload is returned instead.
+func (n *Notifier) readOutbox(ctx context.Context, table string, after Position) ([]Payload, error) {
+	rows, err := n.querier.ListAfter(ctx, notifysql.ListAfterParams{
+		TableName:   table,
+		AfterXactID: after.XactID,
+		AfterSeq:    after.Seq,
+		Limit:       subscriptionBatchSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The pruned position is read after the notifications, so a prune that completes in between is never missed. At
+	// worst it causes a reset for notifications that were already read.
+	row, err := n.querier.PrunedPosition(ctx, table)
+	if err != nil {
+		return nil, fmt.Errorf("get pruned position: %w", err)
+	}
+
+	if pruned := (Position{XactID: row.XactID, Seq: row.Seq}); after.Less(pruned) {
+		return []Payload{{Seq: pruned.Seq, XactID: pruned.XactID, Table: table, Op: Reset}}, nil
+	}
+
+	ret := make([]Payload, len(rows))
+	for i, row := range rows {
+		ret[i] = Payload{
+			Seq:    row.Seq,
+			XactID: row.XactID,
+			Table:  row.TableName,
+			Op:     Operation(row.Op),
+		}
+		if err := json.Unmarshal(row.Data, &ret[i].Data); err != nil {
+			return nil, fmt.Errorf("unmarshal data: %w", err)
+		}
+	}
+	return ret, nil
+}
+
+// pruneOutbox periodically removes notifications older than the outbox retention. The outbox is shared by all
+// replicas, so only the leader prunes it.
+func (n *Notifier) pruneOutbox(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		if leaderelection.IsLeader() {
+			n.prune(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *Notifier) prune(ctx context.Context) {
+	tables, err := n.querier.Prune(ctx, pgtype.Timestamptz{Time: time.Now().Add(-n.outboxRetention), Valid: true})
+	if err != nil && ctx.Err() == nil {
+		n.log.WithError(err).Error("prune outbox")
+	} else if tables > 0 {
+		n.log.WithField("tables", tables).Debug("pruned outbox")
+	}
+}
@@ -0,0 +1,275 @@
+//go:build integration_test
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/database"
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("notifications are delivered in order", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		n := New(pool, log, WithPollInterval(10*time.Millisecond))
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		positions := insertOutbox(ctx, t, pool, "table_a", 3, time.Now())
+		insertOutbox(ctx, t, pool, "table_b", 2, time.Now())
+		positions = append(positions, insertOutbox(ctx, t, pool, "table_a", 2, time.Now())...)
+
+		ch := n.Subscribe(ctx, "table_a", Position{})
+		for i, want := range positions {
+			got := receive(t, ch)
+			if got.Position() != want || got.Table != "table_a" || got.Op != Insert {
+				t.Fatalf("notification %d: expected %+v of table_a, got %+v", i, want, got)
+			}
+			if got.Data["id"] != fmt.Sprint(want.Seq) {
+				t.Errorf("notification %d: expected data id %d, got %v", i, want.Seq, got.Data["id"])
+			}
+		}
+	})
+
+	t.Run("subscription resumes after position", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		n := New(pool, log, WithPollInterval(10*time.Millisecond))
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		positions := insertOutbox(ctx, t, pool, "table_a", 5, time.Now())
+
+		ch := n.Subscribe(ctx, "table_a", positions[2])
+		for _, want := range positions[3:] {
+			if got := receive(t, ch); got.Position() != want {
+				t.Fatalf("expected %+v, got %+v", want, got.Position())
+			}
+		}
+
+		// Notifications added after the subscription was started are delivered as well
+		later := insertOutbox(ctx, t, pool, "table_a", 1, time.Now())
+		if got := receive(t, ch); got.Position() != later[0] {
+			t.Fatalf("expected %+v, got %+v", later[0], got.Position())
+		}
+	})
+
+	t.Run("subscription resets when resuming after pruned notifications", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		n := New(pool, log, WithPollInterval(10*time.Millisecond), WithOutboxRetention(time.Hour))
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		pruned := insertOutbox(ctx, t, pool, "table_a", 3, time.Now().Add(-2*time.Hour))
+		retained := insertOutbox(ctx, t, pool, "table_a", 2, time.Now())
+		n.prune(ctx)
+
+		ch := n.Subscribe(ctx, "table_a", pruned[0])
+		if got := receive(t, ch); got.Op != Reset || got.Position() != pruned[2] {
+			t.Fatalf("expected reset at %+v, got %+v", pruned[2], got)
+		}
+
+		for _, want := range retained {
+			if got := receive(t, ch); got.Position() != want || got.Op != Insert {
+				t.Fatalf("expected %+v, got %+v", want, got)
+			}
+		}
+	})
+
+	t.Run("subscription does not reset when resuming after the last pruned notification", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		n := New(pool, log, WithPollInterval(10*time.Millisecond), WithOutboxRetention(time.Hour))
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		pruned := insertOutbox(ctx, t, pool, "table_a", 3, time.Now().Add(-2*time.Hour))
+		retained := insertOutbox(ctx, t, pool, "table_a", 1, time.Now())
+		n.prune(ctx)
+
+		ch := n.Subscribe(ctx, "table_a", pruned[2])
+		if got := receive(t, ch); got.Position() != retained[0] || got.Op != Insert {
+			t.Fatalf("expected %+v, got %+v", retained[0], got)
+		}
+	})
+
+	t.Run("notifications of running transactions are delivered before notifications of later transactions", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		n := New(pool, log, WithPollInterval(10*time.Millisecond))
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		start, err := n.LastPosition(ctx, "teams")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		early, err := pool.Begin(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer early.Rollback(ctx)
+		if _, err := early.Exec(ctx, "INSERT INTO teams (slug, purpose, slack_channel) VALUES ('early', 'purpose', '#channel')"); err != nil {
+			t.Fatal(err)
+		}
+
+		// Notifying from another transaction does not wait for the running transaction
+		if _, err := pool.Exec(ctx, "INSERT INTO teams (slug, purpose, slack_channel) VALUES ('late', 'purpose', '#channel')"); err != nil {
+			t.Fatal(err)
+		}
+
+		ch := n.Subscribe(ctx, "teams", start)
+		select {
+		case got := <-ch:
+			t.Fatalf("expected no notifications while an earlier transaction is running, got %+v", got)
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		if err := early.Commit(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, want := range []string{"early", "late"} {
+			if got := receive(t, ch); got.Data["slug"] != want {
+				t.Fatalf("expected notification of team %q, got %+v", want, got)
+			}
+		}
+	})
+}
+
+func TestAPINotify(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("inserts are stored with their values", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+
+		if _, err := pool.Exec(ctx, "INSERT INTO teams (slug, purpose, slack_channel) VALUES ('team', 'purpose', '#channel')"); err != nil {
+			t.Fatal(err)
+		}
+
+		var op string
+		var data map[string]any
+		if err := pool.QueryRow(ctx, "SELECT op, data FROM notify_outbox WHERE table_name = 'teams' ORDER BY seq DESC LIMIT 1").Scan(&op, &data); err != nil {
+			t.Fatal(err)
+		}
+
+		if op != "INSERT" {
+			t.Errorf("expected INSERT, got %s", op)
+		}
+		if data["slug"] != "team" || data["purpose"] != "purpose" {
+			t.Errorf("expected values of the inserted team, got %v", data)
+		}
+	})
+}
+
+func receive(t *testing.T, ch <-chan Payload) Payload {
+	t.Helper()
+
+	select {
+	case p, ok := <-ch:
+		if !ok {
+			t.Fatal("subscription closed")
+		}
+		return p
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for notification")
+	}
+	return Payload{}
+}
+
+// insertOutbox inserts num notifications for the table into the outbox, one transaction each, and returns their
+// positions
+func insertOutbox(ctx context.Context, t *testing.T, pool *pgxpool.Pool, table string, num int, createdAt time.Time) []Position {
+	t.Helper()
+
+	positions := make([]Position, 0, num)
+	for range num {
+		var p Position
+		stmt := `
+			INSERT INTO notify_outbox (table_name, op, data, created_at)
+			VALUES ($1, 'INSERT', '{}', $2)
+			RETURNING xact_id, seq`
+		if err := pool.QueryRow(ctx, stmt, table, createdAt).Scan(&p.XactID, &p.Seq); err != nil {
+			t.Fatalf("failed to insert notification: %v", err)
+		}
+
+		if _, err := pool.Exec(ctx, "UPDATE notify_outbox SET data = jsonb_build_object('id', seq::TEXT) WHERE seq = $1", p.Seq); err != nil {
+			t.Fatalf("failed to update notification: %v", err)
+		}
+		positions = append(positions, p)
+	}
+
+	return positions
+}
+
+func startPostgresql(ctx context.Context, t *testing.T, log logrus.FieldLogger) (container *postgres.PostgresContainer, dsn string, err error) {
+	container, err = postgres.Run(
+		ctx,
+		"docker.io/postgres:16-alpine",
+		postgres.WithDatabase("test"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		postgres.WithSQLDriver("pgx"),
+		postgres.BasicWaitStrategies(),
+	)
+	defer testcontainers.CleanupContainer(t, container)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to start container: %w", err)
+	}
+
+	dsn, err = container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get connection string: %w", err)
+	}
+
+	pool, err := database.NewPool(ctx, dsn, log, true)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create pool: %w", err)
+	}
+	pool.Close()
+
+	if err := container.Snapshot(ctx); err != nil {
+		return nil, "", fmt.Errorf("failed to snapshot: %w", err)
+	}
+
+	return container, dsn, nil
+}
+
+func getConnection(ctx context.Context, t *testing.T, container *postgres.PostgresContainer, dsn string, log logrus.FieldLogger) *pgxpool.Pool {
+	pool, _ := database.NewPool(ctx, dsn, log, false)
+
+	t.Cleanup(func() {
+		pool.Close()
+		if err := container.Restore(ctx); err != nil {
+			t.Fatalf("failed to restore database: %v", err)
+		}
+	})
+
+	return pool
+}
@@ -0,0 +1,86 @@
+-- name: LastPosition :one
+-- Returns the position of the last notification for the table that can be delivered. Notifications of transactions
+-- that are still running are delivered after this position once they are committed.
+SELECT
+	xact_id,
+	seq
+FROM
+	notify_outbox
+WHERE
+	table_name = @table_name
+	AND xact_id < pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT
+ORDER BY
+	xact_id DESC,
+	seq DESC
+LIMIT
+	1
+;
+
+-- name: ListAfter :many
+-- Notifications of transactions that are still running are not returned, as they could otherwise become visible after
+-- later notifications have been returned.
+SELECT
+	*
+FROM
+	notify_outbox
+WHERE
+	table_name = @table_name
+	AND (xact_id, seq) > (@after_xact_id::BIGINT, @after_seq::BIGINT)
+	AND xact_id < pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT
+ORDER BY
+	xact_id ASC,
+	seq ASC
+LIMIT
+	sqlc.arg('limit')
+;
+
+-- name: PrunedPosition :one
+SELECT
+	COALESCE(MAX(xact_id), 0)::BIGINT AS xact_id,
+	COALESCE(MAX(seq), 0)::BIGINT AS seq
+FROM
+	notify_outbox_pruned
+WHERE
+	table_name = @table_name
+;
+
+-- name: Prune :execrows
+-- Removes notifications created before the given time, and records the position of the last pruned notification for
+-- each table.
+WITH
+	deleted AS (
+		DELETE FROM notify_outbox
+		WHERE
+			created_at < @created_before
+		RETURNING
+			table_name,
+			xact_id,
+			seq
+	),
+	last_pruned AS (
+		SELECT DISTINCT ON (table_name)
+			table_name,
+			xact_id,
+			seq
+		FROM
+			deleted
+		ORDER BY
+			table_name,
+			xact_id DESC,
+			seq DESC
+	)
+INSERT INTO
+	notify_outbox_pruned (table_name, xact_id, seq)
+SELECT
+	table_name,
+	xact_id,
+	seq
+FROM
+	last_pruned
+ON CONFLICT (table_name) DO UPDATE
+SET
+	xact_id = EXCLUDED.xact_id,
+	seq = EXCLUDED.seq
+WHERE
+	(EXCLUDED.xact_id, EXCLUDED.seq) > (notify_outbox_pruned.xact_id, notify_outbox_pruned.seq)
+;
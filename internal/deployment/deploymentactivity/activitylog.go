@@ -36,6 +36,10 @@ type DeploymentActivityLogEntry struct {
 	Data *DeploymentActivityLogEntryData `json:"data"`
 }
 
+func (e DeploymentActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type DeploymentActivityLogEntryData struct {
 	TriggerURL string `json:"triggerURL,omitempty"`
 }
@@ -23,6 +23,131 @@ func (q *Queries) CleanupNaisVerification(ctx context.Context) (pgconn.CommandTa
 	return q.db.Exec(ctx, cleanupNaisVerification)
 }
 
+const createPromotion = `-- name: CreatePromotion :one
+INSERT INTO
+	deployments (
+		id,
+		team_slug,
+		repository,
+		commit_sha,
+		deployer_username,
+		environment_name,
+		promoted_from_deployment_id
+	)
+VALUES
+	(
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7
+	)
+RETURNING
+	id, external_id, created_at, team_slug, repository, commit_sha, deployer_username, trigger_url, environment_name, promoted_from_deployment_id
+`
+
+type CreatePromotionParams struct {
+	ID                       uuid.UUID
+	TeamSlug                 slug.Slug
+	Repository               *string
+	CommitSha                *string
+	DeployerUsername         *string
+	EnvironmentName          string
+	PromotedFromDeploymentID *uuid.UUID
+}
+
+func (q *Queries) CreatePromotion(ctx context.Context, arg CreatePromotionParams) (*Deployment, error) {
+	row := q.db.QueryRow(ctx, createPromotion,
+		arg.ID,
+		arg.TeamSlug,
+		arg.Repository,
+		arg.CommitSha,
+		arg.DeployerUsername,
+		arg.EnvironmentName,
+		arg.PromotedFromDeploymentID,
+	)
+	var i Deployment
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.CreatedAt,
+		&i.TeamSlug,
+		&i.Repository,
+		&i.CommitSha,
+		&i.DeployerUsername,
+		&i.TriggerUrl,
+		&i.EnvironmentName,
+		&i.PromotedFromDeploymentID,
+	)
+	return &i, err
+}
+
+const createResource = `-- name: CreateResource :exec
+INSERT INTO
+	deployment_k8s_resources (
+		deployment_id,
+		"group",
+		version,
+		kind,
+		name,
+		namespace,
+		spec
+	)
+VALUES
+	(
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7
+	)
+`
+
+type CreateResourceParams struct {
+	DeploymentID uuid.UUID
+	Group        string
+	Version      string
+	Kind         string
+	Name         string
+	Namespace    string
+	Spec         []byte
+}
+
+func (q *Queries) CreateResource(ctx context.Context, arg CreateResourceParams) error {
+	_, err := q.db.Exec(ctx, createResource,
+		arg.DeploymentID,
+		arg.Group,
+		arg.Version,
+		arg.Kind,
+		arg.Name,
+		arg.Namespace,
+		arg.Spec,
+	)
+	return err
+}
+
+const createStatus = `-- name: CreateStatus :exec
+INSERT INTO
+	deployment_statuses (deployment_id, state, message)
+VALUES
+	($1, $2, $3)
+`
+
+type CreateStatusParams struct {
+	DeploymentID uuid.UUID
+	State        DeploymentState
+	Message      string
+}
+
+func (q *Queries) CreateStatus(ctx context.Context, arg CreateStatusParams) error {
+	_, err := q.db.Exec(ctx, createStatus, arg.DeploymentID, arg.State, arg.Message)
+	return err
+}
+
 const latestDeploymentTimestampForWorkload = `-- name: LatestDeploymentTimestampForWorkload :one
 SELECT
 	deployments.created_at
@@ -59,9 +184,56 @@ func (q *Queries) LatestDeploymentTimestampForWorkload(ctx context.Context, arg
 	return created_at, err
 }
 
+const latestForWorkload = `-- name: LatestForWorkload :one
+SELECT
+	deployments.id, deployments.external_id, deployments.created_at, deployments.team_slug, deployments.repository, deployments.commit_sha, deployments.deployer_username, deployments.trigger_url, deployments.environment_name, deployments.promoted_from_deployment_id
+FROM
+	deployments
+	JOIN deployment_k8s_resources ON deployments.id = deployment_k8s_resources.deployment_id
+WHERE
+	deployment_k8s_resources.name = $1
+	AND deployment_k8s_resources.kind = $2
+	AND deployments.environment_name = $3
+	AND deployments.team_slug = $4
+ORDER BY
+	deployments.created_at DESC
+LIMIT
+	1
+`
+
+type LatestForWorkloadParams struct {
+	WorkloadName    string
+	WorkloadKind    string
+	EnvironmentName string
+	TeamSlug        slug.Slug
+}
+
+func (q *Queries) LatestForWorkload(ctx context.Context, arg LatestForWorkloadParams) (*Deployment, error) {
+	row := q.db.QueryRow(ctx, latestForWorkload,
+		arg.WorkloadName,
+		arg.WorkloadKind,
+		arg.EnvironmentName,
+		arg.TeamSlug,
+	)
+	var i Deployment
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.CreatedAt,
+		&i.TeamSlug,
+		&i.Repository,
+		&i.CommitSha,
+		&i.DeployerUsername,
+		&i.TriggerUrl,
+		&i.EnvironmentName,
+		&i.PromotedFromDeploymentID,
+	)
+	return &i, err
+}
+
 const list = `-- name: List :many
 SELECT
-	deployments.id, deployments.external_id, deployments.created_at, deployments.team_slug, deployments.repository, deployments.commit_sha, deployments.deployer_username, deployments.trigger_url, deployments.environment_name,
+	deployments.id, deployments.external_id, deployments.created_at, deployments.team_slug, deployments.repository, deployments.commit_sha, deployments.deployer_username, deployments.trigger_url, deployments.environment_name, deployments.promoted_from_deployment_id,
 	COUNT(*) OVER () AS total_count
 FROM
 	deployments
@@ -127,6 +299,7 @@ func (q *Queries) List(ctx context.Context, arg ListParams) ([]*ListRow, error)
 			&i.Deployment.DeployerUsername,
 			&i.Deployment.TriggerUrl,
 			&i.Deployment.EnvironmentName,
+			&i.Deployment.PromotedFromDeploymentID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -141,7 +314,7 @@ func (q *Queries) List(ctx context.Context, arg ListParams) ([]*ListRow, error)
 
 const listByIDs = `-- name: ListByIDs :many
 SELECT
-	id, external_id, created_at, team_slug, repository, commit_sha, deployer_username, trigger_url, environment_name
+	id, external_id, created_at, team_slug, repository, commit_sha, deployer_username, trigger_url, environment_name, promoted_from_deployment_id
 FROM
 	deployments
 WHERE
@@ -169,6 +342,7 @@ func (q *Queries) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*Deployment
 			&i.DeployerUsername,
 			&i.TriggerUrl,
 			&i.EnvironmentName,
+			&i.PromotedFromDeploymentID,
 		); err != nil {
 			return nil, err
 		}
@@ -182,7 +356,7 @@ func (q *Queries) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*Deployment
 
 const listByTeamSlug = `-- name: ListByTeamSlug :many
 SELECT
-	deployments.id, deployments.external_id, deployments.created_at, deployments.team_slug, deployments.repository, deployments.commit_sha, deployments.deployer_username, deployments.trigger_url, deployments.environment_name,
+	deployments.id, deployments.external_id, deployments.created_at, deployments.team_slug, deployments.repository, deployments.commit_sha, deployments.deployer_username, deployments.trigger_url, deployments.environment_name, deployments.promoted_from_deployment_id,
 	COUNT(*) OVER () AS total_count
 FROM
 	deployments
@@ -226,6 +400,7 @@ func (q *Queries) ListByTeamSlug(ctx context.Context, arg ListByTeamSlugParams)
 			&i.Deployment.DeployerUsername,
 			&i.Deployment.TriggerUrl,
 			&i.Deployment.EnvironmentName,
+			&i.Deployment.PromotedFromDeploymentID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -240,7 +415,7 @@ func (q *Queries) ListByTeamSlug(ctx context.Context, arg ListByTeamSlugParams)
 
 const listDeploymentResourcesByIDs = `-- name: ListDeploymentResourcesByIDs :many
 SELECT
-	id, created_at, deployment_id, "group", version, kind, name, namespace
+	id, created_at, deployment_id, "group", version, kind, name, namespace, spec
 FROM
 	deployment_k8s_resources
 WHERE
@@ -267,6 +442,7 @@ func (q *Queries) ListDeploymentResourcesByIDs(ctx context.Context, ids []uuid.U
 			&i.Kind,
 			&i.Name,
 			&i.Namespace,
+			&i.Spec,
 		); err != nil {
 			return nil, err
 		}
@@ -317,7 +493,7 @@ func (q *Queries) ListDeploymentStatusesByIDs(ctx context.Context, ids []uuid.UU
 
 const listForWorkload = `-- name: ListForWorkload :many
 SELECT
-	deployments.id, deployments.external_id, deployments.created_at, deployments.team_slug, deployments.repository, deployments.commit_sha, deployments.deployer_username, deployments.trigger_url, deployments.environment_name,
+	deployments.id, deployments.external_id, deployments.created_at, deployments.team_slug, deployments.repository, deployments.commit_sha, deployments.deployer_username, deployments.trigger_url, deployments.environment_name, deployments.promoted_from_deployment_id,
 	COUNT(*) OVER () AS total_count
 FROM
 	deployments
@@ -375,6 +551,7 @@ func (q *Queries) ListForWorkload(ctx context.Context, arg ListForWorkloadParams
 			&i.Deployment.DeployerUsername,
 			&i.Deployment.TriggerUrl,
 			&i.Deployment.EnvironmentName,
+			&i.Deployment.PromotedFromDeploymentID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -387,9 +564,130 @@ func (q *Queries) ListForWorkload(ctx context.Context, arg ListForWorkloadParams
 	return items, nil
 }
 
+const listOutcomes = `-- name: ListOutcomes :many
+SELECT
+	deployments.id,
+	deployments.created_at,
+	deployments.environment_name,
+	COALESCE(
+		(
+			SELECT
+				r.kind || '/' || r.name
+			FROM
+				deployment_k8s_resources r
+			WHERE
+				r.deployment_id = deployments.id
+				AND r."group" = 'nais.io'
+				AND r.kind IN ('Application', 'Naisjob')
+			ORDER BY
+				r.created_at
+			LIMIT
+				1
+		),
+		''
+	)::TEXT AS workload,
+	(
+		SELECT
+			MIN(s.created_at)
+		FROM
+			deployment_statuses s
+		WHERE
+			s.deployment_id = deployments.id
+			AND s.state = 'success'
+	)::TIMESTAMPTZ AS succeeded_at,
+	(
+		SELECT
+			MIN(s.created_at)
+		FROM
+			deployment_statuses s
+		WHERE
+			s.deployment_id = deployments.id
+			AND s.state IN ('failure', 'error')
+	)::TIMESTAMPTZ AS failed_at
+FROM
+	deployments
+WHERE
+	deployments.team_slug = $1::slug
+	AND deployments.created_at >= $2::TIMESTAMPTZ
+	AND deployments.created_at < $3::TIMESTAMPTZ
+	AND (
+		$4::TEXT IS NULL
+		OR deployments.environment_name = $4::TEXT
+	)
+	AND (
+		$5::TEXT IS NULL
+		OR EXISTS (
+			SELECT
+				1
+			FROM
+				deployment_k8s_resources r
+			WHERE
+				r.deployment_id = deployments.id
+				AND r.name = $5::TEXT
+				AND r.kind = $6::TEXT
+		)
+	)
+ORDER BY
+	deployments.created_at ASC
+`
+
+type ListOutcomesParams struct {
+	TeamSlug        slug.Slug
+	Since           pgtype.Timestamptz
+	Until           pgtype.Timestamptz
+	EnvironmentName *string
+	WorkloadName    *string
+	WorkloadKind    *string
+}
+
+type ListOutcomesRow struct {
+	ID              uuid.UUID
+	CreatedAt       pgtype.Timestamptz
+	EnvironmentName string
+	Workload        string
+	SucceededAt     pgtype.Timestamptz
+	FailedAt        pgtype.Timestamptz
+}
+
+// Lists deployments created in the time window, together with the time of the first successful and the first failed
+// status of each deployment. Used to compute DORA metrics.
+func (q *Queries) ListOutcomes(ctx context.Context, arg ListOutcomesParams) ([]*ListOutcomesRow, error) {
+	rows, err := q.db.Query(ctx, listOutcomes,
+		arg.TeamSlug,
+		arg.Since,
+		arg.Until,
+		arg.EnvironmentName,
+		arg.WorkloadName,
+		arg.WorkloadKind,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListOutcomesRow{}
+	for rows.Next() {
+		var i ListOutcomesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.EnvironmentName,
+			&i.Workload,
+			&i.SucceededAt,
+			&i.FailedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listResourcesForDeployment = `-- name: ListResourcesForDeployment :many
 SELECT
-	deployment_k8s_resources.id, deployment_k8s_resources.created_at, deployment_k8s_resources.deployment_id, deployment_k8s_resources."group", deployment_k8s_resources.version, deployment_k8s_resources.kind, deployment_k8s_resources.name, deployment_k8s_resources.namespace,
+	deployment_k8s_resources.id, deployment_k8s_resources.created_at, deployment_k8s_resources.deployment_id, deployment_k8s_resources."group", deployment_k8s_resources.version, deployment_k8s_resources.kind, deployment_k8s_resources.name, deployment_k8s_resources.namespace, deployment_k8s_resources.spec,
 	COUNT(*) OVER () AS total_count
 FROM
 	deployment_k8s_resources
@@ -432,6 +730,7 @@ func (q *Queries) ListResourcesForDeployment(ctx context.Context, arg ListResour
 			&i.DeploymentK8sResource.Kind,
 			&i.DeploymentK8sResource.Name,
 			&i.DeploymentK8sResource.Namespace,
+			&i.DeploymentK8sResource.Spec,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -444,6 +743,47 @@ func (q *Queries) ListResourcesForDeployment(ctx context.Context, arg ListResour
 	return items, nil
 }
 
+const listResourcesForDeployments = `-- name: ListResourcesForDeployments :many
+SELECT
+	id, created_at, deployment_id, "group", version, kind, name, namespace, spec
+FROM
+	deployment_k8s_resources
+WHERE
+	deployment_id = ANY ($1::UUID[])
+ORDER BY
+	created_at
+`
+
+func (q *Queries) ListResourcesForDeployments(ctx context.Context, deploymentIds []uuid.UUID) ([]*DeploymentK8sResource, error) {
+	rows, err := q.db.Query(ctx, listResourcesForDeployments, deploymentIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*DeploymentK8sResource{}
+	for rows.Next() {
+		var i DeploymentK8sResource
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.DeploymentID,
+			&i.Group,
+			&i.Version,
+			&i.Kind,
+			&i.Name,
+			&i.Namespace,
+			&i.Spec,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStatusesForDeployment = `-- name: ListStatusesForDeployment :many
 SELECT
 	deployment_statuses.id, deployment_statuses.created_at, deployment_statuses.deployment_id, deployment_statuses.state, deployment_statuses.message,
@@ -497,3 +837,162 @@ func (q *Queries) ListStatusesForDeployment(ctx context.Context, arg ListStatuse
 	}
 	return items, nil
 }
+
+const listWorkloadResourcesAwaitingRollout = `-- name: ListWorkloadResourcesAwaitingRollout :many
+SELECT
+	deployment_k8s_resources.deployment_id,
+	deployment_k8s_resources.kind,
+	deployment_k8s_resources.name,
+	deployment_k8s_resources.namespace,
+	deployments.environment_name
+FROM
+	deployment_k8s_resources
+	JOIN deployments ON deployments.id = deployment_k8s_resources.deployment_id
+WHERE
+	deployment_k8s_resources."group" = 'nais.io'
+	AND deployment_k8s_resources.kind IN ('Application', 'Naisjob')
+	AND deployments.created_at > NOW() - '1 day'::INTERVAL
+	AND (
+		SELECT
+			deployment_statuses.state
+		FROM
+			deployment_statuses
+		WHERE
+			deployment_statuses.deployment_id = deployments.id
+		ORDER BY
+			deployment_statuses.created_at DESC
+		LIMIT
+			1
+	) = 'in_progress'
+`
+
+type ListWorkloadResourcesAwaitingRolloutRow struct {
+	DeploymentID    uuid.UUID
+	Kind            string
+	Name            string
+	Namespace       string
+	EnvironmentName string
+}
+
+// Lists workload resources of recent deployments whose latest status is in progress. The rollout of these deployments is
+// tracked through the correlation ID that naiserator reports in the status of the workload.
+func (q *Queries) ListWorkloadResourcesAwaitingRollout(ctx context.Context) ([]*ListWorkloadResourcesAwaitingRolloutRow, error) {
+	rows, err := q.db.Query(ctx, listWorkloadResourcesAwaitingRollout)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListWorkloadResourcesAwaitingRolloutRow{}
+	for rows.Next() {
+		var i ListWorkloadResourcesAwaitingRolloutRow
+		if err := rows.Scan(
+			&i.DeploymentID,
+			&i.Kind,
+			&i.Name,
+			&i.Namespace,
+			&i.EnvironmentName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkloadResourcesMissingSpec = `-- name: ListWorkloadResourcesMissingSpec :many
+SELECT
+	deployment_k8s_resources.id,
+	deployment_k8s_resources.kind,
+	deployment_k8s_resources.name,
+	deployment_k8s_resources.namespace,
+	deployments.environment_name
+FROM
+	deployment_k8s_resources
+	JOIN deployments ON deployments.id = deployment_k8s_resources.deployment_id
+WHERE
+	deployment_k8s_resources.spec IS NULL
+	AND deployment_k8s_resources."group" = 'nais.io'
+	AND deployment_k8s_resources.kind IN ('Application', 'Naisjob')
+	AND deployments.created_at > NOW() - '1 day'::INTERVAL
+	AND EXISTS (
+		SELECT
+			1
+		FROM
+			deployment_statuses
+		WHERE
+			deployment_statuses.deployment_id = deployments.id
+			AND deployment_statuses.state = 'success'
+	)
+	AND NOT EXISTS (
+		SELECT
+			1
+		FROM
+			deployments newer
+			JOIN deployment_k8s_resources newer_resource ON newer.id = newer_resource.deployment_id
+			JOIN deployment_statuses newer_status ON newer.id = newer_status.deployment_id
+		WHERE
+			newer.team_slug = deployments.team_slug
+			AND newer.environment_name = deployments.environment_name
+			AND newer.created_at > deployments.created_at
+			AND newer_resource.kind = deployment_k8s_resources.kind
+			AND newer_resource.name = deployment_k8s_resources.name
+			AND newer_status.state = 'success'
+	)
+`
+
+type ListWorkloadResourcesMissingSpecRow struct {
+	ID              uuid.UUID
+	Kind            string
+	Name            string
+	Namespace       string
+	EnvironmentName string
+}
+
+// Lists workload resources of recent successful deployments that have no spec snapshot, where the deployment is the
+// latest successful deployment of the workload. The spec running in the cluster is then the one that was deployed.
+func (q *Queries) ListWorkloadResourcesMissingSpec(ctx context.Context) ([]*ListWorkloadResourcesMissingSpecRow, error) {
+	rows, err := q.db.Query(ctx, listWorkloadResourcesMissingSpec)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListWorkloadResourcesMissingSpecRow{}
+	for rows.Next() {
+		var i ListWorkloadResourcesMissingSpecRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Name,
+			&i.Namespace,
+			&i.EnvironmentName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setResourceSpec = `-- name: SetResourceSpec :exec
+UPDATE deployment_k8s_resources
+SET
+	spec = $1
+WHERE
+	id = $2
+`
+
+type SetResourceSpecParams struct {
+	Spec []byte
+	ID   uuid.UUID
+}
+
+func (q *Queries) SetResourceSpec(ctx context.Context, arg SetResourceSpecParams) error {
+	_, err := q.db.Exec(ctx, setResourceSpec, arg.Spec, arg.ID)
+	return err
+}
@@ -85,15 +85,16 @@ func AllDeploymentStateValues() []DeploymentState {
 }
 
 type Deployment struct {
-	ID               uuid.UUID
-	ExternalID       *string
-	CreatedAt        pgtype.Timestamptz
-	TeamSlug         slug.Slug
-	Repository       *string
-	CommitSha        *string
-	DeployerUsername *string
-	TriggerUrl       *string
-	EnvironmentName  string
+	ID                       uuid.UUID
+	ExternalID               *string
+	CreatedAt                pgtype.Timestamptz
+	TeamSlug                 slug.Slug
+	Repository               *string
+	CommitSha                *string
+	DeployerUsername         *string
+	TriggerUrl               *string
+	EnvironmentName          string
+	PromotedFromDeploymentID *uuid.UUID
 }
 
 type DeploymentK8sResource struct {
@@ -105,6 +106,7 @@ type DeploymentK8sResource struct {
 	Kind         string
 	Name         string
 	Namespace    string
+	Spec         []byte
 }
 
 type DeploymentStatus struct {
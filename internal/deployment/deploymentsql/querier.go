@@ -12,15 +12,30 @@ import (
 
 type Querier interface {
 	CleanupNaisVerification(ctx context.Context) (pgconn.CommandTag, error)
+	CreatePromotion(ctx context.Context, arg CreatePromotionParams) (*Deployment, error)
+	CreateResource(ctx context.Context, arg CreateResourceParams) error
+	CreateStatus(ctx context.Context, arg CreateStatusParams) error
 	LatestDeploymentTimestampForWorkload(ctx context.Context, arg LatestDeploymentTimestampForWorkloadParams) (pgtype.Timestamptz, error)
+	LatestForWorkload(ctx context.Context, arg LatestForWorkloadParams) (*Deployment, error)
 	List(ctx context.Context, arg ListParams) ([]*ListRow, error)
 	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*Deployment, error)
 	ListByTeamSlug(ctx context.Context, arg ListByTeamSlugParams) ([]*ListByTeamSlugRow, error)
 	ListDeploymentResourcesByIDs(ctx context.Context, ids []uuid.UUID) ([]*DeploymentK8sResource, error)
 	ListDeploymentStatusesByIDs(ctx context.Context, ids []uuid.UUID) ([]*DeploymentStatus, error)
 	ListForWorkload(ctx context.Context, arg ListForWorkloadParams) ([]*ListForWorkloadRow, error)
+	// Lists deployments created in the time window, together with the time of the first successful and the first failed
+	// status of each deployment. Used to compute DORA metrics.
+	ListOutcomes(ctx context.Context, arg ListOutcomesParams) ([]*ListOutcomesRow, error)
 	ListResourcesForDeployment(ctx context.Context, arg ListResourcesForDeploymentParams) ([]*ListResourcesForDeploymentRow, error)
+	ListResourcesForDeployments(ctx context.Context, deploymentIds []uuid.UUID) ([]*DeploymentK8sResource, error)
 	ListStatusesForDeployment(ctx context.Context, arg ListStatusesForDeploymentParams) ([]*ListStatusesForDeploymentRow, error)
+	// Lists workload resources of recent deployments whose latest status is in progress. The rollout of these deployments is
+	// tracked through the correlation ID that naiserator reports in the status of the workload.
+	ListWorkloadResourcesAwaitingRollout(ctx context.Context) ([]*ListWorkloadResourcesAwaitingRolloutRow, error)
+	// Lists workload resources of recent successful deployments that have no spec snapshot, where the deployment is the
+	// latest successful deployment of the workload. The spec running in the cluster is then the one that was deployed.
+	ListWorkloadResourcesMissingSpec(ctx context.Context) ([]*ListWorkloadResourcesMissingSpecRow, error)
+	SetResourceSpec(ctx context.Context, arg SetResourceSpecParams) error
 }
 
 var _ Querier = (*Queries)(nil)
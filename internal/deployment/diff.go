@@ -0,0 +1,175 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/apply"
+	"github.com/nais/api/internal/deployment/deploymentsql"
+	"github.com/nais/api/internal/graph/apierror"
+	"github.com/nais/api/internal/graph/ident"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type DeploymentDiff struct {
+	From        *Deployment            `json:"from"`
+	To          *Deployment            `json:"to"`
+	CommitRange *DeploymentCommitRange `json:"commitRange,omitempty"`
+	// ChangedResourceKinds are the kinds of resources that were only deployed by one of the deployments, and the kind of
+	// the workload if its spec changed.
+	ChangedResourceKinds []string                           `json:"changedResourceKinds"`
+	SpecChanges          []activitylog.ResourceChangedField `json:"specChanges"`
+	SpecAvailable        bool                               `json:"specAvailable"`
+}
+
+type DeploymentCommitRange struct {
+	Repository    *string `json:"repository,omitempty"`
+	FromCommitSha string  `json:"fromCommitSha"`
+	ToCommitSha   string  `json:"toCommitSha"`
+}
+
+func (c *DeploymentCommitRange) CompareURL() *string {
+	if c.Repository == nil || *c.Repository == "" {
+		return nil
+	}
+	return new("https://github.com/" + *c.Repository + "/compare/" + c.FromCommitSha + "..." + c.ToCommitSha)
+}
+
+// Diff compares two deployments of the same workload.
+func Diff(ctx context.Context, fromID, toID ident.Ident) (*DeploymentDiff, error) {
+	fromUUID, err := parseDeploymentIdent(fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	toUUID, err := parseDeploymentIdent(toID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := get(ctx, fromUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := get(ctx, toUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := db(ctx).ListResourcesForDeployments(ctx, []uuid.UUID{fromUUID, toUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	var fromResources, toResources []*deploymentsql.DeploymentK8sResource
+	for _, r := range resources {
+		switch r.DeploymentID {
+		case fromUUID:
+			fromResources = append(fromResources, r)
+		case toUUID:
+			toResources = append(toResources, r)
+		}
+	}
+
+	return diffDeployments(from, to, fromResources, toResources)
+}
+
+func diffDeployments(from, to *Deployment, fromResources, toResources []*deploymentsql.DeploymentK8sResource) (*DeploymentDiff, error) {
+	fromWorkload := workloadResource(fromResources)
+	toWorkload := workloadResource(toResources)
+
+	if from.TeamSlug != to.TeamSlug ||
+		from.EnvironmentName != to.EnvironmentName ||
+		fromWorkload == nil ||
+		toWorkload == nil ||
+		fromWorkload.Kind != toWorkload.Kind ||
+		fromWorkload.Name != toWorkload.Name {
+		return nil, apierror.Errorf("The deployments must be of the same workload.")
+	}
+
+	ret := &DeploymentDiff{
+		From:                 from,
+		To:                   to,
+		ChangedResourceKinds: []string{},
+		SpecChanges:          []activitylog.ResourceChangedField{},
+	}
+
+	if from.CommitSha != nil && to.CommitSha != nil {
+		ret.CommitRange = &DeploymentCommitRange{
+			Repository:    to.Repository,
+			FromCommitSha: *from.CommitSha,
+			ToCommitSha:   *to.CommitSha,
+		}
+	}
+
+	key := func(r *deploymentsql.DeploymentK8sResource) string {
+		return r.Group + "/" + r.Kind + "/" + r.Namespace + "/" + r.Name
+	}
+
+	fromKeys := make(map[string]struct{}, len(fromResources))
+	for _, r := range fromResources {
+		fromKeys[key(r)] = struct{}{}
+	}
+	toKeys := make(map[string]struct{}, len(toResources))
+	for _, r := range toResources {
+		toKeys[key(r)] = struct{}{}
+	}
+
+	for _, r := range fromResources {
+		if _, ok := toKeys[key(r)]; !ok {
+			ret.ChangedResourceKinds = append(ret.ChangedResourceKinds, r.Kind)
+		}
+	}
+	for _, r := range toResources {
+		if _, ok := fromKeys[key(r)]; !ok {
+			ret.ChangedResourceKinds = append(ret.ChangedResourceKinds, r.Kind)
+		}
+	}
+
+	if fromWorkload.Spec != nil && toWorkload.Spec != nil {
+		fromSpec, err := specObject(fromWorkload.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		toSpec, err := specObject(toWorkload.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		ret.SpecAvailable = true
+		if changes := apply.Diff(fromSpec, toSpec); len(changes) > 0 {
+			ret.SpecChanges = changes
+			ret.ChangedResourceKinds = append(ret.ChangedResourceKinds, toWorkload.Kind)
+		}
+	}
+
+	slices.Sort(ret.ChangedResourceKinds)
+	ret.ChangedResourceKinds = slices.Compact(ret.ChangedResourceKinds)
+
+	return ret, nil
+}
+
+// workloadResource returns the Application or Naisjob deployed by a deployment.
+func workloadResource(resources []*deploymentsql.DeploymentK8sResource) *deploymentsql.DeploymentK8sResource {
+	for _, r := range resources {
+		if r.Group == "nais.io" && (r.Kind == "Application" || r.Kind == "Naisjob") {
+			return r
+		}
+	}
+	return nil
+}
+
+// specObject wraps a spec snapshot in an object, so that changed fields are reported as spec.<field>.
+func specObject(spec []byte) (*unstructured.Unstructured, error) {
+	var m map[string]any
+	if err := json.Unmarshal(spec, &m); err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{"spec": m}}, nil
+}
@@ -0,0 +1,80 @@
+package deployment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/deployment/deploymentsql"
+)
+
+func TestDiffDeployments(t *testing.T) {
+	app := func(spec string) *deploymentsql.DeploymentK8sResource {
+		r := &deploymentsql.DeploymentK8sResource{Group: "nais.io", Kind: "Application", Name: "app", Namespace: "team"}
+		if spec != "" {
+			r.Spec = []byte(spec)
+		}
+		return r
+	}
+	alert := &deploymentsql.DeploymentK8sResource{Group: "monitoring.coreos.com", Kind: "PrometheusRule", Name: "alerts", Namespace: "team"}
+
+	from := &Deployment{TeamSlug: "team", EnvironmentName: "dev", Repository: new("org/repo"), CommitSha: new("aaa")}
+	to := &Deployment{TeamSlug: "team", EnvironmentName: "dev", Repository: new("org/repo"), CommitSha: new("bbb")}
+
+	t.Run("different workloads", func(t *testing.T) {
+		other := app("")
+		other.Name = "other"
+		if _, err := diffDeployments(from, to, []*deploymentsql.DeploymentK8sResource{app("")}, []*deploymentsql.DeploymentK8sResource{other}); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("different environments", func(t *testing.T) {
+		prod := &Deployment{TeamSlug: "team", EnvironmentName: "prod"}
+		if _, err := diffDeployments(from, prod, []*deploymentsql.DeploymentK8sResource{app("")}, []*deploymentsql.DeploymentK8sResource{app("")}); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("without spec snapshots", func(t *testing.T) {
+		diff, err := diffDeployments(from, to, []*deploymentsql.DeploymentK8sResource{app("")}, []*deploymentsql.DeploymentK8sResource{app(""), alert})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff.SpecAvailable {
+			t.Error("expected spec to be unavailable")
+		}
+		if diff := cmp.Diff([]string{"PrometheusRule"}, diff.ChangedResourceKinds); diff != "" {
+			t.Errorf("diff -want +got:\n%s", diff)
+		}
+		if got := diff.CommitRange.CompareURL(); got == nil || *got != "https://github.com/org/repo/compare/aaa...bbb" {
+			t.Errorf("unexpected compare url %v", got)
+		}
+	})
+
+	t.Run("with spec snapshots", func(t *testing.T) {
+		diff, err := diffDeployments(
+			from,
+			to,
+			[]*deploymentsql.DeploymentK8sResource{app(`{"image":"app:1","replicas":{"min":2}}`)},
+			[]*deploymentsql.DeploymentK8sResource{app(`{"image":"app:2","replicas":{"min":2}}`)},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []activitylog.ResourceChangedField{
+			{Field: "spec.image", OldValue: new("app:1"), NewValue: new("app:2")},
+		}
+		if !diff.SpecAvailable {
+			t.Error("expected spec to be available")
+		}
+		if diff := cmp.Diff(want, diff.SpecChanges); diff != "" {
+			t.Errorf("diff -want +got:\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"Application"}, diff.ChangedResourceKinds); diff != "" {
+			t.Errorf("diff -want +got:\n%s", diff)
+		}
+	})
+}
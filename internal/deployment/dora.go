@@ -0,0 +1,181 @@
+package deployment
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/deployment/deploymentsql"
+	"github.com/nais/api/internal/graph/apierror"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/workload"
+)
+
+const (
+	doraMetricsDefaultWindow = 30 * 24 * time.Hour
+	doraMetricsMaxWindow     = 365 * 24 * time.Hour
+)
+
+// DoraMetrics are the DORA metrics computed from the deployments in a time window.
+//
+// A deployment is successful if it has reported a successful status, and failed if it has reported a failure or an
+// error status without ever succeeding. Deployments that have not yet succeeded or failed are not counted.
+type DoraMetrics struct {
+	From                  time.Time `json:"from"`
+	To                    time.Time `json:"to"`
+	SuccessfulDeployments int       `json:"successfulDeployments"`
+	FailedDeployments     int       `json:"failedDeployments"`
+	// DeploymentFrequency is the number of successful deployments per day.
+	DeploymentFrequency float64 `json:"deploymentFrequency"`
+	// LeadTimeSeconds is the median time from a deployment was created until it succeeded.
+	LeadTimeSeconds *float64 `json:"leadTimeSeconds"`
+	// ChangeFailureRate is the fraction of deployments that failed.
+	ChangeFailureRate *float64 `json:"changeFailureRate"`
+	// TimeToRestoreSeconds is the median time from a deployment failed until the next successful deployment of the
+	// same workload in the same environment.
+	TimeToRestoreSeconds *float64 `json:"timeToRestoreSeconds"`
+}
+
+type DoraMetricsWindowInput struct {
+	From *time.Time `json:"from"`
+	To   *time.Time `json:"to"`
+}
+
+func (w *DoraMetricsWindowInput) resolve(now time.Time) (time.Time, time.Time, error) {
+	to := now
+	if w != nil && w.To != nil {
+		to = *w.To
+	}
+
+	from := to.Add(-doraMetricsDefaultWindow)
+	if w != nil && w.From != nil {
+		from = *w.From
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, apierror.Errorf("The start of the time window must be before the end.")
+	}
+
+	if to.Sub(from) > doraMetricsMaxWindow {
+		return time.Time{}, time.Time{}, apierror.Errorf("The time window can not be longer than %d days.", int(doraMetricsMaxWindow.Hours()/24))
+	}
+
+	return from, to, nil
+}
+
+type deploymentOutcome struct {
+	createdAt   time.Time
+	environment string
+	workload    string
+	succeededAt *time.Time
+	failedAt    *time.Time
+}
+
+// DoraMetricsForTeam computes the DORA metrics for the deployments of a team, optionally limited to a single
+// environment.
+func DoraMetricsForTeam(ctx context.Context, teamSlug slug.Slug, environmentName *string, window *DoraMetricsWindowInput) (*DoraMetrics, error) {
+	return doraMetrics(ctx, window, deploymentsql.ListOutcomesParams{
+		TeamSlug:        teamSlug,
+		EnvironmentName: environmentName,
+	})
+}
+
+// DoraMetricsForWorkload computes the DORA metrics for the deployments of a single workload.
+func DoraMetricsForWorkload(ctx context.Context, teamSlug slug.Slug, environmentName, workloadName string, workloadType workload.Type, window *DoraMetricsWindowInput) (*DoraMetrics, error) {
+	return doraMetrics(ctx, window, deploymentsql.ListOutcomesParams{
+		TeamSlug:        teamSlug,
+		EnvironmentName: &environmentName,
+		WorkloadName:    &workloadName,
+		WorkloadKind:    new(workloadType.String()),
+	})
+}
+
+func doraMetrics(ctx context.Context, window *DoraMetricsWindowInput, params deploymentsql.ListOutcomesParams) (*DoraMetrics, error) {
+	from, to, err := window.resolve(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	params.Since = pgtype.Timestamptz{Time: from, Valid: true}
+	params.Until = pgtype.Timestamptz{Time: to, Valid: true}
+
+	rows, err := db(ctx).ListOutcomes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes := make([]*deploymentOutcome, len(rows))
+	for i, row := range rows {
+		outcomes[i] = &deploymentOutcome{
+			createdAt:   row.CreatedAt.Time,
+			environment: row.EnvironmentName,
+			workload:    row.Workload,
+		}
+		if row.SucceededAt.Valid {
+			outcomes[i].succeededAt = &row.SucceededAt.Time
+		}
+		if row.FailedAt.Valid {
+			outcomes[i].failedAt = &row.FailedAt.Time
+		}
+	}
+
+	return computeDoraMetrics(from, to, outcomes), nil
+}
+
+// computeDoraMetrics computes the metrics from deployment outcomes ordered by creation time.
+func computeDoraMetrics(from, to time.Time, outcomes []*deploymentOutcome) *DoraMetrics {
+	ret := &DoraMetrics{
+		From: from,
+		To:   to,
+	}
+
+	var leadTimes, restoreTimes []float64
+	// The first failure of each workload that has not yet been followed by a successful deployment
+	unrestored := make(map[string]time.Time)
+
+	for _, o := range outcomes {
+		key := o.environment + "/" + o.workload
+
+		switch {
+		case o.succeededAt != nil:
+			ret.SuccessfulDeployments++
+			leadTimes = append(leadTimes, max(0, o.succeededAt.Sub(o.createdAt).Seconds()))
+
+			if failedAt, ok := unrestored[key]; ok && o.succeededAt.After(failedAt) {
+				restoreTimes = append(restoreTimes, o.succeededAt.Sub(failedAt).Seconds())
+				delete(unrestored, key)
+			}
+		case o.failedAt != nil:
+			ret.FailedDeployments++
+
+			if _, ok := unrestored[key]; !ok {
+				unrestored[key] = *o.failedAt
+			}
+		}
+	}
+
+	ret.DeploymentFrequency = float64(ret.SuccessfulDeployments) / (to.Sub(from).Hours() / 24)
+
+	if total := ret.SuccessfulDeployments + ret.FailedDeployments; total > 0 {
+		ret.ChangeFailureRate = new(float64(ret.FailedDeployments) / float64(total))
+	}
+
+	ret.LeadTimeSeconds = median(leadTimes)
+	ret.TimeToRestoreSeconds = median(restoreTimes)
+
+	return ret
+}
+
+func median(values []float64) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	slices.Sort(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return new((values[mid-1] + values[mid]) / 2)
+	}
+	return new(values[mid])
+}
@@ -0,0 +1,84 @@
+package deployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestComputeDoraMetrics(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(10 * 24 * time.Hour)
+	at := func(hours float64) *time.Time {
+		return new(from.Add(time.Duration(hours * float64(time.Hour))))
+	}
+
+	t.Run("no deployments", func(t *testing.T) {
+		want := &DoraMetrics{
+			From: from,
+			To:   to,
+		}
+		if diff := cmp.Diff(want, computeDoraMetrics(from, to, nil)); diff != "" {
+			t.Errorf("diff -want +got:\n%s", diff)
+		}
+	})
+
+	t.Run("successful and failed deployments", func(t *testing.T) {
+		outcomes := []*deploymentOutcome{
+			{createdAt: *at(0), environment: "dev", workload: "Application/app", succeededAt: at(0.5)},
+			{createdAt: *at(10), environment: "dev", workload: "Application/app", failedAt: at(11)},
+			// A failure in another environment is restored separately
+			{createdAt: *at(12), environment: "prod", workload: "Application/app", failedAt: at(12)},
+			// A second failure before the restore does not reset the start of the outage
+			{createdAt: *at(13), environment: "dev", workload: "Application/app", failedAt: at(13)},
+			{createdAt: *at(14), environment: "dev", workload: "Application/app", succeededAt: at(15)},
+			{createdAt: *at(20), environment: "prod", workload: "Application/app", succeededAt: at(22)},
+			// Deployments that are still in progress are not counted
+			{createdAt: *at(30), environment: "prod", workload: "Application/app"},
+		}
+
+		want := &DoraMetrics{
+			From:                  from,
+			To:                    to,
+			SuccessfulDeployments: 3,
+			FailedDeployments:     3,
+			DeploymentFrequency:   0.3,
+			LeadTimeSeconds:       new(3600.0),
+			ChangeFailureRate:     new(0.5),
+			TimeToRestoreSeconds:  new(7 * 3600.0),
+		}
+		if diff := cmp.Diff(want, computeDoraMetrics(from, to, outcomes)); diff != "" {
+			t.Errorf("diff -want +got:\n%s", diff)
+		}
+	})
+}
+
+func TestDoraMetricsWindowInput_resolve(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("defaults to the last 30 days", func(t *testing.T) {
+		var w *DoraMetricsWindowInput
+		from, to, err := w.resolve(now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !to.Equal(now) || !from.Equal(now.Add(-30*24*time.Hour)) {
+			t.Errorf("unexpected window %v - %v", from, to)
+		}
+	})
+
+	t.Run("start after end", func(t *testing.T) {
+		w := &DoraMetricsWindowInput{From: new(now), To: new(now.Add(-time.Hour))}
+		if _, _, err := w.resolve(now); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("window too long", func(t *testing.T) {
+		w := &DoraMetricsWindowInput{From: new(now.Add(-400 * 24 * time.Hour))}
+		if _, _, err := w.resolve(now); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package freeze
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nais/api/internal/activitylog"
+)
+
+const (
+	activityLogEntryResourceTypeDeploymentFreeze activitylog.ActivityLogEntryResourceType = "DEPLOYMENT_FREEZE"
+	activityLogEntryActionEndDeploymentFreeze    activitylog.ActivityLogEntryAction       = "END_DEPLOYMENT_FREEZE"
+	activityLogEntryActionOverrideFreeze         activitylog.ActivityLogEntryAction       = "OVERRIDE_DEPLOYMENT_FREEZE"
+)
+
+func init() {
+	activitylog.RegisterTransformer(activityLogEntryResourceTypeDeploymentFreeze, func(entry activitylog.GenericActivityLogEntry) (activitylog.ActivityLogEntry, error) {
+		switch entry.Action {
+		case activitylog.ActivityLogEntryActionCreated:
+			data, err := activitylog.TransformData(entry, func(data *DeploymentFreezeCreatedActivityLogEntryData) *DeploymentFreezeCreatedActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return DeploymentFreezeCreatedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Created deployment freeze"),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionEndDeploymentFreeze:
+			return DeploymentFreezeEndedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Ended deployment freeze"),
+			}, nil
+		case activityLogEntryActionOverrideFreeze:
+			data, err := activitylog.TransformData(entry, func(data *DeploymentFreezeOverriddenActivityLogEntryData) *DeploymentFreezeOverriddenActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return DeploymentFreezeOverriddenActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Overrode deployment freeze"),
+				Data:                    data,
+			}, nil
+		default:
+			return nil, fmt.Errorf("unsupported deployment freeze activity log entry action: %q", entry.Action)
+		}
+	})
+
+	activitylog.RegisterFilter("DEPLOYMENT_FREEZE_CREATED", activitylog.ActivityLogEntryActionCreated, activityLogEntryResourceTypeDeploymentFreeze)
+	activitylog.RegisterFilter("DEPLOYMENT_FREEZE_ENDED", activityLogEntryActionEndDeploymentFreeze, activityLogEntryResourceTypeDeploymentFreeze)
+	activitylog.RegisterFilter("DEPLOYMENT_FREEZE_OVERRIDDEN", activityLogEntryActionOverrideFreeze, activityLogEntryResourceTypeDeploymentFreeze)
+}
+
+type DeploymentFreezeCreatedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *DeploymentFreezeCreatedActivityLogEntryData `json:"data"`
+}
+
+func (e DeploymentFreezeCreatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type DeploymentFreezeCreatedActivityLogEntryData struct {
+	Reason   string     `json:"reason"`
+	StartsAt time.Time  `json:"startsAt"`
+	EndsAt   *time.Time `json:"endsAt,omitempty"`
+}
+
+type DeploymentFreezeEndedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+}
+
+type DeploymentFreezeOverriddenActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *DeploymentFreezeOverriddenActivityLogEntryData `json:"data"`
+}
+
+func (e DeploymentFreezeOverriddenActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type DeploymentFreezeOverriddenActivityLogEntryData struct {
+	Justification string    `json:"justification"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
@@ -0,0 +1,58 @@
+package freeze
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/deployment/freeze/freezesql"
+	"github.com/nais/api/internal/graph/loader"
+	"github.com/vikstrous/dataloadgen"
+)
+
+type ctxKey int
+
+const loadersKey ctxKey = iota
+
+func NewLoaderContext(ctx context.Context, pool *pgxpool.Pool) context.Context {
+	return context.WithValue(ctx, loadersKey, newLoaders(pool))
+}
+
+func fromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey).(*loaders)
+}
+
+type loaders struct {
+	internalQuerier *freezesql.Queries
+	freezeLoader    *dataloadgen.Loader[uuid.UUID, *DeploymentFreeze]
+}
+
+func newLoaders(pool *pgxpool.Pool) *loaders {
+	db := freezesql.New(pool)
+	freezeLoader := &dataloader{db: db}
+
+	return &loaders{
+		internalQuerier: db,
+		freezeLoader:    dataloadgen.NewLoader(freezeLoader.list, loader.DefaultDataLoaderOptions...),
+	}
+}
+
+type dataloader struct {
+	db *freezesql.Queries
+}
+
+func (l dataloader) list(ctx context.Context, ids []uuid.UUID) ([]*DeploymentFreeze, []error) {
+	makeKey := func(obj *DeploymentFreeze) uuid.UUID { return obj.UUID }
+	return loader.LoadModels(ctx, ids, l.db.ListByIDs, toGraphDeploymentFreeze, makeKey)
+}
+
+func db(ctx context.Context) *freezesql.Queries {
+	l := fromContext(ctx)
+
+	if tx := database.TransactionFromContext(ctx); tx != nil {
+		return l.internalQuerier.WithTx(tx)
+	}
+
+	return l.internalQuerier
+}
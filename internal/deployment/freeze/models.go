@@ -0,0 +1,157 @@
+package freeze
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/api/internal/deployment/freeze/freezesql"
+	"github.com/nais/api/internal/graph/ident"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/team"
+	"github.com/nais/api/internal/validate"
+)
+
+const (
+	defaultOverrideDuration = 1 * time.Hour
+	maxOverrideDuration     = 24 * time.Hour
+)
+
+type (
+	DeploymentFreezeConnection = pagination.Connection[*DeploymentFreeze]
+	DeploymentFreezeEdge       = pagination.Edge[*DeploymentFreeze]
+)
+
+type DeploymentFreeze struct {
+	UUID            uuid.UUID  `json:"-"`
+	TeamSlug        slug.Slug  `json:"-"`
+	EnvironmentName string     `json:"environmentName"`
+	Reason          string     `json:"reason"`
+	StartsAt        time.Time  `json:"startsAt"`
+	EndsAt          *time.Time `json:"endsAt"`
+	CreatedBy       string     `json:"createdBy"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+func (DeploymentFreeze) IsNode() {}
+
+func (f *DeploymentFreeze) ID() ident.Ident {
+	return newIdent(f.UUID)
+}
+
+// Active reports whether the freeze blocks deployments at the given time.
+func (f *DeploymentFreeze) Active(now time.Time) bool {
+	return !f.StartsAt.After(now) && (f.EndsAt == nil || f.EndsAt.After(now))
+}
+
+type DeploymentFreezeOverride struct {
+	Justification string    `json:"justification"`
+	CreatedBy     string    `json:"createdBy"`
+	CreatedAt     time.Time `json:"createdAt"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
+
+type CreateDeploymentFreezeInput struct {
+	TeamSlug        slug.Slug  `json:"teamSlug"`
+	EnvironmentName string     `json:"environmentName"`
+	Reason          string     `json:"reason"`
+	StartsAt        *time.Time `json:"startsAt"`
+	EndsAt          *time.Time `json:"endsAt"`
+}
+
+func (i *CreateDeploymentFreezeInput) Validate(ctx context.Context) error {
+	i.Reason = strings.TrimSpace(i.Reason)
+
+	verr := validate.New()
+	if i.Reason == "" {
+		verr.Add("reason", "A reason is required.")
+	}
+
+	envs, err := team.ListTeamEnvironments(ctx, i.TeamSlug)
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(envs, func(e *team.TeamEnvironment) bool { return e.EnvironmentName == i.EnvironmentName }) {
+		verr.Add("environmentName", "Environment %q does not exist.", i.EnvironmentName)
+	}
+
+	if i.EndsAt != nil {
+		if i.StartsAt != nil && !i.EndsAt.After(*i.StartsAt) {
+			verr.Add("endsAt", "The freeze must end after it starts.")
+		} else if !i.EndsAt.After(time.Now()) {
+			verr.Add("endsAt", "The freeze must end in the future.")
+		}
+	}
+
+	return verr.NilIfEmpty()
+}
+
+type CreateDeploymentFreezePayload struct {
+	DeploymentFreeze *DeploymentFreeze `json:"deploymentFreeze"`
+}
+
+type EndDeploymentFreezeInput struct {
+	ID ident.Ident `json:"id"`
+}
+
+type EndDeploymentFreezePayload struct {
+	DeploymentFreeze *DeploymentFreeze `json:"deploymentFreeze"`
+}
+
+type OverrideDeploymentFreezeInput struct {
+	ID            ident.Ident `json:"id"`
+	Justification string      `json:"justification"`
+	ExpiresAt     *time.Time  `json:"expiresAt"`
+}
+
+func (i *OverrideDeploymentFreezeInput) Validate() error {
+	i.Justification = strings.TrimSpace(i.Justification)
+
+	verr := validate.New()
+	if len(i.Justification) < 10 {
+		verr.Add("justification", "The justification must be at least 10 characters.")
+	}
+
+	if i.ExpiresAt != nil {
+		if d := time.Until(*i.ExpiresAt); d <= 0 {
+			verr.Add("expiresAt", "The override must expire in the future.")
+		} else if d > maxOverrideDuration {
+			verr.Add("expiresAt", "The override can not last longer than %d hours.", int(maxOverrideDuration.Hours()))
+		}
+	}
+
+	return verr.NilIfEmpty()
+}
+
+type OverrideDeploymentFreezePayload struct {
+	DeploymentFreeze *DeploymentFreeze `json:"deploymentFreeze"`
+}
+
+func toGraphDeploymentFreeze(row *freezesql.DeploymentFreeze) *DeploymentFreeze {
+	ret := &DeploymentFreeze{
+		UUID:            row.ID,
+		TeamSlug:        row.TeamSlug,
+		EnvironmentName: row.EnvironmentName,
+		Reason:          row.Reason,
+		StartsAt:        row.StartsAt.Time,
+		CreatedBy:       row.CreatedBy,
+		CreatedAt:       row.CreatedAt.Time,
+	}
+	if row.EndsAt.Valid {
+		ret.EndsAt = &row.EndsAt.Time
+	}
+	return ret
+}
+
+func toGraphDeploymentFreezeOverride(row *freezesql.DeploymentFreezeOverride) *DeploymentFreezeOverride {
+	return &DeploymentFreezeOverride{
+		Justification: row.Justification,
+		CreatedBy:     row.CreatedBy,
+		CreatedAt:     row.CreatedAt.Time,
+		ExpiresAt:     row.ExpiresAt.Time,
+	}
+}
@@ -0,0 +1,71 @@
+package freeze
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeploymentFreeze_Active(t *testing.T) {
+	now := time.Now()
+
+	tests := map[string]struct {
+		freeze *DeploymentFreeze
+		want   bool
+	}{
+		"started without end": {
+			freeze: &DeploymentFreeze{StartsAt: now.Add(-time.Hour)},
+			want:   true,
+		},
+		"started and not ended": {
+			freeze: &DeploymentFreeze{StartsAt: now.Add(-time.Hour), EndsAt: new(now.Add(time.Hour))},
+			want:   true,
+		},
+		"scheduled": {
+			freeze: &DeploymentFreeze{StartsAt: now.Add(time.Hour)},
+			want:   false,
+		},
+		"ended": {
+			freeze: &DeploymentFreeze{StartsAt: now.Add(-2 * time.Hour), EndsAt: new(now.Add(-time.Hour))},
+			want:   false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.freeze.Active(now); got != tt.want {
+				t.Errorf("Active() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverrideDeploymentFreezeInput_Validate(t *testing.T) {
+	tests := map[string]struct {
+		input   *OverrideDeploymentFreezeInput
+		wantErr bool
+	}{
+		"valid": {
+			input: &OverrideDeploymentFreezeInput{Justification: "Critical security fix"},
+		},
+		"short justification": {
+			input:   &OverrideDeploymentFreezeInput{Justification: "  fix  "},
+			wantErr: true,
+		},
+		"expired": {
+			input:   &OverrideDeploymentFreezeInput{Justification: "Critical security fix", ExpiresAt: new(time.Now().Add(-time.Minute))},
+			wantErr: true,
+		},
+		"too long": {
+			input:   &OverrideDeploymentFreezeInput{Justification: "Critical security fix", ExpiresAt: new(time.Now().Add(48 * time.Hour))},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
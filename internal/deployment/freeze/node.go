@@ -0,0 +1,31 @@
+package freeze
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nais/api/internal/graph/ident"
+)
+
+type identType int
+
+const (
+	identKey identType = iota
+)
+
+func init() {
+	ident.RegisterIdentType(identKey, "DF", GetByIdent)
+}
+
+func newIdent(id uuid.UUID) ident.Ident {
+	return ident.NewIdent(identKey, id.String())
+}
+
+func parseIdent(id ident.Ident) (uuid.UUID, error) {
+	parts := id.Parts()
+	if len(parts) != 1 {
+		return uuid.Nil, fmt.Errorf("invalid deployment freeze ident")
+	}
+
+	return uuid.Parse(parts[0])
+}
@@ -0,0 +1,316 @@
+package freeze
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/deployment/freeze/freezesql"
+	"github.com/nais/api/internal/graph/apierror"
+	"github.com/nais/api/internal/graph/ident"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/slug"
+)
+
+// FrozenError is returned when a change is rejected because of an active deployment freeze.
+type FrozenError struct {
+	Freeze *DeploymentFreeze
+}
+
+func (e *FrozenError) Error() string {
+	return fmt.Sprintf("deployments to environment %q are frozen: %s", e.Freeze.EnvironmentName, e.Freeze.Reason)
+}
+
+func GetByIdent(ctx context.Context, id ident.Ident) (*DeploymentFreeze, error) {
+	uid, err := parseIdent(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return Get(ctx, uid)
+}
+
+func Get(ctx context.Context, id uuid.UUID) (*DeploymentFreeze, error) {
+	return fromContext(ctx).freezeLoader.Load(ctx, id)
+}
+
+// ListForTeam returns the active and scheduled freezes of a team.
+func ListForTeam(ctx context.Context, teamSlug slug.Slug, environmentName *string, page *pagination.Pagination) (*DeploymentFreezeConnection, error) {
+	ret, err := db(ctx).ListForTeam(ctx, freezesql.ListForTeamParams{
+		TeamSlug:        teamSlug,
+		EnvironmentName: environmentName,
+		Offset:          page.Offset(),
+		Limit:           page.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+
+	return pagination.NewConvertConnection(ret, page, total, func(from *freezesql.ListForTeamRow) *DeploymentFreeze {
+		return toGraphDeploymentFreeze(&from.DeploymentFreeze)
+	}), nil
+}
+
+func ListOverrides(ctx context.Context, freezeID uuid.UUID) ([]*DeploymentFreezeOverride, error) {
+	rows, err := db(ctx).ListOverridesForFreezes(ctx, []uuid.UUID{freezeID})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*DeploymentFreezeOverride, len(rows))
+	for i, row := range rows {
+		ret[i] = toGraphDeploymentFreezeOverride(row)
+	}
+	return ret, nil
+}
+
+// Create creates a freeze. Freezes without a start time start immediately, and freezes without an end time last until
+// they are ended.
+func Create(ctx context.Context, input *CreateDeploymentFreezeInput) (*DeploymentFreeze, error) {
+	if err := authz.CanManageDeploymentFreezes(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
+		return nil, err
+	}
+
+	if err := input.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	startsAt := time.Now()
+	if input.StartsAt != nil {
+		startsAt = *input.StartsAt
+	}
+
+	endsAt := pgtype.Timestamptz{}
+	if input.EndsAt != nil {
+		endsAt = pgtype.Timestamptz{Time: *input.EndsAt, Valid: true}
+	}
+
+	var ret *DeploymentFreeze
+	err := database.Transaction(ctx, func(ctx context.Context) error {
+		actor := authz.ActorFromContext(ctx).User
+		row, err := db(ctx).Create(ctx, freezesql.CreateParams{
+			TeamSlug:        input.TeamSlug,
+			EnvironmentName: input.EnvironmentName,
+			Reason:          input.Reason,
+			StartsAt:        pgtype.Timestamptz{Time: startsAt, Valid: true},
+			EndsAt:          endsAt,
+			CreatedBy:       actor.Identity(),
+		})
+		if err != nil {
+			return err
+		}
+		ret = toGraphDeploymentFreeze(row)
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:          activitylog.ActivityLogEntryActionCreated,
+			Actor:           actor,
+			EnvironmentName: new(input.EnvironmentName),
+			ResourceType:    activityLogEntryResourceTypeDeploymentFreeze,
+			ResourceName:    row.ID.String(),
+			TeamSlug:        new(input.TeamSlug),
+			Data: &DeploymentFreezeCreatedActivityLogEntryData{
+				Reason:   ret.Reason,
+				StartsAt: ret.StartsAt,
+				EndsAt:   ret.EndsAt,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// End ends an active freeze. Scheduled freezes that have not yet started are removed.
+func End(ctx context.Context, input *EndDeploymentFreezeInput) (*DeploymentFreeze, error) {
+	freeze, err := GetByIdent(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.CanManageDeploymentFreezes(ctx, freeze.TeamSlug, freeze.EnvironmentName); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if freeze.EndsAt != nil && !freeze.EndsAt.After(now) {
+		return nil, apierror.Errorf("The deployment freeze has already ended.")
+	}
+
+	var ret *DeploymentFreeze
+	err = database.Transaction(ctx, func(ctx context.Context) error {
+		if freeze.StartsAt.After(now) {
+			if err := db(ctx).Delete(ctx, freeze.UUID); err != nil {
+				return err
+			}
+			ended := *freeze
+			ended.EndsAt = &ended.StartsAt
+			ret = &ended
+		} else {
+			row, err := db(ctx).End(ctx, freeze.UUID)
+			if err != nil {
+				return err
+			}
+			ret = toGraphDeploymentFreeze(row)
+		}
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:          activityLogEntryActionEndDeploymentFreeze,
+			Actor:           authz.ActorFromContext(ctx).User,
+			EnvironmentName: new(freeze.EnvironmentName),
+			ResourceType:    activityLogEntryResourceTypeDeploymentFreeze,
+			ResourceName:    freeze.UUID.String(),
+			TeamSlug:        new(freeze.TeamSlug),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// Override allows deployments during an active freeze until the override expires.
+func Override(ctx context.Context, input *OverrideDeploymentFreezeInput) (*DeploymentFreeze, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	freeze, err := GetByIdent(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !freeze.Active(time.Now()) {
+		return nil, apierror.Errorf("Only active deployment freezes can be overridden.")
+	}
+
+	expiresAt := time.Now().Add(defaultOverrideDuration)
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
+
+	if err := override(ctx, freeze, input.Justification, expiresAt); err != nil {
+		return nil, err
+	}
+
+	return freeze, nil
+}
+
+// ApplyOverride is an override of the freezes blocking a change. It is returned by CheckApply, and must be recorded
+// once the change has been applied. Callers must report a failure to record the override as a failure of the change, so
+// that a freeze is never overridden without a trace.
+type ApplyOverride struct {
+	freezes       []*DeploymentFreeze
+	justification string
+}
+
+// Record records the override of the blocking freezes. It does nothing if no freezes had to be overridden.
+func (o *ApplyOverride) Record(ctx context.Context) error {
+	if o == nil {
+		return nil
+	}
+
+	now := time.Now()
+	for _, freeze := range o.freezes {
+		if err := recordOverride(ctx, freeze, o.justification, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckApply returns a FrozenError if changes to the team environment are blocked by an active freeze that has not been
+// overridden. If a justification is given, and the actor is allowed to override the blocking freezes, an override for
+// this change only is returned. Nothing is persisted until the override is recorded.
+func CheckApply(ctx context.Context, teamSlug slug.Slug, environmentName, justification string) (*ApplyOverride, error) {
+	rows, err := db(ctx).ListActive(ctx, freezesql.ListActiveParams{
+		TeamSlug:        teamSlug,
+		EnvironmentName: environmentName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var blocking []*DeploymentFreeze
+	for _, row := range rows {
+		overrides, err := ListOverrides(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.ContainsFunc(overrides, func(o *DeploymentFreezeOverride) bool { return o.ExpiresAt.After(now) }) {
+			blocking = append(blocking, toGraphDeploymentFreeze(row))
+		}
+	}
+
+	if len(blocking) == 0 {
+		return nil, nil
+	}
+
+	if justification == "" {
+		return nil, &FrozenError{Freeze: blocking[0]}
+	}
+
+	input := &OverrideDeploymentFreezeInput{Justification: justification}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, freeze := range blocking {
+		if err := authz.CanOverrideDeploymentFreezes(ctx, freeze.TeamSlug, freeze.EnvironmentName); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ApplyOverride{freezes: blocking, justification: input.Justification}, nil
+}
+
+func override(ctx context.Context, freeze *DeploymentFreeze, justification string, expiresAt time.Time) error {
+	if err := authz.CanOverrideDeploymentFreezes(ctx, freeze.TeamSlug, freeze.EnvironmentName); err != nil {
+		return err
+	}
+
+	return recordOverride(ctx, freeze, justification, expiresAt)
+}
+
+func recordOverride(ctx context.Context, freeze *DeploymentFreeze, justification string, expiresAt time.Time) error {
+	return database.Transaction(ctx, func(ctx context.Context) error {
+		actor := authz.ActorFromContext(ctx).User
+		if _, err := db(ctx).CreateOverride(ctx, freezesql.CreateOverrideParams{
+			FreezeID:      freeze.UUID,
+			Justification: justification,
+			CreatedBy:     actor.Identity(),
+			ExpiresAt:     pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		}); err != nil {
+			return err
+		}
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:          activityLogEntryActionOverrideFreeze,
+			Actor:           actor,
+			EnvironmentName: new(freeze.EnvironmentName),
+			ResourceType:    activityLogEntryResourceTypeDeploymentFreeze,
+			ResourceName:    freeze.UUID.String(),
+			TeamSlug:        new(freeze.TeamSlug),
+			Data: &DeploymentFreezeOverriddenActivityLogEntryData{
+				Justification: justification,
+				ExpiresAt:     expiresAt,
+			},
+		})
+	})
+}
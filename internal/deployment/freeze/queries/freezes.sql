@@ -0,0 +1,110 @@
+-- name: ListForTeam :many
+-- Lists freezes that are active or scheduled, optionally limited to an environment.
+SELECT
+	sqlc.embed(deployment_freezes),
+	COUNT(*) OVER () AS total_count
+FROM
+	deployment_freezes
+WHERE
+	team_slug = @team_slug
+	AND (
+		sqlc.narg('environment_name')::TEXT IS NULL
+		OR environment_name = sqlc.narg('environment_name')::TEXT
+	)
+	AND (
+		ends_at IS NULL
+		OR ends_at > NOW()
+	)
+ORDER BY
+	starts_at,
+	created_at
+LIMIT
+	sqlc.arg('limit')
+OFFSET
+	sqlc.arg('offset')
+;
+
+-- name: ListByIDs :many
+SELECT
+	*
+FROM
+	deployment_freezes
+WHERE
+	id = ANY (@ids::UUID[])
+ORDER BY
+	id
+;
+
+-- name: ListActive :many
+SELECT
+	*
+FROM
+	deployment_freezes
+WHERE
+	team_slug = @team_slug
+	AND environment_name = @environment_name
+	AND starts_at <= NOW()
+	AND (
+		ends_at IS NULL
+		OR ends_at > NOW()
+	)
+ORDER BY
+	starts_at
+;
+
+-- name: Create :one
+INSERT INTO
+	deployment_freezes (team_slug, environment_name, reason, starts_at, ends_at, created_by)
+VALUES
+	(
+		@team_slug,
+		@environment_name,
+		@reason,
+		@starts_at,
+		@ends_at,
+		@created_by
+	)
+RETURNING
+	*
+;
+
+-- name: End :one
+UPDATE deployment_freezes
+SET
+	ends_at = NOW()
+WHERE
+	id = @id
+RETURNING
+	*
+;
+
+-- name: Delete :exec
+DELETE FROM deployment_freezes
+WHERE
+	id = @id
+;
+
+-- name: CreateOverride :one
+INSERT INTO
+	deployment_freeze_overrides (freeze_id, justification, created_by, expires_at)
+VALUES
+	(
+		@freeze_id,
+		@justification,
+		@created_by,
+		@expires_at
+	)
+RETURNING
+	*
+;
+
+-- name: ListOverridesForFreezes :many
+SELECT
+	*
+FROM
+	deployment_freeze_overrides
+WHERE
+	freeze_id = ANY (@freeze_ids::UUID[])
+ORDER BY
+	created_at DESC
+;
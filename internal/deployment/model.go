@@ -36,6 +36,8 @@ type Deployment struct {
 	TeamSlug         slug.Slug `json:"teamSlug"`
 	EnvironmentName  string    `json:"environmentName"`
 	UUID             uuid.UUID `json:"-"`
+	// PromotedFromUUID is the deployment the workload was promoted from, if the deployment was created by a promotion.
+	PromotedFromUUID *uuid.UUID `json:"-"`
 }
 
 func (Deployment) IsNode() {}
@@ -148,6 +150,24 @@ type ChangeDeploymentKeyPayload struct {
 	DeploymentKey *DeploymentKey `json:"deploymentKey,omitempty"`
 }
 
+type PromoteWorkloadInput struct {
+	WorkloadID                  ident.Ident                     `json:"workloadID"`
+	TargetEnvironmentName       string                          `json:"targetEnvironmentName"`
+	Overrides                   []*PromoteWorkloadFieldOverride `json:"overrides,omitempty"`
+	FreezeOverrideJustification *string                         `json:"freezeOverrideJustification,omitempty"`
+}
+
+type PromoteWorkloadFieldOverride struct {
+	// Field is the dot separated path of the field in the workload spec, e.g. replicas.min.
+	Field string `json:"field"`
+	// Value is the JSON encoded value of the field. The field is removed if the value is null.
+	Value string `json:"value"`
+}
+
+type PromoteWorkloadPayload struct {
+	Deployment *Deployment `json:"deployment,omitempty"`
+}
+
 type DeploymentKey struct {
 	Key      string    `json:"key"`
 	Created  time.Time `json:"created"`
@@ -180,6 +200,7 @@ func toGraphDeployment(row *deploymentsql.Deployment) *Deployment {
 		DeployerUsername: row.DeployerUsername,
 		CommitSha:        row.CommitSha,
 		TriggerUrl:       row.TriggerUrl,
+		PromotedFromUUID: row.PromotedFromDeploymentID,
 	}
 }
 
@@ -0,0 +1,261 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nais/api/internal/apply"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/deployment/deploymentsql"
+	"github.com/nais/api/internal/deployment/freeze"
+	"github.com/nais/api/internal/graph/apierror"
+	"github.com/nais/api/internal/graph/ident"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/team"
+	"github.com/nais/api/internal/validate"
+	"github.com/nais/api/internal/workload/application"
+	"github.com/nais/api/internal/workload/job"
+	nais_io "github.com/nais/liberator/pkg/apis/nais.io"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// promotionSource is the workload that is promoted to another environment.
+type promotionSource struct {
+	teamSlug        slug.Slug
+	environmentName string
+	name            string
+	gvk             schema.GroupVersionKind
+	spec            any
+}
+
+// Promote applies the image and spec of an application or job to another environment of the same team through the
+// apply machinery, and records a deployment that links back to the latest deployment of the workload in the source
+// environment.
+func Promote(ctx context.Context, input *PromoteWorkloadInput) (*Deployment, error) {
+	source, err := getPromotionSource(ctx, input.WorkloadID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.CanApplyKubernetesResource(ctx, source.teamSlug, input.TargetEnvironmentName); err != nil {
+		return nil, err
+	}
+
+	if err := input.Validate(ctx, source); err != nil {
+		return nil, err
+	}
+
+	res, err := promotedResource(source, input.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deployment ID is used as correlation ID, so that the rollout of the promoted workload can be tracked
+	deploymentID := uuid.New()
+	res.SetAnnotations(map[string]string{nais_io.DeploymentCorrelationIDAnnotation: deploymentID.String()})
+
+	justification := ""
+	if input.FreezeOverrideJustification != nil {
+		justification = *input.FreezeOverrideJustification
+	}
+
+	freezeOverride, err := freeze.CheckApply(ctx, source.teamSlug, input.TargetEnvironmentName, justification)
+	if err != nil {
+		var frozen *freeze.FrozenError
+		if errors.As(err, &frozen) {
+			return nil, apierror.Errorf("Deployments to environment %q are frozen: %s. Set freezeOverrideJustification to promote anyway.", frozen.Freeze.EnvironmentName, frozen.Freeze.Reason)
+		}
+		return nil, err
+	}
+
+	results, err := apply.Apply(ctx, source.teamSlug, input.TargetEnvironmentName, []unstructured.Unstructured{*res})
+	if err != nil {
+		return nil, apierror.Errorf("Unable to promote workload: %s", err)
+	}
+
+	if r := results[0]; r.Status == apply.StatusError {
+		return nil, apierror.Errorf("Unable to promote %s: %s", r.Resource, r.Error)
+	}
+
+	if err := freezeOverride.Record(ctx); err != nil {
+		return nil, fmt.Errorf("recording deployment freeze override: %w", err)
+	}
+
+	return recordPromotion(ctx, deploymentID, source, input.TargetEnvironmentName, res)
+}
+
+// PromotedFrom returns the deployment the given deployment was promoted from, if any.
+func PromotedFrom(ctx context.Context, d *Deployment) (*Deployment, error) {
+	if d.PromotedFromUUID == nil {
+		return nil, nil
+	}
+
+	return get(ctx, *d.PromotedFromUUID)
+}
+
+func (i *PromoteWorkloadInput) Validate(ctx context.Context, source *promotionSource) error {
+	verr := validate.New()
+
+	if i.TargetEnvironmentName == source.environmentName {
+		verr.Add("targetEnvironmentName", "The workload can not be promoted to the environment it is running in.")
+	} else {
+		envs, err := team.ListTeamEnvironments(ctx, source.teamSlug)
+		if err != nil {
+			return err
+		}
+
+		if !slices.ContainsFunc(envs, func(e *team.TeamEnvironment) bool { return e.EnvironmentName == i.TargetEnvironmentName }) {
+			verr.Add("targetEnvironmentName", "Environment %q does not exist.", i.TargetEnvironmentName)
+		}
+	}
+
+	for _, o := range i.Overrides {
+		if o.Field == "" || slices.Contains(strings.Split(o.Field, "."), "") {
+			verr.Add("overrides", "Invalid field %q.", o.Field)
+			continue
+		}
+
+		if !json.Valid([]byte(o.Value)) {
+			verr.Add("overrides", "The value of field %q must be valid JSON.", o.Field)
+		}
+	}
+
+	return verr.NilIfEmpty()
+}
+
+func getPromotionSource(ctx context.Context, id ident.Ident) (*promotionSource, error) {
+	node, err := ident.GetByIdent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch w := node.(type) {
+	case *application.Application:
+		return &promotionSource{
+			teamSlug:        w.TeamSlug,
+			environmentName: w.EnvironmentName,
+			name:            w.Name,
+			gvk:             schema.GroupVersionKind{Group: "nais.io", Version: "v1alpha1", Kind: "Application"},
+			spec:            w.Spec,
+		}, nil
+	case *job.Job:
+		return &promotionSource{
+			teamSlug:        w.TeamSlug,
+			environmentName: w.EnvironmentName,
+			name:            w.Name,
+			gvk:             schema.GroupVersionKind{Group: "nais.io", Version: "v1", Kind: "Naisjob"},
+			spec:            w.Spec,
+		}, nil
+	default:
+		return nil, apierror.Errorf("Only applications and jobs can be promoted.")
+	}
+}
+
+// promotedResource builds the resource to apply in the target environment from the spec of the source workload, with the
+// field overrides applied.
+func promotedResource(source *promotionSource, overrides []*PromoteWorkloadFieldOverride) (*unstructured.Unstructured, error) {
+	b, err := json.Marshal(source.spec)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]any{}
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, err
+	}
+
+	for _, o := range overrides {
+		var value any
+		if err := json.Unmarshal([]byte(o.Value), &value); err != nil {
+			return nil, err
+		}
+
+		path := strings.Split(o.Field, ".")
+		if value == nil {
+			unstructured.RemoveNestedField(spec, path...)
+			continue
+		}
+
+		if err := unstructured.SetNestedField(spec, value, path...); err != nil {
+			return nil, apierror.Errorf("Unable to override field %q: %s", o.Field, err)
+		}
+	}
+
+	res := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	res.SetGroupVersionKind(source.gvk)
+	res.SetName(source.name)
+	res.SetNamespace(source.teamSlug.String())
+
+	return res, nil
+}
+
+// recordPromotion records an in progress deployment of the promoted workload in the target environment. Repository and
+// commit are copied from the latest deployment of the workload in the source environment. The deployment is marked as
+// successful by the rollout tracker once the workload has been rolled out.
+func recordPromotion(ctx context.Context, id uuid.UUID, source *promotionSource, targetEnvironmentName string, res *unstructured.Unstructured) (*Deployment, error) {
+	spec, err := json.Marshal(res.Object["spec"])
+	if err != nil {
+		return nil, err
+	}
+
+	params := deploymentsql.CreatePromotionParams{
+		ID:               id,
+		TeamSlug:         source.teamSlug,
+		DeployerUsername: new(authz.ActorFromContext(ctx).User.Identity()),
+		EnvironmentName:  targetEnvironmentName,
+	}
+
+	from, err := db(ctx).LatestForWorkload(ctx, deploymentsql.LatestForWorkloadParams{
+		WorkloadName:    source.name,
+		WorkloadKind:    source.gvk.Kind,
+		EnvironmentName: source.environmentName,
+		TeamSlug:        source.teamSlug,
+	})
+	if err == nil {
+		params.Repository = from.Repository
+		params.CommitSha = from.CommitSha
+		params.PromotedFromDeploymentID = new(from.ID)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	var ret *Deployment
+	err = database.Transaction(ctx, func(ctx context.Context) error {
+		row, err := db(ctx).CreatePromotion(ctx, params)
+		if err != nil {
+			return err
+		}
+		ret = toGraphDeployment(row)
+
+		if err := db(ctx).CreateResource(ctx, deploymentsql.CreateResourceParams{
+			DeploymentID: row.ID,
+			Group:        source.gvk.Group,
+			Version:      source.gvk.Version,
+			Kind:         source.gvk.Kind,
+			Name:         source.name,
+			Namespace:    source.teamSlug.String(),
+			Spec:         spec,
+		}); err != nil {
+			return err
+		}
+
+		return db(ctx).CreateStatus(ctx, deploymentsql.CreateStatusParams{
+			DeploymentID: row.ID,
+			State:        deploymentsql.DeploymentStateInProgress,
+			Message:      fmt.Sprintf("Promoted from %s.", source.environmentName),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
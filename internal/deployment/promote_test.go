@@ -0,0 +1,57 @@
+package deployment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestPromotedResource(t *testing.T) {
+	source := &promotionSource{
+		teamSlug:        "team",
+		environmentName: "dev",
+		name:            "app",
+		gvk:             schema.GroupVersionKind{Group: "nais.io", Version: "v1alpha1", Kind: "Application"},
+		spec: map[string]any{
+			"image":     "app:1",
+			"ingresses": []string{"https://app.dev.example.com"},
+			"replicas":  map[string]int{"min": 1, "max": 2},
+			"env":       []map[string]string{{"name": "FOO", "value": "bar"}},
+		},
+	}
+
+	res, err := promotedResource(source, []*PromoteWorkloadFieldOverride{
+		{Field: "ingresses", Value: `["https://app.example.com"]`},
+		{Field: "replicas.min", Value: `2`},
+		{Field: "env", Value: `null`},
+		{Field: "resources.limits.memory", Value: `"512Mi"`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"apiVersion": "nais.io/v1alpha1",
+		"kind":       "Application",
+		"metadata": map[string]any{
+			"name":      "app",
+			"namespace": "team",
+		},
+		"spec": map[string]any{
+			"image":     "app:1",
+			"ingresses": []any{"https://app.example.com"},
+			"replicas":  map[string]any{"min": float64(2), "max": float64(2)},
+			"resources": map[string]any{"limits": map[string]any{"memory": "512Mi"}},
+		},
+	}
+	if diff := cmp.Diff(want, res.Object); diff != "" {
+		t.Errorf("diff -want +got:\n%s", diff)
+	}
+
+	t.Run("override of a field that is not an object", func(t *testing.T) {
+		if _, err := promotedResource(source, []*PromoteWorkloadFieldOverride{{Field: "image.tag", Value: `"2"`}}); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -152,3 +152,236 @@ WHERE
 	team_slug = 'nais-verification'
 	AND created_at < NOW() - '1 week'::INTERVAL
 ;
+
+-- name: ListOutcomes :many
+-- Lists deployments created in the time window, together with the time of the first successful and the first failed
+-- status of each deployment. Used to compute DORA metrics.
+SELECT
+	deployments.id,
+	deployments.created_at,
+	deployments.environment_name,
+	COALESCE(
+		(
+			SELECT
+				r.kind || '/' || r.name
+			FROM
+				deployment_k8s_resources r
+			WHERE
+				r.deployment_id = deployments.id
+				AND r."group" = 'nais.io'
+				AND r.kind IN ('Application', 'Naisjob')
+			ORDER BY
+				r.created_at
+			LIMIT
+				1
+		),
+		''
+	)::TEXT AS workload,
+	(
+		SELECT
+			MIN(s.created_at)
+		FROM
+			deployment_statuses s
+		WHERE
+			s.deployment_id = deployments.id
+			AND s.state = 'success'
+	)::TIMESTAMPTZ AS succeeded_at,
+	(
+		SELECT
+			MIN(s.created_at)
+		FROM
+			deployment_statuses s
+		WHERE
+			s.deployment_id = deployments.id
+			AND s.state IN ('failure', 'error')
+	)::TIMESTAMPTZ AS failed_at
+FROM
+	deployments
+WHERE
+	deployments.team_slug = @team_slug::slug
+	AND deployments.created_at >= @since::TIMESTAMPTZ
+	AND deployments.created_at < @until::TIMESTAMPTZ
+	AND (
+		sqlc.narg(environment_name)::TEXT IS NULL
+		OR deployments.environment_name = sqlc.narg(environment_name)::TEXT
+	)
+	AND (
+		sqlc.narg(workload_name)::TEXT IS NULL
+		OR EXISTS (
+			SELECT
+				1
+			FROM
+				deployment_k8s_resources r
+			WHERE
+				r.deployment_id = deployments.id
+				AND r.name = sqlc.narg(workload_name)::TEXT
+				AND r.kind = sqlc.narg(workload_kind)::TEXT
+		)
+	)
+ORDER BY
+	deployments.created_at ASC
+;
+
+-- name: ListWorkloadResourcesMissingSpec :many
+-- Lists workload resources of recent successful deployments that have no spec snapshot, where the deployment is the
+-- latest successful deployment of the workload. The spec running in the cluster is then the one that was deployed.
+SELECT
+	deployment_k8s_resources.id,
+	deployment_k8s_resources.kind,
+	deployment_k8s_resources.name,
+	deployment_k8s_resources.namespace,
+	deployments.environment_name
+FROM
+	deployment_k8s_resources
+	JOIN deployments ON deployments.id = deployment_k8s_resources.deployment_id
+WHERE
+	deployment_k8s_resources.spec IS NULL
+	AND deployment_k8s_resources."group" = 'nais.io'
+	AND deployment_k8s_resources.kind IN ('Application', 'Naisjob')
+	AND deployments.created_at > NOW() - '1 day'::INTERVAL
+	AND EXISTS (
+		SELECT
+			1
+		FROM
+			deployment_statuses
+		WHERE
+			deployment_statuses.deployment_id = deployments.id
+			AND deployment_statuses.state = 'success'
+	)
+	AND NOT EXISTS (
+		SELECT
+			1
+		FROM
+			deployments newer
+			JOIN deployment_k8s_resources newer_resource ON newer.id = newer_resource.deployment_id
+			JOIN deployment_statuses newer_status ON newer.id = newer_status.deployment_id
+		WHERE
+			newer.team_slug = deployments.team_slug
+			AND newer.environment_name = deployments.environment_name
+			AND newer.created_at > deployments.created_at
+			AND newer_resource.kind = deployment_k8s_resources.kind
+			AND newer_resource.name = deployment_k8s_resources.name
+			AND newer_status.state = 'success'
+	)
+;
+
+-- name: ListWorkloadResourcesAwaitingRollout :many
+-- Lists workload resources of recent deployments whose latest status is in progress. The rollout of these deployments is
+-- tracked through the correlation ID that naiserator reports in the status of the workload.
+SELECT
+	deployment_k8s_resources.deployment_id,
+	deployment_k8s_resources.kind,
+	deployment_k8s_resources.name,
+	deployment_k8s_resources.namespace,
+	deployments.environment_name
+FROM
+	deployment_k8s_resources
+	JOIN deployments ON deployments.id = deployment_k8s_resources.deployment_id
+WHERE
+	deployment_k8s_resources."group" = 'nais.io'
+	AND deployment_k8s_resources.kind IN ('Application', 'Naisjob')
+	AND deployments.created_at > NOW() - '1 day'::INTERVAL
+	AND (
+		SELECT
+			deployment_statuses.state
+		FROM
+			deployment_statuses
+		WHERE
+			deployment_statuses.deployment_id = deployments.id
+		ORDER BY
+			deployment_statuses.created_at DESC
+		LIMIT
+			1
+	) = 'in_progress'
+;
+
+-- name: SetResourceSpec :exec
+UPDATE deployment_k8s_resources
+SET
+	spec = @spec
+WHERE
+	id = @id
+;
+
+-- name: ListResourcesForDeployments :many
+SELECT
+	*
+FROM
+	deployment_k8s_resources
+WHERE
+	deployment_id = ANY (@deployment_ids::UUID[])
+ORDER BY
+	created_at
+;
+
+-- name: LatestForWorkload :one
+SELECT
+	deployments.*
+FROM
+	deployments
+	JOIN deployment_k8s_resources ON deployments.id = deployment_k8s_resources.deployment_id
+WHERE
+	deployment_k8s_resources.name = @workload_name
+	AND deployment_k8s_resources.kind = @workload_kind
+	AND deployments.environment_name = @environment_name
+	AND deployments.team_slug = @team_slug
+ORDER BY
+	deployments.created_at DESC
+LIMIT
+	1
+;
+
+-- name: CreatePromotion :one
+INSERT INTO
+	deployments (
+		id,
+		team_slug,
+		repository,
+		commit_sha,
+		deployer_username,
+		environment_name,
+		promoted_from_deployment_id
+	)
+VALUES
+	(
+		@id,
+		@team_slug,
+		@repository,
+		@commit_sha,
+		@deployer_username,
+		@environment_name,
+		@promoted_from_deployment_id
+	)
+RETURNING
+	*
+;
+
+-- name: CreateResource :exec
+INSERT INTO
+	deployment_k8s_resources (
+		deployment_id,
+		"group",
+		version,
+		kind,
+		name,
+		namespace,
+		spec
+	)
+VALUES
+	(
+		@deployment_id,
+		sqlc.arg('group'),
+		@version,
+		@kind,
+		@name,
+		@namespace,
+		@spec
+	)
+;
+
+-- name: CreateStatus :exec
+INSERT INTO
+	deployment_statuses (deployment_id, state, message)
+VALUES
+	(@deployment_id, @state, @message)
+;
@@ -0,0 +1,119 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/api/internal/deployment/deploymentsql"
+	"github.com/nais/api/internal/kubernetes/watcher"
+	"github.com/nais/api/internal/leaderelection"
+	nais_io_v1 "github.com/nais/liberator/pkg/apis/nais.io/v1"
+	nais_io_v1alpha1 "github.com/nais/liberator/pkg/apis/nais.io/v1alpha1"
+	"github.com/nais/liberator/pkg/events"
+	"github.com/sirupsen/logrus"
+)
+
+const rolloutTrackerSchedule = 30 * time.Second
+
+type rolloutTracker struct {
+	db         deploymentsql.Querier
+	appWatcher *watcher.Watcher[*nais_io_v1alpha1.Application]
+	jobWatcher *watcher.Watcher[*nais_io_v1.Naisjob]
+	log        logrus.FieldLogger
+}
+
+// RunRolloutTracker completes in progress deployments once naiserator reports the outcome of their rollout. Only
+// deployments whose ID is used as correlation ID of the workload, such as promotions, are tracked.
+func RunRolloutTracker(ctx context.Context, dbtx deploymentsql.DBTX, appWatcher *watcher.Watcher[*nais_io_v1alpha1.Application], jobWatcher *watcher.Watcher[*nais_io_v1.Naisjob], log logrus.FieldLogger) {
+	r := &rolloutTracker{
+		db:         deploymentsql.New(dbtx),
+		appWatcher: appWatcher,
+		jobWatcher: jobWatcher,
+		log:        log,
+	}
+
+	for {
+		if err := r.track(ctx); err != nil {
+			log.WithError(err).Error("error tracking deployment rollouts")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rolloutTrackerSchedule):
+		}
+	}
+}
+
+func (r *rolloutTracker) track(ctx context.Context) error {
+	if !leaderelection.IsLeader() {
+		return nil
+	}
+
+	resources, err := r.db.ListWorkloadResourcesAwaitingRollout(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range resources {
+		status, err := r.status(res.EnvironmentName, res.Namespace, res.Kind, res.Name)
+		if err != nil {
+			r.log.WithError(err).WithFields(logrus.Fields{
+				"environment": res.EnvironmentName,
+				"namespace":   res.Namespace,
+				"kind":        res.Kind,
+				"name":        res.Name,
+			}).Debug("getting workload status")
+			continue
+		}
+
+		state, message, done := rolloutState(res.DeploymentID, status)
+		if !done {
+			continue
+		}
+
+		if err := r.db.CreateStatus(ctx, deploymentsql.CreateStatusParams{
+			DeploymentID: res.DeploymentID,
+			State:        state,
+			Message:      message,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *rolloutTracker) status(environmentName, namespace, kind, name string) (*nais_io_v1.Status, error) {
+	if kind == "Naisjob" {
+		job, err := r.jobWatcher.Get(environmentName, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		return &job.Status, nil
+	}
+
+	app, err := r.appWatcher.Get(environmentName, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return &app.Status, nil
+}
+
+// rolloutState returns the final state of the deployment with the given ID, based on the status of the deployed
+// workload. done is false while the rollout is in progress, or when the status belongs to another deployment.
+func rolloutState(deploymentID uuid.UUID, status *nais_io_v1.Status) (state deploymentsql.DeploymentState, message string, done bool) {
+	if status.CorrelationID != deploymentID.String() {
+		return "", "", false
+	}
+
+	switch status.SynchronizationState {
+	case events.RolloutComplete:
+		return deploymentsql.DeploymentStateSuccess, "Rollout complete.", true
+	case events.FailedGenerate, events.FailedSynchronization:
+		return deploymentsql.DeploymentStateFailure, fmt.Sprintf("Rollout failed: %s.", status.SynchronizationState), true
+	default:
+		return "", "", false
+	}
+}
@@ -0,0 +1,55 @@
+package deployment
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nais/api/internal/deployment/deploymentsql"
+	nais_io_v1 "github.com/nais/liberator/pkg/apis/nais.io/v1"
+	"github.com/nais/liberator/pkg/events"
+)
+
+func TestRolloutState(t *testing.T) {
+	deploymentID := uuid.New()
+
+	tests := []struct {
+		name      string
+		status    nais_io_v1.Status
+		wantState deploymentsql.DeploymentState
+		wantDone  bool
+	}{
+		{
+			name:      "rollout complete",
+			status:    nais_io_v1.Status{CorrelationID: deploymentID.String(), SynchronizationState: events.RolloutComplete},
+			wantState: deploymentsql.DeploymentStateSuccess,
+			wantDone:  true,
+		},
+		{
+			name:      "rollout failed",
+			status:    nais_io_v1.Status{CorrelationID: deploymentID.String(), SynchronizationState: events.FailedGenerate},
+			wantState: deploymentsql.DeploymentStateFailure,
+			wantDone:  true,
+		},
+		{
+			name:   "rollout in progress",
+			status: nais_io_v1.Status{CorrelationID: deploymentID.String(), SynchronizationState: events.Synchronized},
+		},
+		{
+			name:   "transient error",
+			status: nais_io_v1.Status{CorrelationID: deploymentID.String(), SynchronizationState: events.Retrying},
+		},
+		{
+			name:   "status of another deployment",
+			status: nais_io_v1.Status{CorrelationID: uuid.NewString(), SynchronizationState: events.RolloutComplete},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, _, done := rolloutState(deploymentID, &tt.status)
+			if state != tt.wantState || done != tt.wantDone {
+				t.Errorf("expected state %q and done %v, got %q and %v", tt.wantState, tt.wantDone, state, done)
+			}
+		})
+	}
+}
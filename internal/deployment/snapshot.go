@@ -0,0 +1,102 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/nais/api/internal/deployment/deploymentsql"
+	"github.com/nais/api/internal/kubernetes/watcher"
+	"github.com/nais/api/internal/leaderelection"
+	nais_io_v1 "github.com/nais/liberator/pkg/apis/nais.io/v1"
+	nais_io_v1alpha1 "github.com/nais/liberator/pkg/apis/nais.io/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+const specSnapshotSchedule = 1 * time.Minute
+
+type specSnapshotter struct {
+	db         deploymentsql.Querier
+	appWatcher *watcher.Watcher[*nais_io_v1alpha1.Application]
+	jobWatcher *watcher.Watcher[*nais_io_v1.Naisjob]
+	log        logrus.FieldLogger
+}
+
+// RunSpecSnapshotter stores the spec of workloads deployed by successful deployments, so that deployments can be
+// compared later on. Deployments only report which resources they deploy, so the spec is read from the cluster once
+// the deployment has succeeded.
+func RunSpecSnapshotter(ctx context.Context, dbtx deploymentsql.DBTX, appWatcher *watcher.Watcher[*nais_io_v1alpha1.Application], jobWatcher *watcher.Watcher[*nais_io_v1.Naisjob], log logrus.FieldLogger) {
+	s := &specSnapshotter{
+		db:         deploymentsql.New(dbtx),
+		appWatcher: appWatcher,
+		jobWatcher: jobWatcher,
+		log:        log,
+	}
+
+	for {
+		if err := s.snapshot(ctx); err != nil {
+			log.WithError(err).Error("error snapshotting deployed specs")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(specSnapshotSchedule):
+		}
+	}
+}
+
+func (s *specSnapshotter) snapshot(ctx context.Context) error {
+	if !leaderelection.IsLeader() {
+		return nil
+	}
+
+	resources, err := s.db.ListWorkloadResourcesMissingSpec(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range resources {
+		log := s.log.WithFields(logrus.Fields{
+			"environment": r.EnvironmentName,
+			"namespace":   r.Namespace,
+			"kind":        r.Kind,
+			"name":        r.Name,
+		})
+
+		spec, err := s.spec(r.EnvironmentName, r.Namespace, r.Kind, r.Name)
+		if err != nil {
+			log.WithError(err).Debug("getting workload spec")
+			continue
+		}
+
+		b, err := json.Marshal(spec)
+		if err != nil {
+			return err
+		}
+
+		if err := s.db.SetResourceSpec(ctx, deploymentsql.SetResourceSpecParams{
+			ID:   r.ID,
+			Spec: b,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *specSnapshotter) spec(environmentName, namespace, kind, name string) (any, error) {
+	if kind == "Naisjob" {
+		job, err := s.jobWatcher.Get(environmentName, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		return job.Spec, nil
+	}
+
+	app, err := s.appWatcher.Get(environmentName, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return app.Spec, nil
+}
@@ -0,0 +1,41 @@
+package duration
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration that is unmarshalled from a string such as "720h". A "d" suffix is supported for
+// days, e.g. "90d".
+type Duration time.Duration
+
+var _ json.Unmarshaler = (*Duration)(nil)
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		*d = Duration(time.Duration(n) * 24 * time.Hour)
+	} else {
+		v, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		*d = Duration(v)
+	}
+
+	if *d < 0 {
+		return fmt.Errorf("duration must not be negative: %q", s)
+	}
+	return nil
+}
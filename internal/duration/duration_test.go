@@ -0,0 +1,45 @@
+package duration_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nais/api/internal/duration"
+)
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    duration.Duration
+		wantErr bool
+	}{
+		{input: `"90d"`, want: duration.Duration(90 * 24 * time.Hour)},
+		{input: `"720h"`, want: duration.Duration(720 * time.Hour)},
+		{input: `"1h30m"`, want: duration.Duration(90 * time.Minute)},
+		{input: `"-1d"`, wantErr: true},
+		{input: `"-1h"`, wantErr: true},
+		{input: `"forever"`, wantErr: true},
+		{input: `"d"`, wantErr: true},
+		{input: `3600`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var got duration.Duration
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", time.Duration(got))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", time.Duration(tt.want), time.Duration(got))
+			}
+		})
+	}
+}
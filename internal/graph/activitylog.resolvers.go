@@ -52,6 +52,10 @@ func (r *reconcilerResolver) ActivityLog(ctx context.Context, obj *reconciler.Re
 	return activitylog.ListForResource(ctx, reconciler.ActivityLogEntryResourceTypeReconciler, obj.Name, page, filter)
 }
 
+func (r *subscriptionResolver) ActivityLog(ctx context.Context, filter *activitylog.ActivityLogFilter) (<-chan activitylog.ActivityLogEntry, error) {
+	return activitylog.Subscribe(ctx, filter)
+}
+
 func (r *teamResolver) ActivityLog(ctx context.Context, obj *team.Team, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) (*activitylog.ActivityLogEntryConnection, error) {
 	page, err := pagination.ParsePage(first, after, last, before)
 	if err != nil {
@@ -136,7 +136,7 @@ func (r *ingressMetricsResolver) Series(ctx context.Context, obj *application.In
 }
 
 func (r *mutationResolver) DeleteApplication(ctx context.Context, input application.DeleteApplicationInput) (*application.DeleteApplicationPayload, error) {
-	if err := authz.CanDeleteApplications(ctx, input.TeamSlug); err != nil {
+	if err := authz.CanDeleteApplications(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
 		return nil, err
 	}
 
@@ -144,7 +144,7 @@ func (r *mutationResolver) DeleteApplication(ctx context.Context, input applicat
 }
 
 func (r *mutationResolver) RestartApplication(ctx context.Context, input application.RestartApplicationInput) (*application.RestartApplicationPayload, error) {
-	if err := authz.CanUpdateApplications(ctx, input.TeamSlug); err != nil {
+	if err := authz.CanUpdateApplications(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
 		return nil, err
 	}
 
@@ -160,7 +160,7 @@ func (r *mutationResolver) RestartApplication(ctx context.Context, input applica
 }
 
 func (r *mutationResolver) UpdateApplication(ctx context.Context, input application.UpdateApplicationInput) (*application.UpdateApplicationPayload, error) {
-	if err := authz.CanUpdateApplications(ctx, input.TeamSlug); err != nil {
+	if err := authz.CanUpdateApplications(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
 		return nil, err
 	}
 
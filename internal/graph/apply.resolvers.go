@@ -0,0 +1,12 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/nais/api/internal/apply"
+	"github.com/nais/api/internal/environment"
+)
+
+func (r *environmentResolver) AllowedApplyResources(ctx context.Context, obj *environment.Environment) ([]*apply.AllowedResource, error) {
+	return apply.ListAllowedResources(ctx, obj.Name), nil
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/graph/gengql"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/team"
+	"github.com/nais/api/internal/user"
+)
+
+func (r *customTeamRoleResolver) Team(ctx context.Context, obj *team.CustomTeamRole) (*team.Team, error) {
+	return team.Get(ctx, obj.TeamSlug)
+}
+
+func (r *mutationResolver) CreateCustomTeamRole(ctx context.Context, input team.CreateCustomTeamRoleInput) (*team.CreateCustomTeamRolePayload, error) {
+	if err := authz.CanManageTeamMembers(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	if _, err := team.Get(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	role, err := team.CreateCustomRole(ctx, input, authz.ActorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &team.CreateCustomTeamRolePayload{
+		CustomRole: role,
+	}, nil
+}
+
+func (r *mutationResolver) UpdateCustomTeamRole(ctx context.Context, input team.UpdateCustomTeamRoleInput) (*team.UpdateCustomTeamRolePayload, error) {
+	if err := authz.CanManageTeamMembers(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	role, err := team.UpdateCustomRole(ctx, input, authz.ActorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &team.UpdateCustomTeamRolePayload{
+		CustomRole: role,
+	}, nil
+}
+
+func (r *mutationResolver) DeleteCustomTeamRole(ctx context.Context, input team.DeleteCustomTeamRoleInput) (*team.DeleteCustomTeamRolePayload, error) {
+	if err := authz.CanManageTeamMembers(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	if err := team.DeleteCustomRole(ctx, input, authz.ActorFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	return &team.DeleteCustomTeamRolePayload{
+		CustomRoleDeleted: new(true),
+	}, nil
+}
+
+func (r *mutationResolver) SetTeamMemberCustomRole(ctx context.Context, input team.SetTeamMemberCustomRoleInput) (*team.SetTeamMemberCustomRolePayload, error) {
+	if err := authz.CanManageTeamMembers(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	if _, err := team.Get(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	u, err := user.GetByEmail(ctx, input.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	input.UserID = u.UUID
+	member, err := team.SetMemberCustomRole(ctx, input, authz.ActorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &team.SetTeamMemberCustomRolePayload{
+		Member: member,
+	}, nil
+}
+
+func (r *queryResolver) CustomTeamRoleAuthorizations(ctx context.Context) ([]*team.CustomTeamRoleAuthorization, error) {
+	return team.ListCustomRoleAuthorizations(ctx)
+}
+
+func (r *teamResolver) CustomRoles(ctx context.Context, obj *team.Team, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) (*pagination.Connection[*team.CustomTeamRole], error) {
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return team.ListCustomRoles(ctx, obj.Slug, page)
+}
+
+func (r *teamMemberResolver) CustomRole(ctx context.Context, obj *team.TeamMember) (*team.CustomTeamRole, error) {
+	if obj.CustomRoleID == nil {
+		return nil, nil
+	}
+
+	return team.GetCustomRoleByID(ctx, *obj.CustomRoleID)
+}
+
+func (r *Resolver) CustomTeamRole() gengql.CustomTeamRoleResolver { return &customTeamRoleResolver{r} }
+
+type customTeamRoleResolver struct{ *Resolver }
@@ -6,6 +6,7 @@ import (
 	"github.com/nais/api/internal/auth/authz"
 	"github.com/nais/api/internal/deployment"
 	"github.com/nais/api/internal/graph/gengql"
+	"github.com/nais/api/internal/graph/ident"
 	"github.com/nais/api/internal/graph/pagination"
 	"github.com/nais/api/internal/team"
 	"github.com/nais/api/internal/workload"
@@ -40,6 +41,10 @@ func (r *deploymentResolver) Statuses(ctx context.Context, obj *deployment.Deplo
 	return deployment.ListStatusesForDeployment(ctx, obj.UUID, page)
 }
 
+func (r *deploymentResolver) PromotedFrom(ctx context.Context, obj *deployment.Deployment) (*deployment.Deployment, error) {
+	return deployment.PromotedFrom(ctx, obj)
+}
+
 func (r *jobResolver) Deployments(ctx context.Context, obj *job.Job, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) (*pagination.Connection[*deployment.Deployment], error) {
 	page, err := pagination.ParsePage(first, after, last, before)
 	if err != nil {
@@ -62,6 +67,15 @@ func (r *mutationResolver) ChangeDeploymentKey(ctx context.Context, input deploy
 	return &deployment.ChangeDeploymentKeyPayload{DeploymentKey: dk}, nil
 }
 
+func (r *mutationResolver) PromoteWorkload(ctx context.Context, input deployment.PromoteWorkloadInput) (*deployment.PromoteWorkloadPayload, error) {
+	d, err := deployment.Promote(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deployment.PromoteWorkloadPayload{Deployment: d}, nil
+}
+
 func (r *queryResolver) Deployments(ctx context.Context, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *deployment.DeploymentOrder, filter *deployment.DeploymentFilter) (*pagination.Connection[*deployment.Deployment], error) {
 	page, err := pagination.ParsePage(first, after, last, before)
 	if err != nil {
@@ -71,6 +85,10 @@ func (r *queryResolver) Deployments(ctx context.Context, first *int, after *pagi
 	return deployment.List(ctx, page, filter, orderBy)
 }
 
+func (r *queryResolver) DeploymentDiff(ctx context.Context, fromDeploymentID ident.Ident, toDeploymentID ident.Ident) (*deployment.DeploymentDiff, error) {
+	return deployment.Diff(ctx, fromDeploymentID, toDeploymentID)
+}
+
 func (r *teamResolver) DeploymentKey(ctx context.Context, obj *team.Team) (*deployment.DeploymentKey, error) {
 	if err := authz.CanReadDeployKey(ctx, obj.Slug); err != nil {
 		return nil, err
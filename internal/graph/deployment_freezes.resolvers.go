@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/nais/api/internal/deployment/freeze"
+	"github.com/nais/api/internal/graph/gengql"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/team"
+)
+
+func (r *deploymentFreezeResolver) Team(ctx context.Context, obj *freeze.DeploymentFreeze) (*team.Team, error) {
+	return team.Get(ctx, obj.TeamSlug)
+}
+
+func (r *deploymentFreezeResolver) TeamEnvironment(ctx context.Context, obj *freeze.DeploymentFreeze) (*team.TeamEnvironment, error) {
+	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
+}
+
+func (r *deploymentFreezeResolver) Active(ctx context.Context, obj *freeze.DeploymentFreeze) (bool, error) {
+	return obj.Active(time.Now()), nil
+}
+
+func (r *deploymentFreezeResolver) Overrides(ctx context.Context, obj *freeze.DeploymentFreeze) ([]*freeze.DeploymentFreezeOverride, error) {
+	return freeze.ListOverrides(ctx, obj.UUID)
+}
+
+func (r *mutationResolver) CreateDeploymentFreeze(ctx context.Context, input freeze.CreateDeploymentFreezeInput) (*freeze.CreateDeploymentFreezePayload, error) {
+	f, err := freeze.Create(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &freeze.CreateDeploymentFreezePayload{
+		DeploymentFreeze: f,
+	}, nil
+}
+
+func (r *mutationResolver) EndDeploymentFreeze(ctx context.Context, input freeze.EndDeploymentFreezeInput) (*freeze.EndDeploymentFreezePayload, error) {
+	f, err := freeze.End(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &freeze.EndDeploymentFreezePayload{
+		DeploymentFreeze: f,
+	}, nil
+}
+
+func (r *mutationResolver) OverrideDeploymentFreeze(ctx context.Context, input freeze.OverrideDeploymentFreezeInput) (*freeze.OverrideDeploymentFreezePayload, error) {
+	f, err := freeze.Override(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &freeze.OverrideDeploymentFreezePayload{
+		DeploymentFreeze: f,
+	}, nil
+}
+
+func (r *teamResolver) DeploymentFreezes(ctx context.Context, obj *team.Team, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, environmentName *string) (*pagination.Connection[*freeze.DeploymentFreeze], error) {
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return freeze.ListForTeam(ctx, obj.Slug, environmentName, page)
+}
+
+func (r *Resolver) DeploymentFreeze() gengql.DeploymentFreezeResolver {
+	return &deploymentFreezeResolver{r}
+}
+
+type deploymentFreezeResolver struct{ *Resolver }
@@ -0,0 +1,18 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/nais/api/internal/deployment"
+	"github.com/nais/api/internal/team"
+	"github.com/nais/api/internal/workload"
+	"github.com/nais/api/internal/workload/application"
+)
+
+func (r *applicationResolver) DoraMetrics(ctx context.Context, obj *application.Application, window *deployment.DoraMetricsWindowInput) (*deployment.DoraMetrics, error) {
+	return deployment.DoraMetricsForWorkload(ctx, obj.TeamSlug, obj.EnvironmentName, obj.Name, workload.TypeApplication, window)
+}
+
+func (r *teamResolver) DoraMetrics(ctx context.Context, obj *team.Team, window *deployment.DoraMetricsWindowInput, environmentName *string) (*deployment.DoraMetrics, error) {
+	return deployment.DoraMetricsForTeam(ctx, obj.Slug, environmentName, window)
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/nais/api/internal/activitylog"
 	"github.com/nais/api/internal/deployment/deploymentactivity"
+	"github.com/nais/api/internal/deployment/freeze"
 	"github.com/nais/api/internal/github/repository"
 	"github.com/nais/api/internal/graph/model"
 	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/issue"
 	"github.com/nais/api/internal/kubernetes/event/pubsublog"
 	"github.com/nais/api/internal/persistence/aivencredentials"
 	"github.com/nais/api/internal/persistence/opensearch"
@@ -572,6 +574,20 @@ func (ec *executionContext) _ActivityLogEntry(ctx context.Context, sel ast.Selec
 			return graphql.Null
 		}
 		return ec._TeamMemberSetRoleActivityLogEntry(ctx, sel, obj)
+	case team.TeamMemberSetEnvironmentsActivityLogEntry:
+		return ec._TeamMemberSetEnvironmentsActivityLogEntry(ctx, sel, &obj)
+	case *team.TeamMemberSetEnvironmentsActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamMemberSetEnvironmentsActivityLogEntry(ctx, sel, obj)
+	case team.TeamMemberSetCustomRoleActivityLogEntry:
+		return ec._TeamMemberSetCustomRoleActivityLogEntry(ctx, sel, &obj)
+	case *team.TeamMemberSetCustomRoleActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamMemberSetCustomRoleActivityLogEntry(ctx, sel, obj)
 	case team.TeamMemberRemovedActivityLogEntry:
 		return ec._TeamMemberRemovedActivityLogEntry(ctx, sel, &obj)
 	case *team.TeamMemberRemovedActivityLogEntry:
@@ -600,6 +616,27 @@ func (ec *executionContext) _ActivityLogEntry(ctx context.Context, sel ast.Selec
 			return graphql.Null
 		}
 		return ec._TeamDeployKeyUpdatedActivityLogEntry(ctx, sel, obj)
+	case team.TeamCustomRoleUpdatedActivityLogEntry:
+		return ec._TeamCustomRoleUpdatedActivityLogEntry(ctx, sel, &obj)
+	case *team.TeamCustomRoleUpdatedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamCustomRoleUpdatedActivityLogEntry(ctx, sel, obj)
+	case team.TeamCustomRoleDeletedActivityLogEntry:
+		return ec._TeamCustomRoleDeletedActivityLogEntry(ctx, sel, &obj)
+	case *team.TeamCustomRoleDeletedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamCustomRoleDeletedActivityLogEntry(ctx, sel, obj)
+	case team.TeamCustomRoleCreatedActivityLogEntry:
+		return ec._TeamCustomRoleCreatedActivityLogEntry(ctx, sel, &obj)
+	case *team.TeamCustomRoleCreatedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamCustomRoleCreatedActivityLogEntry(ctx, sel, obj)
 	case team.TeamCreatedActivityLogEntry:
 		return ec._TeamCreatedActivityLogEntry(ctx, sel, &obj)
 	case *team.TeamCreatedActivityLogEntry:
@@ -719,6 +756,27 @@ func (ec *executionContext) _ActivityLogEntry(ctx context.Context, sel ast.Selec
 			return graphql.Null
 		}
 		return ec._SecretUpdatedActivityLogEntry(ctx, sel, obj)
+	case secret.SecretRolledBackActivityLogEntry:
+		return ec._SecretRolledBackActivityLogEntry(ctx, sel, &obj)
+	case *secret.SecretRolledBackActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SecretRolledBackActivityLogEntry(ctx, sel, obj)
+	case secret.SecretExternalSourceSetActivityLogEntry:
+		return ec._SecretExternalSourceSetActivityLogEntry(ctx, sel, &obj)
+	case *secret.SecretExternalSourceSetActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SecretExternalSourceSetActivityLogEntry(ctx, sel, obj)
+	case secret.SecretExternalSourceRemovedActivityLogEntry:
+		return ec._SecretExternalSourceRemovedActivityLogEntry(ctx, sel, &obj)
+	case *secret.SecretExternalSourceRemovedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SecretExternalSourceRemovedActivityLogEntry(ctx, sel, obj)
 	case secret.SecretDeletedActivityLogEntry:
 		return ec._SecretDeletedActivityLogEntry(ctx, sel, &obj)
 	case *secret.SecretDeletedActivityLogEntry:
@@ -733,6 +791,34 @@ func (ec *executionContext) _ActivityLogEntry(ctx context.Context, sel ast.Selec
 			return graphql.Null
 		}
 		return ec._SecretCreatedActivityLogEntry(ctx, sel, obj)
+	case secret.SecretAccessRequestedActivityLogEntry:
+		return ec._SecretAccessRequestedActivityLogEntry(ctx, sel, &obj)
+	case *secret.SecretAccessRequestedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SecretAccessRequestedActivityLogEntry(ctx, sel, obj)
+	case secret.SecretAccessExpiredActivityLogEntry:
+		return ec._SecretAccessExpiredActivityLogEntry(ctx, sel, &obj)
+	case *secret.SecretAccessExpiredActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SecretAccessExpiredActivityLogEntry(ctx, sel, obj)
+	case secret.SecretAccessDeniedActivityLogEntry:
+		return ec._SecretAccessDeniedActivityLogEntry(ctx, sel, &obj)
+	case *secret.SecretAccessDeniedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SecretAccessDeniedActivityLogEntry(ctx, sel, obj)
+	case secret.SecretAccessApprovedActivityLogEntry:
+		return ec._SecretAccessApprovedActivityLogEntry(ctx, sel, &obj)
+	case *secret.SecretAccessApprovedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SecretAccessApprovedActivityLogEntry(ctx, sel, obj)
 	case serviceaccount.RoleRevokedFromServiceAccountActivityLogEntry:
 		return ec._RoleRevokedFromServiceAccountActivityLogEntry(ctx, sel, &obj)
 	case *serviceaccount.RoleRevokedFromServiceAccountActivityLogEntry:
@@ -852,6 +938,34 @@ func (ec *executionContext) _ActivityLogEntry(ctx context.Context, sel ast.Selec
 			return graphql.Null
 		}
 		return ec._JobCreatedActivityLogEntry(ctx, sel, obj)
+	case issue.IssueSuppressionRuleDeletedActivityLogEntry:
+		return ec._IssueSuppressionRuleDeletedActivityLogEntry(ctx, sel, &obj)
+	case *issue.IssueSuppressionRuleDeletedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._IssueSuppressionRuleDeletedActivityLogEntry(ctx, sel, obj)
+	case issue.IssueSuppressionRuleCreatedActivityLogEntry:
+		return ec._IssueSuppressionRuleCreatedActivityLogEntry(ctx, sel, &obj)
+	case *issue.IssueSuppressionRuleCreatedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._IssueSuppressionRuleCreatedActivityLogEntry(ctx, sel, obj)
+	case issue.IssueAcknowledgementRemovedActivityLogEntry:
+		return ec._IssueAcknowledgementRemovedActivityLogEntry(ctx, sel, &obj)
+	case *issue.IssueAcknowledgementRemovedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._IssueAcknowledgementRemovedActivityLogEntry(ctx, sel, obj)
+	case issue.IssueAcknowledgedActivityLogEntry:
+		return ec._IssueAcknowledgedActivityLogEntry(ctx, sel, &obj)
+	case *issue.IssueAcknowledgedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._IssueAcknowledgedActivityLogEntry(ctx, sel, obj)
 	case activitylog.GenericKubernetesResourceActivityLogEntry:
 		return ec._GenericKubernetesResourceActivityLogEntry(ctx, sel, &obj)
 	case *activitylog.GenericKubernetesResourceActivityLogEntry:
@@ -859,6 +973,27 @@ func (ec *executionContext) _ActivityLogEntry(ctx context.Context, sel ast.Selec
 			return graphql.Null
 		}
 		return ec._GenericKubernetesResourceActivityLogEntry(ctx, sel, obj)
+	case freeze.DeploymentFreezeOverriddenActivityLogEntry:
+		return ec._DeploymentFreezeOverriddenActivityLogEntry(ctx, sel, &obj)
+	case *freeze.DeploymentFreezeOverriddenActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DeploymentFreezeOverriddenActivityLogEntry(ctx, sel, obj)
+	case freeze.DeploymentFreezeEndedActivityLogEntry:
+		return ec._DeploymentFreezeEndedActivityLogEntry(ctx, sel, &obj)
+	case *freeze.DeploymentFreezeEndedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DeploymentFreezeEndedActivityLogEntry(ctx, sel, obj)
+	case freeze.DeploymentFreezeCreatedActivityLogEntry:
+		return ec._DeploymentFreezeCreatedActivityLogEntry(ctx, sel, &obj)
+	case *freeze.DeploymentFreezeCreatedActivityLogEntry:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DeploymentFreezeCreatedActivityLogEntry(ctx, sel, obj)
 	case deploymentactivity.DeploymentActivityLogEntry:
 		return ec._DeploymentActivityLogEntry(ctx, sel, &obj)
 	case *deploymentactivity.DeploymentActivityLogEntry:
@@ -18,6 +18,7 @@ import (
 	sqlinstance "github.com/nais/api/internal/persistence/sqlinstance"
 	valkey "github.com/nais/api/internal/persistence/valkey"
 	search "github.com/nais/api/internal/search"
+	slug "github.com/nais/api/internal/slug"
 	team "github.com/nais/api/internal/team"
 	user "github.com/nais/api/internal/user"
 	vulnerability "github.com/nais/api/internal/vulnerability"
@@ -160,6 +161,12 @@ func NewComplexityRoot() ComplexityRoot {
 	c.Reconciler.Errors = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
+	c.Reconciler.StateRevisions = func(childComplexity int, teamSlug slug.Slug, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
+	c.Secret.AccessRequests = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
 	c.Secret.ActivityLog = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
@@ -169,6 +176,9 @@ func NewComplexityRoot() ComplexityRoot {
 	c.Secret.Jobs = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
+	c.Secret.Versions = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
 	c.Secret.Workloads = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
@@ -208,9 +218,21 @@ func NewComplexityRoot() ComplexityRoot {
 	c.Team.Configs = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *config.ConfigOrder, filter *config.ConfigFilter) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
+	c.Team.CustomRoles = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
+	c.Team.DeploymentFreezes = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, environmentName *string) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
 	c.Team.Deployments = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
+	c.Team.IssueHistory = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *issue.IssueHistoryFilter) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
+	c.Team.IssueSuppressionRules = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
 	c.Team.Issues = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *issue.IssueOrder, filter *issue.IssueFilter) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
@@ -235,9 +257,15 @@ func NewComplexityRoot() ComplexityRoot {
 	c.Team.SQLInstances = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *sqlinstance.SQLInstanceOrder, filter *sqlinstance.SQLInstanceFilter) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
+	c.Team.SecretAccessRequests = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, state *secret.SecretAccessRequestState) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
 	c.Team.Secrets = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *secret.SecretOrder, filter *secret.SecretFilter) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
+	c.Team.ServiceAccountTokenIssues = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
+		return cursorComplexity(first, last) * childComplexity
+	}
 	c.Team.ServiceAccounts = func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int {
 		return cursorComplexity(first, last) * childComplexity
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/nais/api/internal/auth/authz"
 	"github.com/nais/api/internal/cost"
 	"github.com/nais/api/internal/deployment"
+	"github.com/nais/api/internal/deployment/freeze"
 	"github.com/nais/api/internal/environment"
 	"github.com/nais/api/internal/github/repository"
 	"github.com/nais/api/internal/graph/ident"
@@ -75,10 +76,13 @@ type ResolverRoot interface {
 	ContainerImageSBOM() ContainerImageSBOMResolver
 	ContainerImageWorkloadReference() ContainerImageWorkloadReferenceResolver
 	CurrentUnitPrices() CurrentUnitPricesResolver
+	CustomIssue() CustomIssueResolver
+	CustomTeamRole() CustomTeamRoleResolver
 	DeleteApplicationPayload() DeleteApplicationPayloadResolver
 	DeleteJobPayload() DeleteJobPayloadResolver
 	DeleteJobRunPayload() DeleteJobRunPayloadResolver
 	Deployment() DeploymentResolver
+	DeploymentFreeze() DeploymentFreezeResolver
 	DeprecatedIngressIssue() DeprecatedIngressIssueResolver
 	DeprecatedRegistryIssue() DeprecatedRegistryIssueResolver
 	Environment() EnvironmentResolver
@@ -88,7 +92,11 @@ type ResolverRoot interface {
 	IngressMetrics() IngressMetricsResolver
 	InstanceGroup() InstanceGroupResolver
 	InvalidSpecIssue() InvalidSpecIssueResolver
+	IssueAcknowledgement() IssueAcknowledgementResolver
 	IssueConnection() IssueConnectionResolver
+	IssueHistoryConnection() IssueHistoryConnectionResolver
+	IssueHistoryEntry() IssueHistoryEntryResolver
+	IssueSuppressionRule() IssueSuppressionRuleResolver
 	Job() JobResolver
 	JobConnection() JobConnectionResolver
 	JobRun() JobRunResolver
@@ -96,6 +104,7 @@ type ResolverRoot interface {
 	KafkaTopicAcl() KafkaTopicAclResolver
 	KafkaTopicConnection() KafkaTopicConnectionResolver
 	LastRunFailedIssue() LastRunFailedIssueResolver
+	MemoryLimitReachedIssue() MemoryLimitReachedIssueResolver
 	MissingSbomIssue() MissingSbomIssueResolver
 	Mutation() MutationResolver
 	NetworkPolicyRule() NetworkPolicyRuleResolver
@@ -105,6 +114,7 @@ type ResolverRoot interface {
 	OpenSearchConnection() OpenSearchConnectionResolver
 	OpenSearchIssue() OpenSearchIssueResolver
 	OpenSearchMaintenance() OpenSearchMaintenanceResolver
+	OverprovisionedWorkloadIssue() OverprovisionedWorkloadIssueResolver
 	PostgresInstance() PostgresInstanceResolver
 	PostgresInstanceAudit() PostgresInstanceAuditResolver
 	PostgresInstanceConnection() PostgresInstanceConnectionResolver
@@ -116,14 +126,19 @@ type ResolverRoot interface {
 	Repository() RepositoryResolver
 	RestartApplicationPayload() RestartApplicationPayloadResolver
 	Secret() SecretResolver
+	SecretAccessRequest() SecretAccessRequestResolver
 	SecretConnection() SecretConnectionResolver
 	ServiceAccount() ServiceAccountResolver
+	ServiceAccountTokenExpiringIssue() ServiceAccountTokenExpiringIssueResolver
+	ServiceAccountTokenUnusedIssue() ServiceAccountTokenUnusedIssueResolver
 	ServiceAccountWorkloadBinding() ServiceAccountWorkloadBindingResolver
 	SqlDatabase() SqlDatabaseResolver
 	SqlInstance() SqlInstanceResolver
 	SqlInstanceMetrics() SqlInstanceMetricsResolver
 	SqlInstanceStateIssue() SqlInstanceStateIssueResolver
 	SqlInstanceVersionIssue() SqlInstanceVersionIssueResolver
+	StaleAivenCredentialIssue() StaleAivenCredentialIssueResolver
+	StaleSecretIssue() StaleSecretIssueResolver
 	Subscription() SubscriptionResolver
 	Team() TeamResolver
 	TeamCost() TeamCostResolver
@@ -162,6 +177,10 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AcknowledgeIssuePayload struct {
+		Issue func(childComplexity int) int
+	}
+
 	ActivityLogActivityTypeFacetItem struct {
 		ActivityType func(childComplexity int) int
 		Count        func(childComplexity int) int
@@ -237,6 +256,11 @@ type ComplexityRoot struct {
 		Unleash func(childComplexity int) int
 	}
 
+	AllowedResource struct {
+		APIVersion func(childComplexity int) int
+		Kind       func(childComplexity int) int
+	}
+
 	Application struct {
 		ActivityLog               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int
 		AuthIntegrations          func(childComplexity int) int
@@ -246,6 +270,7 @@ type ComplexityRoot struct {
 		Cost                      func(childComplexity int) int
 		DeletionStartedAt         func(childComplexity int) int
 		Deployments               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		DoraMetrics               func(childComplexity int, window *deployment.DoraMetricsWindowInput) int
 		History                   func(childComplexity int) int
 		ID                        func(childComplexity int) int
 		Image                     func(childComplexity int) int
@@ -365,9 +390,12 @@ type ComplexityRoot struct {
 	}
 
 	ApplicationRestartLoopIssue struct {
+		Acknowledgement   func(childComplexity int) int
+		FirstSeen         func(childComplexity int) int
 		ID                func(childComplexity int) int
 		LastExitReason    func(childComplexity int) int
 		LastExitTimestamp func(childComplexity int) int
+		LastSeen          func(childComplexity int) int
 		Message           func(childComplexity int) int
 		RestartCount      func(childComplexity int) int
 		Severity          func(childComplexity int) int
@@ -431,6 +459,10 @@ type ComplexityRoot struct {
 		GitHubActorClaims func(childComplexity int) int
 	}
 
+	ApproveSecretAccessRequestPayload struct {
+		AccessRequest func(childComplexity int) int
+	}
+
 	AssignRoleToServiceAccountPayload struct {
 		ServiceAccount func(childComplexity int) int
 	}
@@ -705,6 +737,18 @@ type ComplexityRoot struct {
 		Config func(childComplexity int) int
 	}
 
+	CreateCustomTeamRolePayload struct {
+		CustomRole func(childComplexity int) int
+	}
+
+	CreateDeploymentFreezePayload struct {
+		DeploymentFreeze func(childComplexity int) int
+	}
+
+	CreateIssueSuppressionRulePayload struct {
+		SuppressionRule func(childComplexity int) int
+	}
+
 	CreateKafkaCredentialsPayload struct {
 		Credentials func(childComplexity int) int
 	}
@@ -773,6 +817,45 @@ type ComplexityRoot struct {
 		Memory func(childComplexity int) int
 	}
 
+	CustomIssue struct {
+		Acknowledgement func(childComplexity int) int
+		CheckName       func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
+		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		Severity        func(childComplexity int) int
+		TeamEnvironment func(childComplexity int) int
+	}
+
+	CustomTeamRole struct {
+		Authorizations func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		Description    func(childComplexity int) int
+		ID             func(childComplexity int) int
+		Name           func(childComplexity int) int
+		Team           func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
+	CustomTeamRoleAuthorization struct {
+		Description func(childComplexity int) int
+		Name        func(childComplexity int) int
+	}
+
+	CustomTeamRoleConnection struct {
+		Edges    func(childComplexity int) int
+		Nodes    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	CustomTeamRoleEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	DeleteApplicationPayload struct {
 		Success func(childComplexity int) int
 		Team    func(childComplexity int) int
@@ -782,6 +865,14 @@ type ComplexityRoot struct {
 		ConfigDeleted func(childComplexity int) int
 	}
 
+	DeleteCustomTeamRolePayload struct {
+		CustomRoleDeleted func(childComplexity int) int
+	}
+
+	DeleteIssueSuppressionRulePayload struct {
+		Success func(childComplexity int) int
+	}
+
 	DeleteJobPayload struct {
 		Success func(childComplexity int) int
 		Team    func(childComplexity int) int
@@ -825,12 +916,17 @@ type ComplexityRoot struct {
 		ValkeyDeleted func(childComplexity int) int
 	}
 
+	DenySecretAccessRequestPayload struct {
+		AccessRequest func(childComplexity int) int
+	}
+
 	Deployment struct {
 		CommitSha        func(childComplexity int) int
 		CreatedAt        func(childComplexity int) int
 		DeployerUsername func(childComplexity int) int
 		EnvironmentName  func(childComplexity int) int
 		ID               func(childComplexity int) int
+		PromotedFrom     func(childComplexity int) int
 		Repository       func(childComplexity int) int
 		Resources        func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
 		Statuses         func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
@@ -854,17 +950,110 @@ type ComplexityRoot struct {
 		TriggerURL func(childComplexity int) int
 	}
 
+	DeploymentCommitRange struct {
+		CompareURL    func(childComplexity int) int
+		FromCommitSha func(childComplexity int) int
+		Repository    func(childComplexity int) int
+		ToCommitSha   func(childComplexity int) int
+	}
+
 	DeploymentConnection struct {
 		Edges    func(childComplexity int) int
 		Nodes    func(childComplexity int) int
 		PageInfo func(childComplexity int) int
 	}
 
+	DeploymentDiff struct {
+		ChangedResourceKinds func(childComplexity int) int
+		CommitRange          func(childComplexity int) int
+		From                 func(childComplexity int) int
+		SpecAvailable        func(childComplexity int) int
+		SpecChanges          func(childComplexity int) int
+		To                   func(childComplexity int) int
+	}
+
 	DeploymentEdge struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
 
+	DeploymentFreeze struct {
+		Active          func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		CreatedBy       func(childComplexity int) int
+		EndsAt          func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Overrides       func(childComplexity int) int
+		Reason          func(childComplexity int) int
+		StartsAt        func(childComplexity int) int
+		Team            func(childComplexity int) int
+		TeamEnvironment func(childComplexity int) int
+	}
+
+	DeploymentFreezeConnection struct {
+		Edges    func(childComplexity int) int
+		Nodes    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	DeploymentFreezeCreatedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	DeploymentFreezeCreatedActivityLogEntryData struct {
+		EndsAt   func(childComplexity int) int
+		Reason   func(childComplexity int) int
+		StartsAt func(childComplexity int) int
+	}
+
+	DeploymentFreezeEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	DeploymentFreezeEndedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	DeploymentFreezeOverriddenActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	DeploymentFreezeOverriddenActivityLogEntryData struct {
+		ExpiresAt     func(childComplexity int) int
+		Justification func(childComplexity int) int
+	}
+
+	DeploymentFreezeOverride struct {
+		CreatedAt     func(childComplexity int) int
+		CreatedBy     func(childComplexity int) int
+		ExpiresAt     func(childComplexity int) int
+		Justification func(childComplexity int) int
+	}
+
 	DeploymentKey struct {
 		Created func(childComplexity int) int
 		Expires func(childComplexity int) int
@@ -908,32 +1097,54 @@ type ComplexityRoot struct {
 	}
 
 	DeprecatedIngressIssue struct {
+		Acknowledgement func(childComplexity int) int
 		Application     func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
 		Ingresses       func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
 	}
 
 	DeprecatedRegistryIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
 		Workload        func(childComplexity int) int
 	}
 
+	DoraMetrics struct {
+		ChangeFailureRate     func(childComplexity int) int
+		DeploymentFrequency   func(childComplexity int) int
+		FailedDeployments     func(childComplexity int) int
+		From                  func(childComplexity int) int
+		LeadTimeSeconds       func(childComplexity int) int
+		SuccessfulDeployments func(childComplexity int) int
+		TimeToRestoreSeconds  func(childComplexity int) int
+		To                    func(childComplexity int) int
+	}
+
+	EndDeploymentFreezePayload struct {
+		DeploymentFreeze func(childComplexity int) int
+	}
+
 	EntraIDAuthIntegration struct {
 		Name func(childComplexity int) int
 	}
 
 	Environment struct {
-		ID            func(childComplexity int) int
-		Metrics       func(childComplexity int, input metrics.MetricsQueryInput) int
-		Name          func(childComplexity int) int
-		OIDCIssuerURL func(childComplexity int) int
-		Workloads     func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *workload.EnvironmentWorkloadOrder) int
+		AllowedApplyResources func(childComplexity int) int
+		ID                    func(childComplexity int) int
+		Metrics               func(childComplexity int, input metrics.MetricsQueryInput) int
+		Name                  func(childComplexity int) int
+		OIDCIssuerURL         func(childComplexity int) int
+		Workloads             func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *workload.EnvironmentWorkloadOrder) int
 	}
 
 	EnvironmentConnection struct {
@@ -948,9 +1159,12 @@ type ComplexityRoot struct {
 	}
 
 	ExternalIngressCriticalVulnerabilityIssue struct {
+		Acknowledgement func(childComplexity int) int
 		CvssScore       func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
 		Ingresses       func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
@@ -968,7 +1182,10 @@ type ComplexityRoot struct {
 	}
 
 	FailedSynchronizationIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
@@ -1146,13 +1363,56 @@ type ComplexityRoot struct {
 	}
 
 	InvalidSpecIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
 		Workload        func(childComplexity int) int
 	}
 
+	IssueAcknowledgedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	IssueAcknowledgedActivityLogEntryData struct {
+		IssueType    func(childComplexity int) int
+		Reason       func(childComplexity int) int
+		ResourceType func(childComplexity int) int
+		Until        func(childComplexity int) int
+	}
+
+	IssueAcknowledgement struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Kind            func(childComplexity int) int
+		Reason          func(childComplexity int) int
+		SuppressionRule func(childComplexity int) int
+		Until           func(childComplexity int) int
+	}
+
+	IssueAcknowledgementRemovedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
 	IssueConnection struct {
 		Edges    func(childComplexity int) int
 		Facets   func(childComplexity int) int
@@ -1172,6 +1432,31 @@ type ComplexityRoot struct {
 		Severities    func(childComplexity int) int
 	}
 
+	IssueHistoryConnection struct {
+		Edges             func(childComplexity int) int
+		MeanTimeToResolve func(childComplexity int) int
+		Nodes             func(childComplexity int) int
+		PageInfo          func(childComplexity int) int
+	}
+
+	IssueHistoryEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	IssueHistoryEntry struct {
+		FirstSeen       func(childComplexity int) int
+		Issue           func(childComplexity int) int
+		IssueType       func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResolvedAt      func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		Severity        func(childComplexity int) int
+		TeamEnvironment func(childComplexity int) int
+	}
+
 	IssueResourceTypeFacetItem struct {
 		Count        func(childComplexity int) int
 		ResourceType func(childComplexity int) int
@@ -1182,11 +1467,68 @@ type ComplexityRoot struct {
 		Severity func(childComplexity int) int
 	}
 
+	IssueSuppressionRule struct {
+		Actor               func(childComplexity int) int
+		CreatedAt           func(childComplexity int) int
+		ID                  func(childComplexity int) int
+		IssueType           func(childComplexity int) int
+		Reason              func(childComplexity int) int
+		ResourceNamePattern func(childComplexity int) int
+		Team                func(childComplexity int) int
+	}
+
+	IssueSuppressionRuleActivityLogEntryData struct {
+		IssueType           func(childComplexity int) int
+		Reason              func(childComplexity int) int
+		ResourceNamePattern func(childComplexity int) int
+	}
+
+	IssueSuppressionRuleConnection struct {
+		Edges    func(childComplexity int) int
+		Nodes    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	IssueSuppressionRuleCreatedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	IssueSuppressionRuleDeletedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	IssueSuppressionRuleEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	IssueTypeFacetItem struct {
 		Count     func(childComplexity int) int
 		IssueType func(childComplexity int) int
 	}
 
+	IssueTypeMeanTimeToResolve struct {
+		IssueType         func(childComplexity int) int
+		MeanTimeToResolve func(childComplexity int) int
+		ResolvedCount     func(childComplexity int) int
+	}
+
 	Job struct {
 		ActivityLog               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int
 		AuthIntegrations          func(childComplexity int) int
@@ -1457,8 +1799,11 @@ type ComplexityRoot struct {
 	}
 
 	LastRunFailedIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
 		Job             func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
@@ -1498,6 +1843,20 @@ type ComplexityRoot struct {
 		Name func(childComplexity int) int
 	}
 
+	MemoryLimitReachedIssue struct {
+		Acknowledgement             func(childComplexity int) int
+		FirstSeen                   func(childComplexity int) int
+		ID                          func(childComplexity int) int
+		LastSeen                    func(childComplexity int) int
+		MemoryLimitBytes            func(childComplexity int) int
+		Message                     func(childComplexity int) int
+		OOMKillCount                func(childComplexity int) int
+		RecommendedMemoryLimitBytes func(childComplexity int) int
+		Severity                    func(childComplexity int) int
+		TeamEnvironment             func(childComplexity int) int
+		Workload                    func(childComplexity int) int
+	}
+
 	MetricLabel struct {
 		Name  func(childComplexity int) int
 		Value func(childComplexity int) int
@@ -1519,7 +1878,10 @@ type ComplexityRoot struct {
 	}
 
 	MissingSbomIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
@@ -1527,17 +1889,22 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
+		AcknowledgeIssue                 func(childComplexity int, input issue.AcknowledgeIssueInput) int
 		AddConfigValue                   func(childComplexity int, input config.AddConfigValueInput) int
 		AddRepositoryToTeam              func(childComplexity int, input repository.AddRepositoryToTeamInput) int
 		AddSecretValue                   func(childComplexity int, input secret.AddSecretValueInput) int
 		AddTeamMember                    func(childComplexity int, input team.AddTeamMemberInput) int
 		AddWorkloadToServiceAccount      func(childComplexity int, input serviceaccount.AddWorkloadToServiceAccountInput) int
 		AllowTeamAccessToUnleash         func(childComplexity int, input unleash.AllowTeamAccessToUnleashInput) int
+		ApproveSecretAccessRequest       func(childComplexity int, input secret.ApproveSecretAccessRequestInput) int
 		AssignRoleToServiceAccount       func(childComplexity int, input serviceaccount.AssignRoleToServiceAccountInput) int
 		ChangeDeploymentKey              func(childComplexity int, input deployment.ChangeDeploymentKeyInput) int
 		ConfigureReconciler              func(childComplexity int, input reconciler.ConfigureReconcilerInput) int
 		ConfirmTeamDeletion              func(childComplexity int, input team.ConfirmTeamDeletionInput) int
 		CreateConfig                     func(childComplexity int, input config.CreateConfigInput) int
+		CreateCustomTeamRole             func(childComplexity int, input team.CreateCustomTeamRoleInput) int
+		CreateDeploymentFreeze           func(childComplexity int, input freeze.CreateDeploymentFreezeInput) int
+		CreateIssueSuppressionRule       func(childComplexity int, input issue.CreateIssueSuppressionRuleInput) int
 		CreateKafkaCredentials           func(childComplexity int, input kafkatopic.CreateKafkaCredentialsInput) int
 		CreateOpenSearch                 func(childComplexity int, input opensearch.CreateOpenSearchInput) int
 		CreateOpenSearchCredentials      func(childComplexity int, input opensearch.CreateOpenSearchCredentialsInput) int
@@ -1551,6 +1918,8 @@ type ComplexityRoot struct {
 		CreateValkeyCredentials          func(childComplexity int, input valkey.CreateValkeyCredentialsInput) int
 		DeleteApplication                func(childComplexity int, input application.DeleteApplicationInput) int
 		DeleteConfig                     func(childComplexity int, input config.DeleteConfigInput) int
+		DeleteCustomTeamRole             func(childComplexity int, input team.DeleteCustomTeamRoleInput) int
+		DeleteIssueSuppressionRule       func(childComplexity int, input issue.DeleteIssueSuppressionRuleInput) int
 		DeleteJob                        func(childComplexity int, input job.DeleteJobInput) int
 		DeleteJobRun                     func(childComplexity int, input job.DeleteJobRunInput) int
 		DeleteOpenSearch                 func(childComplexity int, input opensearch.DeleteOpenSearchInput) int
@@ -1561,25 +1930,38 @@ type ComplexityRoot struct {
 		DeleteTunnel                     func(childComplexity int, input tunnel.DeleteTunnelInput) int
 		DeleteUnleashInstance            func(childComplexity int, input unleash.DeleteUnleashInstanceInput) int
 		DeleteValkey                     func(childComplexity int, input valkey.DeleteValkeyInput) int
+		DenySecretAccessRequest          func(childComplexity int, input secret.DenySecretAccessRequestInput) int
 		DisableReconciler                func(childComplexity int, input reconciler.DisableReconcilerInput) int
 		EnableReconciler                 func(childComplexity int, input reconciler.EnableReconcilerInput) int
+		EndDeploymentFreeze              func(childComplexity int, input freeze.EndDeploymentFreezeInput) int
 		GrantPostgresAccess              func(childComplexity int, input postgres.GrantPostgresAccessInput) int
+		OverrideDeploymentFreeze         func(childComplexity int, input freeze.OverrideDeploymentFreezeInput) int
+		PromoteWorkload                  func(childComplexity int, input deployment.PromoteWorkloadInput) int
 		RemoveConfigValue                func(childComplexity int, input config.RemoveConfigValueInput) int
+		RemoveIssueAcknowledgement       func(childComplexity int, input issue.RemoveIssueAcknowledgementInput) int
 		RemoveRepositoryFromTeam         func(childComplexity int, input repository.RemoveRepositoryFromTeamInput) int
+		RemoveSecretExternalSource       func(childComplexity int, input secret.RemoveSecretExternalSourceInput) int
 		RemoveSecretValue                func(childComplexity int, input secret.RemoveSecretValueInput) int
 		RemoveTeamMember                 func(childComplexity int, input team.RemoveTeamMemberInput) int
 		RemoveWorkloadFromServiceAccount func(childComplexity int, input serviceaccount.RemoveWorkloadFromServiceAccountInput) int
+		RequestSecretAccess              func(childComplexity int, input secret.RequestSecretAccessInput) int
 		RequestTeamDeletion              func(childComplexity int, input team.RequestTeamDeletionInput) int
 		RestartApplication               func(childComplexity int, input application.RestartApplicationInput) int
 		RevokeRoleFromServiceAccount     func(childComplexity int, input serviceaccount.RevokeRoleFromServiceAccountInput) int
 		RevokeTeamAccessToUnleash        func(childComplexity int, input unleash.RevokeTeamAccessToUnleashInput) int
+		RollbackSecret                   func(childComplexity int, input secret.RollbackSecretInput) int
+		SetSecretExternalSource          func(childComplexity int, input secret.SetSecretExternalSourceInput) int
+		SetTeamMemberCustomRole          func(childComplexity int, input team.SetTeamMemberCustomRoleInput) int
+		SetTeamMemberEnvironments        func(childComplexity int, input team.SetTeamMemberEnvironmentsInput) int
 		SetTeamMemberRole                func(childComplexity int, input team.SetTeamMemberRoleInput) int
+		SnoozeIssue                      func(childComplexity int, input issue.SnoozeIssueInput) int
 		StartOpenSearchMaintenance       func(childComplexity int, input servicemaintenance.StartOpenSearchMaintenanceInput) int
 		StartValkeyMaintenance           func(childComplexity int, input servicemaintenance.StartValkeyMaintenanceInput) int
 		TriggerJob                       func(childComplexity int, input job.TriggerJobInput) int
 		UpdateApplication                func(childComplexity int, input application.UpdateApplicationInput) int
 		UpdateConfig                     func(childComplexity int, input config.UpdateConfigInput) int
 		UpdateConfigValue                func(childComplexity int, input config.UpdateConfigValueInput) int
+		UpdateCustomTeamRole             func(childComplexity int, input team.UpdateCustomTeamRoleInput) int
 		UpdateImageVulnerability         func(childComplexity int, input vulnerability.UpdateImageVulnerabilityInput) int
 		UpdateJob                        func(childComplexity int, input job.UpdateJobInput) int
 		UpdateOpenSearch                 func(childComplexity int, input opensearch.UpdateOpenSearchInput) int
@@ -1608,7 +1990,10 @@ type ComplexityRoot struct {
 	}
 
 	NoRunningInstancesIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
@@ -1704,8 +2089,11 @@ type ComplexityRoot struct {
 	}
 
 	OpenSearchIssue struct {
+		Acknowledgement func(childComplexity int) int
 		Event           func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		OpenSearch      func(childComplexity int) int
 		Severity        func(childComplexity int) int
@@ -1772,6 +2160,27 @@ type ComplexityRoot struct {
 		Rules    func(childComplexity int) int
 	}
 
+	OverprovisionedWorkloadIssue struct {
+		Acknowledgement               func(childComplexity int) int
+		CPUPeakUsageCores             func(childComplexity int) int
+		CPURequestCores               func(childComplexity int) int
+		FirstSeen                     func(childComplexity int) int
+		ID                            func(childComplexity int) int
+		LastSeen                      func(childComplexity int) int
+		MemoryPeakUsageBytes          func(childComplexity int) int
+		MemoryRequestBytes            func(childComplexity int) int
+		Message                       func(childComplexity int) int
+		RecommendedCPURequestCores    func(childComplexity int) int
+		RecommendedMemoryRequestBytes func(childComplexity int) int
+		Severity                      func(childComplexity int) int
+		TeamEnvironment               func(childComplexity int) int
+		Workload                      func(childComplexity int) int
+	}
+
+	OverrideDeploymentFreezePayload struct {
+		DeploymentFreeze func(childComplexity int) int
+	}
+
 	PageInfo struct {
 		EndCursor       func(childComplexity int) int
 		HasNextPage     func(childComplexity int) int
@@ -1892,45 +2301,52 @@ type ComplexityRoot struct {
 		TeamEnvironment func(childComplexity int) int
 	}
 
+	PromoteWorkloadPayload struct {
+		Deployment func(childComplexity int) int
+	}
+
 	Query struct {
-		ActivityLog               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int
-		CVE                       func(childComplexity int, identifier string) int
-		CostMonthlySummary        func(childComplexity int, from scalar.Date, to scalar.Date) int
-		CurrentUnitPrices         func(childComplexity int) int
-		Cves                      func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *vulnerability.CVEOrder) int
-		Deployments               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *deployment.DeploymentOrder, filter *deployment.DeploymentFilter) int
-		Environment               func(childComplexity int, name string) int
-		Environments              func(childComplexity int, orderBy *environment.EnvironmentOrder) int
-		Features                  func(childComplexity int) int
-		ImageVulnerabilityHistory func(childComplexity int, from scalar.Date) int
-		Me                        func(childComplexity int) int
-		Node                      func(childComplexity int, id ident.Ident) int
-		Reconcilers               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
-		Roles                     func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *authz.RoleFilter) int
-		Search                    func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter search.SearchFilter) int
-		ServiceAccount            func(childComplexity int, id ident.Ident) int
-		ServiceAccounts           func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
-		Team                      func(childComplexity int, slug slug.Slug) int
-		Teams                     func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *team.TeamOrder, filter *team.TeamFilter) int
-		TeamsUtilization          func(childComplexity int, resourceType utilization.UtilizationResourceType) int
-		UnleashReleaseChannels    func(childComplexity int) int
-		User                      func(childComplexity int, email *string) int
-		UserSyncLog               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
-		Users                     func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *user.UserOrder) int
-		VulnerabilityFixHistory   func(childComplexity int, from scalar.Date) int
-		VulnerabilitySummary      func(childComplexity int) int
+		ActivityLog                  func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int
+		CVE                          func(childComplexity int, identifier string) int
+		CostMonthlySummary           func(childComplexity int, from scalar.Date, to scalar.Date) int
+		CurrentUnitPrices            func(childComplexity int) int
+		CustomTeamRoleAuthorizations func(childComplexity int) int
+		Cves                         func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *vulnerability.CVEOrder) int
+		DeploymentDiff               func(childComplexity int, fromDeploymentID ident.Ident, toDeploymentID ident.Ident) int
+		Deployments                  func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *deployment.DeploymentOrder, filter *deployment.DeploymentFilter) int
+		Environment                  func(childComplexity int, name string) int
+		Environments                 func(childComplexity int, orderBy *environment.EnvironmentOrder) int
+		Features                     func(childComplexity int) int
+		ImageVulnerabilityHistory    func(childComplexity int, from scalar.Date) int
+		Me                           func(childComplexity int) int
+		Node                         func(childComplexity int, id ident.Ident) int
+		Reconcilers                  func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		Roles                        func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *authz.RoleFilter) int
+		Search                       func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter search.SearchFilter) int
+		ServiceAccount               func(childComplexity int, id ident.Ident) int
+		ServiceAccounts              func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		Team                         func(childComplexity int, slug slug.Slug) int
+		Teams                        func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *team.TeamOrder, filter *team.TeamFilter) int
+		TeamsUtilization             func(childComplexity int, resourceType utilization.UtilizationResourceType) int
+		UnleashReleaseChannels       func(childComplexity int) int
+		User                         func(childComplexity int, email *string) int
+		UserSyncLog                  func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		Users                        func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *user.UserOrder) int
+		VulnerabilityFixHistory      func(childComplexity int, from scalar.Date) int
+		VulnerabilitySummary         func(childComplexity int) int
 	}
 
 	Reconciler struct {
-		ActivityLog func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int
-		Config      func(childComplexity int) int
-		Configured  func(childComplexity int) int
-		Description func(childComplexity int) int
-		DisplayName func(childComplexity int) int
-		Enabled     func(childComplexity int) int
-		Errors      func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
-		ID          func(childComplexity int) int
-		Name        func(childComplexity int) int
+		ActivityLog    func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int
+		Config         func(childComplexity int) int
+		Configured     func(childComplexity int) int
+		Description    func(childComplexity int) int
+		DisplayName    func(childComplexity int) int
+		Enabled        func(childComplexity int) int
+		Errors         func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		ID             func(childComplexity int) int
+		Name           func(childComplexity int) int
+		StateRevisions func(childComplexity int, teamSlug slug.Slug, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
 	}
 
 	ReconcilerConfig struct {
@@ -2010,14 +2426,40 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	ReconcilerStateRevision struct {
+		Base64Encoded func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		Revision      func(childComplexity int) int
+		Value         func(childComplexity int) int
+	}
+
+	ReconcilerStateRevisionConnection struct {
+		Edges    func(childComplexity int) int
+		Nodes    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	ReconcilerStateRevisionEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	RemoveConfigValuePayload struct {
 		Config func(childComplexity int) int
 	}
 
+	RemoveIssueAcknowledgementPayload struct {
+		Issue func(childComplexity int) int
+	}
+
 	RemoveRepositoryFromTeamPayload struct {
 		Success func(childComplexity int) int
 	}
 
+	RemoveSecretExternalSourcePayload struct {
+		Secret func(childComplexity int) int
+	}
+
 	RemoveSecretValuePayload struct {
 		Secret func(childComplexity int) int
 	}
@@ -2071,6 +2513,10 @@ type ComplexityRoot struct {
 		TeamSlug        func(childComplexity int) int
 	}
 
+	RequestSecretAccessPayload struct {
+		AccessRequest func(childComplexity int) int
+	}
+
 	RequestTeamDeletionPayload struct {
 		Key func(childComplexity int) int
 	}
@@ -2167,6 +2613,10 @@ type ComplexityRoot struct {
 		UserName  func(childComplexity int) int
 	}
 
+	RollbackSecretPayload struct {
+		Secret func(childComplexity int) int
+	}
+
 	SearchNodeConnection struct {
 		Edges    func(childComplexity int) int
 		Nodes    func(childComplexity int) int
@@ -2179,18 +2629,120 @@ type ComplexityRoot struct {
 	}
 
 	Secret struct {
-		ActivityLog     func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int
-		Applications    func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		AccessRequests        func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		ActivityLog           func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) int
+		Applications          func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		ExternalSource        func(childComplexity int) int
+		ID                    func(childComplexity int) int
+		Jobs                  func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		Keys                  func(childComplexity int) int
+		Labels                func(childComplexity int) int
+		LastModifiedAt        func(childComplexity int) int
+		LastModifiedBy        func(childComplexity int) int
+		Name                  func(childComplexity int) int
+		Team                  func(childComplexity int) int
+		TeamEnvironment       func(childComplexity int) int
+		ValuesRequireApproval func(childComplexity int) int
+		Versions              func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		Workloads             func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+	}
+
+	SecretAccessApprovedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
 		ID              func(childComplexity int) int
-		Jobs            func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
-		Keys            func(childComplexity int) int
-		Labels          func(childComplexity int) int
-		LastModifiedAt  func(childComplexity int) int
-		LastModifiedBy  func(childComplexity int) int
-		Name            func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	SecretAccessApprovedActivityLogEntryData struct {
+		AccessExpiresAt func(childComplexity int) int
+		Comment         func(childComplexity int) int
+		RequestID       func(childComplexity int) int
+		Requester       func(childComplexity int) int
+	}
+
+	SecretAccessDeniedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	SecretAccessDeniedActivityLogEntryData struct {
+		Comment   func(childComplexity int) int
+		RequestID func(childComplexity int) int
+		Requester func(childComplexity int) int
+	}
+
+	SecretAccessExpiredActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	SecretAccessExpiredActivityLogEntryData struct {
+		RequestID   func(childComplexity int) int
+		Requester   func(childComplexity int) int
+		WasApproved func(childComplexity int) int
+	}
+
+	SecretAccessRequest struct {
+		AccessExpiresAt func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		HandledAt       func(childComplexity int) int
+		HandledBy       func(childComplexity int) int
+		HandlerComment  func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Reason          func(childComplexity int) int
+		Requester       func(childComplexity int) int
+		SecretName      func(childComplexity int) int
+		State           func(childComplexity int) int
 		Team            func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
-		Workloads       func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+	}
+
+	SecretAccessRequestConnection struct {
+		Edges    func(childComplexity int) int
+		Nodes    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	SecretAccessRequestEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	SecretAccessRequestedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	SecretAccessRequestedActivityLogEntryData struct {
+		Reason    func(childComplexity int) int
+		RequestID func(childComplexity int) int
 	}
 
 	SecretConnection struct {
@@ -2227,12 +2779,69 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	SecretExternalSource struct {
+		LastSyncedAt func(childComplexity int) int
+		Reference    func(childComplexity int) int
+		Source       func(childComplexity int) int
+		SyncError    func(childComplexity int) int
+		SyncStatus   func(childComplexity int) int
+	}
+
+	SecretExternalSourceRemovedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	SecretExternalSourceRemovedActivityLogEntryData struct {
+		Source func(childComplexity int) int
+	}
+
+	SecretExternalSourceSetActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	SecretExternalSourceSetActivityLogEntryData struct {
+		Reference func(childComplexity int) int
+		Source    func(childComplexity int) int
+	}
+
 	SecretFacets struct {
 		Environments func(childComplexity int) int
 		InUse        func(childComplexity int) int
 		Labels       func(childComplexity int) int
 	}
 
+	SecretRolledBackActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	SecretRolledBackActivityLogEntryData struct {
+		Version func(childComplexity int) int
+	}
+
 	SecretUpdatedActivityLogEntry struct {
 		Actor           func(childComplexity int) int
 		CreatedAt       func(childComplexity int) int
@@ -2325,6 +2934,24 @@ type ComplexityRoot struct {
 		Reason func(childComplexity int) int
 	}
 
+	SecretVersion struct {
+		ChangedKeys func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		CreatedBy   func(childComplexity int) int
+		Version     func(childComplexity int) int
+	}
+
+	SecretVersionConnection struct {
+		Edges    func(childComplexity int) int
+		Nodes    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	SecretVersionEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	ServiceAccount struct {
 		CreatedAt        func(childComplexity int) int
 		Description      func(childComplexity int) int
@@ -2424,6 +3051,39 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	ServiceAccountTokenExpiringIssue struct {
+		Acknowledgement     func(childComplexity int) int
+		FirstSeen           func(childComplexity int) int
+		ID                  func(childComplexity int) int
+		LastSeen            func(childComplexity int) int
+		Message             func(childComplexity int) int
+		ServiceAccount      func(childComplexity int) int
+		ServiceAccountToken func(childComplexity int) int
+		Severity            func(childComplexity int) int
+	}
+
+	ServiceAccountTokenIssueConnection struct {
+		Edges    func(childComplexity int) int
+		Nodes    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	ServiceAccountTokenIssueEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	ServiceAccountTokenUnusedIssue struct {
+		Acknowledgement     func(childComplexity int) int
+		FirstSeen           func(childComplexity int) int
+		ID                  func(childComplexity int) int
+		LastSeen            func(childComplexity int) int
+		Message             func(childComplexity int) int
+		ServiceAccount      func(childComplexity int) int
+		ServiceAccountToken func(childComplexity int) int
+		Severity            func(childComplexity int) int
+	}
+
 	ServiceAccountTokenUpdatedActivityLogEntry struct {
 		Actor           func(childComplexity int) int
 		CreatedAt       func(childComplexity int) int
@@ -2547,10 +3207,26 @@ type ComplexityRoot struct {
 		TeamSlug        func(childComplexity int) int
 	}
 
+	SetSecretExternalSourcePayload struct {
+		Secret func(childComplexity int) int
+	}
+
+	SetTeamMemberCustomRolePayload struct {
+		Member func(childComplexity int) int
+	}
+
+	SetTeamMemberEnvironmentsPayload struct {
+		Member func(childComplexity int) int
+	}
+
 	SetTeamMemberRolePayload struct {
 		Member func(childComplexity int) int
 	}
 
+	SnoozeIssuePayload struct {
+		Issue func(childComplexity int) int
+	}
+
 	SqlDatabase struct {
 		Charset         func(childComplexity int) int
 		Collation       func(childComplexity int) int
@@ -2659,7 +3335,10 @@ type ComplexityRoot struct {
 	}
 
 	SqlInstanceStateIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		SQLInstance     func(childComplexity int) int
 		Severity        func(childComplexity int) int
@@ -2689,13 +3368,44 @@ type ComplexityRoot struct {
 	}
 
 	SqlInstanceVersionIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		SQLInstance     func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
 	}
 
+	StaleAivenCredentialIssue struct {
+		Acknowledgement func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		CreatedBy       func(childComplexity int) int
+		ExpiresAt       func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
+		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
+		Message         func(childComplexity int) int
+		Name            func(childComplexity int) int
+		Service         func(childComplexity int) int
+		Severity        func(childComplexity int) int
+		TeamEnvironment func(childComplexity int) int
+	}
+
+	StaleSecretIssue struct {
+		Acknowledgement func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
+		ID              func(childComplexity int) int
+		LastModifiedAt  func(childComplexity int) int
+		LastModifiedBy  func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
+		Message         func(childComplexity int) int
+		Secret          func(childComplexity int) int
+		Severity        func(childComplexity int) int
+		TeamEnvironment func(childComplexity int) int
+	}
+
 	StartOpenSearchMaintenancePayload struct {
 		Error func(childComplexity int) int
 	}
@@ -2710,6 +3420,7 @@ type ComplexityRoot struct {
 	}
 
 	Subscription struct {
+		ActivityLog func(childComplexity int, filter *activitylog.ActivityLogFilter) int
 		Log         func(childComplexity int, filter loki.LogSubscriptionFilter) int
 		WorkloadLog func(childComplexity int, filter podlog.WorkloadLogSubscriptionFilter) int
 	}
@@ -2722,16 +3433,21 @@ type ComplexityRoot struct {
 		Buckets                   func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *bucket.BucketOrder, filter *bucket.BucketFilter) int
 		Configs                   func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *config.ConfigOrder, filter *config.ConfigFilter) int
 		Cost                      func(childComplexity int) int
+		CustomRoles               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
 		DeleteKey                 func(childComplexity int, key string) int
 		DeletionInProgress        func(childComplexity int) int
+		DeploymentFreezes         func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, environmentName *string) int
 		DeploymentKey             func(childComplexity int) int
 		Deployments               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
+		DoraMetrics               func(childComplexity int, window *deployment.DoraMetricsWindowInput, environmentName *string) int
 		Environment               func(childComplexity int, name string) int
 		Environments              func(childComplexity int) int
 		ExternalResources         func(childComplexity int) int
 		ID                        func(childComplexity int) int
 		ImageVulnerabilityHistory func(childComplexity int, from scalar.Date) int
 		InventoryCounts           func(childComplexity int) int
+		IssueHistory              func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *issue.IssueHistoryFilter) int
+		IssueSuppressionRules     func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
 		Issues                    func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *issue.IssueOrder, filter *issue.IssueFilter) int
 		Jobs                      func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *job.JobOrder, filter *job.TeamJobsFilter) int
 		KafkaTopics               func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *kafkatopic.KafkaTopicOrder, filter *kafkatopic.KafkaTopicFilter) int
@@ -2743,7 +3459,9 @@ type ComplexityRoot struct {
 		Purpose                   func(childComplexity int) int
 		Repositories              func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *repository.RepositoryOrder, filter *repository.TeamRepositoryFilter) int
 		SQLInstances              func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *sqlinstance.SQLInstanceOrder, filter *sqlinstance.SQLInstanceFilter) int
+		SecretAccessRequests      func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, state *secret.SecretAccessRequestState) int
 		Secrets                   func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *secret.SecretOrder, filter *secret.SecretFilter) int
+		ServiceAccountTokenIssues func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
 		ServiceAccounts           func(childComplexity int, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) int
 		ServiceUtilization        func(childComplexity int) int
 		SlackChannel              func(childComplexity int) int
@@ -2822,6 +3540,56 @@ type ComplexityRoot struct {
 		TeamSlug        func(childComplexity int) int
 	}
 
+	TeamCustomRoleCreatedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	TeamCustomRoleCreatedActivityLogEntryData struct {
+		Authorizations func(childComplexity int) int
+		Name           func(childComplexity int) int
+	}
+
+	TeamCustomRoleDeletedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	TeamCustomRoleDeletedActivityLogEntryData struct {
+		Name func(childComplexity int) int
+	}
+
+	TeamCustomRoleUpdatedActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	TeamCustomRoleUpdatedActivityLogEntryData struct {
+		Authorizations func(childComplexity int) int
+		Name           func(childComplexity int) int
+	}
+
 	TeamDeleteKey struct {
 		CreatedAt func(childComplexity int) int
 		CreatedBy func(childComplexity int) int
@@ -2991,9 +3759,11 @@ type ComplexityRoot struct {
 	}
 
 	TeamMember struct {
-		Role func(childComplexity int) int
-		Team func(childComplexity int) int
-		User func(childComplexity int) int
+		CustomRole   func(childComplexity int) int
+		Environments func(childComplexity int) int
+		Role         func(childComplexity int) int
+		Team         func(childComplexity int) int
+		User         func(childComplexity int) int
 	}
 
 	TeamMemberAddedActivityLogEntry struct {
@@ -3042,6 +3812,42 @@ type ComplexityRoot struct {
 		UserID    func(childComplexity int) int
 	}
 
+	TeamMemberSetCustomRoleActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	TeamMemberSetCustomRoleActivityLogEntryData struct {
+		CustomRoleName func(childComplexity int) int
+		UserEmail      func(childComplexity int) int
+		UserID         func(childComplexity int) int
+	}
+
+	TeamMemberSetEnvironmentsActivityLogEntry struct {
+		Actor           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Data            func(childComplexity int) int
+		EnvironmentName func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Message         func(childComplexity int) int
+		ResourceName    func(childComplexity int) int
+		ResourceType    func(childComplexity int) int
+		TeamSlug        func(childComplexity int) int
+	}
+
+	TeamMemberSetEnvironmentsActivityLogEntryData struct {
+		Environments func(childComplexity int) int
+		UserEmail    func(childComplexity int) int
+		UserID       func(childComplexity int) int
+	}
+
 	TeamMemberSetRoleActivityLogEntry struct {
 		Actor           func(childComplexity int) int
 		CreatedAt       func(childComplexity int) int
@@ -3270,9 +4076,12 @@ type ComplexityRoot struct {
 	}
 
 	UnleashReleaseChannelIssue struct {
+		Acknowledgement     func(childComplexity int) int
 		ChannelName         func(childComplexity int) int
 		CurrentMajorVersion func(childComplexity int) int
+		FirstSeen           func(childComplexity int) int
 		ID                  func(childComplexity int) int
+		LastSeen            func(childComplexity int) int
 		MajorVersion        func(childComplexity int) int
 		Message             func(childComplexity int) int
 		Severity            func(childComplexity int) int
@@ -3292,6 +4101,10 @@ type ComplexityRoot struct {
 		Config func(childComplexity int) int
 	}
 
+	UpdateCustomTeamRolePayload struct {
+		CustomRole func(childComplexity int) int
+	}
+
 	UpdateImageVulnerabilityPayload struct {
 		Vulnerability func(childComplexity int) int
 	}
@@ -3494,8 +4307,11 @@ type ComplexityRoot struct {
 	}
 
 	ValkeyIssue struct {
+		Acknowledgement func(childComplexity int) int
 		Event           func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		Severity        func(childComplexity int) int
 		TeamEnvironment func(childComplexity int) int
@@ -3591,8 +4407,11 @@ type ComplexityRoot struct {
 	}
 
 	VulnerableImageIssue struct {
+		Acknowledgement func(childComplexity int) int
 		Critical        func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		RiskScore       func(childComplexity int) int
 		Severity        func(childComplexity int) int
@@ -3640,8 +4459,11 @@ type ComplexityRoot struct {
 	}
 
 	WorkloadProblemIssue struct {
+		Acknowledgement func(childComplexity int) int
 		EndOfLife       func(childComplexity int) int
+		FirstSeen       func(childComplexity int) int
 		ID              func(childComplexity int) int
+		LastSeen        func(childComplexity int) int
 		Message         func(childComplexity int) int
 		ProblemType     func(childComplexity int) int
 		Severity        func(childComplexity int) int
@@ -3727,6 +4549,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AcknowledgeIssuePayload.issue":
+		if e.ComplexityRoot.AcknowledgeIssuePayload.Issue == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AcknowledgeIssuePayload.Issue(childComplexity), true
+
 	case "ActivityLogActivityTypeFacetItem.activityType":
 		if e.ComplexityRoot.ActivityLogActivityTypeFacetItem.ActivityType == nil {
 			break
@@ -3937,6 +4766,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AllowTeamAccessToUnleashPayload.Unleash(childComplexity), true
 
+	case "AllowedResource.apiVersion":
+		if e.ComplexityRoot.AllowedResource.APIVersion == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AllowedResource.APIVersion(childComplexity), true
+
+	case "AllowedResource.kind":
+		if e.ComplexityRoot.AllowedResource.Kind == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AllowedResource.Kind(childComplexity), true
+
 	case "Application.activityLog":
 		if e.ComplexityRoot.Application.ActivityLog == nil {
 			break
@@ -4018,6 +4861,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Application.Deployments(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
 
+	case "Application.doraMetrics":
+		if e.ComplexityRoot.Application.DoraMetrics == nil {
+			break
+		}
+
+		args, err := ec.field_Application_doraMetrics_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Application.DoraMetrics(childComplexity, args["window"].(*deployment.DoraMetricsWindowInput)), true
+
 	case "Application.history":
 		if e.ComplexityRoot.Application.History == nil {
 			break
@@ -4607,6 +5462,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ApplicationResources.Scaling(childComplexity), true
 
+	case "ApplicationRestartLoopIssue.acknowledgement":
+		if e.ComplexityRoot.ApplicationRestartLoopIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ApplicationRestartLoopIssue.Acknowledgement(childComplexity), true
+
+	case "ApplicationRestartLoopIssue.firstSeen":
+		if e.ComplexityRoot.ApplicationRestartLoopIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ApplicationRestartLoopIssue.FirstSeen(childComplexity), true
+
 	case "ApplicationRestartLoopIssue.id":
 		if e.ComplexityRoot.ApplicationRestartLoopIssue.ID == nil {
 			break
@@ -4628,6 +5497,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ApplicationRestartLoopIssue.LastExitTimestamp(childComplexity), true
 
+	case "ApplicationRestartLoopIssue.lastSeen":
+		if e.ComplexityRoot.ApplicationRestartLoopIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ApplicationRestartLoopIssue.LastSeen(childComplexity), true
+
 	case "ApplicationRestartLoopIssue.message":
 		if e.ComplexityRoot.ApplicationRestartLoopIssue.Message == nil {
 			break
@@ -4908,6 +5784,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ApplicationUpdatedActivityLogEntryData.GitHubActorClaims(childComplexity), true
 
+	case "ApproveSecretAccessRequestPayload.accessRequest":
+		if e.ComplexityRoot.ApproveSecretAccessRequestPayload.AccessRequest == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ApproveSecretAccessRequestPayload.AccessRequest(childComplexity), true
+
 	case "AssignRoleToServiceAccountPayload.serviceAccount":
 		if e.ComplexityRoot.AssignRoleToServiceAccountPayload.ServiceAccount == nil {
 			break
@@ -6010,6 +6893,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.CreateConfigPayload.Config(childComplexity), true
 
+	case "CreateCustomTeamRolePayload.customRole":
+		if e.ComplexityRoot.CreateCustomTeamRolePayload.CustomRole == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CreateCustomTeamRolePayload.CustomRole(childComplexity), true
+
+	case "CreateDeploymentFreezePayload.deploymentFreeze":
+		if e.ComplexityRoot.CreateDeploymentFreezePayload.DeploymentFreeze == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CreateDeploymentFreezePayload.DeploymentFreeze(childComplexity), true
+
+	case "CreateIssueSuppressionRulePayload.suppressionRule":
+		if e.ComplexityRoot.CreateIssueSuppressionRulePayload.SuppressionRule == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CreateIssueSuppressionRulePayload.SuppressionRule(childComplexity), true
+
 	case "CreateKafkaCredentialsPayload.credentials":
 		if e.ComplexityRoot.CreateKafkaCredentialsPayload.Credentials == nil {
 			break
@@ -6192,6 +7096,174 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.CurrentUnitPrices.Memory(childComplexity), true
 
+	case "CustomIssue.acknowledgement":
+		if e.ComplexityRoot.CustomIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.Acknowledgement(childComplexity), true
+
+	case "CustomIssue.checkName":
+		if e.ComplexityRoot.CustomIssue.CheckName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.CheckName(childComplexity), true
+
+	case "CustomIssue.firstSeen":
+		if e.ComplexityRoot.CustomIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.FirstSeen(childComplexity), true
+
+	case "CustomIssue.id":
+		if e.ComplexityRoot.CustomIssue.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.ID(childComplexity), true
+
+	case "CustomIssue.lastSeen":
+		if e.ComplexityRoot.CustomIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.LastSeen(childComplexity), true
+
+	case "CustomIssue.message":
+		if e.ComplexityRoot.CustomIssue.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.Message(childComplexity), true
+
+	case "CustomIssue.resourceName":
+		if e.ComplexityRoot.CustomIssue.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.ResourceName(childComplexity), true
+
+	case "CustomIssue.resourceType":
+		if e.ComplexityRoot.CustomIssue.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.ResourceType(childComplexity), true
+
+	case "CustomIssue.severity":
+		if e.ComplexityRoot.CustomIssue.Severity == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.Severity(childComplexity), true
+
+	case "CustomIssue.teamEnvironment":
+		if e.ComplexityRoot.CustomIssue.TeamEnvironment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomIssue.TeamEnvironment(childComplexity), true
+
+	case "CustomTeamRole.authorizations":
+		if e.ComplexityRoot.CustomTeamRole.Authorizations == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRole.Authorizations(childComplexity), true
+
+	case "CustomTeamRole.createdAt":
+		if e.ComplexityRoot.CustomTeamRole.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRole.CreatedAt(childComplexity), true
+
+	case "CustomTeamRole.description":
+		if e.ComplexityRoot.CustomTeamRole.Description == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRole.Description(childComplexity), true
+
+	case "CustomTeamRole.id":
+		if e.ComplexityRoot.CustomTeamRole.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRole.ID(childComplexity), true
+
+	case "CustomTeamRole.name":
+		if e.ComplexityRoot.CustomTeamRole.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRole.Name(childComplexity), true
+
+	case "CustomTeamRole.team":
+		if e.ComplexityRoot.CustomTeamRole.Team == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRole.Team(childComplexity), true
+
+	case "CustomTeamRole.updatedAt":
+		if e.ComplexityRoot.CustomTeamRole.UpdatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRole.UpdatedAt(childComplexity), true
+
+	case "CustomTeamRoleAuthorization.description":
+		if e.ComplexityRoot.CustomTeamRoleAuthorization.Description == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRoleAuthorization.Description(childComplexity), true
+
+	case "CustomTeamRoleAuthorization.name":
+		if e.ComplexityRoot.CustomTeamRoleAuthorization.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRoleAuthorization.Name(childComplexity), true
+
+	case "CustomTeamRoleConnection.edges":
+		if e.ComplexityRoot.CustomTeamRoleConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRoleConnection.Edges(childComplexity), true
+
+	case "CustomTeamRoleConnection.nodes":
+		if e.ComplexityRoot.CustomTeamRoleConnection.Nodes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRoleConnection.Nodes(childComplexity), true
+
+	case "CustomTeamRoleConnection.pageInfo":
+		if e.ComplexityRoot.CustomTeamRoleConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRoleConnection.PageInfo(childComplexity), true
+
+	case "CustomTeamRoleEdge.cursor":
+		if e.ComplexityRoot.CustomTeamRoleEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRoleEdge.Cursor(childComplexity), true
+
+	case "CustomTeamRoleEdge.node":
+		if e.ComplexityRoot.CustomTeamRoleEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CustomTeamRoleEdge.Node(childComplexity), true
+
 	case "DeleteApplicationPayload.success":
 		if e.ComplexityRoot.DeleteApplicationPayload.Success == nil {
 			break
@@ -6213,6 +7285,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeleteConfigPayload.ConfigDeleted(childComplexity), true
 
+	case "DeleteCustomTeamRolePayload.customRoleDeleted":
+		if e.ComplexityRoot.DeleteCustomTeamRolePayload.CustomRoleDeleted == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeleteCustomTeamRolePayload.CustomRoleDeleted(childComplexity), true
+
+	case "DeleteIssueSuppressionRulePayload.success":
+		if e.ComplexityRoot.DeleteIssueSuppressionRulePayload.Success == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeleteIssueSuppressionRulePayload.Success(childComplexity), true
+
 	case "DeleteJobPayload.success":
 		if e.ComplexityRoot.DeleteJobPayload.Success == nil {
 			break
@@ -6304,6 +7390,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeleteValkeyPayload.ValkeyDeleted(childComplexity), true
 
+	case "DenySecretAccessRequestPayload.accessRequest":
+		if e.ComplexityRoot.DenySecretAccessRequestPayload.AccessRequest == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DenySecretAccessRequestPayload.AccessRequest(childComplexity), true
+
 	case "Deployment.commitSha":
 		if e.ComplexityRoot.Deployment.CommitSha == nil {
 			break
@@ -6339,6 +7432,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Deployment.ID(childComplexity), true
 
+	case "Deployment.promotedFrom":
+		if e.ComplexityRoot.Deployment.PromotedFrom == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Deployment.PromotedFrom(childComplexity), true
+
 	case "Deployment.repository":
 		if e.ComplexityRoot.Deployment.Repository == nil {
 			break
@@ -6454,6 +7554,34 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeploymentActivityLogEntryData.TriggerURL(childComplexity), true
 
+	case "DeploymentCommitRange.compareURL":
+		if e.ComplexityRoot.DeploymentCommitRange.CompareURL == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentCommitRange.CompareURL(childComplexity), true
+
+	case "DeploymentCommitRange.fromCommitSha":
+		if e.ComplexityRoot.DeploymentCommitRange.FromCommitSha == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentCommitRange.FromCommitSha(childComplexity), true
+
+	case "DeploymentCommitRange.repository":
+		if e.ComplexityRoot.DeploymentCommitRange.Repository == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentCommitRange.Repository(childComplexity), true
+
+	case "DeploymentCommitRange.toCommitSha":
+		if e.ComplexityRoot.DeploymentCommitRange.ToCommitSha == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentCommitRange.ToCommitSha(childComplexity), true
+
 	case "DeploymentConnection.edges":
 		if e.ComplexityRoot.DeploymentConnection.Edges == nil {
 			break
@@ -6475,6 +7603,48 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeploymentConnection.PageInfo(childComplexity), true
 
+	case "DeploymentDiff.changedResourceKinds":
+		if e.ComplexityRoot.DeploymentDiff.ChangedResourceKinds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentDiff.ChangedResourceKinds(childComplexity), true
+
+	case "DeploymentDiff.commitRange":
+		if e.ComplexityRoot.DeploymentDiff.CommitRange == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentDiff.CommitRange(childComplexity), true
+
+	case "DeploymentDiff.from":
+		if e.ComplexityRoot.DeploymentDiff.From == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentDiff.From(childComplexity), true
+
+	case "DeploymentDiff.specAvailable":
+		if e.ComplexityRoot.DeploymentDiff.SpecAvailable == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentDiff.SpecAvailable(childComplexity), true
+
+	case "DeploymentDiff.specChanges":
+		if e.ComplexityRoot.DeploymentDiff.SpecChanges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentDiff.SpecChanges(childComplexity), true
+
+	case "DeploymentDiff.to":
+		if e.ComplexityRoot.DeploymentDiff.To == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentDiff.To(childComplexity), true
+
 	case "DeploymentEdge.cursor":
 		if e.ComplexityRoot.DeploymentEdge.Cursor == nil {
 			break
@@ -6489,6 +7659,356 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeploymentEdge.Node(childComplexity), true
 
+	case "DeploymentFreeze.active":
+		if e.ComplexityRoot.DeploymentFreeze.Active == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.Active(childComplexity), true
+
+	case "DeploymentFreeze.createdAt":
+		if e.ComplexityRoot.DeploymentFreeze.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.CreatedAt(childComplexity), true
+
+	case "DeploymentFreeze.createdBy":
+		if e.ComplexityRoot.DeploymentFreeze.CreatedBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.CreatedBy(childComplexity), true
+
+	case "DeploymentFreeze.endsAt":
+		if e.ComplexityRoot.DeploymentFreeze.EndsAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.EndsAt(childComplexity), true
+
+	case "DeploymentFreeze.id":
+		if e.ComplexityRoot.DeploymentFreeze.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.ID(childComplexity), true
+
+	case "DeploymentFreeze.overrides":
+		if e.ComplexityRoot.DeploymentFreeze.Overrides == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.Overrides(childComplexity), true
+
+	case "DeploymentFreeze.reason":
+		if e.ComplexityRoot.DeploymentFreeze.Reason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.Reason(childComplexity), true
+
+	case "DeploymentFreeze.startsAt":
+		if e.ComplexityRoot.DeploymentFreeze.StartsAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.StartsAt(childComplexity), true
+
+	case "DeploymentFreeze.team":
+		if e.ComplexityRoot.DeploymentFreeze.Team == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.Team(childComplexity), true
+
+	case "DeploymentFreeze.teamEnvironment":
+		if e.ComplexityRoot.DeploymentFreeze.TeamEnvironment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreeze.TeamEnvironment(childComplexity), true
+
+	case "DeploymentFreezeConnection.edges":
+		if e.ComplexityRoot.DeploymentFreezeConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeConnection.Edges(childComplexity), true
+
+	case "DeploymentFreezeConnection.nodes":
+		if e.ComplexityRoot.DeploymentFreezeConnection.Nodes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeConnection.Nodes(childComplexity), true
+
+	case "DeploymentFreezeConnection.pageInfo":
+		if e.ComplexityRoot.DeploymentFreezeConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeConnection.PageInfo(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.actor":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.Actor(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.data":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.Data(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.id":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.ID(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.message":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.Message(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntryData.endsAt":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntryData.EndsAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntryData.EndsAt(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntryData.reason":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntryData.Reason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntryData.Reason(childComplexity), true
+
+	case "DeploymentFreezeCreatedActivityLogEntryData.startsAt":
+		if e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntryData.StartsAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeCreatedActivityLogEntryData.StartsAt(childComplexity), true
+
+	case "DeploymentFreezeEdge.cursor":
+		if e.ComplexityRoot.DeploymentFreezeEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEdge.Cursor(childComplexity), true
+
+	case "DeploymentFreezeEdge.node":
+		if e.ComplexityRoot.DeploymentFreezeEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEdge.Node(childComplexity), true
+
+	case "DeploymentFreezeEndedActivityLogEntry.actor":
+		if e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.Actor(childComplexity), true
+
+	case "DeploymentFreezeEndedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "DeploymentFreezeEndedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "DeploymentFreezeEndedActivityLogEntry.id":
+		if e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.ID(childComplexity), true
+
+	case "DeploymentFreezeEndedActivityLogEntry.message":
+		if e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.Message(childComplexity), true
+
+	case "DeploymentFreezeEndedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "DeploymentFreezeEndedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "DeploymentFreezeEndedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeEndedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.actor":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.Actor(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.createdAt":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.data":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.Data(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.environmentName":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.id":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.ID(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.message":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.Message(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.resourceName":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.ResourceName(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.resourceType":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.ResourceType(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntryData.expiresAt":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntryData.ExpiresAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntryData.ExpiresAt(childComplexity), true
+
+	case "DeploymentFreezeOverriddenActivityLogEntryData.justification":
+		if e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntryData.Justification == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverriddenActivityLogEntryData.Justification(childComplexity), true
+
+	case "DeploymentFreezeOverride.createdAt":
+		if e.ComplexityRoot.DeploymentFreezeOverride.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverride.CreatedAt(childComplexity), true
+
+	case "DeploymentFreezeOverride.createdBy":
+		if e.ComplexityRoot.DeploymentFreezeOverride.CreatedBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverride.CreatedBy(childComplexity), true
+
+	case "DeploymentFreezeOverride.expiresAt":
+		if e.ComplexityRoot.DeploymentFreezeOverride.ExpiresAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverride.ExpiresAt(childComplexity), true
+
+	case "DeploymentFreezeOverride.justification":
+		if e.ComplexityRoot.DeploymentFreezeOverride.Justification == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeploymentFreezeOverride.Justification(childComplexity), true
+
 	case "DeploymentKey.created":
 		if e.ComplexityRoot.DeploymentKey.Created == nil {
 			break
@@ -6636,6 +8156,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeploymentStatusEdge.Node(childComplexity), true
 
+	case "DeprecatedIngressIssue.acknowledgement":
+		if e.ComplexityRoot.DeprecatedIngressIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeprecatedIngressIssue.Acknowledgement(childComplexity), true
+
 	case "DeprecatedIngressIssue.application":
 		if e.ComplexityRoot.DeprecatedIngressIssue.Application == nil {
 			break
@@ -6643,6 +8170,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeprecatedIngressIssue.Application(childComplexity), true
 
+	case "DeprecatedIngressIssue.firstSeen":
+		if e.ComplexityRoot.DeprecatedIngressIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeprecatedIngressIssue.FirstSeen(childComplexity), true
+
 	case "DeprecatedIngressIssue.id":
 		if e.ComplexityRoot.DeprecatedIngressIssue.ID == nil {
 			break
@@ -6657,6 +8191,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeprecatedIngressIssue.Ingresses(childComplexity), true
 
+	case "DeprecatedIngressIssue.lastSeen":
+		if e.ComplexityRoot.DeprecatedIngressIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeprecatedIngressIssue.LastSeen(childComplexity), true
+
 	case "DeprecatedIngressIssue.message":
 		if e.ComplexityRoot.DeprecatedIngressIssue.Message == nil {
 			break
@@ -6678,6 +8219,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeprecatedIngressIssue.TeamEnvironment(childComplexity), true
 
+	case "DeprecatedRegistryIssue.acknowledgement":
+		if e.ComplexityRoot.DeprecatedRegistryIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeprecatedRegistryIssue.Acknowledgement(childComplexity), true
+
+	case "DeprecatedRegistryIssue.firstSeen":
+		if e.ComplexityRoot.DeprecatedRegistryIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeprecatedRegistryIssue.FirstSeen(childComplexity), true
+
 	case "DeprecatedRegistryIssue.id":
 		if e.ComplexityRoot.DeprecatedRegistryIssue.ID == nil {
 			break
@@ -6685,6 +8240,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeprecatedRegistryIssue.ID(childComplexity), true
 
+	case "DeprecatedRegistryIssue.lastSeen":
+		if e.ComplexityRoot.DeprecatedRegistryIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DeprecatedRegistryIssue.LastSeen(childComplexity), true
+
 	case "DeprecatedRegistryIssue.message":
 		if e.ComplexityRoot.DeprecatedRegistryIssue.Message == nil {
 			break
@@ -6713,6 +8275,69 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DeprecatedRegistryIssue.Workload(childComplexity), true
 
+	case "DoraMetrics.changeFailureRate":
+		if e.ComplexityRoot.DoraMetrics.ChangeFailureRate == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DoraMetrics.ChangeFailureRate(childComplexity), true
+
+	case "DoraMetrics.deploymentFrequency":
+		if e.ComplexityRoot.DoraMetrics.DeploymentFrequency == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DoraMetrics.DeploymentFrequency(childComplexity), true
+
+	case "DoraMetrics.failedDeployments":
+		if e.ComplexityRoot.DoraMetrics.FailedDeployments == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DoraMetrics.FailedDeployments(childComplexity), true
+
+	case "DoraMetrics.from":
+		if e.ComplexityRoot.DoraMetrics.From == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DoraMetrics.From(childComplexity), true
+
+	case "DoraMetrics.leadTimeSeconds":
+		if e.ComplexityRoot.DoraMetrics.LeadTimeSeconds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DoraMetrics.LeadTimeSeconds(childComplexity), true
+
+	case "DoraMetrics.successfulDeployments":
+		if e.ComplexityRoot.DoraMetrics.SuccessfulDeployments == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DoraMetrics.SuccessfulDeployments(childComplexity), true
+
+	case "DoraMetrics.timeToRestoreSeconds":
+		if e.ComplexityRoot.DoraMetrics.TimeToRestoreSeconds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DoraMetrics.TimeToRestoreSeconds(childComplexity), true
+
+	case "DoraMetrics.to":
+		if e.ComplexityRoot.DoraMetrics.To == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DoraMetrics.To(childComplexity), true
+
+	case "EndDeploymentFreezePayload.deploymentFreeze":
+		if e.ComplexityRoot.EndDeploymentFreezePayload.DeploymentFreeze == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EndDeploymentFreezePayload.DeploymentFreeze(childComplexity), true
+
 	case "EntraIDAuthIntegration.name":
 		if e.ComplexityRoot.EntraIDAuthIntegration.Name == nil {
 			break
@@ -6720,6 +8345,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.EntraIDAuthIntegration.Name(childComplexity), true
 
+	case "Environment.allowedApplyResources":
+		if e.ComplexityRoot.Environment.AllowedApplyResources == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Environment.AllowedApplyResources(childComplexity), true
+
 	case "Environment.id":
 		if e.ComplexityRoot.Environment.ID == nil {
 			break
@@ -6800,6 +8432,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.EnvironmentEdge.Node(childComplexity), true
 
+	case "ExternalIngressCriticalVulnerabilityIssue.acknowledgement":
+		if e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.Acknowledgement(childComplexity), true
+
 	case "ExternalIngressCriticalVulnerabilityIssue.cvssScore":
 		if e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.CvssScore == nil {
 			break
@@ -6807,6 +8446,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.CvssScore(childComplexity), true
 
+	case "ExternalIngressCriticalVulnerabilityIssue.firstSeen":
+		if e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.FirstSeen(childComplexity), true
+
 	case "ExternalIngressCriticalVulnerabilityIssue.id":
 		if e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.ID == nil {
 			break
@@ -6821,6 +8467,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.Ingresses(childComplexity), true
 
+	case "ExternalIngressCriticalVulnerabilityIssue.lastSeen":
+		if e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.LastSeen(childComplexity), true
+
 	case "ExternalIngressCriticalVulnerabilityIssue.message":
 		if e.ComplexityRoot.ExternalIngressCriticalVulnerabilityIssue.Message == nil {
 			break
@@ -6877,6 +8530,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ExternalNetworkPolicyIpv4.Target(childComplexity), true
 
+	case "FailedSynchronizationIssue.acknowledgement":
+		if e.ComplexityRoot.FailedSynchronizationIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.FailedSynchronizationIssue.Acknowledgement(childComplexity), true
+
+	case "FailedSynchronizationIssue.firstSeen":
+		if e.ComplexityRoot.FailedSynchronizationIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.FailedSynchronizationIssue.FirstSeen(childComplexity), true
+
 	case "FailedSynchronizationIssue.id":
 		if e.ComplexityRoot.FailedSynchronizationIssue.ID == nil {
 			break
@@ -6884,6 +8551,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.FailedSynchronizationIssue.ID(childComplexity), true
 
+	case "FailedSynchronizationIssue.lastSeen":
+		if e.ComplexityRoot.FailedSynchronizationIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.FailedSynchronizationIssue.LastSeen(childComplexity), true
+
 	case "FailedSynchronizationIssue.message":
 		if e.ComplexityRoot.FailedSynchronizationIssue.Message == nil {
 			break
@@ -7582,6 +9256,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.InstanceGroupValueSource.Name(childComplexity), true
 
+	case "InvalidSpecIssue.acknowledgement":
+		if e.ComplexityRoot.InvalidSpecIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.InvalidSpecIssue.Acknowledgement(childComplexity), true
+
+	case "InvalidSpecIssue.firstSeen":
+		if e.ComplexityRoot.InvalidSpecIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.InvalidSpecIssue.FirstSeen(childComplexity), true
+
 	case "InvalidSpecIssue.id":
 		if e.ComplexityRoot.InvalidSpecIssue.ID == nil {
 			break
@@ -7589,6 +9277,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.InvalidSpecIssue.ID(childComplexity), true
 
+	case "InvalidSpecIssue.lastSeen":
+		if e.ComplexityRoot.InvalidSpecIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.InvalidSpecIssue.LastSeen(childComplexity), true
+
 	case "InvalidSpecIssue.message":
 		if e.ComplexityRoot.InvalidSpecIssue.Message == nil {
 			break
@@ -7617,6 +9312,202 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.InvalidSpecIssue.Workload(childComplexity), true
 
+	case "IssueAcknowledgedActivityLogEntry.actor":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.Actor(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntry.data":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.Data(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntry.id":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.ID(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntry.message":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.Message(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntryData.issueType":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntryData.IssueType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntryData.IssueType(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntryData.reason":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntryData.Reason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntryData.Reason(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntryData.resourceType":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntryData.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntryData.ResourceType(childComplexity), true
+
+	case "IssueAcknowledgedActivityLogEntryData.until":
+		if e.ComplexityRoot.IssueAcknowledgedActivityLogEntryData.Until == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgedActivityLogEntryData.Until(childComplexity), true
+
+	case "IssueAcknowledgement.actor":
+		if e.ComplexityRoot.IssueAcknowledgement.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgement.Actor(childComplexity), true
+
+	case "IssueAcknowledgement.createdAt":
+		if e.ComplexityRoot.IssueAcknowledgement.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgement.CreatedAt(childComplexity), true
+
+	case "IssueAcknowledgement.kind":
+		if e.ComplexityRoot.IssueAcknowledgement.Kind == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgement.Kind(childComplexity), true
+
+	case "IssueAcknowledgement.reason":
+		if e.ComplexityRoot.IssueAcknowledgement.Reason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgement.Reason(childComplexity), true
+
+	case "IssueAcknowledgement.suppressionRule":
+		if e.ComplexityRoot.IssueAcknowledgement.SuppressionRule == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgement.SuppressionRule(childComplexity), true
+
+	case "IssueAcknowledgement.until":
+		if e.ComplexityRoot.IssueAcknowledgement.Until == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgement.Until(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.actor":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.Actor(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.data":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.Data(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.id":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.ID(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.message":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.Message(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "IssueAcknowledgementRemovedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueAcknowledgementRemovedActivityLogEntry.TeamSlug(childComplexity), true
+
 	case "IssueConnection.edges":
 		if e.ComplexityRoot.IssueConnection.Edges == nil {
 			break
@@ -7687,6 +9578,118 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.IssueFacets.Severities(childComplexity), true
 
+	case "IssueHistoryConnection.edges":
+		if e.ComplexityRoot.IssueHistoryConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryConnection.Edges(childComplexity), true
+
+	case "IssueHistoryConnection.meanTimeToResolve":
+		if e.ComplexityRoot.IssueHistoryConnection.MeanTimeToResolve == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryConnection.MeanTimeToResolve(childComplexity), true
+
+	case "IssueHistoryConnection.nodes":
+		if e.ComplexityRoot.IssueHistoryConnection.Nodes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryConnection.Nodes(childComplexity), true
+
+	case "IssueHistoryConnection.pageInfo":
+		if e.ComplexityRoot.IssueHistoryConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryConnection.PageInfo(childComplexity), true
+
+	case "IssueHistoryEdge.cursor":
+		if e.ComplexityRoot.IssueHistoryEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEdge.Cursor(childComplexity), true
+
+	case "IssueHistoryEdge.node":
+		if e.ComplexityRoot.IssueHistoryEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEdge.Node(childComplexity), true
+
+	case "IssueHistoryEntry.firstSeen":
+		if e.ComplexityRoot.IssueHistoryEntry.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.FirstSeen(childComplexity), true
+
+	case "IssueHistoryEntry.issue":
+		if e.ComplexityRoot.IssueHistoryEntry.Issue == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.Issue(childComplexity), true
+
+	case "IssueHistoryEntry.issueType":
+		if e.ComplexityRoot.IssueHistoryEntry.IssueType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.IssueType(childComplexity), true
+
+	case "IssueHistoryEntry.lastSeen":
+		if e.ComplexityRoot.IssueHistoryEntry.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.LastSeen(childComplexity), true
+
+	case "IssueHistoryEntry.message":
+		if e.ComplexityRoot.IssueHistoryEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.Message(childComplexity), true
+
+	case "IssueHistoryEntry.resolvedAt":
+		if e.ComplexityRoot.IssueHistoryEntry.ResolvedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.ResolvedAt(childComplexity), true
+
+	case "IssueHistoryEntry.resourceName":
+		if e.ComplexityRoot.IssueHistoryEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.ResourceName(childComplexity), true
+
+	case "IssueHistoryEntry.resourceType":
+		if e.ComplexityRoot.IssueHistoryEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.ResourceType(childComplexity), true
+
+	case "IssueHistoryEntry.severity":
+		if e.ComplexityRoot.IssueHistoryEntry.Severity == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.Severity(childComplexity), true
+
+	case "IssueHistoryEntry.teamEnvironment":
+		if e.ComplexityRoot.IssueHistoryEntry.TeamEnvironment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueHistoryEntry.TeamEnvironment(childComplexity), true
+
 	case "IssueResourceTypeFacetItem.count":
 		if e.ComplexityRoot.IssueResourceTypeFacetItem.Count == nil {
 			break
@@ -7715,6 +9718,237 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.IssueSeverityFacetItem.Severity(childComplexity), true
 
+	case "IssueSuppressionRule.actor":
+		if e.ComplexityRoot.IssueSuppressionRule.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRule.Actor(childComplexity), true
+
+	case "IssueSuppressionRule.createdAt":
+		if e.ComplexityRoot.IssueSuppressionRule.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRule.CreatedAt(childComplexity), true
+
+	case "IssueSuppressionRule.id":
+		if e.ComplexityRoot.IssueSuppressionRule.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRule.ID(childComplexity), true
+
+	case "IssueSuppressionRule.issueType":
+		if e.ComplexityRoot.IssueSuppressionRule.IssueType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRule.IssueType(childComplexity), true
+
+	case "IssueSuppressionRule.reason":
+		if e.ComplexityRoot.IssueSuppressionRule.Reason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRule.Reason(childComplexity), true
+
+	case "IssueSuppressionRule.resourceNamePattern":
+		if e.ComplexityRoot.IssueSuppressionRule.ResourceNamePattern == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRule.ResourceNamePattern(childComplexity), true
+
+	case "IssueSuppressionRule.team":
+		if e.ComplexityRoot.IssueSuppressionRule.Team == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRule.Team(childComplexity), true
+
+	case "IssueSuppressionRuleActivityLogEntryData.issueType":
+		if e.ComplexityRoot.IssueSuppressionRuleActivityLogEntryData.IssueType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleActivityLogEntryData.IssueType(childComplexity), true
+
+	case "IssueSuppressionRuleActivityLogEntryData.reason":
+		if e.ComplexityRoot.IssueSuppressionRuleActivityLogEntryData.Reason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleActivityLogEntryData.Reason(childComplexity), true
+
+	case "IssueSuppressionRuleActivityLogEntryData.resourceNamePattern":
+		if e.ComplexityRoot.IssueSuppressionRuleActivityLogEntryData.ResourceNamePattern == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleActivityLogEntryData.ResourceNamePattern(childComplexity), true
+
+	case "IssueSuppressionRuleConnection.edges":
+		if e.ComplexityRoot.IssueSuppressionRuleConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleConnection.Edges(childComplexity), true
+
+	case "IssueSuppressionRuleConnection.nodes":
+		if e.ComplexityRoot.IssueSuppressionRuleConnection.Nodes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleConnection.Nodes(childComplexity), true
+
+	case "IssueSuppressionRuleConnection.pageInfo":
+		if e.ComplexityRoot.IssueSuppressionRuleConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleConnection.PageInfo(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.actor":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.Actor(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.data":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.Data(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.id":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.ID(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.message":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.Message(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "IssueSuppressionRuleCreatedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleCreatedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.actor":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.Actor(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.data":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.Data(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.id":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.ID(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.message":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.Message(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "IssueSuppressionRuleDeletedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleDeletedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "IssueSuppressionRuleEdge.cursor":
+		if e.ComplexityRoot.IssueSuppressionRuleEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleEdge.Cursor(childComplexity), true
+
+	case "IssueSuppressionRuleEdge.node":
+		if e.ComplexityRoot.IssueSuppressionRuleEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueSuppressionRuleEdge.Node(childComplexity), true
+
 	case "IssueTypeFacetItem.count":
 		if e.ComplexityRoot.IssueTypeFacetItem.Count == nil {
 			break
@@ -7729,6 +9963,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.IssueTypeFacetItem.IssueType(childComplexity), true
 
+	case "IssueTypeMeanTimeToResolve.issueType":
+		if e.ComplexityRoot.IssueTypeMeanTimeToResolve.IssueType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueTypeMeanTimeToResolve.IssueType(childComplexity), true
+
+	case "IssueTypeMeanTimeToResolve.meanTimeToResolve":
+		if e.ComplexityRoot.IssueTypeMeanTimeToResolve.MeanTimeToResolve == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueTypeMeanTimeToResolve.MeanTimeToResolve(childComplexity), true
+
+	case "IssueTypeMeanTimeToResolve.resolvedCount":
+		if e.ComplexityRoot.IssueTypeMeanTimeToResolve.ResolvedCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.IssueTypeMeanTimeToResolve.ResolvedCount(childComplexity), true
+
 	case "Job.activityLog":
 		if e.ComplexityRoot.Job.ActivityLog == nil {
 			break
@@ -8978,6 +11233,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.LabelFacetItem.Value(childComplexity), true
 
+	case "LastRunFailedIssue.acknowledgement":
+		if e.ComplexityRoot.LastRunFailedIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.LastRunFailedIssue.Acknowledgement(childComplexity), true
+
+	case "LastRunFailedIssue.firstSeen":
+		if e.ComplexityRoot.LastRunFailedIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.LastRunFailedIssue.FirstSeen(childComplexity), true
+
 	case "LastRunFailedIssue.id":
 		if e.ComplexityRoot.LastRunFailedIssue.ID == nil {
 			break
@@ -8992,6 +11261,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.LastRunFailedIssue.Job(childComplexity), true
 
+	case "LastRunFailedIssue.lastSeen":
+		if e.ComplexityRoot.LastRunFailedIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.LastRunFailedIssue.LastSeen(childComplexity), true
+
 	case "LastRunFailedIssue.message":
 		if e.ComplexityRoot.LastRunFailedIssue.Message == nil {
 			break
@@ -9104,6 +11380,83 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.MaskinportenAuthIntegration.Name(childComplexity), true
 
+	case "MemoryLimitReachedIssue.acknowledgement":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.Acknowledgement(childComplexity), true
+
+	case "MemoryLimitReachedIssue.firstSeen":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.FirstSeen(childComplexity), true
+
+	case "MemoryLimitReachedIssue.id":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.ID(childComplexity), true
+
+	case "MemoryLimitReachedIssue.lastSeen":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.LastSeen(childComplexity), true
+
+	case "MemoryLimitReachedIssue.memoryLimitBytes":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.MemoryLimitBytes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.MemoryLimitBytes(childComplexity), true
+
+	case "MemoryLimitReachedIssue.message":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.Message(childComplexity), true
+
+	case "MemoryLimitReachedIssue.oomKillCount":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.OOMKillCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.OOMKillCount(childComplexity), true
+
+	case "MemoryLimitReachedIssue.recommendedMemoryLimitBytes":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.RecommendedMemoryLimitBytes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.RecommendedMemoryLimitBytes(childComplexity), true
+
+	case "MemoryLimitReachedIssue.severity":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.Severity == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.Severity(childComplexity), true
+
+	case "MemoryLimitReachedIssue.teamEnvironment":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.TeamEnvironment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.TeamEnvironment(childComplexity), true
+
+	case "MemoryLimitReachedIssue.workload":
+		if e.ComplexityRoot.MemoryLimitReachedIssue.Workload == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MemoryLimitReachedIssue.Workload(childComplexity), true
+
 	case "MetricLabel.name":
 		if e.ComplexityRoot.MetricLabel.Name == nil {
 			break
@@ -9160,6 +11513,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.MetricsQueryResult.Warnings(childComplexity), true
 
+	case "MissingSbomIssue.acknowledgement":
+		if e.ComplexityRoot.MissingSbomIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MissingSbomIssue.Acknowledgement(childComplexity), true
+
+	case "MissingSbomIssue.firstSeen":
+		if e.ComplexityRoot.MissingSbomIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MissingSbomIssue.FirstSeen(childComplexity), true
+
 	case "MissingSbomIssue.id":
 		if e.ComplexityRoot.MissingSbomIssue.ID == nil {
 			break
@@ -9167,6 +11534,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.MissingSbomIssue.ID(childComplexity), true
 
+	case "MissingSbomIssue.lastSeen":
+		if e.ComplexityRoot.MissingSbomIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.MissingSbomIssue.LastSeen(childComplexity), true
+
 	case "MissingSbomIssue.message":
 		if e.ComplexityRoot.MissingSbomIssue.Message == nil {
 			break
@@ -9195,6 +11569,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.MissingSbomIssue.Workload(childComplexity), true
 
+	case "Mutation.acknowledgeIssue":
+		if e.ComplexityRoot.Mutation.AcknowledgeIssue == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_acknowledgeIssue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.AcknowledgeIssue(childComplexity, args["input"].(issue.AcknowledgeIssueInput)), true
+
 	case "Mutation.addConfigValue":
 		if e.ComplexityRoot.Mutation.AddConfigValue == nil {
 			break
@@ -9267,6 +11653,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.AllowTeamAccessToUnleash(childComplexity, args["input"].(unleash.AllowTeamAccessToUnleashInput)), true
 
+	case "Mutation.approveSecretAccessRequest":
+		if e.ComplexityRoot.Mutation.ApproveSecretAccessRequest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveSecretAccessRequest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.ApproveSecretAccessRequest(childComplexity, args["input"].(secret.ApproveSecretAccessRequestInput)), true
+
 	case "Mutation.assignRoleToServiceAccount":
 		if e.ComplexityRoot.Mutation.AssignRoleToServiceAccount == nil {
 			break
@@ -9327,6 +11725,42 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.CreateConfig(childComplexity, args["input"].(config.CreateConfigInput)), true
 
+	case "Mutation.createCustomTeamRole":
+		if e.ComplexityRoot.Mutation.CreateCustomTeamRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createCustomTeamRole_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.CreateCustomTeamRole(childComplexity, args["input"].(team.CreateCustomTeamRoleInput)), true
+
+	case "Mutation.createDeploymentFreeze":
+		if e.ComplexityRoot.Mutation.CreateDeploymentFreeze == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createDeploymentFreeze_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.CreateDeploymentFreeze(childComplexity, args["input"].(freeze.CreateDeploymentFreezeInput)), true
+
+	case "Mutation.createIssueSuppressionRule":
+		if e.ComplexityRoot.Mutation.CreateIssueSuppressionRule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createIssueSuppressionRule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.CreateIssueSuppressionRule(childComplexity, args["input"].(issue.CreateIssueSuppressionRuleInput)), true
+
 	case "Mutation.createKafkaCredentials":
 		if e.ComplexityRoot.Mutation.CreateKafkaCredentials == nil {
 			break
@@ -9483,6 +11917,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.DeleteConfig(childComplexity, args["input"].(config.DeleteConfigInput)), true
 
+	case "Mutation.deleteCustomTeamRole":
+		if e.ComplexityRoot.Mutation.DeleteCustomTeamRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCustomTeamRole_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.DeleteCustomTeamRole(childComplexity, args["input"].(team.DeleteCustomTeamRoleInput)), true
+
+	case "Mutation.deleteIssueSuppressionRule":
+		if e.ComplexityRoot.Mutation.DeleteIssueSuppressionRule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteIssueSuppressionRule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.DeleteIssueSuppressionRule(childComplexity, args["input"].(issue.DeleteIssueSuppressionRuleInput)), true
+
 	case "Mutation.deleteJob":
 		if e.ComplexityRoot.Mutation.DeleteJob == nil {
 			break
@@ -9603,6 +12061,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.DeleteValkey(childComplexity, args["input"].(valkey.DeleteValkeyInput)), true
 
+	case "Mutation.denySecretAccessRequest":
+		if e.ComplexityRoot.Mutation.DenySecretAccessRequest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_denySecretAccessRequest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.DenySecretAccessRequest(childComplexity, args["input"].(secret.DenySecretAccessRequestInput)), true
+
 	case "Mutation.disableReconciler":
 		if e.ComplexityRoot.Mutation.DisableReconciler == nil {
 			break
@@ -9627,6 +12097,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.EnableReconciler(childComplexity, args["input"].(reconciler.EnableReconcilerInput)), true
 
+	case "Mutation.endDeploymentFreeze":
+		if e.ComplexityRoot.Mutation.EndDeploymentFreeze == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_endDeploymentFreeze_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.EndDeploymentFreeze(childComplexity, args["input"].(freeze.EndDeploymentFreezeInput)), true
+
 	case "Mutation.grantPostgresAccess":
 		if e.ComplexityRoot.Mutation.GrantPostgresAccess == nil {
 			break
@@ -9639,6 +12121,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.GrantPostgresAccess(childComplexity, args["input"].(postgres.GrantPostgresAccessInput)), true
 
+	case "Mutation.overrideDeploymentFreeze":
+		if e.ComplexityRoot.Mutation.OverrideDeploymentFreeze == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_overrideDeploymentFreeze_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.OverrideDeploymentFreeze(childComplexity, args["input"].(freeze.OverrideDeploymentFreezeInput)), true
+
+	case "Mutation.promoteWorkload":
+		if e.ComplexityRoot.Mutation.PromoteWorkload == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_promoteWorkload_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.PromoteWorkload(childComplexity, args["input"].(deployment.PromoteWorkloadInput)), true
+
 	case "Mutation.removeConfigValue":
 		if e.ComplexityRoot.Mutation.RemoveConfigValue == nil {
 			break
@@ -9651,6 +12157,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.RemoveConfigValue(childComplexity, args["input"].(config.RemoveConfigValueInput)), true
 
+	case "Mutation.removeIssueAcknowledgement":
+		if e.ComplexityRoot.Mutation.RemoveIssueAcknowledgement == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeIssueAcknowledgement_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.RemoveIssueAcknowledgement(childComplexity, args["input"].(issue.RemoveIssueAcknowledgementInput)), true
+
 	case "Mutation.removeRepositoryFromTeam":
 		if e.ComplexityRoot.Mutation.RemoveRepositoryFromTeam == nil {
 			break
@@ -9663,6 +12181,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.RemoveRepositoryFromTeam(childComplexity, args["input"].(repository.RemoveRepositoryFromTeamInput)), true
 
+	case "Mutation.removeSecretExternalSource":
+		if e.ComplexityRoot.Mutation.RemoveSecretExternalSource == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeSecretExternalSource_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.RemoveSecretExternalSource(childComplexity, args["input"].(secret.RemoveSecretExternalSourceInput)), true
+
 	case "Mutation.removeSecretValue":
 		if e.ComplexityRoot.Mutation.RemoveSecretValue == nil {
 			break
@@ -9699,6 +12229,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.RemoveWorkloadFromServiceAccount(childComplexity, args["input"].(serviceaccount.RemoveWorkloadFromServiceAccountInput)), true
 
+	case "Mutation.requestSecretAccess":
+		if e.ComplexityRoot.Mutation.RequestSecretAccess == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestSecretAccess_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.RequestSecretAccess(childComplexity, args["input"].(secret.RequestSecretAccessInput)), true
+
 	case "Mutation.requestTeamDeletion":
 		if e.ComplexityRoot.Mutation.RequestTeamDeletion == nil {
 			break
@@ -9747,6 +12289,54 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.RevokeTeamAccessToUnleash(childComplexity, args["input"].(unleash.RevokeTeamAccessToUnleashInput)), true
 
+	case "Mutation.rollbackSecret":
+		if e.ComplexityRoot.Mutation.RollbackSecret == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rollbackSecret_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.RollbackSecret(childComplexity, args["input"].(secret.RollbackSecretInput)), true
+
+	case "Mutation.setSecretExternalSource":
+		if e.ComplexityRoot.Mutation.SetSecretExternalSource == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setSecretExternalSource_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.SetSecretExternalSource(childComplexity, args["input"].(secret.SetSecretExternalSourceInput)), true
+
+	case "Mutation.setTeamMemberCustomRole":
+		if e.ComplexityRoot.Mutation.SetTeamMemberCustomRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setTeamMemberCustomRole_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.SetTeamMemberCustomRole(childComplexity, args["input"].(team.SetTeamMemberCustomRoleInput)), true
+
+	case "Mutation.setTeamMemberEnvironments":
+		if e.ComplexityRoot.Mutation.SetTeamMemberEnvironments == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setTeamMemberEnvironments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.SetTeamMemberEnvironments(childComplexity, args["input"].(team.SetTeamMemberEnvironmentsInput)), true
+
 	case "Mutation.setTeamMemberRole":
 		if e.ComplexityRoot.Mutation.SetTeamMemberRole == nil {
 			break
@@ -9759,6 +12349,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.SetTeamMemberRole(childComplexity, args["input"].(team.SetTeamMemberRoleInput)), true
 
+	case "Mutation.snoozeIssue":
+		if e.ComplexityRoot.Mutation.SnoozeIssue == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_snoozeIssue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.SnoozeIssue(childComplexity, args["input"].(issue.SnoozeIssueInput)), true
+
 	case "Mutation.startOpenSearchMaintenance":
 		if e.ComplexityRoot.Mutation.StartOpenSearchMaintenance == nil {
 			break
@@ -9831,6 +12433,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.UpdateConfigValue(childComplexity, args["input"].(config.UpdateConfigValueInput)), true
 
+	case "Mutation.updateCustomTeamRole":
+		if e.ComplexityRoot.Mutation.UpdateCustomTeamRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateCustomTeamRole_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.UpdateCustomTeamRole(childComplexity, args["input"].(team.UpdateCustomTeamRoleInput)), true
+
 	case "Mutation.updateImageVulnerability":
 		if e.ComplexityRoot.Mutation.UpdateImageVulnerability == nil {
 			break
@@ -10024,6 +12638,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.NetworkPolicyRule.TargetWorkloadName(childComplexity), true
 
+	case "NoRunningInstancesIssue.acknowledgement":
+		if e.ComplexityRoot.NoRunningInstancesIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.NoRunningInstancesIssue.Acknowledgement(childComplexity), true
+
+	case "NoRunningInstancesIssue.firstSeen":
+		if e.ComplexityRoot.NoRunningInstancesIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.NoRunningInstancesIssue.FirstSeen(childComplexity), true
+
 	case "NoRunningInstancesIssue.id":
 		if e.ComplexityRoot.NoRunningInstancesIssue.ID == nil {
 			break
@@ -10031,6 +12659,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.NoRunningInstancesIssue.ID(childComplexity), true
 
+	case "NoRunningInstancesIssue.lastSeen":
+		if e.ComplexityRoot.NoRunningInstancesIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.NoRunningInstancesIssue.LastSeen(childComplexity), true
+
 	case "NoRunningInstancesIssue.message":
 		if e.ComplexityRoot.NoRunningInstancesIssue.Message == nil {
 			break
@@ -10459,6 +13094,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.OpenSearchFacets.Tiers(childComplexity), true
 
+	case "OpenSearchIssue.acknowledgement":
+		if e.ComplexityRoot.OpenSearchIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OpenSearchIssue.Acknowledgement(childComplexity), true
+
 	case "OpenSearchIssue.event":
 		if e.ComplexityRoot.OpenSearchIssue.Event == nil {
 			break
@@ -10466,6 +13108,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.OpenSearchIssue.Event(childComplexity), true
 
+	case "OpenSearchIssue.firstSeen":
+		if e.ComplexityRoot.OpenSearchIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OpenSearchIssue.FirstSeen(childComplexity), true
+
 	case "OpenSearchIssue.id":
 		if e.ComplexityRoot.OpenSearchIssue.ID == nil {
 			break
@@ -10473,6 +13122,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.OpenSearchIssue.ID(childComplexity), true
 
+	case "OpenSearchIssue.lastSeen":
+		if e.ComplexityRoot.OpenSearchIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OpenSearchIssue.LastSeen(childComplexity), true
+
 	case "OpenSearchIssue.message":
 		if e.ComplexityRoot.OpenSearchIssue.Message == nil {
 			break
@@ -10716,6 +13372,111 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.OutboundNetworkPolicy.Rules(childComplexity), true
 
+	case "OverprovisionedWorkloadIssue.acknowledgement":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.Acknowledgement(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.cpuPeakUsageCores":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.CPUPeakUsageCores == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.CPUPeakUsageCores(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.cpuRequestCores":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.CPURequestCores == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.CPURequestCores(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.firstSeen":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.FirstSeen(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.id":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.ID(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.lastSeen":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.LastSeen(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.memoryPeakUsageBytes":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.MemoryPeakUsageBytes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.MemoryPeakUsageBytes(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.memoryRequestBytes":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.MemoryRequestBytes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.MemoryRequestBytes(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.message":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.Message(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.recommendedCpuRequestCores":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.RecommendedCPURequestCores == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.RecommendedCPURequestCores(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.recommendedMemoryRequestBytes":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.RecommendedMemoryRequestBytes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.RecommendedMemoryRequestBytes(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.severity":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.Severity == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.Severity(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.teamEnvironment":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.TeamEnvironment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.TeamEnvironment(childComplexity), true
+
+	case "OverprovisionedWorkloadIssue.workload":
+		if e.ComplexityRoot.OverprovisionedWorkloadIssue.Workload == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverprovisionedWorkloadIssue.Workload(childComplexity), true
+
+	case "OverrideDeploymentFreezePayload.deploymentFreeze":
+		if e.ComplexityRoot.OverrideDeploymentFreezePayload.DeploymentFreeze == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OverrideDeploymentFreezePayload.DeploymentFreeze(childComplexity), true
+
 	case "PageInfo.endCursor":
 		if e.ComplexityRoot.PageInfo.EndCursor == nil {
 			break
@@ -11246,6 +14007,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.PrometheusAlert.TeamEnvironment(childComplexity), true
 
+	case "PromoteWorkloadPayload.deployment":
+		if e.ComplexityRoot.PromoteWorkloadPayload.Deployment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.PromoteWorkloadPayload.Deployment(childComplexity), true
+
 	case "Query.activityLog":
 		if e.ComplexityRoot.Query.ActivityLog == nil {
 			break
@@ -11289,6 +14057,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Query.CurrentUnitPrices(childComplexity), true
 
+	case "Query.customTeamRoleAuthorizations":
+		if e.ComplexityRoot.Query.CustomTeamRoleAuthorizations == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.CustomTeamRoleAuthorizations(childComplexity), true
+
 	case "Query.cves":
 		if e.ComplexityRoot.Query.Cves == nil {
 			break
@@ -11301,6 +14076,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Query.Cves(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor), args["orderBy"].(*vulnerability.CVEOrder)), true
 
+	case "Query.deploymentDiff":
+		if e.ComplexityRoot.Query.DeploymentDiff == nil {
+			break
+		}
+
+		args, err := ec.field_Query_deploymentDiff_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.DeploymentDiff(childComplexity, args["fromDeploymentID"].(ident.Ident), args["toDeploymentID"].(ident.Ident)), true
+
 	case "Query.deployments":
 		if e.ComplexityRoot.Query.Deployments == nil {
 			break
@@ -11606,6 +14393,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Reconciler.Name(childComplexity), true
 
+	case "Reconciler.stateRevisions":
+		if e.ComplexityRoot.Reconciler.StateRevisions == nil {
+			break
+		}
+
+		args, err := ec.field_Reconciler_stateRevisions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Reconciler.StateRevisions(childComplexity, args["teamSlug"].(slug.Slug), args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
+
 	case "ReconcilerConfig.configured":
 		if e.ComplexityRoot.ReconcilerConfig.Configured == nil {
 			break
@@ -11935,6 +14734,69 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ReconcilerErrorEdge.Node(childComplexity), true
 
+	case "ReconcilerStateRevision.base64Encoded":
+		if e.ComplexityRoot.ReconcilerStateRevision.Base64Encoded == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevision.Base64Encoded(childComplexity), true
+
+	case "ReconcilerStateRevision.createdAt":
+		if e.ComplexityRoot.ReconcilerStateRevision.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevision.CreatedAt(childComplexity), true
+
+	case "ReconcilerStateRevision.revision":
+		if e.ComplexityRoot.ReconcilerStateRevision.Revision == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevision.Revision(childComplexity), true
+
+	case "ReconcilerStateRevision.value":
+		if e.ComplexityRoot.ReconcilerStateRevision.Value == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevision.Value(childComplexity), true
+
+	case "ReconcilerStateRevisionConnection.edges":
+		if e.ComplexityRoot.ReconcilerStateRevisionConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevisionConnection.Edges(childComplexity), true
+
+	case "ReconcilerStateRevisionConnection.nodes":
+		if e.ComplexityRoot.ReconcilerStateRevisionConnection.Nodes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevisionConnection.Nodes(childComplexity), true
+
+	case "ReconcilerStateRevisionConnection.pageInfo":
+		if e.ComplexityRoot.ReconcilerStateRevisionConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevisionConnection.PageInfo(childComplexity), true
+
+	case "ReconcilerStateRevisionEdge.cursor":
+		if e.ComplexityRoot.ReconcilerStateRevisionEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevisionEdge.Cursor(childComplexity), true
+
+	case "ReconcilerStateRevisionEdge.node":
+		if e.ComplexityRoot.ReconcilerStateRevisionEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ReconcilerStateRevisionEdge.Node(childComplexity), true
+
 	case "RemoveConfigValuePayload.config":
 		if e.ComplexityRoot.RemoveConfigValuePayload.Config == nil {
 			break
@@ -11942,6 +14804,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.RemoveConfigValuePayload.Config(childComplexity), true
 
+	case "RemoveIssueAcknowledgementPayload.issue":
+		if e.ComplexityRoot.RemoveIssueAcknowledgementPayload.Issue == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RemoveIssueAcknowledgementPayload.Issue(childComplexity), true
+
 	case "RemoveRepositoryFromTeamPayload.success":
 		if e.ComplexityRoot.RemoveRepositoryFromTeamPayload.Success == nil {
 			break
@@ -11949,6 +14818,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.RemoveRepositoryFromTeamPayload.Success(childComplexity), true
 
+	case "RemoveSecretExternalSourcePayload.secret":
+		if e.ComplexityRoot.RemoveSecretExternalSourcePayload.Secret == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RemoveSecretExternalSourcePayload.Secret(childComplexity), true
+
 	case "RemoveSecretValuePayload.secret":
 		if e.ComplexityRoot.RemoveSecretValuePayload.Secret == nil {
 			break
@@ -12152,6 +15028,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.RepositoryRemovedActivityLogEntry.TeamSlug(childComplexity), true
 
+	case "RequestSecretAccessPayload.accessRequest":
+		if e.ComplexityRoot.RequestSecretAccessPayload.AccessRequest == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RequestSecretAccessPayload.AccessRequest(childComplexity), true
+
 	case "RequestTeamDeletionPayload.key":
 		if e.ComplexityRoot.RequestTeamDeletionPayload.Key == nil {
 			break
@@ -12509,6 +15392,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.RoleRevokedUserSyncLogEntry.UserName(childComplexity), true
 
+	case "RollbackSecretPayload.secret":
+		if e.ComplexityRoot.RollbackSecretPayload.Secret == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RollbackSecretPayload.Secret(childComplexity), true
+
 	case "SearchNodeConnection.edges":
 		if e.ComplexityRoot.SearchNodeConnection.Edges == nil {
 			break
@@ -12544,6 +15434,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SearchNodeEdge.Node(childComplexity), true
 
+	case "Secret.accessRequests":
+		if e.ComplexityRoot.Secret.AccessRequests == nil {
+			break
+		}
+
+		args, err := ec.field_Secret_accessRequests_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Secret.AccessRequests(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
+
 	case "Secret.activityLog":
 		if e.ComplexityRoot.Secret.ActivityLog == nil {
 			break
@@ -12568,6 +15470,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Secret.Applications(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
 
+	case "Secret.externalSource":
+		if e.ComplexityRoot.Secret.ExternalSource == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Secret.ExternalSource(childComplexity), true
+
 	case "Secret.id":
 		if e.ComplexityRoot.Secret.ID == nil {
 			break
@@ -12636,6 +15545,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Secret.TeamEnvironment(childComplexity), true
 
+	case "Secret.valuesRequireApproval":
+		if e.ComplexityRoot.Secret.ValuesRequireApproval == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Secret.ValuesRequireApproval(childComplexity), true
+
+	case "Secret.versions":
+		if e.ComplexityRoot.Secret.Versions == nil {
+			break
+		}
+
+		args, err := ec.field_Secret_versions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Secret.Versions(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
+
 	case "Secret.workloads":
 		if e.ComplexityRoot.Secret.Workloads == nil {
 			break
@@ -12648,6 +15576,461 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Secret.Workloads(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
 
+	case "SecretAccessApprovedActivityLogEntry.actor":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.Actor(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntry.data":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.Data(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntry.id":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.ID(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntry.message":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.Message(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntryData.accessExpiresAt":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntryData.AccessExpiresAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntryData.AccessExpiresAt(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntryData.comment":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntryData.Comment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntryData.Comment(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntryData.requestID":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntryData.RequestID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntryData.RequestID(childComplexity), true
+
+	case "SecretAccessApprovedActivityLogEntryData.requester":
+		if e.ComplexityRoot.SecretAccessApprovedActivityLogEntryData.Requester == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessApprovedActivityLogEntryData.Requester(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.actor":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.Actor(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.data":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.Data(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.id":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.ID(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.message":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.Message(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntryData.comment":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntryData.Comment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntryData.Comment(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntryData.requestID":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntryData.RequestID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntryData.RequestID(childComplexity), true
+
+	case "SecretAccessDeniedActivityLogEntryData.requester":
+		if e.ComplexityRoot.SecretAccessDeniedActivityLogEntryData.Requester == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessDeniedActivityLogEntryData.Requester(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.actor":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.Actor(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.createdAt":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.data":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.Data(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.environmentName":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.id":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.ID(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.message":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.Message(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.resourceName":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.ResourceName(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.resourceType":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.ResourceType(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntryData.requestID":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntryData.RequestID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntryData.RequestID(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntryData.requester":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntryData.Requester == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntryData.Requester(childComplexity), true
+
+	case "SecretAccessExpiredActivityLogEntryData.wasApproved":
+		if e.ComplexityRoot.SecretAccessExpiredActivityLogEntryData.WasApproved == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessExpiredActivityLogEntryData.WasApproved(childComplexity), true
+
+	case "SecretAccessRequest.accessExpiresAt":
+		if e.ComplexityRoot.SecretAccessRequest.AccessExpiresAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.AccessExpiresAt(childComplexity), true
+
+	case "SecretAccessRequest.createdAt":
+		if e.ComplexityRoot.SecretAccessRequest.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.CreatedAt(childComplexity), true
+
+	case "SecretAccessRequest.handledAt":
+		if e.ComplexityRoot.SecretAccessRequest.HandledAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.HandledAt(childComplexity), true
+
+	case "SecretAccessRequest.handledBy":
+		if e.ComplexityRoot.SecretAccessRequest.HandledBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.HandledBy(childComplexity), true
+
+	case "SecretAccessRequest.handlerComment":
+		if e.ComplexityRoot.SecretAccessRequest.HandlerComment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.HandlerComment(childComplexity), true
+
+	case "SecretAccessRequest.id":
+		if e.ComplexityRoot.SecretAccessRequest.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.ID(childComplexity), true
+
+	case "SecretAccessRequest.reason":
+		if e.ComplexityRoot.SecretAccessRequest.Reason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.Reason(childComplexity), true
+
+	case "SecretAccessRequest.requester":
+		if e.ComplexityRoot.SecretAccessRequest.Requester == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.Requester(childComplexity), true
+
+	case "SecretAccessRequest.secretName":
+		if e.ComplexityRoot.SecretAccessRequest.SecretName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.SecretName(childComplexity), true
+
+	case "SecretAccessRequest.state":
+		if e.ComplexityRoot.SecretAccessRequest.State == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.State(childComplexity), true
+
+	case "SecretAccessRequest.team":
+		if e.ComplexityRoot.SecretAccessRequest.Team == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.Team(childComplexity), true
+
+	case "SecretAccessRequest.teamEnvironment":
+		if e.ComplexityRoot.SecretAccessRequest.TeamEnvironment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequest.TeamEnvironment(childComplexity), true
+
+	case "SecretAccessRequestConnection.edges":
+		if e.ComplexityRoot.SecretAccessRequestConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestConnection.Edges(childComplexity), true
+
+	case "SecretAccessRequestConnection.nodes":
+		if e.ComplexityRoot.SecretAccessRequestConnection.Nodes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestConnection.Nodes(childComplexity), true
+
+	case "SecretAccessRequestConnection.pageInfo":
+		if e.ComplexityRoot.SecretAccessRequestConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestConnection.PageInfo(childComplexity), true
+
+	case "SecretAccessRequestEdge.cursor":
+		if e.ComplexityRoot.SecretAccessRequestEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestEdge.Cursor(childComplexity), true
+
+	case "SecretAccessRequestEdge.node":
+		if e.ComplexityRoot.SecretAccessRequestEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestEdge.Node(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.actor":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.Actor(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.data":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.Data(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.id":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.ID(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.message":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.Message(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntryData.reason":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntryData.Reason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntryData.Reason(childComplexity), true
+
+	case "SecretAccessRequestedActivityLogEntryData.requestID":
+		if e.ComplexityRoot.SecretAccessRequestedActivityLogEntryData.RequestID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretAccessRequestedActivityLogEntryData.RequestID(childComplexity), true
+
 	case "SecretConnection.edges":
 		if e.ComplexityRoot.SecretConnection.Edges == nil {
 			break
@@ -12802,6 +16185,188 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SecretEdge.Node(childComplexity), true
 
+	case "SecretExternalSource.lastSyncedAt":
+		if e.ComplexityRoot.SecretExternalSource.LastSyncedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSource.LastSyncedAt(childComplexity), true
+
+	case "SecretExternalSource.reference":
+		if e.ComplexityRoot.SecretExternalSource.Reference == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSource.Reference(childComplexity), true
+
+	case "SecretExternalSource.source":
+		if e.ComplexityRoot.SecretExternalSource.Source == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSource.Source(childComplexity), true
+
+	case "SecretExternalSource.syncError":
+		if e.ComplexityRoot.SecretExternalSource.SyncError == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSource.SyncError(childComplexity), true
+
+	case "SecretExternalSource.syncStatus":
+		if e.ComplexityRoot.SecretExternalSource.SyncStatus == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSource.SyncStatus(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.actor":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.Actor(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.data":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.Data(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.id":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.ID(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.message":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.Message(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "SecretExternalSourceRemovedActivityLogEntryData.source":
+		if e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntryData.Source == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceRemovedActivityLogEntryData.Source(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.actor":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.Actor(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.createdAt":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.data":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.Data(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.environmentName":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.id":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.ID(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.message":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.Message(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.resourceName":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.ResourceName(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.resourceType":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.ResourceType(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntryData.reference":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntryData.Reference == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntryData.Reference(childComplexity), true
+
+	case "SecretExternalSourceSetActivityLogEntryData.source":
+		if e.ComplexityRoot.SecretExternalSourceSetActivityLogEntryData.Source == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretExternalSourceSetActivityLogEntryData.Source(childComplexity), true
+
 	case "SecretFacets.environments":
 		if e.ComplexityRoot.SecretFacets.Environments == nil {
 			break
@@ -12823,6 +16388,76 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SecretFacets.Labels(childComplexity), true
 
+	case "SecretRolledBackActivityLogEntry.actor":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.Actor(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntry.createdAt":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntry.data":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.Data(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntry.environmentName":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntry.id":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.ID(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntry.message":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.Message(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntry.resourceName":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.ResourceName(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntry.resourceType":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.ResourceType(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "SecretRolledBackActivityLogEntryData.version":
+		if e.ComplexityRoot.SecretRolledBackActivityLogEntryData.Version == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretRolledBackActivityLogEntryData.Version(childComplexity), true
+
 	case "SecretUpdatedActivityLogEntry.actor":
 		if e.ComplexityRoot.SecretUpdatedActivityLogEntry.Actor == nil {
 			break
@@ -13215,6 +16850,69 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SecretValuesViewedActivityLogEntryData.Reason(childComplexity), true
 
+	case "SecretVersion.changedKeys":
+		if e.ComplexityRoot.SecretVersion.ChangedKeys == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersion.ChangedKeys(childComplexity), true
+
+	case "SecretVersion.createdAt":
+		if e.ComplexityRoot.SecretVersion.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersion.CreatedAt(childComplexity), true
+
+	case "SecretVersion.createdBy":
+		if e.ComplexityRoot.SecretVersion.CreatedBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersion.CreatedBy(childComplexity), true
+
+	case "SecretVersion.version":
+		if e.ComplexityRoot.SecretVersion.Version == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersion.Version(childComplexity), true
+
+	case "SecretVersionConnection.edges":
+		if e.ComplexityRoot.SecretVersionConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersionConnection.Edges(childComplexity), true
+
+	case "SecretVersionConnection.nodes":
+		if e.ComplexityRoot.SecretVersionConnection.Nodes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersionConnection.Nodes(childComplexity), true
+
+	case "SecretVersionConnection.pageInfo":
+		if e.ComplexityRoot.SecretVersionConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersionConnection.PageInfo(childComplexity), true
+
+	case "SecretVersionEdge.cursor":
+		if e.ComplexityRoot.SecretVersionEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersionEdge.Cursor(childComplexity), true
+
+	case "SecretVersionEdge.node":
+		if e.ComplexityRoot.SecretVersionEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SecretVersionEdge.Node(childComplexity), true
+
 	case "ServiceAccount.createdAt":
 		if e.ComplexityRoot.ServiceAccount.CreatedAt == nil {
 			break
@@ -13671,6 +17369,153 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ServiceAccountTokenEdge.Node(childComplexity), true
 
+	case "ServiceAccountTokenExpiringIssue.acknowledgement":
+		if e.ComplexityRoot.ServiceAccountTokenExpiringIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenExpiringIssue.Acknowledgement(childComplexity), true
+
+	case "ServiceAccountTokenExpiringIssue.firstSeen":
+		if e.ComplexityRoot.ServiceAccountTokenExpiringIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenExpiringIssue.FirstSeen(childComplexity), true
+
+	case "ServiceAccountTokenExpiringIssue.id":
+		if e.ComplexityRoot.ServiceAccountTokenExpiringIssue.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenExpiringIssue.ID(childComplexity), true
+
+	case "ServiceAccountTokenExpiringIssue.lastSeen":
+		if e.ComplexityRoot.ServiceAccountTokenExpiringIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenExpiringIssue.LastSeen(childComplexity), true
+
+	case "ServiceAccountTokenExpiringIssue.message":
+		if e.ComplexityRoot.ServiceAccountTokenExpiringIssue.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenExpiringIssue.Message(childComplexity), true
+
+	case "ServiceAccountTokenExpiringIssue.serviceAccount":
+		if e.ComplexityRoot.ServiceAccountTokenExpiringIssue.ServiceAccount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenExpiringIssue.ServiceAccount(childComplexity), true
+
+	case "ServiceAccountTokenExpiringIssue.serviceAccountToken":
+		if e.ComplexityRoot.ServiceAccountTokenExpiringIssue.ServiceAccountToken == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenExpiringIssue.ServiceAccountToken(childComplexity), true
+
+	case "ServiceAccountTokenExpiringIssue.severity":
+		if e.ComplexityRoot.ServiceAccountTokenExpiringIssue.Severity == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenExpiringIssue.Severity(childComplexity), true
+
+	case "ServiceAccountTokenIssueConnection.edges":
+		if e.ComplexityRoot.ServiceAccountTokenIssueConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenIssueConnection.Edges(childComplexity), true
+
+	case "ServiceAccountTokenIssueConnection.nodes":
+		if e.ComplexityRoot.ServiceAccountTokenIssueConnection.Nodes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenIssueConnection.Nodes(childComplexity), true
+
+	case "ServiceAccountTokenIssueConnection.pageInfo":
+		if e.ComplexityRoot.ServiceAccountTokenIssueConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenIssueConnection.PageInfo(childComplexity), true
+
+	case "ServiceAccountTokenIssueEdge.cursor":
+		if e.ComplexityRoot.ServiceAccountTokenIssueEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenIssueEdge.Cursor(childComplexity), true
+
+	case "ServiceAccountTokenIssueEdge.node":
+		if e.ComplexityRoot.ServiceAccountTokenIssueEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenIssueEdge.Node(childComplexity), true
+
+	case "ServiceAccountTokenUnusedIssue.acknowledgement":
+		if e.ComplexityRoot.ServiceAccountTokenUnusedIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenUnusedIssue.Acknowledgement(childComplexity), true
+
+	case "ServiceAccountTokenUnusedIssue.firstSeen":
+		if e.ComplexityRoot.ServiceAccountTokenUnusedIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenUnusedIssue.FirstSeen(childComplexity), true
+
+	case "ServiceAccountTokenUnusedIssue.id":
+		if e.ComplexityRoot.ServiceAccountTokenUnusedIssue.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenUnusedIssue.ID(childComplexity), true
+
+	case "ServiceAccountTokenUnusedIssue.lastSeen":
+		if e.ComplexityRoot.ServiceAccountTokenUnusedIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenUnusedIssue.LastSeen(childComplexity), true
+
+	case "ServiceAccountTokenUnusedIssue.message":
+		if e.ComplexityRoot.ServiceAccountTokenUnusedIssue.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenUnusedIssue.Message(childComplexity), true
+
+	case "ServiceAccountTokenUnusedIssue.serviceAccount":
+		if e.ComplexityRoot.ServiceAccountTokenUnusedIssue.ServiceAccount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenUnusedIssue.ServiceAccount(childComplexity), true
+
+	case "ServiceAccountTokenUnusedIssue.serviceAccountToken":
+		if e.ComplexityRoot.ServiceAccountTokenUnusedIssue.ServiceAccountToken == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenUnusedIssue.ServiceAccountToken(childComplexity), true
+
+	case "ServiceAccountTokenUnusedIssue.severity":
+		if e.ComplexityRoot.ServiceAccountTokenUnusedIssue.Severity == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ServiceAccountTokenUnusedIssue.Severity(childComplexity), true
+
 	case "ServiceAccountTokenUpdatedActivityLogEntry.actor":
 		if e.ComplexityRoot.ServiceAccountTokenUpdatedActivityLogEntry.Actor == nil {
 			break
@@ -14196,6 +18041,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ServiceMaintenanceActivityLogEntry.TeamSlug(childComplexity), true
 
+	case "SetSecretExternalSourcePayload.secret":
+		if e.ComplexityRoot.SetSecretExternalSourcePayload.Secret == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SetSecretExternalSourcePayload.Secret(childComplexity), true
+
+	case "SetTeamMemberCustomRolePayload.member":
+		if e.ComplexityRoot.SetTeamMemberCustomRolePayload.Member == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SetTeamMemberCustomRolePayload.Member(childComplexity), true
+
+	case "SetTeamMemberEnvironmentsPayload.member":
+		if e.ComplexityRoot.SetTeamMemberEnvironmentsPayload.Member == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SetTeamMemberEnvironmentsPayload.Member(childComplexity), true
+
 	case "SetTeamMemberRolePayload.member":
 		if e.ComplexityRoot.SetTeamMemberRolePayload.Member == nil {
 			break
@@ -14203,6 +18069,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SetTeamMemberRolePayload.Member(childComplexity), true
 
+	case "SnoozeIssuePayload.issue":
+		if e.ComplexityRoot.SnoozeIssuePayload.Issue == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SnoozeIssuePayload.Issue(childComplexity), true
+
 	case "SqlDatabase.charset":
 		if e.ComplexityRoot.SqlDatabase.Charset == nil {
 			break
@@ -14673,6 +18546,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SqlInstanceMetrics.Memory(childComplexity), true
 
+	case "SqlInstanceStateIssue.acknowledgement":
+		if e.ComplexityRoot.SqlInstanceStateIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SqlInstanceStateIssue.Acknowledgement(childComplexity), true
+
+	case "SqlInstanceStateIssue.firstSeen":
+		if e.ComplexityRoot.SqlInstanceStateIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SqlInstanceStateIssue.FirstSeen(childComplexity), true
+
 	case "SqlInstanceStateIssue.id":
 		if e.ComplexityRoot.SqlInstanceStateIssue.ID == nil {
 			break
@@ -14680,6 +18567,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SqlInstanceStateIssue.ID(childComplexity), true
 
+	case "SqlInstanceStateIssue.lastSeen":
+		if e.ComplexityRoot.SqlInstanceStateIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SqlInstanceStateIssue.LastSeen(childComplexity), true
+
 	case "SqlInstanceStateIssue.message":
 		if e.ComplexityRoot.SqlInstanceStateIssue.Message == nil {
 			break
@@ -14778,6 +18672,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SqlInstanceUserEdge.Node(childComplexity), true
 
+	case "SqlInstanceVersionIssue.acknowledgement":
+		if e.ComplexityRoot.SqlInstanceVersionIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SqlInstanceVersionIssue.Acknowledgement(childComplexity), true
+
+	case "SqlInstanceVersionIssue.firstSeen":
+		if e.ComplexityRoot.SqlInstanceVersionIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SqlInstanceVersionIssue.FirstSeen(childComplexity), true
+
 	case "SqlInstanceVersionIssue.id":
 		if e.ComplexityRoot.SqlInstanceVersionIssue.ID == nil {
 			break
@@ -14785,6 +18693,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SqlInstanceVersionIssue.ID(childComplexity), true
 
+	case "SqlInstanceVersionIssue.lastSeen":
+		if e.ComplexityRoot.SqlInstanceVersionIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SqlInstanceVersionIssue.LastSeen(childComplexity), true
+
 	case "SqlInstanceVersionIssue.message":
 		if e.ComplexityRoot.SqlInstanceVersionIssue.Message == nil {
 			break
@@ -14813,6 +18728,160 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SqlInstanceVersionIssue.TeamEnvironment(childComplexity), true
 
+	case "StaleAivenCredentialIssue.acknowledgement":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.Acknowledgement(childComplexity), true
+
+	case "StaleAivenCredentialIssue.createdAt":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.CreatedAt(childComplexity), true
+
+	case "StaleAivenCredentialIssue.createdBy":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.CreatedBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.CreatedBy(childComplexity), true
+
+	case "StaleAivenCredentialIssue.expiresAt":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.ExpiresAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.ExpiresAt(childComplexity), true
+
+	case "StaleAivenCredentialIssue.firstSeen":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.FirstSeen(childComplexity), true
+
+	case "StaleAivenCredentialIssue.id":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.ID(childComplexity), true
+
+	case "StaleAivenCredentialIssue.lastSeen":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.LastSeen(childComplexity), true
+
+	case "StaleAivenCredentialIssue.message":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.Message(childComplexity), true
+
+	case "StaleAivenCredentialIssue.name":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.Name(childComplexity), true
+
+	case "StaleAivenCredentialIssue.service":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.Service == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.Service(childComplexity), true
+
+	case "StaleAivenCredentialIssue.severity":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.Severity == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.Severity(childComplexity), true
+
+	case "StaleAivenCredentialIssue.teamEnvironment":
+		if e.ComplexityRoot.StaleAivenCredentialIssue.TeamEnvironment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleAivenCredentialIssue.TeamEnvironment(childComplexity), true
+
+	case "StaleSecretIssue.acknowledgement":
+		if e.ComplexityRoot.StaleSecretIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.Acknowledgement(childComplexity), true
+
+	case "StaleSecretIssue.firstSeen":
+		if e.ComplexityRoot.StaleSecretIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.FirstSeen(childComplexity), true
+
+	case "StaleSecretIssue.id":
+		if e.ComplexityRoot.StaleSecretIssue.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.ID(childComplexity), true
+
+	case "StaleSecretIssue.lastModifiedAt":
+		if e.ComplexityRoot.StaleSecretIssue.LastModifiedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.LastModifiedAt(childComplexity), true
+
+	case "StaleSecretIssue.lastModifiedBy":
+		if e.ComplexityRoot.StaleSecretIssue.LastModifiedBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.LastModifiedBy(childComplexity), true
+
+	case "StaleSecretIssue.lastSeen":
+		if e.ComplexityRoot.StaleSecretIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.LastSeen(childComplexity), true
+
+	case "StaleSecretIssue.message":
+		if e.ComplexityRoot.StaleSecretIssue.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.Message(childComplexity), true
+
+	case "StaleSecretIssue.secret":
+		if e.ComplexityRoot.StaleSecretIssue.Secret == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.Secret(childComplexity), true
+
+	case "StaleSecretIssue.severity":
+		if e.ComplexityRoot.StaleSecretIssue.Severity == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.Severity(childComplexity), true
+
+	case "StaleSecretIssue.teamEnvironment":
+		if e.ComplexityRoot.StaleSecretIssue.TeamEnvironment == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StaleSecretIssue.TeamEnvironment(childComplexity), true
+
 	case "StartOpenSearchMaintenancePayload.error":
 		if e.ComplexityRoot.StartOpenSearchMaintenancePayload.Error == nil {
 			break
@@ -14841,6 +18910,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.StringFacetItem.Value(childComplexity), true
 
+	case "Subscription.activityLog":
+		if e.ComplexityRoot.Subscription.ActivityLog == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_activityLog_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Subscription.ActivityLog(childComplexity, args["filter"].(*activitylog.ActivityLogFilter)), true
+
 	case "Subscription.log":
 		if e.ComplexityRoot.Subscription.Log == nil {
 			break
@@ -14944,6 +19025,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Team.Cost(childComplexity), true
 
+	case "Team.customRoles":
+		if e.ComplexityRoot.Team.CustomRoles == nil {
+			break
+		}
+
+		args, err := ec.field_Team_customRoles_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Team.CustomRoles(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
+
 	case "Team.deleteKey":
 		if e.ComplexityRoot.Team.DeleteKey == nil {
 			break
@@ -14963,6 +19056,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Team.DeletionInProgress(childComplexity), true
 
+	case "Team.deploymentFreezes":
+		if e.ComplexityRoot.Team.DeploymentFreezes == nil {
+			break
+		}
+
+		args, err := ec.field_Team_deploymentFreezes_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Team.DeploymentFreezes(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor), args["environmentName"].(*string)), true
+
 	case "Team.deploymentKey":
 		if e.ComplexityRoot.Team.DeploymentKey == nil {
 			break
@@ -14982,6 +19087,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Team.Deployments(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
 
+	case "Team.doraMetrics":
+		if e.ComplexityRoot.Team.DoraMetrics == nil {
+			break
+		}
+
+		args, err := ec.field_Team_doraMetrics_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Team.DoraMetrics(childComplexity, args["window"].(*deployment.DoraMetricsWindowInput), args["environmentName"].(*string)), true
+
 	case "Team.environment":
 		if e.ComplexityRoot.Team.Environment == nil {
 			break
@@ -15034,6 +19151,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Team.InventoryCounts(childComplexity), true
 
+	case "Team.issueHistory":
+		if e.ComplexityRoot.Team.IssueHistory == nil {
+			break
+		}
+
+		args, err := ec.field_Team_issueHistory_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Team.IssueHistory(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor), args["filter"].(*issue.IssueHistoryFilter)), true
+
+	case "Team.issueSuppressionRules":
+		if e.ComplexityRoot.Team.IssueSuppressionRules == nil {
+			break
+		}
+
+		args, err := ec.field_Team_issueSuppressionRules_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Team.IssueSuppressionRules(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
+
 	case "Team.issues":
 		if e.ComplexityRoot.Team.Issues == nil {
 			break
@@ -15156,6 +19297,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Team.SQLInstances(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor), args["orderBy"].(*sqlinstance.SQLInstanceOrder), args["filter"].(*sqlinstance.SQLInstanceFilter)), true
 
+	case "Team.secretAccessRequests":
+		if e.ComplexityRoot.Team.SecretAccessRequests == nil {
+			break
+		}
+
+		args, err := ec.field_Team_secretAccessRequests_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Team.SecretAccessRequests(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor), args["state"].(*secret.SecretAccessRequestState)), true
+
 	case "Team.secrets":
 		if e.ComplexityRoot.Team.Secrets == nil {
 			break
@@ -15168,6 +19321,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Team.Secrets(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor), args["orderBy"].(*secret.SecretOrder), args["filter"].(*secret.SecretFilter)), true
 
+	case "Team.serviceAccountTokenIssues":
+		if e.ComplexityRoot.Team.ServiceAccountTokenIssues == nil {
+			break
+		}
+
+		args, err := ec.field_Team_serviceAccountTokenIssues_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Team.ServiceAccountTokenIssues(childComplexity, args["first"].(*int), args["after"].(*pagination.Cursor), args["last"].(*int), args["before"].(*pagination.Cursor)), true
+
 	case "Team.serviceAccounts":
 		if e.ComplexityRoot.Team.ServiceAccounts == nil {
 			break
@@ -15551,6 +19716,230 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.TeamCreatedActivityLogEntry.TeamSlug(childComplexity), true
 
+	case "TeamCustomRoleCreatedActivityLogEntry.actor":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.Actor(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntry.data":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.Data(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntry.id":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.ID(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntry.message":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.Message(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntryData.authorizations":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntryData.Authorizations == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntryData.Authorizations(childComplexity), true
+
+	case "TeamCustomRoleCreatedActivityLogEntryData.name":
+		if e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntryData.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleCreatedActivityLogEntryData.Name(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.actor":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.Actor(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.data":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.Data(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.id":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.ID(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.message":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.Message(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "TeamCustomRoleDeletedActivityLogEntryData.name":
+		if e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntryData.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleDeletedActivityLogEntryData.Name(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.actor":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.Actor(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.createdAt":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.data":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.Data(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.environmentName":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.id":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.ID(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.message":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.Message(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.resourceName":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.ResourceName(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.resourceType":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.ResourceType(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntryData.authorizations":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntryData.Authorizations == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntryData.Authorizations(childComplexity), true
+
+	case "TeamCustomRoleUpdatedActivityLogEntryData.name":
+		if e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntryData.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamCustomRoleUpdatedActivityLogEntryData.Name(childComplexity), true
+
 	case "TeamDeleteKey.createdAt":
 		if e.ComplexityRoot.TeamDeleteKey.CreatedAt == nil {
 			break
@@ -16256,6 +20645,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.TeamInventoryCounts.Valkeys(childComplexity), true
 
+	case "TeamMember.customRole":
+		if e.ComplexityRoot.TeamMember.CustomRole == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMember.CustomRole(childComplexity), true
+
+	case "TeamMember.environments":
+		if e.ComplexityRoot.TeamMember.Environments == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMember.Environments(childComplexity), true
+
 	case "TeamMember.role":
 		if e.ComplexityRoot.TeamMember.Role == nil {
 			break
@@ -16473,6 +20876,174 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.TeamMemberRemovedActivityLogEntryData.UserID(childComplexity), true
 
+	case "TeamMemberSetCustomRoleActivityLogEntry.actor":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.Actor(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntry.createdAt":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntry.data":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.Data(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntry.environmentName":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntry.id":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.ID(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntry.message":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.Message(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntry.resourceName":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.ResourceName(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntry.resourceType":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.ResourceType(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntryData.customRoleName":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntryData.CustomRoleName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntryData.CustomRoleName(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntryData.userEmail":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntryData.UserEmail == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntryData.UserEmail(childComplexity), true
+
+	case "TeamMemberSetCustomRoleActivityLogEntryData.userID":
+		if e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntryData.UserID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetCustomRoleActivityLogEntryData.UserID(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.actor":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.Actor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.Actor(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.createdAt":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.CreatedAt(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.data":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.Data == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.Data(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.environmentName":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.EnvironmentName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.EnvironmentName(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.id":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.ID(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.message":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.Message == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.Message(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.resourceName":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.ResourceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.ResourceName(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.resourceType":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.ResourceType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.ResourceType(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntry.teamSlug":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.TeamSlug == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntry.TeamSlug(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntryData.environments":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntryData.Environments == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntryData.Environments(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntryData.userEmail":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntryData.UserEmail == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntryData.UserEmail(childComplexity), true
+
+	case "TeamMemberSetEnvironmentsActivityLogEntryData.userID":
+		if e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntryData.UserID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TeamMemberSetEnvironmentsActivityLogEntryData.UserID(childComplexity), true
+
 	case "TeamMemberSetRoleActivityLogEntry.actor":
 		if e.ComplexityRoot.TeamMemberSetRoleActivityLogEntry.Actor == nil {
 			break
@@ -17479,6 +22050,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.UnleashReleaseChannel.Type(childComplexity), true
 
+	case "UnleashReleaseChannelIssue.acknowledgement":
+		if e.ComplexityRoot.UnleashReleaseChannelIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnleashReleaseChannelIssue.Acknowledgement(childComplexity), true
+
 	case "UnleashReleaseChannelIssue.channelName":
 		if e.ComplexityRoot.UnleashReleaseChannelIssue.ChannelName == nil {
 			break
@@ -17493,6 +22071,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.UnleashReleaseChannelIssue.CurrentMajorVersion(childComplexity), true
 
+	case "UnleashReleaseChannelIssue.firstSeen":
+		if e.ComplexityRoot.UnleashReleaseChannelIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnleashReleaseChannelIssue.FirstSeen(childComplexity), true
+
 	case "UnleashReleaseChannelIssue.id":
 		if e.ComplexityRoot.UnleashReleaseChannelIssue.ID == nil {
 			break
@@ -17500,6 +22085,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.UnleashReleaseChannelIssue.ID(childComplexity), true
 
+	case "UnleashReleaseChannelIssue.lastSeen":
+		if e.ComplexityRoot.UnleashReleaseChannelIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnleashReleaseChannelIssue.LastSeen(childComplexity), true
+
 	case "UnleashReleaseChannelIssue.majorVersion":
 		if e.ComplexityRoot.UnleashReleaseChannelIssue.MajorVersion == nil {
 			break
@@ -17556,6 +22148,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.UpdateConfigValuePayload.Config(childComplexity), true
 
+	case "UpdateCustomTeamRolePayload.customRole":
+		if e.ComplexityRoot.UpdateCustomTeamRolePayload.CustomRole == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UpdateCustomTeamRolePayload.CustomRole(childComplexity), true
+
 	case "UpdateImageVulnerabilityPayload.vulnerability":
 		if e.ComplexityRoot.UpdateImageVulnerabilityPayload.Vulnerability == nil {
 			break
@@ -18332,6 +22931,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ValkeyFacets.Tiers(childComplexity), true
 
+	case "ValkeyIssue.acknowledgement":
+		if e.ComplexityRoot.ValkeyIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ValkeyIssue.Acknowledgement(childComplexity), true
+
 	case "ValkeyIssue.event":
 		if e.ComplexityRoot.ValkeyIssue.Event == nil {
 			break
@@ -18339,6 +22945,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ValkeyIssue.Event(childComplexity), true
 
+	case "ValkeyIssue.firstSeen":
+		if e.ComplexityRoot.ValkeyIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ValkeyIssue.FirstSeen(childComplexity), true
+
 	case "ValkeyIssue.id":
 		if e.ComplexityRoot.ValkeyIssue.ID == nil {
 			break
@@ -18346,6 +22959,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ValkeyIssue.ID(childComplexity), true
 
+	case "ValkeyIssue.lastSeen":
+		if e.ComplexityRoot.ValkeyIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ValkeyIssue.LastSeen(childComplexity), true
+
 	case "ValkeyIssue.message":
 		if e.ComplexityRoot.ValkeyIssue.Message == nil {
 			break
@@ -18722,6 +23342,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.VulnerabilityUpdatedActivityLogEntry.TeamSlug(childComplexity), true
 
+	case "VulnerableImageIssue.acknowledgement":
+		if e.ComplexityRoot.VulnerableImageIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.VulnerableImageIssue.Acknowledgement(childComplexity), true
+
 	case "VulnerableImageIssue.critical":
 		if e.ComplexityRoot.VulnerableImageIssue.Critical == nil {
 			break
@@ -18729,6 +23356,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.VulnerableImageIssue.Critical(childComplexity), true
 
+	case "VulnerableImageIssue.firstSeen":
+		if e.ComplexityRoot.VulnerableImageIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.VulnerableImageIssue.FirstSeen(childComplexity), true
+
 	case "VulnerableImageIssue.id":
 		if e.ComplexityRoot.VulnerableImageIssue.ID == nil {
 			break
@@ -18736,6 +23370,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.VulnerableImageIssue.ID(childComplexity), true
 
+	case "VulnerableImageIssue.lastSeen":
+		if e.ComplexityRoot.VulnerableImageIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.VulnerableImageIssue.LastSeen(childComplexity), true
+
 	case "VulnerableImageIssue.message":
 		if e.ComplexityRoot.VulnerableImageIssue.Message == nil {
 			break
@@ -18902,6 +23543,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.WorkloadLogLine.Time(childComplexity), true
 
+	case "WorkloadProblemIssue.acknowledgement":
+		if e.ComplexityRoot.WorkloadProblemIssue.Acknowledgement == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WorkloadProblemIssue.Acknowledgement(childComplexity), true
+
 	case "WorkloadProblemIssue.endOfLife":
 		if e.ComplexityRoot.WorkloadProblemIssue.EndOfLife == nil {
 			break
@@ -18909,6 +23557,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.WorkloadProblemIssue.EndOfLife(childComplexity), true
 
+	case "WorkloadProblemIssue.firstSeen":
+		if e.ComplexityRoot.WorkloadProblemIssue.FirstSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WorkloadProblemIssue.FirstSeen(childComplexity), true
+
 	case "WorkloadProblemIssue.id":
 		if e.ComplexityRoot.WorkloadProblemIssue.ID == nil {
 			break
@@ -18916,6 +23571,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.WorkloadProblemIssue.ID(childComplexity), true
 
+	case "WorkloadProblemIssue.lastSeen":
+		if e.ComplexityRoot.WorkloadProblemIssue.LastSeen == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WorkloadProblemIssue.LastSeen(childComplexity), true
+
 	case "WorkloadProblemIssue.message":
 		if e.ComplexityRoot.WorkloadProblemIssue.Message == nil {
 			break
@@ -19220,6 +23882,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAcknowledgeIssueInput,
 		ec.unmarshalInputActivityLogFilter,
 		ec.unmarshalInputAddConfigValueInput,
 		ec.unmarshalInputAddRepositoryToTeamInput,
@@ -19229,6 +23892,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputAlertOrder,
 		ec.unmarshalInputAllowTeamAccessToUnleashInput,
 		ec.unmarshalInputApplicationOrder,
+		ec.unmarshalInputApproveSecretAccessRequestInput,
 		ec.unmarshalInputAssignRoleToServiceAccountInput,
 		ec.unmarshalInputBigQueryDatasetAccessOrder,
 		ec.unmarshalInputBigQueryDatasetFilter,
@@ -19244,6 +23908,9 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputConfigureReconcilerInput,
 		ec.unmarshalInputConfirmTeamDeletionInput,
 		ec.unmarshalInputCreateConfigInput,
+		ec.unmarshalInputCreateCustomTeamRoleInput,
+		ec.unmarshalInputCreateDeploymentFreezeInput,
+		ec.unmarshalInputCreateIssueSuppressionRuleInput,
 		ec.unmarshalInputCreateKafkaCredentialsInput,
 		ec.unmarshalInputCreateOpenSearchCredentialsInput,
 		ec.unmarshalInputCreateOpenSearchInput,
@@ -19257,6 +23924,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCreateValkeyInput,
 		ec.unmarshalInputDeleteApplicationInput,
 		ec.unmarshalInputDeleteConfigInput,
+		ec.unmarshalInputDeleteCustomTeamRoleInput,
+		ec.unmarshalInputDeleteIssueSuppressionRuleInput,
 		ec.unmarshalInputDeleteJobInput,
 		ec.unmarshalInputDeleteJobRunInput,
 		ec.unmarshalInputDeleteOpenSearchInput,
@@ -19267,10 +23936,13 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputDeleteTunnelInput,
 		ec.unmarshalInputDeleteUnleashInstanceInput,
 		ec.unmarshalInputDeleteValkeyInput,
+		ec.unmarshalInputDenySecretAccessRequestInput,
 		ec.unmarshalInputDeploymentFilter,
 		ec.unmarshalInputDeploymentOrder,
 		ec.unmarshalInputDisableReconcilerInput,
+		ec.unmarshalInputDoraMetricsWindowInput,
 		ec.unmarshalInputEnableReconcilerInput,
+		ec.unmarshalInputEndDeploymentFreezeInput,
 		ec.unmarshalInputEnvironmentOrder,
 		ec.unmarshalInputEnvironmentWorkloadOrder,
 		ec.unmarshalInputGrantPostgresAccessInput,
@@ -19278,6 +23950,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputImageVulnerabilityOrder,
 		ec.unmarshalInputIngressMetricsInput,
 		ec.unmarshalInputIssueFilter,
+		ec.unmarshalInputIssueHistoryFilter,
 		ec.unmarshalInputIssueOrder,
 		ec.unmarshalInputJobOrder,
 		ec.unmarshalInputKafkaTopicAclFilter,
@@ -19292,15 +23965,21 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputOpenSearchAccessOrder,
 		ec.unmarshalInputOpenSearchFilter,
 		ec.unmarshalInputOpenSearchOrder,
+		ec.unmarshalInputOverrideDeploymentFreezeInput,
 		ec.unmarshalInputPostgresInstanceFilter,
 		ec.unmarshalInputPostgresInstanceOrder,
+		ec.unmarshalInputPromoteWorkloadFieldOverride,
+		ec.unmarshalInputPromoteWorkloadInput,
 		ec.unmarshalInputReconcilerConfigInput,
 		ec.unmarshalInputRemoveConfigValueInput,
+		ec.unmarshalInputRemoveIssueAcknowledgementInput,
 		ec.unmarshalInputRemoveRepositoryFromTeamInput,
+		ec.unmarshalInputRemoveSecretExternalSourceInput,
 		ec.unmarshalInputRemoveSecretValueInput,
 		ec.unmarshalInputRemoveTeamMemberInput,
 		ec.unmarshalInputRemoveWorkloadFromServiceAccountInput,
 		ec.unmarshalInputRepositoryOrder,
+		ec.unmarshalInputRequestSecretAccessInput,
 		ec.unmarshalInputRequestTeamDeletionInput,
 		ec.unmarshalInputResourceIssueFilter,
 		ec.unmarshalInputResourceLabelInput,
@@ -19308,11 +23987,16 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputRevokeRoleFromServiceAccountInput,
 		ec.unmarshalInputRevokeTeamAccessToUnleashInput,
 		ec.unmarshalInputRoleFilter,
+		ec.unmarshalInputRollbackSecretInput,
 		ec.unmarshalInputSearchFilter,
 		ec.unmarshalInputSecretFilter,
 		ec.unmarshalInputSecretOrder,
 		ec.unmarshalInputSecretValueInput,
+		ec.unmarshalInputSetSecretExternalSourceInput,
+		ec.unmarshalInputSetTeamMemberCustomRoleInput,
+		ec.unmarshalInputSetTeamMemberEnvironmentsInput,
 		ec.unmarshalInputSetTeamMemberRoleInput,
+		ec.unmarshalInputSnoozeIssueInput,
 		ec.unmarshalInputSqlInstanceFilter,
 		ec.unmarshalInputSqlInstanceOrder,
 		ec.unmarshalInputSqlInstanceUserOrder,
@@ -19333,6 +24017,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputUpdateApplicationReplicasInput,
 		ec.unmarshalInputUpdateConfigInput,
 		ec.unmarshalInputUpdateConfigValueInput,
+		ec.unmarshalInputUpdateCustomTeamRoleInput,
 		ec.unmarshalInputUpdateImageVulnerabilityInput,
 		ec.unmarshalInputUpdateJobInput,
 		ec.unmarshalInputUpdateOpenSearchInput,
@@ -19479,6 +24164,21 @@ var sources = []*ast.Source{
 	): ActivityLogEntryConnection!
 }
 
+extend type Subscription {
+	"""
+	Subscribe to activity log entries
+
+	This subscription streams activity log entries as they are created. Only entries the authenticated user is
+	authorized to read are included. Entries derived from deployments and Kubernetes events are not streamed.
+	"""
+	activityLog(
+		"""
+		Filter entries.
+		"""
+		filter: ActivityLogFilter
+	): ActivityLogEntry!
+}
+
 extend type Team implements ActivityLogger {
 	"""
 	Activity log associated with the team.
@@ -21170,7 +25870,25 @@ extend enum ActivityLogActivityType {
 	APPLICATION_UPDATED
 }
 `, BuiltIn: false},
-	{Name: "../schema/apply.graphqls", Input: `extend enum ActivityLogActivityType {
+	{Name: "../schema/apply.graphqls", Input: `extend type Environment {
+	"""
+	The Kubernetes resources that can be applied to the environment through the apply endpoint.
+	Manifests with any other apiVersion and kind are rejected.
+	"""
+	allowedApplyResources: [AllowedResource!]!
+}
+
+"""
+A Kubernetes resource type that can be applied through the apply endpoint.
+"""
+type AllowedResource {
+	"The apiVersion of the resource, e.g. 'nais.io/v1alpha1'."
+	apiVersion: String!
+	"The kind of the resource, e.g. 'Application'."
+	kind: String!
+}
+
+extend enum ActivityLogActivityType {
 	"A generic kubernetes resource was created via apply."
 	GENERIC_KUBERNETES_RESOURCE_CREATED
 }
@@ -22424,6 +27142,335 @@ extend type SqlInstance {
 type SqlInstanceCost {
 	sum: Float!
 }
+`, BuiltIn: false},
+	{Name: "../schema/custom_team_roles.graphqls", Input: `extend type Mutation {
+	"""
+	Create a custom role for a team. A custom role grants a subset of the authorizations of a team owner, and can be
+	assigned to team members to give them more or less access to the team than their team role.
+	"""
+	createCustomTeamRole(input: CreateCustomTeamRoleInput!): CreateCustomTeamRolePayload!
+
+	"Update a custom role. The changes apply to all team members with the custom role."
+	updateCustomTeamRole(input: UpdateCustomTeamRoleInput!): UpdateCustomTeamRolePayload!
+
+	"Delete a custom role. Team members with the custom role are granted the authorizations of their team role again."
+	deleteCustomTeamRole(input: DeleteCustomTeamRoleInput!): DeleteCustomTeamRolePayload!
+
+	"Assign a custom role to a team member, or remove the custom role from the team member."
+	setTeamMemberCustomRole(input: SetTeamMemberCustomRoleInput!): SetTeamMemberCustomRolePayload!
+}
+
+extend type Query {
+	"Authorizations that can be granted by custom team roles."
+	customTeamRoleAuthorizations: [CustomTeamRoleAuthorization!]!
+}
+
+extend type Team {
+	"Custom roles defined by the team."
+	customRoles(
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+	): CustomTeamRoleConnection!
+}
+
+extend type TeamMember {
+	"""
+	The custom role of the team member. When set, the team member is granted the authorizations of the custom role
+	instead of the authorizations of the team role. The custom role is removed when the team role of the member changes.
+	"""
+	customRole: CustomTeamRole
+}
+
+"A role defined by a team, built from the authorizations that can be granted to team members."
+type CustomTeamRole implements Node {
+	"The globally unique ID of the custom role."
+	id: ID!
+
+	"The team the custom role belongs to."
+	team: Team!
+
+	"The name of the custom role. Unique within the team."
+	name: String!
+
+	"Description of the custom role."
+	description: String!
+
+	"The names of the authorizations granted by the custom role."
+	authorizations: [String!]!
+
+	"Creation time of the custom role."
+	createdAt: Time!
+
+	"Last time the custom role was updated."
+	updatedAt: Time!
+}
+
+"An authorization that can be granted by a custom team role."
+type CustomTeamRoleAuthorization {
+	"The name of the authorization."
+	name: String!
+
+	"Description of the authorization."
+	description: String!
+}
+
+type CustomTeamRoleConnection {
+	"Pagination information."
+	pageInfo: PageInfo!
+
+	"List of nodes."
+	nodes: [CustomTeamRole!]!
+
+	"List of edges."
+	edges: [CustomTeamRoleEdge!]!
+}
+
+type CustomTeamRoleEdge {
+	"Cursor for this edge that can be used for pagination."
+	cursor: Cursor!
+
+	"The custom role."
+	node: CustomTeamRole!
+}
+
+input CreateCustomTeamRoleInput {
+	"The slug of the team."
+	teamSlug: Slug!
+
+	"The name of the custom role."
+	name: String!
+
+	"Description of the custom role."
+	description: String!
+
+	"The names of the authorizations granted by the custom role. See the customTeamRoleAuthorizations query."
+	authorizations: [String!]!
+}
+
+type CreateCustomTeamRolePayload {
+	"The created custom role."
+	customRole: CustomTeamRole
+}
+
+input UpdateCustomTeamRoleInput {
+	"The slug of the team."
+	teamSlug: Slug!
+
+	"The name of the custom role."
+	name: String!
+
+	"New description of the custom role."
+	description: String
+
+	"The names of the authorizations granted by the custom role. Replaces the current authorizations when set."
+	authorizations: [String!]
+}
+
+type UpdateCustomTeamRolePayload {
+	"The updated custom role."
+	customRole: CustomTeamRole
+}
+
+input DeleteCustomTeamRoleInput {
+	"The slug of the team."
+	teamSlug: Slug!
+
+	"The name of the custom role."
+	name: String!
+}
+
+type DeleteCustomTeamRolePayload {
+	"Whether or not the custom role was deleted."
+	customRoleDeleted: Boolean
+}
+
+input SetTeamMemberCustomRoleInput {
+	"The slug of the team."
+	teamSlug: Slug!
+
+	"The email address of the user."
+	userEmail: String!
+
+	"The name of the custom role to assign. Set to null to remove the custom role from the team member."
+	customRoleName: String
+}
+
+type SetTeamMemberCustomRolePayload {
+	"The updated team member."
+	member: TeamMember
+}
+
+extend enum ActivityLogActivityType {
+	"A custom team role was created."
+	TEAM_CUSTOM_ROLE_CREATED
+	"A custom team role was updated."
+	TEAM_CUSTOM_ROLE_UPDATED
+	"A custom team role was deleted."
+	TEAM_CUSTOM_ROLE_DELETED
+	"A custom role was assigned to or removed from a team member."
+	TEAM_MEMBER_SET_CUSTOM_ROLE
+}
+
+"Activity log entry for creating a custom team role."
+type TeamCustomRoleCreatedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the action."
+	data: TeamCustomRoleCreatedActivityLogEntryData!
+}
+
+type TeamCustomRoleCreatedActivityLogEntryData {
+	"The name of the custom role."
+	name: String!
+
+	"The names of the authorizations granted by the custom role."
+	authorizations: [String!]!
+}
+
+"Activity log entry for updating a custom team role."
+type TeamCustomRoleUpdatedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the action."
+	data: TeamCustomRoleUpdatedActivityLogEntryData!
+}
+
+type TeamCustomRoleUpdatedActivityLogEntryData {
+	"The name of the custom role."
+	name: String!
+
+	"The names of the authorizations granted by the custom role after the update."
+	authorizations: [String!]!
+}
+
+"Activity log entry for deleting a custom team role."
+type TeamCustomRoleDeletedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the action."
+	data: TeamCustomRoleDeletedActivityLogEntryData!
+}
+
+type TeamCustomRoleDeletedActivityLogEntryData {
+	"The name of the custom role."
+	name: String!
+}
+
+"Activity log entry for assigning a custom role to, or removing a custom role from, a team member."
+type TeamMemberSetCustomRoleActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the action."
+	data: TeamMemberSetCustomRoleActivityLogEntryData!
+}
+
+type TeamMemberSetCustomRoleActivityLogEntryData {
+	"The name of the custom role that was assigned. Null if the custom role was removed."
+	customRoleName: String
+
+	"The ID of the user."
+	userID: ID!
+
+	"The email address of the user."
+	userEmail: String!
+}
 `, BuiltIn: false},
 	{Name: "../schema/deployment.graphqls", Input: `extend type Query {
 	"Get a list of deployments."
@@ -22446,6 +27493,17 @@ type SqlInstanceCost {
 		"Filter options for the deployments returned from the connection."
 		filter: DeploymentFilter
 	): DeploymentConnection!
+
+	"""
+	Compare two deployments of the same workload.
+	"""
+	deploymentDiff(
+		"ID of the deployment to compare from, typically the last good deployment."
+		fromDeploymentID: ID!
+
+		"ID of the deployment to compare to."
+		toDeploymentID: ID!
+	): DeploymentDiff!
 }
 
 extend type Team {
@@ -22532,6 +27590,13 @@ extend type Job {
 extend type Mutation {
 	"Update the deploy key of a team. Returns the updated deploy key."
 	changeDeploymentKey(input: ChangeDeploymentKeyInput!): ChangeDeploymentKeyPayload!
+
+	"""
+	Promote an application or job to another environment of the team. The image and spec of the workload are applied to
+	the target environment, with the given field overrides, and a deployment is recorded that links back to the latest
+	deployment of the workload.
+	"""
+	promoteWorkload(input: PromoteWorkloadInput!): PromoteWorkloadPayload!
 }
 
 input ChangeDeploymentKeyInput {
@@ -22723,6 +27788,12 @@ type Deployment implements Node {
 		"Get items before this cursor."
 		before: Cursor
 	): DeploymentStatusConnection!
+
+	"""
+	The deployment the workload was promoted from. Null if the deployment was not created by promoting a workload, or if
+	the workload had no deployments in the source environment.
+	"""
+	promotedFrom: Deployment
 }
 
 """
@@ -22887,6 +27958,438 @@ enum DeploymentOrderField {
 	"The time the deployment was created at."
 	CREATED_AT
 }
+
+"""
+The difference between two deployments of the same workload.
+"""
+type DeploymentDiff {
+	"""
+	The deployment compared from.
+	"""
+	from: Deployment!
+
+	"""
+	The deployment compared to.
+	"""
+	to: Deployment!
+
+	"""
+	The range of commits between the deployments. Null if one of the deployments has no commit SHA.
+	"""
+	commitRange: DeploymentCommitRange
+
+	"""
+	Kinds of resources that were only deployed by one of the deployments, and the kind of the workload if its spec
+	changed.
+	"""
+	changedResourceKinds: [String!]!
+
+	"""
+	Fields of the workload spec that changed between the deployments.
+	"""
+	specChanges: [ResourceChangedField!]!
+
+	"""
+	Whether the workload spec was captured for both deployments. The spec is captured from the cluster when a deployment
+	succeeds, so spec changes are not available for deployments that failed or were replaced before the spec was
+	captured.
+	"""
+	specAvailable: Boolean!
+}
+
+"""
+A range of commits between two deployments.
+"""
+type DeploymentCommitRange {
+	"""
+	The repository of the deployment compared to.
+	"""
+	repository: String
+
+	"""
+	The commit SHA of the deployment compared from.
+	"""
+	fromCommitSha: String!
+
+	"""
+	The commit SHA of the deployment compared to.
+	"""
+	toCommitSha: String!
+
+	"""
+	URL to compare the commits on GitHub. Null if the repository is unknown.
+	"""
+	compareURL: String
+}
+
+input PromoteWorkloadInput {
+	"The ID of the application or job to promote."
+	workloadID: ID!
+
+	"The environment to promote the workload to."
+	targetEnvironmentName: String!
+
+	"Fields of the workload spec to set in the target environment, e.g. ingresses or replicas."
+	overrides: [PromoteWorkloadFieldOverride!]
+
+	"Justification for promoting the workload during an active deployment freeze in the target environment."
+	freezeOverrideJustification: String
+}
+
+input PromoteWorkloadFieldOverride {
+	"Dot separated path of the field in the workload spec, e.g. replicas.min."
+	field: String!
+
+	"The value of the field encoded as JSON, e.g. 2 or [\"https://app.example.com\"]. The field is removed if the value is null."
+	value: String!
+}
+
+type PromoteWorkloadPayload {
+	"The deployment recorded for the promotion."
+	deployment: Deployment
+}
+`, BuiltIn: false},
+	{Name: "../schema/deployment_freezes.graphqls", Input: `extend type Mutation {
+	"""
+	Freeze deployments to a team environment. While the freeze is active, new deployments and changes through the apply
+	endpoint are rejected unless the freeze is overridden.
+	"""
+	createDeploymentFreeze(input: CreateDeploymentFreezeInput!): CreateDeploymentFreezePayload!
+
+	"""
+	End a deployment freeze. Scheduled freezes that have not started are removed.
+	"""
+	endDeploymentFreeze(input: EndDeploymentFreezeInput!): EndDeploymentFreezePayload!
+
+	"""
+	Allow deployments during an active deployment freeze until the override expires. Requires team ownership.
+	"""
+	overrideDeploymentFreeze(input: OverrideDeploymentFreezeInput!): OverrideDeploymentFreezePayload!
+}
+
+extend type Team {
+	"Active and scheduled deployment freezes of the team, ordered by start time."
+	deploymentFreezes(
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+
+		"Only include freezes of this environment."
+		environmentName: String
+	): DeploymentFreezeConnection!
+}
+
+"A time range where deployments to a team environment are blocked."
+type DeploymentFreeze implements Node {
+	"The globally unique ID of the deployment freeze."
+	id: ID!
+
+	"The team the freeze belongs to."
+	team: Team!
+
+	"The environment the freeze applies to."
+	teamEnvironment: TeamEnvironment!
+
+	"The reason for the freeze."
+	reason: String!
+
+	"Time the freeze starts."
+	startsAt: Time!
+
+	"Time the freeze ends. Null if the freeze lasts until it is ended."
+	endsAt: Time
+
+	"Whether the freeze currently blocks deployments, unless it is overridden."
+	active: Boolean!
+
+	"The identity of the user who created the freeze."
+	createdBy: String!
+
+	"Time the freeze was created."
+	createdAt: Time!
+
+	"Overrides of the freeze, newest first."
+	overrides: [DeploymentFreezeOverride!]!
+}
+
+"An override that allows deployments during a deployment freeze."
+type DeploymentFreezeOverride {
+	"The justification for the override."
+	justification: String!
+
+	"The identity of the user who created the override."
+	createdBy: String!
+
+	"Time the override was created."
+	createdAt: Time!
+
+	"Time the override expires. Overrides given with a single change through the apply endpoint expire immediately."
+	expiresAt: Time!
+}
+
+type DeploymentFreezeConnection {
+	"Pagination information."
+	pageInfo: PageInfo!
+
+	"List of nodes."
+	nodes: [DeploymentFreeze!]!
+
+	"List of edges."
+	edges: [DeploymentFreezeEdge!]!
+}
+
+type DeploymentFreezeEdge {
+	"Cursor for this edge that can be used for pagination."
+	cursor: Cursor!
+
+	"The deployment freeze."
+	node: DeploymentFreeze!
+}
+
+input CreateDeploymentFreezeInput {
+	"The team to freeze deployments for."
+	teamSlug: Slug!
+
+	"The environment to freeze deployments to."
+	environmentName: String!
+
+	"The reason for the freeze, e.g. a holiday or an ongoing incident."
+	reason: String!
+
+	"Time the freeze starts. Defaults to now."
+	startsAt: Time
+
+	"Time the freeze ends. If not set, the freeze lasts until it is ended."
+	endsAt: Time
+}
+
+type CreateDeploymentFreezePayload {
+	"The created deployment freeze."
+	deploymentFreeze: DeploymentFreeze
+}
+
+input EndDeploymentFreezeInput {
+	"The ID of the deployment freeze."
+	id: ID!
+}
+
+type EndDeploymentFreezePayload {
+	"The ended deployment freeze."
+	deploymentFreeze: DeploymentFreeze
+}
+
+input OverrideDeploymentFreezeInput {
+	"The ID of the deployment freeze."
+	id: ID!
+
+	"Justification for deploying during the freeze. Must be at least 10 characters."
+	justification: String!
+
+	"Time the override expires. Defaults to one hour from now, and can be at most 24 hours from now."
+	expiresAt: Time
+}
+
+type OverrideDeploymentFreezePayload {
+	"The overridden deployment freeze."
+	deploymentFreeze: DeploymentFreeze
+}
+
+extend enum ActivityLogEntryResourceType {
+	"All activity log entries related to deployment freezes."
+	DEPLOYMENT_FREEZE
+}
+
+extend enum ActivityLogActivityType {
+	"A deployment freeze was created."
+	DEPLOYMENT_FREEZE_CREATED
+	"A deployment freeze was ended."
+	DEPLOYMENT_FREEZE_ENDED
+	"A deployment freeze was overridden."
+	DEPLOYMENT_FREEZE_OVERRIDDEN
+}
+
+"Activity log entry for creating a deployment freeze."
+type DeploymentFreezeCreatedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the entry."
+	data: DeploymentFreezeCreatedActivityLogEntryData!
+}
+
+type DeploymentFreezeCreatedActivityLogEntryData {
+	"The reason for the freeze."
+	reason: String!
+
+	"Time the freeze starts."
+	startsAt: Time!
+
+	"Time the freeze ends. Null if the freeze lasts until it is ended."
+	endsAt: Time
+}
+
+"Activity log entry for ending a deployment freeze."
+type DeploymentFreezeEndedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+}
+
+"Activity log entry for overriding a deployment freeze."
+type DeploymentFreezeOverriddenActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the entry."
+	data: DeploymentFreezeOverriddenActivityLogEntryData!
+}
+
+type DeploymentFreezeOverriddenActivityLogEntryData {
+	"The justification for the override."
+	justification: String!
+
+	"Time the override expires."
+	expiresAt: Time!
+}
+`, BuiltIn: false},
+	{Name: "../schema/dora_metrics.graphqls", Input: `extend type Team {
+	"DORA metrics computed from the deployments of the team."
+	doraMetrics(
+		"The time window to compute the metrics for. Defaults to the last 30 days."
+		window: DoraMetricsWindowInput
+
+		"Only include deployments to this environment."
+		environmentName: String
+	): DoraMetrics!
+}
+
+extend type Application {
+	"DORA metrics computed from the deployments of the application."
+	doraMetrics(
+		"The time window to compute the metrics for. Defaults to the last 30 days."
+		window: DoraMetricsWindowInput
+	): DoraMetrics!
+}
+
+"""
+A time window for DORA metrics. The window can be at most 365 days long.
+"""
+input DoraMetricsWindowInput {
+	"Start of the window. Defaults to 30 days before the end of the window."
+	from: Time
+
+	"End of the window. Defaults to now."
+	to: Time
+}
+
+"""
+DORA metrics computed from the deployments created in a time window.
+
+A deployment is successful if it has reported a successful status, and failed if it has reported a failure or an error
+status without ever succeeding. Deployments that have not yet succeeded or failed are not counted.
+"""
+type DoraMetrics {
+	"Start of the time window."
+	from: Time!
+
+	"End of the time window."
+	to: Time!
+
+	"Number of successful deployments."
+	successfulDeployments: Int!
+
+	"Number of failed deployments."
+	failedDeployments: Int!
+
+	"Number of successful deployments per day."
+	deploymentFrequency: Float!
+
+	"""
+	Median time in seconds from a deployment was created until it succeeded. Deployments do not report when the change
+	was committed, so the creation of the deployment is used as the start of the lead time. Null if there are no
+	successful deployments.
+	"""
+	leadTimeSeconds: Float
+
+	"Fraction of deployments that failed, between 0 and 1. Null if there are no successful or failed deployments."
+	changeFailureRate: Float
+
+	"""
+	Median time in seconds from a deployment failed until the next successful deployment of the same workload in the
+	same environment. Null if no failed deployments have been restored.
+	"""
+	timeToRestoreSeconds: Float
+}
 `, BuiltIn: false},
 	{Name: "../schema/environments.graphqls", Input: `extend type Query {
 	"""
@@ -23275,6 +28778,347 @@ enum InstanceGroupValueSourceKind {
 	"""
 	NAIS
 }
+`, BuiltIn: false},
+	{Name: "../schema/issue_acknowledgements.graphqls", Input: `extend type Team {
+	"Rules suppressing issues for the team."
+	issueSuppressionRules(
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+	): IssueSuppressionRuleConnection!
+}
+
+extend type Mutation {
+	"Acknowledge an issue. The acknowledgement is removed when the issue is resolved."
+	acknowledgeIssue(input: AcknowledgeIssueInput!): AcknowledgeIssuePayload!
+
+	"Snooze an issue until the given time. The snooze is removed when the issue is resolved."
+	snoozeIssue(input: SnoozeIssueInput!): SnoozeIssuePayload!
+
+	"Remove the acknowledgement or snooze of an issue."
+	removeIssueAcknowledgement(input: RemoveIssueAcknowledgementInput!): RemoveIssueAcknowledgementPayload!
+
+	"Create a rule suppressing issues of a type for resources with names matching a pattern."
+	createIssueSuppressionRule(input: CreateIssueSuppressionRuleInput!): CreateIssueSuppressionRulePayload!
+
+	"Delete an issue suppression rule."
+	deleteIssueSuppressionRule(input: DeleteIssueSuppressionRuleInput!): DeleteIssueSuppressionRulePayload!
+}
+
+extend enum ActivityLogEntryResourceType {
+	"All activity log entries related to issues will use this resource type."
+	ISSUE
+}
+
+"How an issue is acknowledged."
+enum IssueAcknowledgementKind {
+	"The issue was acknowledged until it is resolved."
+	ACKNOWLEDGED
+
+	"The issue was snoozed until a given time."
+	SNOOZED
+
+	"The issue matches a suppression rule."
+	SUPPRESSED
+}
+
+"An acknowledgement of an issue."
+type IssueAcknowledgement {
+	"How the issue is acknowledged."
+	kind: IssueAcknowledgementKind!
+
+	"The reason for the acknowledgement. For suppressed issues, the reason of the suppression rule."
+	reason: String!
+
+	"The identity of the actor who acknowledged the issue, or created the suppression rule."
+	actor: String!
+
+	"When the issue was acknowledged, or the suppression rule was created."
+	createdAt: Time!
+
+	"When a snoozed issue is no longer acknowledged."
+	until: Time
+
+	"The suppression rule suppressing the issue, if the issue is suppressed."
+	suppressionRule: IssueSuppressionRule
+}
+
+"A rule suppressing issues of a type for resources with names matching a pattern."
+type IssueSuppressionRule implements Node {
+	"The globally unique ID of the suppression rule."
+	id: ID!
+
+	"The type of issues suppressed by the rule."
+	issueType: IssueType!
+
+	"Pattern matching the names of the resources, where * matches any sequence of characters."
+	resourceNamePattern: String!
+
+	"The reason for the suppression."
+	reason: String!
+
+	"The identity of the actor who created the rule."
+	actor: String!
+
+	"When the rule was created."
+	createdAt: Time!
+
+	"The team the rule belongs to."
+	team: Team!
+}
+
+type IssueSuppressionRuleConnection {
+	"Pagination information."
+	pageInfo: PageInfo!
+
+	"List of nodes."
+	nodes: [IssueSuppressionRule!]!
+
+	"List of edges."
+	edges: [IssueSuppressionRuleEdge!]!
+}
+
+type IssueSuppressionRuleEdge {
+	"Cursor for this edge that can be used for pagination."
+	cursor: Cursor!
+
+	"The suppression rule."
+	node: IssueSuppressionRule!
+}
+
+input AcknowledgeIssueInput {
+	"The ID of the issue to acknowledge."
+	issueID: ID!
+
+	"The reason for acknowledging the issue."
+	reason: String!
+}
+
+type AcknowledgeIssuePayload {
+	"The acknowledged issue."
+	issue: Issue
+}
+
+input SnoozeIssueInput {
+	"The ID of the issue to snooze."
+	issueID: ID!
+
+	"The time until the issue is snoozed."
+	until: Time!
+
+	"The reason for snoozing the issue."
+	reason: String!
+}
+
+type SnoozeIssuePayload {
+	"The snoozed issue."
+	issue: Issue
+}
+
+input RemoveIssueAcknowledgementInput {
+	"The ID of the issue to remove the acknowledgement from."
+	issueID: ID!
+}
+
+type RemoveIssueAcknowledgementPayload {
+	"The issue."
+	issue: Issue
+}
+
+input CreateIssueSuppressionRuleInput {
+	"The slug of the team to create the rule for."
+	teamSlug: Slug!
+
+	"The type of issues to suppress."
+	issueType: IssueType!
+
+	"Pattern matching the names of the resources, where * matches any sequence of characters."
+	resourceNamePattern: String!
+
+	"The reason for the suppression."
+	reason: String!
+}
+
+type CreateIssueSuppressionRulePayload {
+	"The created suppression rule."
+	suppressionRule: IssueSuppressionRule
+}
+
+input DeleteIssueSuppressionRuleInput {
+	"The ID of the suppression rule to delete."
+	id: ID!
+}
+
+type DeleteIssueSuppressionRulePayload {
+	"Whether or not the suppression rule was deleted."
+	success: Boolean
+}
+
+type IssueAcknowledgedActivityLogEntryData {
+	"The type of the issue."
+	issueType: IssueType!
+
+	"The type of the resource the issue affects."
+	resourceType: ResourceType!
+
+	"The reason for the acknowledgement."
+	reason: String
+
+	"When a snoozed issue is no longer acknowledged."
+	until: Time
+}
+
+type IssueAcknowledgedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the acknowledgement."
+	data: IssueAcknowledgedActivityLogEntryData!
+}
+
+type IssueAcknowledgementRemovedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the removed acknowledgement."
+	data: IssueAcknowledgedActivityLogEntryData!
+}
+
+type IssueSuppressionRuleActivityLogEntryData {
+	"The type of issues suppressed by the rule."
+	issueType: IssueType!
+
+	"Pattern matching the names of the resources."
+	resourceNamePattern: String!
+
+	"The reason for the suppression."
+	reason: String!
+}
+
+type IssueSuppressionRuleCreatedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the suppression rule."
+	data: IssueSuppressionRuleActivityLogEntryData!
+}
+
+type IssueSuppressionRuleDeletedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the suppression rule."
+	data: IssueSuppressionRuleActivityLogEntryData!
+}
+
+extend enum ActivityLogActivityType {
+	"An issue was acknowledged."
+	ISSUE_ACKNOWLEDGED
+
+	"An issue was snoozed."
+	ISSUE_SNOOZED
+
+	"The acknowledgement of an issue was removed."
+	ISSUE_ACKNOWLEDGEMENT_REMOVED
+
+	"An issue suppression rule was created."
+	ISSUE_SUPPRESSION_RULE_CREATED
+
+	"An issue suppression rule was deleted."
+	ISSUE_SUPPRESSION_RULE_DELETED
+}
 `, BuiltIn: false},
 	{Name: "../schema/issues.graphqls", Input: `extend type Team {
 	"Issues that affects the team."
@@ -23322,6 +29166,9 @@ input IssueFilter {
 
 	"Filter by issue type."
 	issueType: IssueType
+
+	"Only include acknowledged issues when true, or unacknowledged issues when false. Snoozed and suppressed issues count as acknowledged."
+	acknowledged: Boolean
 }
 
 input ResourceIssueFilter {
@@ -23330,6 +29177,9 @@ input ResourceIssueFilter {
 
 	"Filter by issue type."
 	issueType: IssueType
+
+	"Only include acknowledged issues when true, or unacknowledged issues when false. Snoozed and suppressed issues count as acknowledged."
+	acknowledged: Boolean
 }
 
 enum IssueOrderField {
@@ -23433,6 +29283,12 @@ interface Issue implements Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	"When the issue was first seen."
+	firstSeen: Time!
+	"When the issue was last seen by the issue checker."
+	lastSeen: Time!
+	"The active acknowledgement of the issue. Null if the issue is not acknowledged, snoozed or suppressed."
+	acknowledgement: IssueAcknowledgement
 }
 
 extend enum ApplicationOrderField {
@@ -23490,6 +29346,8 @@ enum ResourceType {
 	APPLICATION
 	JOB
 	UNLEASH
+	AIVEN_CREDENTIAL
+	SECRET
 }
 
 enum IssueType {
@@ -23511,6 +29369,20 @@ enum IssueType {
 	UNLEASH_RELEASE_CHANNEL
 	"Raised when an application is stuck in a restart loop."
 	APPLICATION_RESTART_LOOP
+	"Raised by an issue check defined in the configuration of the platform."
+	CUSTOM
+	"Raised when an application requests far more CPU or memory than it uses."
+	OVERPROVISIONED_WORKLOAD
+	"Raised when instances of an application are repeatedly killed for exceeding their memory limit."
+	MEMORY_LIMIT_REACHED
+	"Raised when a service account token is about to expire, or has expired. Listed in ` + "`" + `Team.serviceAccountTokenIssues` + "`" + `."
+	SERVICE_ACCOUNT_TOKEN_EXPIRING
+	"Raised when a service account token has not been used for a long time. Listed in ` + "`" + `Team.serviceAccountTokenIssues` + "`" + `."
+	SERVICE_ACCOUNT_TOKEN_UNUSED
+	"Raised when temporary Aiven credentials are older than the credential policy allows."
+	STALE_AIVEN_CREDENTIAL
+	"Raised when a secret has not been updated within the rotation window of the credential policy."
+	STALE_SECRET
 }
 
 type VulnerableImageIssue implements Issue & Node {
@@ -23518,6 +29390,9 @@ type VulnerableImageIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	workload: Workload!
 	riskScore: Int!
@@ -23529,6 +29404,9 @@ type ExternalIngressCriticalVulnerabilityIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	workload: Workload!
 	cvssScore: Float!
@@ -23540,6 +29418,9 @@ type MissingSbomIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	workload: Workload!
 }
@@ -23550,6 +29431,9 @@ type WorkloadProblemIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	workload: Workload!
 	"The kind of problem as reported by the platform."
@@ -23578,6 +29462,9 @@ type InvalidSpecIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment! @deprecated(reason: "Use WorkloadProblemIssue instead.")
 	severity: Severity! @deprecated(reason: "Use WorkloadProblemIssue instead.")
 	message: String! @deprecated(reason: "Use WorkloadProblemIssue instead.")
+	firstSeen: Time! @deprecated(reason: "Use WorkloadProblemIssue instead.")
+	lastSeen: Time! @deprecated(reason: "Use WorkloadProblemIssue instead.")
+	acknowledgement: IssueAcknowledgement @deprecated(reason: "Use WorkloadProblemIssue instead.")
 
 	workload: Workload! @deprecated(reason: "Use WorkloadProblemIssue instead.")
 }
@@ -23590,6 +29477,9 @@ type FailedSynchronizationIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment! @deprecated(reason: "Use WorkloadProblemIssue instead.")
 	severity: Severity! @deprecated(reason: "Use WorkloadProblemIssue instead.")
 	message: String! @deprecated(reason: "Use WorkloadProblemIssue instead.")
+	firstSeen: Time! @deprecated(reason: "Use WorkloadProblemIssue instead.")
+	lastSeen: Time! @deprecated(reason: "Use WorkloadProblemIssue instead.")
+	acknowledgement: IssueAcknowledgement @deprecated(reason: "Use WorkloadProblemIssue instead.")
 
 	workload: Workload! @deprecated(reason: "Use WorkloadProblemIssue instead.")
 }
@@ -23599,6 +29489,9 @@ type OpenSearchIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	openSearch: OpenSearch!
 	event: String!
@@ -23609,6 +29502,9 @@ type ValkeyIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	valkey: Valkey!
 	event: String!
@@ -23619,6 +29515,9 @@ type SqlInstanceVersionIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	sqlInstance: SqlInstance!
 }
@@ -23628,6 +29527,9 @@ type SqlInstanceStateIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	state: SqlInstanceState!
 	sqlInstance: SqlInstance!
@@ -23638,6 +29540,9 @@ type DeprecatedIngressIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	ingresses: [String!]!
 	application: Application!
@@ -23648,6 +29553,9 @@ type DeprecatedRegistryIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	workload: Workload!
 }
@@ -23657,6 +29565,9 @@ type NoRunningInstancesIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	workload: Workload!
 }
@@ -23666,6 +29577,9 @@ type LastRunFailedIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	job: Job!
 }
@@ -23675,6 +29589,9 @@ type UnleashReleaseChannelIssue implements Issue & Node {
 	teamEnvironment: TeamEnvironment!
 	severity: Severity!
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	unleash: UnleashInstance!
 	"The name of the release channel the instance is on."
@@ -23695,6 +29612,9 @@ type ApplicationRestartLoopIssue implements Issue & Node {
 	severity: Severity!
 	"A human-readable description of the issue."
 	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
 
 	"The workload that is stuck in a restart loop."
 	workload: Workload!
@@ -23705,6 +29625,326 @@ type ApplicationRestartLoopIssue implements Issue & Node {
 	"The timestamp of the last container exit."
 	lastExitTimestamp: Time!
 }
+
+"An issue raised by an issue check defined in the configuration of the platform."
+type CustomIssue implements Issue & Node {
+	"Unique identifier for this issue."
+	id: ID!
+	"The team environment where the issue was detected."
+	teamEnvironment: TeamEnvironment!
+	"The severity of the issue."
+	severity: Severity!
+	"A human-readable description of the issue."
+	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
+
+	"The name of the check that raised the issue."
+	checkName: String!
+	"The type of the resource the issue affects."
+	resourceType: ResourceType!
+	"The name of the resource the issue affects."
+	resourceName: String!
+}
+
+"An issue raised when an application requests far more CPU or memory than it has used during the last day."
+type OverprovisionedWorkloadIssue implements Issue & Node {
+	"Unique identifier for this issue."
+	id: ID!
+	"The team environment where the issue was detected."
+	teamEnvironment: TeamEnvironment!
+	"The severity of the issue."
+	severity: Severity!
+	"A human-readable description of the issue."
+	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
+
+	"The over-provisioned workload."
+	workload: Workload!
+	"CPU requested by an instance, in cores."
+	cpuRequestCores: Float!
+	"Peak CPU usage of an instance during the last day, in cores."
+	cpuPeakUsageCores: Float!
+	"Recommended CPU request, in cores."
+	recommendedCpuRequestCores: Float!
+	"Memory requested by an instance, in bytes."
+	memoryRequestBytes: Int!
+	"Peak memory usage of an instance during the last day, in bytes."
+	memoryPeakUsageBytes: Int!
+	"Recommended memory request, in bytes."
+	recommendedMemoryRequestBytes: Int!
+}
+
+"An issue raised when instances of an application are repeatedly killed for exceeding their memory limit."
+type MemoryLimitReachedIssue implements Issue & Node {
+	"Unique identifier for this issue."
+	id: ID!
+	"The team environment where the issue was detected."
+	teamEnvironment: TeamEnvironment!
+	"The severity of the issue."
+	severity: Severity!
+	"A human-readable description of the issue."
+	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
+
+	"The workload reaching its memory limit."
+	workload: Workload!
+	"Number of times instances were killed for exceeding their memory limit during the last day."
+	oomKillCount: Int!
+	"The current memory limit of an instance, in bytes, if set."
+	memoryLimitBytes: Int
+	"Recommended memory limit, in bytes."
+	recommendedMemoryLimitBytes: Int!
+}
+
+extend type Team {
+	"Issues with the service account tokens of the team. Service account tokens are not tied to an environment, so these issues are not included in ` + "`" + `issues` + "`" + `."
+	serviceAccountTokenIssues(
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+	): ServiceAccountTokenIssueConnection!
+}
+
+type ServiceAccountTokenIssueConnection {
+	"Pagination information."
+	pageInfo: PageInfo!
+
+	"List of nodes."
+	nodes: [ServiceAccountTokenIssue!]!
+
+	"List of edges."
+	edges: [ServiceAccountTokenIssueEdge!]!
+}
+
+type ServiceAccountTokenIssueEdge {
+	"Cursor for this edge that can be used for pagination."
+	cursor: Cursor!
+
+	"The issue."
+	node: ServiceAccountTokenIssue!
+}
+
+"An issue with a service account token."
+interface ServiceAccountTokenIssue implements Node {
+	id: ID!
+	severity: Severity!
+	message: String!
+	"When the issue was first seen."
+	firstSeen: Time!
+	"When the issue was last seen by the issue checker."
+	lastSeen: Time!
+	"The active suppression of the issue, if any. Issues for service account tokens can not be acknowledged or snoozed."
+	acknowledgement: IssueAcknowledgement
+	"The service account the token belongs to."
+	serviceAccount: ServiceAccount!
+	"The token the issue is raised for."
+	serviceAccountToken: ServiceAccountToken!
+}
+
+"An issue raised when a service account token is about to expire, or has expired."
+type ServiceAccountTokenExpiringIssue implements ServiceAccountTokenIssue & Node {
+	"Unique identifier for this issue."
+	id: ID!
+	"The severity of the issue."
+	severity: Severity!
+	"A human-readable description of the issue."
+	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
+
+	"The service account the token belongs to."
+	serviceAccount: ServiceAccount!
+	"The expiring token."
+	serviceAccountToken: ServiceAccountToken!
+}
+
+"An issue raised when a service account token has not been used for a long time."
+type ServiceAccountTokenUnusedIssue implements ServiceAccountTokenIssue & Node {
+	"Unique identifier for this issue."
+	id: ID!
+	"The severity of the issue."
+	severity: Severity!
+	"A human-readable description of the issue."
+	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
+
+	"The service account the token belongs to."
+	serviceAccount: ServiceAccount!
+	"The unused token."
+	serviceAccountToken: ServiceAccountToken!
+}
+
+"An issue raised when temporary Aiven credentials are older than the credential policy allows."
+type StaleAivenCredentialIssue implements Issue & Node {
+	"Unique identifier for this issue."
+	id: ID!
+	"The team environment where the issue was detected."
+	teamEnvironment: TeamEnvironment!
+	"The severity of the issue."
+	severity: Severity!
+	"A human-readable description of the issue."
+	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
+
+	"The name of the AivenApplication holding the credentials."
+	name: String!
+	"The Aiven service the credentials are for, e.g. Kafka, OpenSearch or Valkey."
+	service: String!
+	"The user that created the credentials, if known."
+	createdBy: String
+	"When the credentials were created."
+	createdAt: Time!
+	"When the credentials expire, if known."
+	expiresAt: Time
+}
+
+"An issue raised when a secret has not been updated within the rotation window of the credential policy."
+type StaleSecretIssue implements Issue & Node {
+	"Unique identifier for this issue."
+	id: ID!
+	"The team environment where the issue was detected."
+	teamEnvironment: TeamEnvironment!
+	"The severity of the issue."
+	severity: Severity!
+	"A human-readable description of the issue."
+	message: String!
+	firstSeen: Time!
+	lastSeen: Time!
+	acknowledgement: IssueAcknowledgement
+
+	"The secret that has not been rotated."
+	secret: Secret!
+	"When the secret was last updated."
+	lastModifiedAt: Time!
+	"The user that last updated the secret, if known."
+	lastModifiedBy: String
+}
+
+extend type Team {
+	"History of issues that have affected the team, including resolved issues. Ordered by when the issues were first seen, newest first."
+	issueHistory(
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+
+		"Filtering options for items returned from the connection."
+		filter: IssueHistoryFilter
+	): IssueHistoryConnection!
+}
+
+input IssueHistoryFilter {
+	"Filter by resource name."
+	resourceName: String
+
+	"Filter by resource type."
+	resourceType: ResourceType
+
+	"Filter by environment."
+	environments: [String!]
+
+	"Filter by issue type."
+	issueType: IssueType
+
+	"Only include resolved issues when true, or unresolved issues when false."
+	resolved: Boolean
+
+	"Only include issues that were seen, or resolved, at or after this time."
+	since: Time
+}
+
+type IssueHistoryConnection {
+	"Pagination information."
+	pageInfo: PageInfo!
+
+	"List of nodes."
+	nodes: [IssueHistoryEntry!]!
+
+	"List of edges."
+	edges: [IssueHistoryEdge!]!
+
+	"Mean time to resolve per issue type, computed over the resolved issues matching the filter."
+	meanTimeToResolve: [IssueTypeMeanTimeToResolve!]!
+}
+
+type IssueHistoryEdge {
+	"Cursor for this edge that can be used for pagination."
+	cursor: Cursor!
+
+	"The issue history entry."
+	node: IssueHistoryEntry!
+}
+
+"The lifecycle of an issue, from when it was first seen until it was resolved."
+type IssueHistoryEntry {
+	"The type of the issue."
+	issueType: IssueType!
+
+	"The type of the resource the issue affects."
+	resourceType: ResourceType!
+
+	"The name of the resource the issue affects."
+	resourceName: String!
+
+	"The team environment of the resource."
+	teamEnvironment: TeamEnvironment!
+
+	"The latest severity of the issue."
+	severity: Severity!
+
+	"The latest message of the issue."
+	message: String!
+
+	"When the issue was first seen."
+	firstSeen: Time!
+
+	"When the issue was last seen by the issue checker."
+	lastSeen: Time!
+
+	"When the issue was resolved. Null if the issue is unresolved."
+	resolvedAt: Time
+
+	"The issue, if it is unresolved."
+	issue: Issue
+}
+
+"Mean time to resolve issues of a type."
+type IssueTypeMeanTimeToResolve {
+	"The issue type."
+	issueType: IssueType!
+
+	"Number of resolved issues of the type."
+	resolvedCount: Int!
+
+	"Mean time from an issue was first seen until it was resolved, in seconds."
+	meanTimeToResolve: Float!
+}
 `, BuiltIn: false},
 	{Name: "../schema/jobs.graphqls", Input: `extend type Team {
 	"Nais jobs owned by the team."
@@ -25922,6 +32162,58 @@ type Reconciler implements Node {
 		"Get items before this cursor."
 		before: Cursor
 	): ReconcilerErrorConnection!
+
+	"Revisions of the state the reconciler has stored for a team, newest first. Only a bounded number of revisions is kept."
+	stateRevisions(
+		"The team to get state revisions for."
+		teamSlug: Slug!
+
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+	): ReconcilerStateRevisionConnection!
+}
+
+type ReconcilerStateRevisionConnection {
+	"Pagination information."
+	pageInfo: PageInfo!
+
+	"List of nodes."
+	nodes: [ReconcilerStateRevision!]!
+
+	"List of edges."
+	edges: [ReconcilerStateRevisionEdge!]!
+}
+
+type ReconcilerStateRevisionEdge {
+	"Cursor for this edge that can be used for pagination."
+	cursor: Cursor!
+
+	"The reconciler state revision."
+	node: ReconcilerStateRevision!
+}
+
+"A revision of the state a reconciler has stored for a team."
+type ReconcilerStateRevision {
+	"The revision number. Revisions are numbered sequentially per reconciler and team."
+	revision: Int!
+
+	"Creation timestamp of the revision."
+	createdAt: Time!
+
+	"The stored state. Values that are not valid UTF-8 are base64 encoded."
+	value: String!
+
+	"Whether or not the value is base64 encoded."
+	base64Encoded: Boolean!
 }
 
 type ReconcilerErrorConnection {
@@ -26576,6 +32868,9 @@ enum SearchType {
 	"Delete a secret, and the values it contains."
 	deleteSecret(input: DeleteSecretInput!): DeleteSecretPayload!
 
+	"Restore the values of a secret to a previous version. The rollback is recorded as a new version."
+	rollbackSecret(input: RollbackSecretInput!): RollbackSecretPayload!
+
 	"""
 	View the values of a secret. Requires team membership and a reason for access.
 	This creates a temporary elevation and logs the access for auditing purposes.
@@ -26758,6 +33053,24 @@ type Secret implements Node & ActivityLogger {
 	"User who last modified the secret."
 	lastModifiedBy: User
 
+	"""
+	Previous versions of the secret, newest first. Versions are recorded when values are changed through the API, and
+	only contain metadata about the change.
+	"""
+	versions(
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+	): SecretVersionConnection!
+
 	"Activity log associated with the secret."
 	activityLog(
 		"Get the first n items in the connection. This can be used in combination with the after parameter."
@@ -26869,6 +33182,20 @@ input DeleteSecretInput {
 	team: Slug!
 }
 
+input RollbackSecretInput {
+	"The name of the secret."
+	name: String!
+
+	"The environment the secret exists in."
+	environment: String!
+
+	"The team that owns the secret."
+	team: Slug!
+
+	"The version to restore."
+	version: Int!
+}
+
 """
 Input for viewing secret values.
 """
@@ -26944,6 +33271,11 @@ type RemoveSecretValuePayload {
 	secret: Secret
 }
 
+type RollbackSecretPayload {
+	"The updated secret."
+	secret: Secret
+}
+
 type DeleteSecretPayload {
 	"The deleted secret."
 	secretDeleted: Boolean
@@ -27177,15 +33509,617 @@ type SecretValueRemovedActivityLogEntry implements ActivityLogEntry & Node {
 	environmentName: String
 
 	"Data associated with the entry."
-	data: SecretValueRemovedActivityLogEntryData!
+	data: SecretValueRemovedActivityLogEntryData!
+}
+
+type SecretValueRemovedActivityLogEntryData {
+	"The name of the removed value."
+	valueName: String!
+}
+
+type SecretDeletedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+}
+
+extend enum ActivityLogActivityType {
+	"Secret was created."
+	SECRET_CREATED
+	"Secret was updated."
+	SECRET_UPDATED
+	"Secret value was added."
+	SECRET_VALUE_ADDED
+	"Secret value was updated."
+	SECRET_VALUE_UPDATED
+	"Secret value was removed."
+	SECRET_VALUE_REMOVED
+	"Secret was deleted."
+	SECRET_DELETED
+	"Secret values were viewed."
+	SECRET_VALUES_VIEWED
+	"Secret was rolled back to a previous version."
+	SECRET_ROLLED_BACK
+}
+
+"""
+Activity log entry for viewing secret values.
+"""
+type SecretValuesViewedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the entry."
+	data: SecretValuesViewedActivityLogEntryData!
+}
+
+"""
+Data associated with a secret values viewed activity log entry.
+"""
+type SecretValuesViewedActivityLogEntryData {
+	"The reason provided for viewing the secret values."
+	reason: String!
+}
+
+"""
+A version of a secret. The values of the version are never exposed.
+"""
+type SecretVersion {
+	"The version number, starting at 1."
+	version: Int!
+
+	"Time the version was created."
+	createdAt: Time!
+
+	"The identity of the actor who created the version. Null if unknown."
+	createdBy: String
+
+	"The names of the values that were added, updated or removed in this version."
+	changedKeys: [String!]!
+}
+
+type SecretVersionConnection {
+	"Pagination information."
+	pageInfo: PageInfo!
+
+	"List of nodes."
+	nodes: [SecretVersion!]!
+
+	"List of edges."
+	edges: [SecretVersionEdge!]!
+}
+
+type SecretVersionEdge {
+	"Cursor for this edge that can be used for pagination."
+	cursor: Cursor!
+
+	"The secret version."
+	node: SecretVersion!
+}
+
+"""
+Activity log entry for rolling back a secret.
+"""
+type SecretRolledBackActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the entry."
+	data: SecretRolledBackActivityLogEntryData!
+}
+
+type SecretRolledBackActivityLogEntryData {
+	"The version the secret was rolled back to."
+	version: Int!
+}
+`, BuiltIn: false},
+	{Name: "../schema/secret_access_requests.graphqls", Input: `extend type Mutation {
+	"""
+	Request access to view the values of a secret in an environment where access must be approved by another team
+	owner. The values can be viewed with viewSecretValues once the request is approved.
+	"""
+	requestSecretAccess(input: RequestSecretAccessInput!): RequestSecretAccessPayload!
+
+	"""
+	Approve a pending request to view secret values. This grants the requester time-limited access to the values.
+	Requires team ownership, and requesters can not approve their own requests.
+	"""
+	approveSecretAccessRequest(input: ApproveSecretAccessRequestInput!): ApproveSecretAccessRequestPayload!
+
+	"""
+	Deny a pending request to view secret values. Requires team ownership, and requesters can not deny their own
+	requests.
+	"""
+	denySecretAccessRequest(input: DenySecretAccessRequestInput!): DenySecretAccessRequestPayload!
+}
+
+extend type Team {
+	"Requests to view the values of the team's secrets, newest first."
+	secretAccessRequests(
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+
+		"Only include requests in this state."
+		state: SecretAccessRequestState
+	): SecretAccessRequestConnection!
+}
+
+extend type Secret {
+	"Whether viewing the values of the secret requires an approved access request."
+	valuesRequireApproval: Boolean!
+
+	"Requests to view the values of the secret, newest first."
+	accessRequests(
+		"Get the first n items in the connection. This can be used in combination with the after parameter."
+		first: Int
+
+		"Get items after this cursor."
+		after: Cursor
+
+		"Get the last n items in the connection. This can be used in combination with the before parameter."
+		last: Int
+
+		"Get items before this cursor."
+		before: Cursor
+	): SecretAccessRequestConnection!
+}
+
+"A request to view the values of a secret."
+type SecretAccessRequest implements Node {
+	"The globally unique ID of the access request."
+	id: ID!
+
+	"The team that owns the secret."
+	team: Team!
+
+	"The environment the secret exists in."
+	teamEnvironment: TeamEnvironment!
+
+	"The name of the secret."
+	secretName: String!
+
+	"The identity of the user who requested access."
+	requester: String!
+
+	"The reason given for the request."
+	reason: String!
+
+	"The state of the request."
+	state: SecretAccessRequestState!
+
+	"Time the request was created."
+	createdAt: Time!
+
+	"The identity of the team owner who approved or denied the request."
+	handledBy: String
+
+	"Time the request was approved or denied."
+	handledAt: Time
+
+	"Comment given when the request was approved or denied."
+	handlerComment: String
+
+	"Time the access ends. Only set for approved requests."
+	accessExpiresAt: Time
+}
+
+enum SecretAccessRequestState {
+	"The request is waiting to be approved or denied."
+	PENDING
+
+	"The request was approved, and the requester can view the secret values until the access expires."
+	APPROVED
+
+	"The request was denied."
+	DENIED
+
+	"The request was not handled in time, or the approved access has ended."
+	EXPIRED
+}
+
+type SecretAccessRequestConnection {
+	"Pagination information."
+	pageInfo: PageInfo!
+
+	"List of nodes."
+	nodes: [SecretAccessRequest!]!
+
+	"List of edges."
+	edges: [SecretAccessRequestEdge!]!
+}
+
+type SecretAccessRequestEdge {
+	"Cursor for this edge that can be used for pagination."
+	cursor: Cursor!
+
+	"The access request."
+	node: SecretAccessRequest!
+}
+
+input RequestSecretAccessInput {
+	"The name of the secret."
+	name: String!
+
+	"The environment the secret exists in."
+	environment: String!
+
+	"The team that owns the secret."
+	team: Slug!
+
+	"Reason for viewing the secret values. Must be at least 10 characters."
+	reason: String!
+}
+
+type RequestSecretAccessPayload {
+	"The created access request."
+	accessRequest: SecretAccessRequest
+}
+
+input ApproveSecretAccessRequestInput {
+	"The ID of the access request."
+	id: ID!
+
+	"Optional comment for the requester."
+	comment: String
+}
+
+type ApproveSecretAccessRequestPayload {
+	"The approved access request."
+	accessRequest: SecretAccessRequest
+}
+
+input DenySecretAccessRequestInput {
+	"The ID of the access request."
+	id: ID!
+
+	"Optional comment for the requester."
+	comment: String
+}
+
+type DenySecretAccessRequestPayload {
+	"The denied access request."
+	accessRequest: SecretAccessRequest
+}
+
+extend enum ActivityLogActivityType {
+	"Access to secret values was requested."
+	SECRET_ACCESS_REQUESTED
+	"Access to secret values was approved."
+	SECRET_ACCESS_APPROVED
+	"Access to secret values was denied."
+	SECRET_ACCESS_DENIED
+	"An access request or approved access to secret values expired."
+	SECRET_ACCESS_EXPIRED
+}
+
+"Activity log entry for requesting access to secret values."
+type SecretAccessRequestedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the entry."
+	data: SecretAccessRequestedActivityLogEntryData!
+}
+
+type SecretAccessRequestedActivityLogEntryData {
+	"The ID of the access request."
+	requestID: String!
+
+	"The reason given for the request."
+	reason: String!
+}
+
+"Activity log entry for approving access to secret values."
+type SecretAccessApprovedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the entry."
+	data: SecretAccessApprovedActivityLogEntryData!
+}
+
+type SecretAccessApprovedActivityLogEntryData {
+	"The ID of the access request."
+	requestID: String!
+
+	"The identity of the user who requested access."
+	requester: String!
+
+	"Time the access ends."
+	accessExpiresAt: Time!
+
+	"Comment given when the request was approved."
+	comment: String
+}
+
+"Activity log entry for denying access to secret values."
+type SecretAccessDeniedActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the entry."
+	data: SecretAccessDeniedActivityLogEntryData!
+}
+
+type SecretAccessDeniedActivityLogEntryData {
+	"The ID of the access request."
+	requestID: String!
+
+	"The identity of the user who requested access."
+	requester: String!
+
+	"Comment given when the request was denied."
+	comment: String
+}
+
+"Activity log entry for an access request or approved access to secret values that expired."
+type SecretAccessExpiredActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the entry."
+	data: SecretAccessExpiredActivityLogEntryData!
 }
 
-type SecretValueRemovedActivityLogEntryData {
-	"The name of the removed value."
-	valueName: String!
+type SecretAccessExpiredActivityLogEntryData {
+	"The ID of the access request."
+	requestID: String!
+
+	"The identity of the user who requested access."
+	requester: String!
+
+	"Whether the request had been approved, meaning that the access ended. Otherwise the request was never handled."
+	wasApproved: Boolean!
 }
+`, BuiltIn: false},
+	{Name: "../schema/secret_external_sources.graphqls", Input: `extend type Mutation {
+	"""
+	Sync the values of a secret from an external source, such as a key management service or a vault. The current values
+	are replaced by the values from the external source, and the secret can not be edited in Console until the external
+	source is removed.
+	"""
+	setSecretExternalSource(input: SetSecretExternalSourceInput!): SetSecretExternalSourcePayload!
 
-type SecretDeletedActivityLogEntry implements ActivityLogEntry & Node {
+	"Stop syncing the values of a secret from its external source. The current values are kept."
+	removeSecretExternalSource(input: RemoveSecretExternalSourceInput!): RemoveSecretExternalSourcePayload!
+}
+
+extend type Secret {
+	"The external source the values of the secret are synced from. Null if the values are managed in Console."
+	externalSource: SecretExternalSource
+}
+
+"An external source that the values of a secret are synced from."
+type SecretExternalSource {
+	"The name of the external source."
+	source: String!
+
+	"Reference to the secret in the external source."
+	reference: String!
+
+	"Status of the last sync."
+	syncStatus: SecretExternalSourceSyncStatus!
+
+	"Time of the last successful sync."
+	lastSyncedAt: Time
+
+	"Error from the last sync. Only set when the last sync failed."
+	syncError: String
+}
+
+enum SecretExternalSourceSyncStatus {
+	"The secret has not been synced yet."
+	PENDING
+
+	"The values of the secret were synced from the external source."
+	SYNCED
+
+	"The values could not be fetched from the external source. The secret keeps the values from the last successful sync."
+	FAILED
+}
+
+input SetSecretExternalSourceInput {
+	"The name of the secret."
+	name: String!
+
+	"The environment the secret exists in."
+	environment: String!
+
+	"The team that owns the secret."
+	team: Slug!
+
+	"The name of the external source."
+	source: String!
+
+	"Reference to the secret in the external source, relative to the team. References to secrets of other teams are rejected."
+	reference: String!
+}
+
+type SetSecretExternalSourcePayload {
+	"The updated secret."
+	secret: Secret
+}
+
+input RemoveSecretExternalSourceInput {
+	"The name of the secret."
+	name: String!
+
+	"The environment the secret exists in."
+	environment: String!
+
+	"The team that owns the secret."
+	team: Slug!
+}
+
+type RemoveSecretExternalSourcePayload {
+	"The updated secret."
+	secret: Secret
+}
+
+extend enum ActivityLogActivityType {
+	"An external source was set for a secret."
+	SECRET_EXTERNAL_SOURCE_SET
+	"The external source of a secret was removed."
+	SECRET_EXTERNAL_SOURCE_REMOVED
+}
+
+"Activity log entry for setting the external source of a secret."
+type SecretExternalSourceSetActivityLogEntry implements ActivityLogEntry & Node {
 	"ID of the entry."
 	id: ID!
 
@@ -27209,29 +34143,21 @@ type SecretDeletedActivityLogEntry implements ActivityLogEntry & Node {
 
 	"The environment name that the entry belongs to."
 	environmentName: String
+
+	"Data associated with the entry."
+	data: SecretExternalSourceSetActivityLogEntryData!
 }
 
-extend enum ActivityLogActivityType {
-	"Secret was created."
-	SECRET_CREATED
-	"Secret was updated."
-	SECRET_UPDATED
-	"Secret value was added."
-	SECRET_VALUE_ADDED
-	"Secret value was updated."
-	SECRET_VALUE_UPDATED
-	"Secret value was removed."
-	SECRET_VALUE_REMOVED
-	"Secret was deleted."
-	SECRET_DELETED
-	"Secret values were viewed."
-	SECRET_VALUES_VIEWED
+type SecretExternalSourceSetActivityLogEntryData {
+	"The name of the external source."
+	source: String!
+
+	"Reference to the secret in the external source."
+	reference: String!
 }
 
-"""
-Activity log entry for viewing secret values.
-"""
-type SecretValuesViewedActivityLogEntry implements ActivityLogEntry & Node {
+"Activity log entry for removing the external source of a secret."
+type SecretExternalSourceRemovedActivityLogEntry implements ActivityLogEntry & Node {
 	"ID of the entry."
 	id: ID!
 
@@ -27257,15 +34183,12 @@ type SecretValuesViewedActivityLogEntry implements ActivityLogEntry & Node {
 	environmentName: String
 
 	"Data associated with the entry."
-	data: SecretValuesViewedActivityLogEntryData!
+	data: SecretExternalSourceRemovedActivityLogEntryData!
 }
 
-"""
-Data associated with a secret values viewed activity log entry.
-"""
-type SecretValuesViewedActivityLogEntryData {
-	"The reason provided for viewing the secret values."
-	reason: String!
+type SecretExternalSourceRemovedActivityLogEntryData {
+	"The name of the external source."
+	source: String!
 }
 `, BuiltIn: false},
 	{Name: "../schema/serviceaccount_workload_bindings.graphqls", Input: `extend type Mutation {
@@ -29131,6 +36054,14 @@ extend type Mutation {
 	The user must already be a member of the team for this mutation to succeed.
 	"""
 	setTeamMemberRole(input: SetTeamMemberRoleInput!): SetTeamMemberRolePayload!
+
+	"""
+	Limit the environments of a team member
+
+	The member can only create, update and delete applications, jobs and secrets, read secret values and apply
+	resources in the given environments. In other environments the member has read access only.
+	"""
+	setTeamMemberEnvironments(input: SetTeamMemberEnvironmentsInput!): SetTeamMemberEnvironmentsPayload!
 }
 
 """
@@ -29228,6 +36159,9 @@ type TeamMember {
 
 	"The role that the user has in the team."
 	role: TeamMemberRole!
+
+	"The environments the member can make changes in. Null if the member is not limited to specific environments."
+	environments: [String!]
 }
 
 type CreateTeamPayload {
@@ -29276,6 +36210,11 @@ type SetTeamMemberRolePayload {
 	member: TeamMember
 }
 
+type SetTeamMemberEnvironmentsPayload {
+	"The updated team member."
+	member: TeamMember
+}
+
 type TeamDeleteKey {
 	"The unique key used to confirm the deletion of a team."
 	key: String!
@@ -29449,6 +36388,17 @@ input SetTeamMemberRoleInput {
 	role: TeamMemberRole!
 }
 
+input SetTeamMemberEnvironmentsInput {
+	"The slug of the team."
+	teamSlug: Slug!
+
+	"The email address of the user."
+	userEmail: String!
+
+	"The environments the member can make changes in. Set to null to allow changes in all environments."
+	environments: [String!]
+}
+
 "Possible fields to order teams by."
 enum TeamOrderField {
 	"The unique slug of the team."
@@ -29721,6 +36671,46 @@ type TeamMemberSetRoleActivityLogEntryData {
 	userEmail: String!
 }
 
+type TeamMemberSetEnvironmentsActivityLogEntry implements ActivityLogEntry & Node {
+	"ID of the entry."
+	id: ID!
+
+	"The identity of the actor who performed the action. The value is either the name of a service account, or the email address of a user."
+	actor: String!
+
+	"Creation time of the entry."
+	createdAt: Time!
+
+	"Message that summarizes the entry."
+	message: String!
+
+	"Type of the resource that was affected by the action."
+	resourceType: ActivityLogEntryResourceType!
+
+	"Name of the resource that was affected by the action."
+	resourceName: String!
+
+	"The team slug that the entry belongs to."
+	teamSlug: Slug!
+
+	"The environment name that the entry belongs to."
+	environmentName: String
+
+	"Data associated with the action."
+	data: TeamMemberSetEnvironmentsActivityLogEntryData!
+}
+
+type TeamMemberSetEnvironmentsActivityLogEntryData {
+	"The environments the member can make changes in. Null if the member can make changes in all environments."
+	environments: [String!]
+
+	"The ID of the user."
+	userID: ID!
+
+	"The email address of the user."
+	userEmail: String!
+}
+
 type TeamEnvironmentUpdatedActivityLogEntry implements ActivityLogEntry & Node {
 	"ID of the entry."
 	id: ID!
@@ -29835,6 +36825,8 @@ extend enum ActivityLogActivityType {
 	TEAM_MEMBER_SET_ROLE
 	"Team environment was updated."
 	TEAM_ENVIRONMENT_UPDATED
+	"Team member environments were set."
+	TEAM_MEMBER_SET_ENVIRONMENTS
 }
 `, BuiltIn: false},
 	{Name: "../schema/tunnel.graphqls", Input: `"""
@@ -32745,6 +39737,14 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 // Each function is generated once per unique object type, deduplicating the
 // switch statements that were previously inlined in every fieldContext_* function.
 
+func (ec *executionContext) childFields_AcknowledgeIssuePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "issue":
+		return ec.fieldContext_AcknowledgeIssuePayload_issue(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type AcknowledgeIssuePayload", field.Name)
+}
+
 func (ec *executionContext) childFields_ActivityLogActivityTypeFacetItem(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "activityType":
@@ -32895,6 +39895,16 @@ func (ec *executionContext) childFields_AllowTeamAccessToUnleashPayload(ctx cont
 	return nil, fmt.Errorf("no field named %q was found under type AllowTeamAccessToUnleashPayload", field.Name)
 }
 
+func (ec *executionContext) childFields_AllowedResource(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "apiVersion":
+		return ec.fieldContext_AllowedResource_apiVersion(ctx, field)
+	case "kind":
+		return ec.fieldContext_AllowedResource_kind(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type AllowedResource", field.Name)
+}
+
 func (ec *executionContext) childFields_Application(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -32939,6 +39949,8 @@ func (ec *executionContext) childFields_Application(ctx context.Context, field g
 		return ec.fieldContext_Application_cost(ctx, field)
 	case "deployments":
 		return ec.fieldContext_Application_deployments(ctx, field)
+	case "doraMetrics":
+		return ec.fieldContext_Application_doraMetrics(ctx, field)
 	case "instanceGroups":
 		return ec.fieldContext_Application_instanceGroups(ctx, field)
 	case "kafkaTopicAcls":
@@ -33145,6 +40157,14 @@ func (ec *executionContext) childFields_ApplicationUpdatedActivityLogEntryData(c
 	return nil, fmt.Errorf("no field named %q was found under type ApplicationUpdatedActivityLogEntryData", field.Name)
 }
 
+func (ec *executionContext) childFields_ApproveSecretAccessRequestPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "accessRequest":
+		return ec.fieldContext_ApproveSecretAccessRequestPayload_accessRequest(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ApproveSecretAccessRequestPayload", field.Name)
+}
+
 func (ec *executionContext) childFields_AssignRoleToServiceAccountPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "serviceAccount":
@@ -33593,6 +40613,30 @@ func (ec *executionContext) childFields_CreateConfigPayload(ctx context.Context,
 	return nil, fmt.Errorf("no field named %q was found under type CreateConfigPayload", field.Name)
 }
 
+func (ec *executionContext) childFields_CreateCustomTeamRolePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "customRole":
+		return ec.fieldContext_CreateCustomTeamRolePayload_customRole(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CreateCustomTeamRolePayload", field.Name)
+}
+
+func (ec *executionContext) childFields_CreateDeploymentFreezePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "deploymentFreeze":
+		return ec.fieldContext_CreateDeploymentFreezePayload_deploymentFreeze(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CreateDeploymentFreezePayload", field.Name)
+}
+
+func (ec *executionContext) childFields_CreateIssueSuppressionRulePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "suppressionRule":
+		return ec.fieldContext_CreateIssueSuppressionRulePayload_suppressionRule(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CreateIssueSuppressionRulePayload", field.Name)
+}
+
 func (ec *executionContext) childFields_CreateKafkaCredentialsPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "credentials":
@@ -33705,6 +40749,58 @@ func (ec *executionContext) childFields_CurrentUnitPrices(ctx context.Context, f
 	return nil, fmt.Errorf("no field named %q was found under type CurrentUnitPrices", field.Name)
 }
 
+func (ec *executionContext) childFields_CustomTeamRole(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_CustomTeamRole_id(ctx, field)
+	case "team":
+		return ec.fieldContext_CustomTeamRole_team(ctx, field)
+	case "name":
+		return ec.fieldContext_CustomTeamRole_name(ctx, field)
+	case "description":
+		return ec.fieldContext_CustomTeamRole_description(ctx, field)
+	case "authorizations":
+		return ec.fieldContext_CustomTeamRole_authorizations(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_CustomTeamRole_createdAt(ctx, field)
+	case "updatedAt":
+		return ec.fieldContext_CustomTeamRole_updatedAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CustomTeamRole", field.Name)
+}
+
+func (ec *executionContext) childFields_CustomTeamRoleAuthorization(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext_CustomTeamRoleAuthorization_name(ctx, field)
+	case "description":
+		return ec.fieldContext_CustomTeamRoleAuthorization_description(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CustomTeamRoleAuthorization", field.Name)
+}
+
+func (ec *executionContext) childFields_CustomTeamRoleConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "pageInfo":
+		return ec.fieldContext_CustomTeamRoleConnection_pageInfo(ctx, field)
+	case "nodes":
+		return ec.fieldContext_CustomTeamRoleConnection_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_CustomTeamRoleConnection_edges(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CustomTeamRoleConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_CustomTeamRoleEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "cursor":
+		return ec.fieldContext_CustomTeamRoleEdge_cursor(ctx, field)
+	case "node":
+		return ec.fieldContext_CustomTeamRoleEdge_node(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CustomTeamRoleEdge", field.Name)
+}
+
 func (ec *executionContext) childFields_DeleteApplicationPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "team":
@@ -33723,6 +40819,22 @@ func (ec *executionContext) childFields_DeleteConfigPayload(ctx context.Context,
 	return nil, fmt.Errorf("no field named %q was found under type DeleteConfigPayload", field.Name)
 }
 
+func (ec *executionContext) childFields_DeleteCustomTeamRolePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "customRoleDeleted":
+		return ec.fieldContext_DeleteCustomTeamRolePayload_customRoleDeleted(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeleteCustomTeamRolePayload", field.Name)
+}
+
+func (ec *executionContext) childFields_DeleteIssueSuppressionRulePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "success":
+		return ec.fieldContext_DeleteIssueSuppressionRulePayload_success(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeleteIssueSuppressionRulePayload", field.Name)
+}
+
 func (ec *executionContext) childFields_DeleteJobPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "team":
@@ -33809,6 +40921,14 @@ func (ec *executionContext) childFields_DeleteValkeyPayload(ctx context.Context,
 	return nil, fmt.Errorf("no field named %q was found under type DeleteValkeyPayload", field.Name)
 }
 
+func (ec *executionContext) childFields_DenySecretAccessRequestPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "accessRequest":
+		return ec.fieldContext_DenySecretAccessRequestPayload_accessRequest(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DenySecretAccessRequestPayload", field.Name)
+}
+
 func (ec *executionContext) childFields_Deployment(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -33831,6 +40951,8 @@ func (ec *executionContext) childFields_Deployment(ctx context.Context, field gr
 		return ec.fieldContext_Deployment_resources(ctx, field)
 	case "statuses":
 		return ec.fieldContext_Deployment_statuses(ctx, field)
+	case "promotedFrom":
+		return ec.fieldContext_Deployment_promotedFrom(ctx, field)
 	}
 	return nil, fmt.Errorf("no field named %q was found under type Deployment", field.Name)
 }
@@ -33843,6 +40965,20 @@ func (ec *executionContext) childFields_DeploymentActivityLogEntryData(ctx conte
 	return nil, fmt.Errorf("no field named %q was found under type DeploymentActivityLogEntryData", field.Name)
 }
 
+func (ec *executionContext) childFields_DeploymentCommitRange(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "repository":
+		return ec.fieldContext_DeploymentCommitRange_repository(ctx, field)
+	case "fromCommitSha":
+		return ec.fieldContext_DeploymentCommitRange_fromCommitSha(ctx, field)
+	case "toCommitSha":
+		return ec.fieldContext_DeploymentCommitRange_toCommitSha(ctx, field)
+	case "compareURL":
+		return ec.fieldContext_DeploymentCommitRange_compareURL(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeploymentCommitRange", field.Name)
+}
+
 func (ec *executionContext) childFields_DeploymentConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "pageInfo":
@@ -33855,6 +40991,24 @@ func (ec *executionContext) childFields_DeploymentConnection(ctx context.Context
 	return nil, fmt.Errorf("no field named %q was found under type DeploymentConnection", field.Name)
 }
 
+func (ec *executionContext) childFields_DeploymentDiff(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "from":
+		return ec.fieldContext_DeploymentDiff_from(ctx, field)
+	case "to":
+		return ec.fieldContext_DeploymentDiff_to(ctx, field)
+	case "commitRange":
+		return ec.fieldContext_DeploymentDiff_commitRange(ctx, field)
+	case "changedResourceKinds":
+		return ec.fieldContext_DeploymentDiff_changedResourceKinds(ctx, field)
+	case "specChanges":
+		return ec.fieldContext_DeploymentDiff_specChanges(ctx, field)
+	case "specAvailable":
+		return ec.fieldContext_DeploymentDiff_specAvailable(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeploymentDiff", field.Name)
+}
+
 func (ec *executionContext) childFields_DeploymentEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "cursor":
@@ -33865,6 +41019,90 @@ func (ec *executionContext) childFields_DeploymentEdge(ctx context.Context, fiel
 	return nil, fmt.Errorf("no field named %q was found under type DeploymentEdge", field.Name)
 }
 
+func (ec *executionContext) childFields_DeploymentFreeze(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_DeploymentFreeze_id(ctx, field)
+	case "team":
+		return ec.fieldContext_DeploymentFreeze_team(ctx, field)
+	case "teamEnvironment":
+		return ec.fieldContext_DeploymentFreeze_teamEnvironment(ctx, field)
+	case "reason":
+		return ec.fieldContext_DeploymentFreeze_reason(ctx, field)
+	case "startsAt":
+		return ec.fieldContext_DeploymentFreeze_startsAt(ctx, field)
+	case "endsAt":
+		return ec.fieldContext_DeploymentFreeze_endsAt(ctx, field)
+	case "active":
+		return ec.fieldContext_DeploymentFreeze_active(ctx, field)
+	case "createdBy":
+		return ec.fieldContext_DeploymentFreeze_createdBy(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_DeploymentFreeze_createdAt(ctx, field)
+	case "overrides":
+		return ec.fieldContext_DeploymentFreeze_overrides(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeploymentFreeze", field.Name)
+}
+
+func (ec *executionContext) childFields_DeploymentFreezeConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "pageInfo":
+		return ec.fieldContext_DeploymentFreezeConnection_pageInfo(ctx, field)
+	case "nodes":
+		return ec.fieldContext_DeploymentFreezeConnection_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_DeploymentFreezeConnection_edges(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeploymentFreezeConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_DeploymentFreezeCreatedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "reason":
+		return ec.fieldContext_DeploymentFreezeCreatedActivityLogEntryData_reason(ctx, field)
+	case "startsAt":
+		return ec.fieldContext_DeploymentFreezeCreatedActivityLogEntryData_startsAt(ctx, field)
+	case "endsAt":
+		return ec.fieldContext_DeploymentFreezeCreatedActivityLogEntryData_endsAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeploymentFreezeCreatedActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_DeploymentFreezeEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "cursor":
+		return ec.fieldContext_DeploymentFreezeEdge_cursor(ctx, field)
+	case "node":
+		return ec.fieldContext_DeploymentFreezeEdge_node(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeploymentFreezeEdge", field.Name)
+}
+
+func (ec *executionContext) childFields_DeploymentFreezeOverriddenActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "justification":
+		return ec.fieldContext_DeploymentFreezeOverriddenActivityLogEntryData_justification(ctx, field)
+	case "expiresAt":
+		return ec.fieldContext_DeploymentFreezeOverriddenActivityLogEntryData_expiresAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeploymentFreezeOverriddenActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_DeploymentFreezeOverride(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "justification":
+		return ec.fieldContext_DeploymentFreezeOverride_justification(ctx, field)
+	case "createdBy":
+		return ec.fieldContext_DeploymentFreezeOverride_createdBy(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_DeploymentFreezeOverride_createdAt(ctx, field)
+	case "expiresAt":
+		return ec.fieldContext_DeploymentFreezeOverride_expiresAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeploymentFreezeOverride", field.Name)
+}
+
 func (ec *executionContext) childFields_DeploymentKey(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -33949,6 +41187,36 @@ func (ec *executionContext) childFields_DeploymentStatusEdge(ctx context.Context
 	return nil, fmt.Errorf("no field named %q was found under type DeploymentStatusEdge", field.Name)
 }
 
+func (ec *executionContext) childFields_DoraMetrics(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "from":
+		return ec.fieldContext_DoraMetrics_from(ctx, field)
+	case "to":
+		return ec.fieldContext_DoraMetrics_to(ctx, field)
+	case "successfulDeployments":
+		return ec.fieldContext_DoraMetrics_successfulDeployments(ctx, field)
+	case "failedDeployments":
+		return ec.fieldContext_DoraMetrics_failedDeployments(ctx, field)
+	case "deploymentFrequency":
+		return ec.fieldContext_DoraMetrics_deploymentFrequency(ctx, field)
+	case "leadTimeSeconds":
+		return ec.fieldContext_DoraMetrics_leadTimeSeconds(ctx, field)
+	case "changeFailureRate":
+		return ec.fieldContext_DoraMetrics_changeFailureRate(ctx, field)
+	case "timeToRestoreSeconds":
+		return ec.fieldContext_DoraMetrics_timeToRestoreSeconds(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DoraMetrics", field.Name)
+}
+
+func (ec *executionContext) childFields_EndDeploymentFreezePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "deploymentFreeze":
+		return ec.fieldContext_EndDeploymentFreezePayload_deploymentFreeze(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type EndDeploymentFreezePayload", field.Name)
+}
+
 func (ec *executionContext) childFields_Environment(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -33957,6 +41225,8 @@ func (ec *executionContext) childFields_Environment(ctx context.Context, field g
 		return ec.fieldContext_Environment_name(ctx, field)
 	case "oidcIssuerURL":
 		return ec.fieldContext_Environment_oidcIssuerURL(ctx, field)
+	case "allowedApplyResources":
+		return ec.fieldContext_Environment_allowedApplyResources(ctx, field)
 	case "metrics":
 		return ec.fieldContext_Environment_metrics(ctx, field)
 	case "workloads":
@@ -34295,6 +41565,38 @@ func (ec *executionContext) childFields_InstanceGroupValueSource(ctx context.Con
 	return nil, fmt.Errorf("no field named %q was found under type InstanceGroupValueSource", field.Name)
 }
 
+func (ec *executionContext) childFields_IssueAcknowledgedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "issueType":
+		return ec.fieldContext_IssueAcknowledgedActivityLogEntryData_issueType(ctx, field)
+	case "resourceType":
+		return ec.fieldContext_IssueAcknowledgedActivityLogEntryData_resourceType(ctx, field)
+	case "reason":
+		return ec.fieldContext_IssueAcknowledgedActivityLogEntryData_reason(ctx, field)
+	case "until":
+		return ec.fieldContext_IssueAcknowledgedActivityLogEntryData_until(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueAcknowledgedActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_IssueAcknowledgement(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "kind":
+		return ec.fieldContext_IssueAcknowledgement_kind(ctx, field)
+	case "reason":
+		return ec.fieldContext_IssueAcknowledgement_reason(ctx, field)
+	case "actor":
+		return ec.fieldContext_IssueAcknowledgement_actor(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_IssueAcknowledgement_createdAt(ctx, field)
+	case "until":
+		return ec.fieldContext_IssueAcknowledgement_until(ctx, field)
+	case "suppressionRule":
+		return ec.fieldContext_IssueAcknowledgement_suppressionRule(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueAcknowledgement", field.Name)
+}
+
 func (ec *executionContext) childFields_IssueConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "pageInfo":
@@ -34333,6 +41635,56 @@ func (ec *executionContext) childFields_IssueFacets(ctx context.Context, field g
 	return nil, fmt.Errorf("no field named %q was found under type IssueFacets", field.Name)
 }
 
+func (ec *executionContext) childFields_IssueHistoryConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "pageInfo":
+		return ec.fieldContext_IssueHistoryConnection_pageInfo(ctx, field)
+	case "nodes":
+		return ec.fieldContext_IssueHistoryConnection_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_IssueHistoryConnection_edges(ctx, field)
+	case "meanTimeToResolve":
+		return ec.fieldContext_IssueHistoryConnection_meanTimeToResolve(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueHistoryConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_IssueHistoryEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "cursor":
+		return ec.fieldContext_IssueHistoryEdge_cursor(ctx, field)
+	case "node":
+		return ec.fieldContext_IssueHistoryEdge_node(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueHistoryEdge", field.Name)
+}
+
+func (ec *executionContext) childFields_IssueHistoryEntry(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "issueType":
+		return ec.fieldContext_IssueHistoryEntry_issueType(ctx, field)
+	case "resourceType":
+		return ec.fieldContext_IssueHistoryEntry_resourceType(ctx, field)
+	case "resourceName":
+		return ec.fieldContext_IssueHistoryEntry_resourceName(ctx, field)
+	case "teamEnvironment":
+		return ec.fieldContext_IssueHistoryEntry_teamEnvironment(ctx, field)
+	case "severity":
+		return ec.fieldContext_IssueHistoryEntry_severity(ctx, field)
+	case "message":
+		return ec.fieldContext_IssueHistoryEntry_message(ctx, field)
+	case "firstSeen":
+		return ec.fieldContext_IssueHistoryEntry_firstSeen(ctx, field)
+	case "lastSeen":
+		return ec.fieldContext_IssueHistoryEntry_lastSeen(ctx, field)
+	case "resolvedAt":
+		return ec.fieldContext_IssueHistoryEntry_resolvedAt(ctx, field)
+	case "issue":
+		return ec.fieldContext_IssueHistoryEntry_issue(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueHistoryEntry", field.Name)
+}
+
 func (ec *executionContext) childFields_IssueResourceTypeFacetItem(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "resourceType":
@@ -34353,6 +41705,60 @@ func (ec *executionContext) childFields_IssueSeverityFacetItem(ctx context.Conte
 	return nil, fmt.Errorf("no field named %q was found under type IssueSeverityFacetItem", field.Name)
 }
 
+func (ec *executionContext) childFields_IssueSuppressionRule(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_IssueSuppressionRule_id(ctx, field)
+	case "issueType":
+		return ec.fieldContext_IssueSuppressionRule_issueType(ctx, field)
+	case "resourceNamePattern":
+		return ec.fieldContext_IssueSuppressionRule_resourceNamePattern(ctx, field)
+	case "reason":
+		return ec.fieldContext_IssueSuppressionRule_reason(ctx, field)
+	case "actor":
+		return ec.fieldContext_IssueSuppressionRule_actor(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_IssueSuppressionRule_createdAt(ctx, field)
+	case "team":
+		return ec.fieldContext_IssueSuppressionRule_team(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueSuppressionRule", field.Name)
+}
+
+func (ec *executionContext) childFields_IssueSuppressionRuleActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "issueType":
+		return ec.fieldContext_IssueSuppressionRuleActivityLogEntryData_issueType(ctx, field)
+	case "resourceNamePattern":
+		return ec.fieldContext_IssueSuppressionRuleActivityLogEntryData_resourceNamePattern(ctx, field)
+	case "reason":
+		return ec.fieldContext_IssueSuppressionRuleActivityLogEntryData_reason(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueSuppressionRuleActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_IssueSuppressionRuleConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "pageInfo":
+		return ec.fieldContext_IssueSuppressionRuleConnection_pageInfo(ctx, field)
+	case "nodes":
+		return ec.fieldContext_IssueSuppressionRuleConnection_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_IssueSuppressionRuleConnection_edges(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueSuppressionRuleConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_IssueSuppressionRuleEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "cursor":
+		return ec.fieldContext_IssueSuppressionRuleEdge_cursor(ctx, field)
+	case "node":
+		return ec.fieldContext_IssueSuppressionRuleEdge_node(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueSuppressionRuleEdge", field.Name)
+}
+
 func (ec *executionContext) childFields_IssueTypeFacetItem(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "issueType":
@@ -34363,6 +41769,18 @@ func (ec *executionContext) childFields_IssueTypeFacetItem(ctx context.Context,
 	return nil, fmt.Errorf("no field named %q was found under type IssueTypeFacetItem", field.Name)
 }
 
+func (ec *executionContext) childFields_IssueTypeMeanTimeToResolve(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "issueType":
+		return ec.fieldContext_IssueTypeMeanTimeToResolve_issueType(ctx, field)
+	case "resolvedCount":
+		return ec.fieldContext_IssueTypeMeanTimeToResolve_resolvedCount(ctx, field)
+	case "meanTimeToResolve":
+		return ec.fieldContext_IssueTypeMeanTimeToResolve_meanTimeToResolve(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueTypeMeanTimeToResolve", field.Name)
+}
+
 func (ec *executionContext) childFields_Job(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -35099,6 +42517,14 @@ func (ec *executionContext) childFields_OutboundNetworkPolicy(ctx context.Contex
 	return nil, fmt.Errorf("no field named %q was found under type OutboundNetworkPolicy", field.Name)
 }
 
+func (ec *executionContext) childFields_OverrideDeploymentFreezePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "deploymentFreeze":
+		return ec.fieldContext_OverrideDeploymentFreezePayload_deploymentFreeze(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type OverrideDeploymentFreezePayload", field.Name)
+}
+
 func (ec *executionContext) childFields_PageInfo(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "hasNextPage":
@@ -35269,6 +42695,14 @@ func (ec *executionContext) childFields_PrometheusAlarm(ctx context.Context, fie
 	return nil, fmt.Errorf("no field named %q was found under type PrometheusAlarm", field.Name)
 }
 
+func (ec *executionContext) childFields_PromoteWorkloadPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "deployment":
+		return ec.fieldContext_PromoteWorkloadPayload_deployment(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type PromoteWorkloadPayload", field.Name)
+}
+
 func (ec *executionContext) childFields_Reconciler(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -35287,6 +42721,8 @@ func (ec *executionContext) childFields_Reconciler(ctx context.Context, field gr
 		return ec.fieldContext_Reconciler_configured(ctx, field)
 	case "errors":
 		return ec.fieldContext_Reconciler_errors(ctx, field)
+	case "stateRevisions":
+		return ec.fieldContext_Reconciler_stateRevisions(ctx, field)
 	case "activityLog":
 		return ec.fieldContext_Reconciler_activityLog(ctx, field)
 	}
@@ -35379,6 +42815,42 @@ func (ec *executionContext) childFields_ReconcilerErrorEdge(ctx context.Context,
 	return nil, fmt.Errorf("no field named %q was found under type ReconcilerErrorEdge", field.Name)
 }
 
+func (ec *executionContext) childFields_ReconcilerStateRevision(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "revision":
+		return ec.fieldContext_ReconcilerStateRevision_revision(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_ReconcilerStateRevision_createdAt(ctx, field)
+	case "value":
+		return ec.fieldContext_ReconcilerStateRevision_value(ctx, field)
+	case "base64Encoded":
+		return ec.fieldContext_ReconcilerStateRevision_base64Encoded(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ReconcilerStateRevision", field.Name)
+}
+
+func (ec *executionContext) childFields_ReconcilerStateRevisionConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "pageInfo":
+		return ec.fieldContext_ReconcilerStateRevisionConnection_pageInfo(ctx, field)
+	case "nodes":
+		return ec.fieldContext_ReconcilerStateRevisionConnection_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_ReconcilerStateRevisionConnection_edges(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ReconcilerStateRevisionConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_ReconcilerStateRevisionEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "cursor":
+		return ec.fieldContext_ReconcilerStateRevisionEdge_cursor(ctx, field)
+	case "node":
+		return ec.fieldContext_ReconcilerStateRevisionEdge_node(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ReconcilerStateRevisionEdge", field.Name)
+}
+
 func (ec *executionContext) childFields_RemoveConfigValuePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "config":
@@ -35387,6 +42859,14 @@ func (ec *executionContext) childFields_RemoveConfigValuePayload(ctx context.Con
 	return nil, fmt.Errorf("no field named %q was found under type RemoveConfigValuePayload", field.Name)
 }
 
+func (ec *executionContext) childFields_RemoveIssueAcknowledgementPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "issue":
+		return ec.fieldContext_RemoveIssueAcknowledgementPayload_issue(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RemoveIssueAcknowledgementPayload", field.Name)
+}
+
 func (ec *executionContext) childFields_RemoveRepositoryFromTeamPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "success":
@@ -35395,6 +42875,14 @@ func (ec *executionContext) childFields_RemoveRepositoryFromTeamPayload(ctx cont
 	return nil, fmt.Errorf("no field named %q was found under type RemoveRepositoryFromTeamPayload", field.Name)
 }
 
+func (ec *executionContext) childFields_RemoveSecretExternalSourcePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "secret":
+		return ec.fieldContext_RemoveSecretExternalSourcePayload_secret(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RemoveSecretExternalSourcePayload", field.Name)
+}
+
 func (ec *executionContext) childFields_RemoveSecretValuePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "secret":
@@ -35457,6 +42945,14 @@ func (ec *executionContext) childFields_RepositoryEdge(ctx context.Context, fiel
 	return nil, fmt.Errorf("no field named %q was found under type RepositoryEdge", field.Name)
 }
 
+func (ec *executionContext) childFields_RequestSecretAccessPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "accessRequest":
+		return ec.fieldContext_RequestSecretAccessPayload_accessRequest(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RequestSecretAccessPayload", field.Name)
+}
+
 func (ec *executionContext) childFields_RequestTeamDeletionPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "key":
@@ -35561,6 +43057,14 @@ func (ec *executionContext) childFields_RoleRevokedFromServiceAccountActivityLog
 	return nil, fmt.Errorf("no field named %q was found under type RoleRevokedFromServiceAccountActivityLogEntryData", field.Name)
 }
 
+func (ec *executionContext) childFields_RollbackSecretPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "secret":
+		return ec.fieldContext_RollbackSecretPayload_secret(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RollbackSecretPayload", field.Name)
+}
+
 func (ec *executionContext) childFields_SearchNodeConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "pageInfo":
@@ -35607,12 +43111,120 @@ func (ec *executionContext) childFields_Secret(ctx context.Context, field graphq
 		return ec.fieldContext_Secret_lastModifiedAt(ctx, field)
 	case "lastModifiedBy":
 		return ec.fieldContext_Secret_lastModifiedBy(ctx, field)
+	case "versions":
+		return ec.fieldContext_Secret_versions(ctx, field)
 	case "activityLog":
 		return ec.fieldContext_Secret_activityLog(ctx, field)
+	case "valuesRequireApproval":
+		return ec.fieldContext_Secret_valuesRequireApproval(ctx, field)
+	case "accessRequests":
+		return ec.fieldContext_Secret_accessRequests(ctx, field)
+	case "externalSource":
+		return ec.fieldContext_Secret_externalSource(ctx, field)
 	}
 	return nil, fmt.Errorf("no field named %q was found under type Secret", field.Name)
 }
 
+func (ec *executionContext) childFields_SecretAccessApprovedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "requestID":
+		return ec.fieldContext_SecretAccessApprovedActivityLogEntryData_requestID(ctx, field)
+	case "requester":
+		return ec.fieldContext_SecretAccessApprovedActivityLogEntryData_requester(ctx, field)
+	case "accessExpiresAt":
+		return ec.fieldContext_SecretAccessApprovedActivityLogEntryData_accessExpiresAt(ctx, field)
+	case "comment":
+		return ec.fieldContext_SecretAccessApprovedActivityLogEntryData_comment(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretAccessApprovedActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretAccessDeniedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "requestID":
+		return ec.fieldContext_SecretAccessDeniedActivityLogEntryData_requestID(ctx, field)
+	case "requester":
+		return ec.fieldContext_SecretAccessDeniedActivityLogEntryData_requester(ctx, field)
+	case "comment":
+		return ec.fieldContext_SecretAccessDeniedActivityLogEntryData_comment(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretAccessDeniedActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretAccessExpiredActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "requestID":
+		return ec.fieldContext_SecretAccessExpiredActivityLogEntryData_requestID(ctx, field)
+	case "requester":
+		return ec.fieldContext_SecretAccessExpiredActivityLogEntryData_requester(ctx, field)
+	case "wasApproved":
+		return ec.fieldContext_SecretAccessExpiredActivityLogEntryData_wasApproved(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretAccessExpiredActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretAccessRequest(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_SecretAccessRequest_id(ctx, field)
+	case "team":
+		return ec.fieldContext_SecretAccessRequest_team(ctx, field)
+	case "teamEnvironment":
+		return ec.fieldContext_SecretAccessRequest_teamEnvironment(ctx, field)
+	case "secretName":
+		return ec.fieldContext_SecretAccessRequest_secretName(ctx, field)
+	case "requester":
+		return ec.fieldContext_SecretAccessRequest_requester(ctx, field)
+	case "reason":
+		return ec.fieldContext_SecretAccessRequest_reason(ctx, field)
+	case "state":
+		return ec.fieldContext_SecretAccessRequest_state(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_SecretAccessRequest_createdAt(ctx, field)
+	case "handledBy":
+		return ec.fieldContext_SecretAccessRequest_handledBy(ctx, field)
+	case "handledAt":
+		return ec.fieldContext_SecretAccessRequest_handledAt(ctx, field)
+	case "handlerComment":
+		return ec.fieldContext_SecretAccessRequest_handlerComment(ctx, field)
+	case "accessExpiresAt":
+		return ec.fieldContext_SecretAccessRequest_accessExpiresAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretAccessRequest", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretAccessRequestConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "pageInfo":
+		return ec.fieldContext_SecretAccessRequestConnection_pageInfo(ctx, field)
+	case "nodes":
+		return ec.fieldContext_SecretAccessRequestConnection_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_SecretAccessRequestConnection_edges(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretAccessRequestConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretAccessRequestEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "cursor":
+		return ec.fieldContext_SecretAccessRequestEdge_cursor(ctx, field)
+	case "node":
+		return ec.fieldContext_SecretAccessRequestEdge_node(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretAccessRequestEdge", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretAccessRequestedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "requestID":
+		return ec.fieldContext_SecretAccessRequestedActivityLogEntryData_requestID(ctx, field)
+	case "reason":
+		return ec.fieldContext_SecretAccessRequestedActivityLogEntryData_reason(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretAccessRequestedActivityLogEntryData", field.Name)
+}
+
 func (ec *executionContext) childFields_SecretConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "pageInfo":
@@ -35637,6 +43249,40 @@ func (ec *executionContext) childFields_SecretEdge(ctx context.Context, field gr
 	return nil, fmt.Errorf("no field named %q was found under type SecretEdge", field.Name)
 }
 
+func (ec *executionContext) childFields_SecretExternalSource(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "source":
+		return ec.fieldContext_SecretExternalSource_source(ctx, field)
+	case "reference":
+		return ec.fieldContext_SecretExternalSource_reference(ctx, field)
+	case "syncStatus":
+		return ec.fieldContext_SecretExternalSource_syncStatus(ctx, field)
+	case "lastSyncedAt":
+		return ec.fieldContext_SecretExternalSource_lastSyncedAt(ctx, field)
+	case "syncError":
+		return ec.fieldContext_SecretExternalSource_syncError(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretExternalSource", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretExternalSourceRemovedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "source":
+		return ec.fieldContext_SecretExternalSourceRemovedActivityLogEntryData_source(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretExternalSourceRemovedActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretExternalSourceSetActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "source":
+		return ec.fieldContext_SecretExternalSourceSetActivityLogEntryData_source(ctx, field)
+	case "reference":
+		return ec.fieldContext_SecretExternalSourceSetActivityLogEntryData_reference(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretExternalSourceSetActivityLogEntryData", field.Name)
+}
+
 func (ec *executionContext) childFields_SecretFacets(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "environments":
@@ -35649,6 +43295,14 @@ func (ec *executionContext) childFields_SecretFacets(ctx context.Context, field
 	return nil, fmt.Errorf("no field named %q was found under type SecretFacets", field.Name)
 }
 
+func (ec *executionContext) childFields_SecretRolledBackActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "version":
+		return ec.fieldContext_SecretRolledBackActivityLogEntryData_version(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretRolledBackActivityLogEntryData", field.Name)
+}
+
 func (ec *executionContext) childFields_SecretUpdatedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "updatedFields":
@@ -35713,6 +43367,42 @@ func (ec *executionContext) childFields_SecretValuesViewedActivityLogEntryData(c
 	return nil, fmt.Errorf("no field named %q was found under type SecretValuesViewedActivityLogEntryData", field.Name)
 }
 
+func (ec *executionContext) childFields_SecretVersion(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "version":
+		return ec.fieldContext_SecretVersion_version(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_SecretVersion_createdAt(ctx, field)
+	case "createdBy":
+		return ec.fieldContext_SecretVersion_createdBy(ctx, field)
+	case "changedKeys":
+		return ec.fieldContext_SecretVersion_changedKeys(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretVersion", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretVersionConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "pageInfo":
+		return ec.fieldContext_SecretVersionConnection_pageInfo(ctx, field)
+	case "nodes":
+		return ec.fieldContext_SecretVersionConnection_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_SecretVersionConnection_edges(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretVersionConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_SecretVersionEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "cursor":
+		return ec.fieldContext_SecretVersionEdge_cursor(ctx, field)
+	case "node":
+		return ec.fieldContext_SecretVersionEdge_node(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SecretVersionEdge", field.Name)
+}
+
 func (ec *executionContext) childFields_ServiceAccount(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -35819,6 +43509,28 @@ func (ec *executionContext) childFields_ServiceAccountTokenEdge(ctx context.Cont
 	return nil, fmt.Errorf("no field named %q was found under type ServiceAccountTokenEdge", field.Name)
 }
 
+func (ec *executionContext) childFields_ServiceAccountTokenIssueConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "pageInfo":
+		return ec.fieldContext_ServiceAccountTokenIssueConnection_pageInfo(ctx, field)
+	case "nodes":
+		return ec.fieldContext_ServiceAccountTokenIssueConnection_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_ServiceAccountTokenIssueConnection_edges(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ServiceAccountTokenIssueConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_ServiceAccountTokenIssueEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "cursor":
+		return ec.fieldContext_ServiceAccountTokenIssueEdge_cursor(ctx, field)
+	case "node":
+		return ec.fieldContext_ServiceAccountTokenIssueEdge_node(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ServiceAccountTokenIssueEdge", field.Name)
+}
+
 func (ec *executionContext) childFields_ServiceAccountTokenUpdatedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "updatedFields":
@@ -35947,6 +43659,30 @@ func (ec *executionContext) childFields_ServiceCostSeries(ctx context.Context, f
 	return nil, fmt.Errorf("no field named %q was found under type ServiceCostSeries", field.Name)
 }
 
+func (ec *executionContext) childFields_SetSecretExternalSourcePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "secret":
+		return ec.fieldContext_SetSecretExternalSourcePayload_secret(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SetSecretExternalSourcePayload", field.Name)
+}
+
+func (ec *executionContext) childFields_SetTeamMemberCustomRolePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "member":
+		return ec.fieldContext_SetTeamMemberCustomRolePayload_member(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SetTeamMemberCustomRolePayload", field.Name)
+}
+
+func (ec *executionContext) childFields_SetTeamMemberEnvironmentsPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "member":
+		return ec.fieldContext_SetTeamMemberEnvironmentsPayload_member(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SetTeamMemberEnvironmentsPayload", field.Name)
+}
+
 func (ec *executionContext) childFields_SetTeamMemberRolePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "member":
@@ -35955,6 +43691,14 @@ func (ec *executionContext) childFields_SetTeamMemberRolePayload(ctx context.Con
 	return nil, fmt.Errorf("no field named %q was found under type SetTeamMemberRolePayload", field.Name)
 }
 
+func (ec *executionContext) childFields_SnoozeIssuePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "issue":
+		return ec.fieldContext_SnoozeIssuePayload_issue(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SnoozeIssuePayload", field.Name)
+}
+
 func (ec *executionContext) childFields_SqlDatabase(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -36283,12 +44027,24 @@ func (ec *executionContext) childFields_Team(ctx context.Context, field graphql.
 		return ec.fieldContext_Team_configs(ctx, field)
 	case "cost":
 		return ec.fieldContext_Team_cost(ctx, field)
+	case "customRoles":
+		return ec.fieldContext_Team_customRoles(ctx, field)
 	case "deploymentKey":
 		return ec.fieldContext_Team_deploymentKey(ctx, field)
 	case "deployments":
 		return ec.fieldContext_Team_deployments(ctx, field)
+	case "deploymentFreezes":
+		return ec.fieldContext_Team_deploymentFreezes(ctx, field)
+	case "doraMetrics":
+		return ec.fieldContext_Team_doraMetrics(ctx, field)
+	case "issueSuppressionRules":
+		return ec.fieldContext_Team_issueSuppressionRules(ctx, field)
 	case "issues":
 		return ec.fieldContext_Team_issues(ctx, field)
+	case "serviceAccountTokenIssues":
+		return ec.fieldContext_Team_serviceAccountTokenIssues(ctx, field)
+	case "issueHistory":
+		return ec.fieldContext_Team_issueHistory(ctx, field)
 	case "jobs":
 		return ec.fieldContext_Team_jobs(ctx, field)
 	case "kafkaTopics":
@@ -36301,6 +44057,8 @@ func (ec *executionContext) childFields_Team(ctx context.Context, field graphql.
 		return ec.fieldContext_Team_repositories(ctx, field)
 	case "secrets":
 		return ec.fieldContext_Team_secrets(ctx, field)
+	case "secretAccessRequests":
+		return ec.fieldContext_Team_secretAccessRequests(ctx, field)
 	case "serviceAccounts":
 		return ec.fieldContext_Team_serviceAccounts(ctx, field)
 	case "sqlInstances":
@@ -36387,6 +44145,34 @@ func (ec *executionContext) childFields_TeamCostPeriod(ctx context.Context, fiel
 	return nil, fmt.Errorf("no field named %q was found under type TeamCostPeriod", field.Name)
 }
 
+func (ec *executionContext) childFields_TeamCustomRoleCreatedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext_TeamCustomRoleCreatedActivityLogEntryData_name(ctx, field)
+	case "authorizations":
+		return ec.fieldContext_TeamCustomRoleCreatedActivityLogEntryData_authorizations(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type TeamCustomRoleCreatedActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_TeamCustomRoleDeletedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext_TeamCustomRoleDeletedActivityLogEntryData_name(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type TeamCustomRoleDeletedActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_TeamCustomRoleUpdatedActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext_TeamCustomRoleUpdatedActivityLogEntryData_name(ctx, field)
+	case "authorizations":
+		return ec.fieldContext_TeamCustomRoleUpdatedActivityLogEntryData_authorizations(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type TeamCustomRoleUpdatedActivityLogEntryData", field.Name)
+}
+
 func (ec *executionContext) childFields_TeamDeleteKey(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "key":
@@ -36685,6 +44471,10 @@ func (ec *executionContext) childFields_TeamMember(ctx context.Context, field gr
 		return ec.fieldContext_TeamMember_user(ctx, field)
 	case "role":
 		return ec.fieldContext_TeamMember_role(ctx, field)
+	case "environments":
+		return ec.fieldContext_TeamMember_environments(ctx, field)
+	case "customRole":
+		return ec.fieldContext_TeamMember_customRole(ctx, field)
 	}
 	return nil, fmt.Errorf("no field named %q was found under type TeamMember", field.Name)
 }
@@ -36733,6 +44523,30 @@ func (ec *executionContext) childFields_TeamMemberRemovedActivityLogEntryData(ct
 	return nil, fmt.Errorf("no field named %q was found under type TeamMemberRemovedActivityLogEntryData", field.Name)
 }
 
+func (ec *executionContext) childFields_TeamMemberSetCustomRoleActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "customRoleName":
+		return ec.fieldContext_TeamMemberSetCustomRoleActivityLogEntryData_customRoleName(ctx, field)
+	case "userID":
+		return ec.fieldContext_TeamMemberSetCustomRoleActivityLogEntryData_userID(ctx, field)
+	case "userEmail":
+		return ec.fieldContext_TeamMemberSetCustomRoleActivityLogEntryData_userEmail(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type TeamMemberSetCustomRoleActivityLogEntryData", field.Name)
+}
+
+func (ec *executionContext) childFields_TeamMemberSetEnvironmentsActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "environments":
+		return ec.fieldContext_TeamMemberSetEnvironmentsActivityLogEntryData_environments(ctx, field)
+	case "userID":
+		return ec.fieldContext_TeamMemberSetEnvironmentsActivityLogEntryData_userID(ctx, field)
+	case "userEmail":
+		return ec.fieldContext_TeamMemberSetEnvironmentsActivityLogEntryData_userEmail(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type TeamMemberSetEnvironmentsActivityLogEntryData", field.Name)
+}
+
 func (ec *executionContext) childFields_TeamMemberSetRoleActivityLogEntryData(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "role":
@@ -37039,6 +44853,14 @@ func (ec *executionContext) childFields_UpdateConfigValuePayload(ctx context.Con
 	return nil, fmt.Errorf("no field named %q was found under type UpdateConfigValuePayload", field.Name)
 }
 
+func (ec *executionContext) childFields_UpdateCustomTeamRolePayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "customRole":
+		return ec.fieldContext_UpdateCustomTeamRolePayload_customRole(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type UpdateCustomTeamRolePayload", field.Name)
+}
+
 func (ec *executionContext) childFields_UpdateImageVulnerabilityPayload(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "vulnerability":
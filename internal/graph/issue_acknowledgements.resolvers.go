@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/graph/gengql"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/issue"
+	"github.com/nais/api/internal/team"
+)
+
+func (r *issueAcknowledgementResolver) SuppressionRule(ctx context.Context, obj *issue.IssueAcknowledgement) (*issue.IssueSuppressionRule, error) {
+	if obj.SuppressionRuleID == nil {
+		return nil, nil
+	}
+
+	return issue.GetSuppressionRule(ctx, *obj.SuppressionRuleID)
+}
+
+func (r *issueSuppressionRuleResolver) Team(ctx context.Context, obj *issue.IssueSuppressionRule) (*team.Team, error) {
+	return team.Get(ctx, obj.TeamSlug)
+}
+
+func (r *mutationResolver) AcknowledgeIssue(ctx context.Context, input issue.AcknowledgeIssueInput) (*issue.AcknowledgeIssuePayload, error) {
+	teamSlug, err := issue.GetTeamSlug(ctx, input.IssueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.CanUpdateIssues(ctx, teamSlug); err != nil {
+		return nil, err
+	}
+
+	iss, err := issue.Acknowledge(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issue.AcknowledgeIssuePayload{
+		Issue: iss,
+	}, nil
+}
+
+func (r *mutationResolver) SnoozeIssue(ctx context.Context, input issue.SnoozeIssueInput) (*issue.SnoozeIssuePayload, error) {
+	teamSlug, err := issue.GetTeamSlug(ctx, input.IssueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.CanUpdateIssues(ctx, teamSlug); err != nil {
+		return nil, err
+	}
+
+	iss, err := issue.Snooze(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issue.SnoozeIssuePayload{
+		Issue: iss,
+	}, nil
+}
+
+func (r *mutationResolver) RemoveIssueAcknowledgement(ctx context.Context, input issue.RemoveIssueAcknowledgementInput) (*issue.RemoveIssueAcknowledgementPayload, error) {
+	teamSlug, err := issue.GetTeamSlug(ctx, input.IssueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.CanUpdateIssues(ctx, teamSlug); err != nil {
+		return nil, err
+	}
+
+	iss, err := issue.RemoveAcknowledgement(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issue.RemoveIssueAcknowledgementPayload{
+		Issue: iss,
+	}, nil
+}
+
+func (r *mutationResolver) CreateIssueSuppressionRule(ctx context.Context, input issue.CreateIssueSuppressionRuleInput) (*issue.CreateIssueSuppressionRulePayload, error) {
+	if err := authz.CanUpdateIssues(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	rule, err := issue.CreateSuppressionRule(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issue.CreateIssueSuppressionRulePayload{
+		SuppressionRule: rule,
+	}, nil
+}
+
+func (r *mutationResolver) DeleteIssueSuppressionRule(ctx context.Context, input issue.DeleteIssueSuppressionRuleInput) (*issue.DeleteIssueSuppressionRulePayload, error) {
+	rule, err := issue.GetSuppressionRuleByIdent(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.CanUpdateIssues(ctx, rule.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	if err := issue.DeleteSuppressionRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return &issue.DeleteIssueSuppressionRulePayload{
+		Success: true,
+	}, nil
+}
+
+func (r *teamResolver) IssueSuppressionRules(ctx context.Context, obj *team.Team, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) (*pagination.Connection[*issue.IssueSuppressionRule], error) {
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return issue.ListSuppressionRules(ctx, obj.Slug, page)
+}
+
+func (r *Resolver) IssueAcknowledgement() gengql.IssueAcknowledgementResolver {
+	return &issueAcknowledgementResolver{r}
+}
+
+func (r *Resolver) IssueSuppressionRule() gengql.IssueSuppressionRuleResolver {
+	return &issueSuppressionRuleResolver{r}
+}
+
+type (
+	issueAcknowledgementResolver struct{ *Resolver }
+	issueSuppressionRuleResolver struct{ *Resolver }
+)
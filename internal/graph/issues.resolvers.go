@@ -9,11 +9,13 @@ import (
 	"github.com/nais/api/internal/persistence/opensearch"
 	"github.com/nais/api/internal/persistence/sqlinstance"
 	"github.com/nais/api/internal/persistence/valkey"
+	"github.com/nais/api/internal/serviceaccount"
 	"github.com/nais/api/internal/team"
 	"github.com/nais/api/internal/unleash"
 	"github.com/nais/api/internal/workload"
 	"github.com/nais/api/internal/workload/application"
 	"github.com/nais/api/internal/workload/job"
+	"github.com/nais/api/internal/workload/secret"
 )
 
 func (r *applicationRestartLoopIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.ApplicationRestartLoopIssue) (*team.TeamEnvironment, error) {
@@ -24,6 +26,10 @@ func (r *applicationRestartLoopIssueResolver) Workload(ctx context.Context, obj
 	return getWorkloadByResourceType(ctx, obj.TeamSlug, obj.EnvironmentName, obj.ResourceName, obj.ResourceType)
 }
 
+func (r *customIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.CustomIssue) (*team.TeamEnvironment, error) {
+	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
+}
+
 func (r *deprecatedIngressIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.DeprecatedIngressIssue) (*team.TeamEnvironment, error) {
 	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
 }
@@ -72,6 +78,18 @@ func (r *issueConnectionResolver) Facets(ctx context.Context, obj *issue.IssueCo
 	return issue.ComputeFacets(ctx, obj.GetTeamSlug(), obj.GetScope(), obj.GetFilter())
 }
 
+func (r *issueHistoryConnectionResolver) MeanTimeToResolve(ctx context.Context, obj *issue.IssueHistoryConnection) ([]*issue.IssueTypeMeanTimeToResolve, error) {
+	return issue.MeanTimeToResolve(ctx, obj.GetTeamSlug(), obj.GetFilter())
+}
+
+func (r *issueHistoryEntryResolver) TeamEnvironment(ctx context.Context, obj *issue.IssueHistoryEntry) (*team.TeamEnvironment, error) {
+	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
+}
+
+func (r *issueHistoryEntryResolver) Issue(ctx context.Context, obj *issue.IssueHistoryEntry) (issue.Issue, error) {
+	return issue.GetForHistoryEntry(ctx, obj)
+}
+
 func (r *lastRunFailedIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.LastRunFailedIssue) (*team.TeamEnvironment, error) {
 	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
 }
@@ -80,6 +98,14 @@ func (r *lastRunFailedIssueResolver) Job(ctx context.Context, obj *issue.LastRun
 	return job.Get(ctx, obj.TeamSlug, obj.EnvironmentName, obj.ResourceName)
 }
 
+func (r *memoryLimitReachedIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.MemoryLimitReachedIssue) (*team.TeamEnvironment, error) {
+	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
+}
+
+func (r *memoryLimitReachedIssueResolver) Workload(ctx context.Context, obj *issue.MemoryLimitReachedIssue) (workload.Workload, error) {
+	return getWorkloadByResourceType(ctx, obj.TeamSlug, obj.EnvironmentName, obj.ResourceName, obj.ResourceType)
+}
+
 func (r *missingSbomIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.MissingSbomIssue) (*team.TeamEnvironment, error) {
 	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
 }
@@ -104,6 +130,30 @@ func (r *openSearchIssueResolver) OpenSearch(ctx context.Context, obj *issue.Ope
 	return opensearch.Get(ctx, obj.TeamSlug, obj.EnvironmentName, obj.ResourceName)
 }
 
+func (r *overprovisionedWorkloadIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.OverprovisionedWorkloadIssue) (*team.TeamEnvironment, error) {
+	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
+}
+
+func (r *overprovisionedWorkloadIssueResolver) Workload(ctx context.Context, obj *issue.OverprovisionedWorkloadIssue) (workload.Workload, error) {
+	return getWorkloadByResourceType(ctx, obj.TeamSlug, obj.EnvironmentName, obj.ResourceName, obj.ResourceType)
+}
+
+func (r *serviceAccountTokenExpiringIssueResolver) ServiceAccount(ctx context.Context, obj *issue.ServiceAccountTokenExpiringIssue) (*serviceaccount.ServiceAccount, error) {
+	return serviceaccount.Get(ctx, obj.ServiceAccountID)
+}
+
+func (r *serviceAccountTokenExpiringIssueResolver) ServiceAccountToken(ctx context.Context, obj *issue.ServiceAccountTokenExpiringIssue) (*serviceaccount.ServiceAccountToken, error) {
+	return serviceaccount.GetToken(ctx, obj.ServiceAccountTokenID)
+}
+
+func (r *serviceAccountTokenUnusedIssueResolver) ServiceAccount(ctx context.Context, obj *issue.ServiceAccountTokenUnusedIssue) (*serviceaccount.ServiceAccount, error) {
+	return serviceaccount.Get(ctx, obj.ServiceAccountID)
+}
+
+func (r *serviceAccountTokenUnusedIssueResolver) ServiceAccountToken(ctx context.Context, obj *issue.ServiceAccountTokenUnusedIssue) (*serviceaccount.ServiceAccountToken, error) {
+	return serviceaccount.GetToken(ctx, obj.ServiceAccountTokenID)
+}
+
 func (r *sqlInstanceStateIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.SqlInstanceStateIssue) (*team.TeamEnvironment, error) {
 	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
 }
@@ -120,6 +170,22 @@ func (r *sqlInstanceVersionIssueResolver) SQLInstance(ctx context.Context, obj *
 	return sqlinstance.Get(ctx, obj.TeamSlug, obj.EnvironmentName, obj.ResourceName)
 }
 
+func (r *staleAivenCredentialIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.StaleAivenCredentialIssue) (*team.TeamEnvironment, error) {
+	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
+}
+
+func (r *staleAivenCredentialIssueResolver) Name(ctx context.Context, obj *issue.StaleAivenCredentialIssue) (string, error) {
+	return obj.ResourceName, nil
+}
+
+func (r *staleSecretIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.StaleSecretIssue) (*team.TeamEnvironment, error) {
+	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
+}
+
+func (r *staleSecretIssueResolver) Secret(ctx context.Context, obj *issue.StaleSecretIssue) (*secret.Secret, error) {
+	return secret.Get(ctx, obj.TeamSlug, obj.EnvironmentName, obj.ResourceName)
+}
+
 func (r *teamResolver) Issues(ctx context.Context, obj *team.Team, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, orderBy *issue.IssueOrder, filter *issue.IssueFilter) (*issue.IssueConnection, error) {
 	page, err := pagination.ParsePage(first, after, last, before)
 	if err != nil {
@@ -129,6 +195,24 @@ func (r *teamResolver) Issues(ctx context.Context, obj *team.Team, first *int, a
 	return issue.ListIssues(ctx, obj.Slug, page, orderBy, nil, filter)
 }
 
+func (r *teamResolver) ServiceAccountTokenIssues(ctx context.Context, obj *team.Team, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) (*pagination.Connection[issue.ServiceAccountTokenIssue], error) {
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return issue.ListServiceAccountTokenIssues(ctx, obj.Slug, page)
+}
+
+func (r *teamResolver) IssueHistory(ctx context.Context, obj *team.Team, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *issue.IssueHistoryFilter) (*issue.IssueHistoryConnection, error) {
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return issue.ListIssueHistory(ctx, obj.Slug, page, filter)
+}
+
 func (r *unleashReleaseChannelIssueResolver) TeamEnvironment(ctx context.Context, obj *issue.UnleashReleaseChannelIssue) (*team.TeamEnvironment, error) {
 	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
 }
@@ -165,6 +249,8 @@ func (r *Resolver) ApplicationRestartLoopIssue() gengql.ApplicationRestartLoopIs
 	return &applicationRestartLoopIssueResolver{r}
 }
 
+func (r *Resolver) CustomIssue() gengql.CustomIssueResolver { return &customIssueResolver{r} }
+
 func (r *Resolver) DeprecatedIngressIssue() gengql.DeprecatedIngressIssueResolver {
 	return &deprecatedIngressIssueResolver{r}
 }
@@ -189,10 +275,22 @@ func (r *Resolver) IssueConnection() gengql.IssueConnectionResolver {
 	return &issueConnectionResolver{r}
 }
 
+func (r *Resolver) IssueHistoryConnection() gengql.IssueHistoryConnectionResolver {
+	return &issueHistoryConnectionResolver{r}
+}
+
+func (r *Resolver) IssueHistoryEntry() gengql.IssueHistoryEntryResolver {
+	return &issueHistoryEntryResolver{r}
+}
+
 func (r *Resolver) LastRunFailedIssue() gengql.LastRunFailedIssueResolver {
 	return &lastRunFailedIssueResolver{r}
 }
 
+func (r *Resolver) MemoryLimitReachedIssue() gengql.MemoryLimitReachedIssueResolver {
+	return &memoryLimitReachedIssueResolver{r}
+}
+
 func (r *Resolver) MissingSbomIssue() gengql.MissingSbomIssueResolver {
 	return &missingSbomIssueResolver{r}
 }
@@ -205,6 +303,18 @@ func (r *Resolver) OpenSearchIssue() gengql.OpenSearchIssueResolver {
 	return &openSearchIssueResolver{r}
 }
 
+func (r *Resolver) OverprovisionedWorkloadIssue() gengql.OverprovisionedWorkloadIssueResolver {
+	return &overprovisionedWorkloadIssueResolver{r}
+}
+
+func (r *Resolver) ServiceAccountTokenExpiringIssue() gengql.ServiceAccountTokenExpiringIssueResolver {
+	return &serviceAccountTokenExpiringIssueResolver{r}
+}
+
+func (r *Resolver) ServiceAccountTokenUnusedIssue() gengql.ServiceAccountTokenUnusedIssueResolver {
+	return &serviceAccountTokenUnusedIssueResolver{r}
+}
+
 func (r *Resolver) SqlInstanceStateIssue() gengql.SqlInstanceStateIssueResolver {
 	return &sqlInstanceStateIssueResolver{r}
 }
@@ -213,6 +323,14 @@ func (r *Resolver) SqlInstanceVersionIssue() gengql.SqlInstanceVersionIssueResol
 	return &sqlInstanceVersionIssueResolver{r}
 }
 
+func (r *Resolver) StaleAivenCredentialIssue() gengql.StaleAivenCredentialIssueResolver {
+	return &staleAivenCredentialIssueResolver{r}
+}
+
+func (r *Resolver) StaleSecretIssue() gengql.StaleSecretIssueResolver {
+	return &staleSecretIssueResolver{r}
+}
+
 func (r *Resolver) UnleashReleaseChannelIssue() gengql.UnleashReleaseChannelIssueResolver {
 	return &unleashReleaseChannelIssueResolver{r}
 }
@@ -229,18 +347,27 @@ func (r *Resolver) WorkloadProblemIssue() gengql.WorkloadProblemIssueResolver {
 
 type (
 	applicationRestartLoopIssueResolver               struct{ *Resolver }
+	customIssueResolver                               struct{ *Resolver }
 	deprecatedIngressIssueResolver                    struct{ *Resolver }
 	deprecatedRegistryIssueResolver                   struct{ *Resolver }
 	externalIngressCriticalVulnerabilityIssueResolver struct{ *Resolver }
 	failedSynchronizationIssueResolver                struct{ *Resolver }
 	invalidSpecIssueResolver                          struct{ *Resolver }
 	issueConnectionResolver                           struct{ *Resolver }
+	issueHistoryConnectionResolver                    struct{ *Resolver }
+	issueHistoryEntryResolver                         struct{ *Resolver }
 	lastRunFailedIssueResolver                        struct{ *Resolver }
+	memoryLimitReachedIssueResolver                   struct{ *Resolver }
 	missingSbomIssueResolver                          struct{ *Resolver }
 	noRunningInstancesIssueResolver                   struct{ *Resolver }
 	openSearchIssueResolver                           struct{ *Resolver }
+	overprovisionedWorkloadIssueResolver              struct{ *Resolver }
+	serviceAccountTokenExpiringIssueResolver          struct{ *Resolver }
+	serviceAccountTokenUnusedIssueResolver            struct{ *Resolver }
 	sqlInstanceStateIssueResolver                     struct{ *Resolver }
 	sqlInstanceVersionIssueResolver                   struct{ *Resolver }
+	staleAivenCredentialIssueResolver                 struct{ *Resolver }
+	staleSecretIssueResolver                          struct{ *Resolver }
 	unleashReleaseChannelIssueResolver                struct{ *Resolver }
 	valkeyIssueResolver                               struct{ *Resolver }
 	vulnerableImageIssueResolver                      struct{ *Resolver }
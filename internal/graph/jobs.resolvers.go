@@ -113,7 +113,7 @@ func (r *jobRunResolver) Instances(ctx context.Context, obj *job.JobRun, first *
 }
 
 func (r *mutationResolver) DeleteJob(ctx context.Context, input job.DeleteJobInput) (*job.DeleteJobPayload, error) {
-	if err := authz.CanDeleteJobs(ctx, input.TeamSlug); err != nil {
+	if err := authz.CanDeleteJobs(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
 		return nil, err
 	}
 
@@ -121,7 +121,7 @@ func (r *mutationResolver) DeleteJob(ctx context.Context, input job.DeleteJobInp
 }
 
 func (r *mutationResolver) DeleteJobRun(ctx context.Context, input job.DeleteJobRunInput) (*job.DeleteJobRunPayload, error) {
-	if err := authz.CanDeleteJobs(ctx, input.TeamSlug); err != nil {
+	if err := authz.CanDeleteJobs(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
 		return nil, err
 	}
 
@@ -129,7 +129,7 @@ func (r *mutationResolver) DeleteJobRun(ctx context.Context, input job.DeleteJob
 }
 
 func (r *mutationResolver) TriggerJob(ctx context.Context, input job.TriggerJobInput) (*job.TriggerJobPayload, error) {
-	if err := authz.CanUpdateJobs(ctx, input.TeamSlug); err != nil {
+	if err := authz.CanUpdateJobs(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
 		return nil, err
 	}
 
@@ -147,7 +147,7 @@ func (r *mutationResolver) TriggerJob(ctx context.Context, input job.TriggerJobI
 }
 
 func (r *mutationResolver) UpdateJob(ctx context.Context, input job.UpdateJobInput) (*job.UpdateJobPayload, error) {
-	if err := authz.CanUpdateJobs(ctx, input.TeamSlug); err != nil {
+	if err := authz.CanUpdateJobs(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
 		return nil, err
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/nais/api/internal/graph/gengql"
 	"github.com/nais/api/internal/graph/pagination"
 	"github.com/nais/api/internal/reconciler"
+	"github.com/nais/api/internal/slug"
 	"github.com/nais/api/internal/team"
 )
 
@@ -96,6 +97,18 @@ func (r *reconcilerResolver) Errors(ctx context.Context, obj *reconciler.Reconci
 	return reconciler.GetErrors(ctx, obj.Name, page)
 }
 
+func (r *reconcilerResolver) StateRevisions(ctx context.Context, obj *reconciler.Reconciler, teamSlug slug.Slug, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) (*pagination.Connection[*reconciler.ReconcilerStateRevision], error) {
+	if err := authz.RequireGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciler.GetStateRevisions(ctx, obj.Name, teamSlug, page)
+}
+
 func (r *reconcilerErrorResolver) Team(ctx context.Context, obj *reconciler.ReconcilerError) (*team.Team, error) {
 	return team.Get(ctx, obj.TeamSlug)
 }
@@ -37,7 +37,7 @@ func (r *jobResolver) Secrets(ctx context.Context, obj *job.Job, first *int, aft
 }
 
 func (r *mutationResolver) CreateSecret(ctx context.Context, input secret.CreateSecretInput) (*secret.CreateSecretPayload, error) {
-	if err := authz.CanCreateSecrets(ctx, input.Team); err != nil {
+	if err := authz.CanCreateSecrets(ctx, input.Team, input.Environment); err != nil {
 		return nil, err
 	}
 
@@ -52,7 +52,7 @@ func (r *mutationResolver) CreateSecret(ctx context.Context, input secret.Create
 }
 
 func (r *mutationResolver) UpdateSecret(ctx context.Context, input secret.UpdateSecretInput) (*secret.UpdateSecretPayload, error) {
-	if err := authz.CanUpdateSecrets(ctx, input.TeamSlug); err != nil {
+	if err := authz.CanUpdateSecrets(ctx, input.TeamSlug, input.EnvironmentName); err != nil {
 		return nil, err
 	}
 
@@ -67,7 +67,7 @@ func (r *mutationResolver) UpdateSecret(ctx context.Context, input secret.Update
 }
 
 func (r *mutationResolver) AddSecretValue(ctx context.Context, input secret.AddSecretValueInput) (*secret.AddSecretValuePayload, error) {
-	if err := authz.CanUpdateSecrets(ctx, input.Team); err != nil {
+	if err := authz.CanUpdateSecrets(ctx, input.Team, input.Environment); err != nil {
 		return nil, err
 	}
 
@@ -82,7 +82,7 @@ func (r *mutationResolver) AddSecretValue(ctx context.Context, input secret.AddS
 }
 
 func (r *mutationResolver) UpdateSecretValue(ctx context.Context, input secret.UpdateSecretValueInput) (*secret.UpdateSecretValuePayload, error) {
-	if err := authz.CanUpdateSecrets(ctx, input.Team); err != nil {
+	if err := authz.CanUpdateSecrets(ctx, input.Team, input.Environment); err != nil {
 		return nil, err
 	}
 
@@ -97,7 +97,7 @@ func (r *mutationResolver) UpdateSecretValue(ctx context.Context, input secret.U
 }
 
 func (r *mutationResolver) RemoveSecretValue(ctx context.Context, input secret.RemoveSecretValueInput) (*secret.RemoveSecretValuePayload, error) {
-	if err := authz.CanUpdateSecrets(ctx, input.Team); err != nil {
+	if err := authz.CanUpdateSecrets(ctx, input.Team, input.Environment); err != nil {
 		return nil, err
 	}
 
@@ -112,7 +112,7 @@ func (r *mutationResolver) RemoveSecretValue(ctx context.Context, input secret.R
 }
 
 func (r *mutationResolver) DeleteSecret(ctx context.Context, input secret.DeleteSecretInput) (*secret.DeleteSecretPayload, error) {
-	if err := authz.CanDeleteSecrets(ctx, input.Team); err != nil {
+	if err := authz.CanDeleteSecrets(ctx, input.Team, input.Environment); err != nil {
 		return nil, err
 	}
 
@@ -125,6 +125,21 @@ func (r *mutationResolver) DeleteSecret(ctx context.Context, input secret.Delete
 	}, nil
 }
 
+func (r *mutationResolver) RollbackSecret(ctx context.Context, input secret.RollbackSecretInput) (*secret.RollbackSecretPayload, error) {
+	if err := authz.CanUpdateSecrets(ctx, input.Team, input.Environment); err != nil {
+		return nil, err
+	}
+
+	s, err := secret.Rollback(ctx, input.Team, input.Environment, input.Name, input.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret.RollbackSecretPayload{
+		Secret: s,
+	}, nil
+}
+
 func (r *mutationResolver) ViewSecretValues(ctx context.Context, input secret.ViewSecretValuesInput) (*secret.ViewSecretValuesPayload, error) {
 	return secret.ViewSecretValues(ctx, input)
 }
@@ -214,6 +229,15 @@ func (r *secretResolver) LastModifiedBy(ctx context.Context, obj *secret.Secret)
 	return user.GetByEmail(ctx, *obj.ModifiedByUserEmail)
 }
 
+func (r *secretResolver) Versions(ctx context.Context, obj *secret.Secret, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) (*pagination.Connection[*secret.SecretVersion], error) {
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret.ListVersions(ctx, obj.TeamSlug, obj.EnvironmentName, obj.Name, page)
+}
+
 func (r *secretResolver) ActivityLog(ctx context.Context, obj *secret.Secret, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, filter *activitylog.ActivityLogFilter) (*activitylog.ActivityLogEntryConnection, error) {
 	page, err := pagination.ParsePage(first, after, last, before)
 	if err != nil {
@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/nais/api/internal/graph/gengql"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/team"
+	"github.com/nais/api/internal/workload/secret"
+)
+
+func (r *mutationResolver) RequestSecretAccess(ctx context.Context, input secret.RequestSecretAccessInput) (*secret.RequestSecretAccessPayload, error) {
+	request, err := secret.RequestAccess(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret.RequestSecretAccessPayload{
+		AccessRequest: request,
+	}, nil
+}
+
+func (r *mutationResolver) ApproveSecretAccessRequest(ctx context.Context, input secret.ApproveSecretAccessRequestInput) (*secret.ApproveSecretAccessRequestPayload, error) {
+	request, err := secret.ApproveAccessRequest(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret.ApproveSecretAccessRequestPayload{
+		AccessRequest: request,
+	}, nil
+}
+
+func (r *mutationResolver) DenySecretAccessRequest(ctx context.Context, input secret.DenySecretAccessRequestInput) (*secret.DenySecretAccessRequestPayload, error) {
+	request, err := secret.DenyAccessRequest(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret.DenySecretAccessRequestPayload{
+		AccessRequest: request,
+	}, nil
+}
+
+func (r *secretResolver) ValuesRequireApproval(ctx context.Context, obj *secret.Secret) (bool, error) {
+	return secret.RequiresAccessApproval(ctx, obj.EnvironmentName), nil
+}
+
+func (r *secretResolver) AccessRequests(ctx context.Context, obj *secret.Secret, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor) (*pagination.Connection[*secret.SecretAccessRequest], error) {
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret.ListAccessRequestsForSecret(ctx, obj.TeamSlug, obj.EnvironmentName, obj.Name, page)
+}
+
+func (r *secretAccessRequestResolver) Team(ctx context.Context, obj *secret.SecretAccessRequest) (*team.Team, error) {
+	return team.Get(ctx, obj.TeamSlug)
+}
+
+func (r *secretAccessRequestResolver) TeamEnvironment(ctx context.Context, obj *secret.SecretAccessRequest) (*team.TeamEnvironment, error) {
+	return team.GetTeamEnvironment(ctx, obj.TeamSlug, obj.EnvironmentName)
+}
+
+func (r *teamResolver) SecretAccessRequests(ctx context.Context, obj *team.Team, first *int, after *pagination.Cursor, last *int, before *pagination.Cursor, state *secret.SecretAccessRequestState) (*pagination.Connection[*secret.SecretAccessRequest], error) {
+	page, err := pagination.ParsePage(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret.ListAccessRequestsForTeam(ctx, obj.Slug, page, state)
+}
+
+func (r *Resolver) SecretAccessRequest() gengql.SecretAccessRequestResolver {
+	return &secretAccessRequestResolver{r}
+}
+
+type secretAccessRequestResolver struct{ *Resolver }
@@ -0,0 +1,38 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/workload/secret"
+)
+
+func (r *mutationResolver) SetSecretExternalSource(ctx context.Context, input secret.SetSecretExternalSourceInput) (*secret.SetSecretExternalSourcePayload, error) {
+	if err := authz.CanUpdateSecrets(ctx, input.Team, input.Environment); err != nil {
+		return nil, err
+	}
+
+	s, err := secret.SetExternalSource(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret.SetSecretExternalSourcePayload{
+		Secret: s,
+	}, nil
+}
+
+func (r *mutationResolver) RemoveSecretExternalSource(ctx context.Context, input secret.RemoveSecretExternalSourceInput) (*secret.RemoveSecretExternalSourcePayload, error) {
+	if err := authz.CanUpdateSecrets(ctx, input.Team, input.Environment); err != nil {
+		return nil, err
+	}
+
+	s, err := secret.RemoveExternalSource(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret.RemoveSecretExternalSourcePayload{
+		Secret: s,
+	}, nil
+}
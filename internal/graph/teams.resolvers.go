@@ -242,12 +242,40 @@ func (r *mutationResolver) SetTeamMemberRole(ctx context.Context, input team.Set
 	correlationID := uuid.New()
 	r.triggerTeamUpdatedEvent(ctx, input.TeamSlug, correlationID)
 
+	member, err := team.GetMemberByEmail(ctx, input.TeamSlug, input.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
 	return &team.SetTeamMemberRolePayload{
-		Member: &team.TeamMember{
-			Role:     input.Role,
-			TeamSlug: input.TeamSlug,
-			UserID:   u.UUID,
-		},
+		Member: member,
+	}, nil
+}
+
+func (r *mutationResolver) SetTeamMemberEnvironments(ctx context.Context, input team.SetTeamMemberEnvironmentsInput) (*team.SetTeamMemberEnvironmentsPayload, error) {
+	actor := authz.ActorFromContext(ctx)
+
+	if err := authz.CanManageTeamMembers(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	if _, err := team.Get(ctx, input.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	u, err := user.GetByEmail(ctx, input.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	input.UserID = u.UUID
+	member, err := team.SetMemberEnvironments(ctx, input, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &team.SetTeamMemberEnvironmentsPayload{
+		Member: member,
 	}, nil
 }
 
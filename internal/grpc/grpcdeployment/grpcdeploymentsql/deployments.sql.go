@@ -164,6 +164,46 @@ func (q *Queries) CreateDeploymentStatus(ctx context.Context, arg CreateDeployme
 	return id, err
 }
 
+const deploymentFrozen = `-- name: DeploymentFrozen :one
+SELECT
+	EXISTS (
+		SELECT
+			1
+		FROM
+			deployment_freezes
+		WHERE
+			team_slug = $1
+			AND environment_name = $2
+			AND starts_at <= NOW()
+			AND (
+				ends_at IS NULL
+				OR ends_at > NOW()
+			)
+			AND NOT EXISTS (
+				SELECT
+					1
+				FROM
+					deployment_freeze_overrides
+				WHERE
+					deployment_freeze_overrides.freeze_id = deployment_freezes.id
+					AND deployment_freeze_overrides.expires_at > NOW()
+			)
+	)
+`
+
+type DeploymentFrozenParams struct {
+	TeamSlug        slug.Slug
+	EnvironmentName string
+}
+
+// Reports whether deployments to the team environment are blocked by an active freeze without an active override.
+func (q *Queries) DeploymentFrozen(ctx context.Context, arg DeploymentFrozenParams) (bool, error) {
+	row := q.db.QueryRow(ctx, deploymentFrozen, arg.TeamSlug, arg.EnvironmentName)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const teamExists = `-- name: TeamExists :one
 SELECT
 	EXISTS (
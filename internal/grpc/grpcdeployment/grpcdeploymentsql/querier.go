@@ -13,6 +13,8 @@ type Querier interface {
 	CreateDeployment(ctx context.Context, arg CreateDeploymentParams) (uuid.UUID, error)
 	CreateDeploymentK8sResource(ctx context.Context, arg CreateDeploymentK8sResourceParams) (uuid.UUID, error)
 	CreateDeploymentStatus(ctx context.Context, arg CreateDeploymentStatusParams) (uuid.UUID, error)
+	// Reports whether deployments to the team environment are blocked by an active freeze without an active override.
+	DeploymentFrozen(ctx context.Context, arg DeploymentFrozenParams) (bool, error)
 	TeamExists(ctx context.Context, argSlug slug.Slug) (bool, error)
 }
 
@@ -89,3 +89,31 @@ VALUES
 RETURNING
 	id
 ;
+
+-- name: DeploymentFrozen :one
+-- Reports whether deployments to the team environment are blocked by an active freeze without an active override.
+SELECT
+	EXISTS (
+		SELECT
+			1
+		FROM
+			deployment_freezes
+		WHERE
+			team_slug = @team_slug
+			AND environment_name = @environment_name
+			AND starts_at <= NOW()
+			AND (
+				ends_at IS NULL
+				OR ends_at > NOW()
+			)
+			AND NOT EXISTS (
+				SELECT
+					1
+				FROM
+					deployment_freeze_overrides
+				WHERE
+					deployment_freeze_overrides.freeze_id = deployment_freezes.id
+					AND deployment_freeze_overrides.expires_at > NOW()
+			)
+	)
+;
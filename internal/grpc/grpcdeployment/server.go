@@ -37,6 +37,16 @@ func (s *Server) CreateDeployment(ctx context.Context, req *protoapi.CreateDeplo
 		return nil, status.Errorf(codes.InvalidArgument, "environment is required")
 	}
 
+	environmentName := environmentmapper.EnvironmentName(req.GetEnvironmentName())
+	if frozen, err := s.querier.DeploymentFrozen(ctx, grpcdeploymentsql.DeploymentFrozenParams{
+		TeamSlug:        slug.Slug(req.GetTeamSlug()),
+		EnvironmentName: environmentName,
+	}); err != nil {
+		return nil, err
+	} else if frozen {
+		return nil, status.Errorf(codes.FailedPrecondition, "deployments to environment %q are frozen", environmentName)
+	}
+
 	var repoName *string
 	if req.HasRepository() {
 		repoName = new(req.GetRepository())
@@ -70,7 +80,7 @@ func (s *Server) CreateDeployment(ctx context.Context, req *protoapi.CreateDeplo
 		},
 		TeamSlug:         slug.Slug(req.GetTeamSlug()),
 		Repository:       repoName,
-		EnvironmentName:  environmentmapper.EnvironmentName(req.GetEnvironmentName()),
+		EnvironmentName:  environmentName,
 		CommitSha:        commitSha,
 		DeployerUsername: deployerUsername,
 		TriggerUrl:       triggerUrl,
@@ -73,6 +73,55 @@ func TestDeploymentServer_CreateDeployment(t *testing.T) {
 		}
 	})
 
+	t.Run("deployment freeze", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		environmentName, teamSlug := "dev", "my-team"
+		stmt := `
+			INSERT INTO teams (slug, purpose, slack_channel) VALUES
+			($1, $2, $3)`
+		if _, err = pool.Exec(ctx, stmt, teamSlug, "my-purpose", "#channel"); err != nil {
+			t.Fatalf("failed to insert team: %v", err)
+		}
+
+		var freezeID uuid.UUID
+		stmt = `
+			INSERT INTO deployment_freezes (team_slug, environment_name, reason, starts_at, created_by) VALUES
+			($1, $2, 'holiday', NOW() - '1 hour'::INTERVAL, 'user@example.com')
+			RETURNING id`
+		if err = pool.QueryRow(ctx, stmt, teamSlug, environmentName).Scan(&freezeID); err != nil {
+			t.Fatalf("failed to insert deployment freeze: %v", err)
+		}
+
+		resp, err := grpcdeployment.NewServer(pool).CreateDeployment(ctx, &protoapi.CreateDeploymentRequest{
+			TeamSlug:        &teamSlug,
+			EnvironmentName: &environmentName,
+		})
+		if resp != nil {
+			t.Error("expected response to be nil")
+		}
+
+		if s, ok := status.FromError(err); !ok || s.Code() != codes.FailedPrecondition {
+			t.Errorf("expected status code %v, got %v", codes.FailedPrecondition, err)
+		}
+
+		stmt = `
+			INSERT INTO deployment_freeze_overrides (freeze_id, justification, created_by, expires_at) VALUES
+			($1, 'hotfix for an incident', 'user@example.com', NOW() + '1 hour'::INTERVAL)`
+		if _, err = pool.Exec(ctx, stmt, freezeID); err != nil {
+			t.Fatalf("failed to insert deployment freeze override: %v", err)
+		}
+
+		resp, err = grpcdeployment.NewServer(pool).CreateDeployment(ctx, &protoapi.CreateDeploymentRequest{
+			TeamSlug:        &teamSlug,
+			EnvironmentName: &environmentName,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		} else if resp == nil {
+			t.Fatalf("expected response to be non-nil")
+		}
+	})
+
 	t.Run("only required", func(t *testing.T) {
 		pool := getConnection(ctx, t, container, dsn, log)
 		environmentName, teamSlug := "prod", "my-team"
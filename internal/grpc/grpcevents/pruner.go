@@ -0,0 +1,50 @@
+package grpcevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/grpc/grpcevents/grpceventssql"
+	"github.com/nais/api/internal/leaderelection"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	pruneSchedule = time.Hour
+
+	// retention is how long team events are kept. Clients resuming from a cursor of a deleted event must restart the
+	// stream without a cursor.
+	retention = 30 * 24 * time.Hour
+)
+
+// RunPruner periodically deletes team events older than the retention
+func RunPruner(ctx context.Context, dbtx grpceventssql.DBTX, log logrus.FieldLogger) {
+	querier := grpceventssql.New(dbtx)
+
+	for {
+		if leaderelection.IsLeader() {
+			if err := prune(ctx, querier, time.Now().Add(-retention), log); err != nil && ctx.Err() == nil {
+				log.WithError(err).Error("error pruning team events")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pruneSchedule):
+		}
+	}
+}
+
+func prune(ctx context.Context, querier grpceventssql.Querier, before time.Time, log logrus.FieldLogger) error {
+	n, err := querier.PruneTeamEvents(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		log.Debug("pruned team events")
+	}
+	return nil
+}
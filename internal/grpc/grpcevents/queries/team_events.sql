@@ -0,0 +1,61 @@
+-- name: ListTeamEventsAfter :many
+-- Events of transactions that are still running are not returned, as they could otherwise become visible after later
+-- events have been returned.
+SELECT
+	*
+FROM
+	team_events
+WHERE
+	(xact_id, id) > (@after_xact_id::BIGINT, @after_id::BIGINT)
+	AND xact_id < pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT
+ORDER BY
+	xact_id ASC,
+	id ASC
+LIMIT
+	sqlc.arg('limit')
+;
+
+-- name: OldestRunningTransactionID :one
+-- Events of the returned transaction and newer transactions have not been returned by ListTeamEventsAfter yet.
+SELECT
+	pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT
+;
+
+-- name: PrunedTeamEventPosition :one
+SELECT
+	*
+FROM
+	team_events_pruned
+;
+
+-- name: PruneTeamEvents :execrows
+WITH
+	pruned AS (
+		DELETE FROM team_events
+		WHERE
+			created_at < @created_before
+		RETURNING
+			xact_id,
+			id
+	),
+	last_pruned AS (
+		SELECT
+			xact_id,
+			id
+		FROM
+			pruned
+		ORDER BY
+			xact_id DESC,
+			id DESC
+		LIMIT
+			1
+	)
+UPDATE team_events_pruned
+SET
+	xact_id = last_pruned.xact_id,
+	id = last_pruned.id
+FROM
+	last_pruned
+WHERE
+	(last_pruned.xact_id, last_pruned.id) > (team_events_pruned.xact_id, team_events_pruned.id)
+;
@@ -0,0 +1,185 @@
+package grpcevents
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/database/notify"
+	"github.com/nais/api/internal/grpc/grpcevents/grpceventssql"
+	"github.com/nais/api/pkg/apiclient/protoapi"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	cursorPrefix = "team_events:"
+	batchSize    = 100
+
+	// pollInterval is how often the stream checks for new events when no notification has been
+	// received. Notifications are best effort, so this makes sure no event is left behind.
+	pollInterval = 30 * time.Second
+)
+
+type Server struct {
+	querier  grpceventssql.Querier
+	notifier *notify.Notifier
+	log      logrus.FieldLogger
+	protoapi.UnimplementedEventsServer
+}
+
+func NewServer(pool *pgxpool.Pool, notifier *notify.Notifier, log logrus.FieldLogger) *Server {
+	return &Server{
+		querier:  grpceventssql.New(pool),
+		notifier: notifier,
+		log:      log,
+	}
+}
+
+func (s *Server) StreamTeamEvents(req *protoapi.StreamTeamEventsRequest, stream grpc.ServerStreamingServer[protoapi.TeamEvent]) error {
+	ctx := stream.Context()
+
+	// Start listening before reading from the database, so events inserted in between are not
+	// missed.
+	notifications := s.notifier.ListenContext(ctx, "team_events")
+
+	var after position
+	if req.GetAfterCursor() != "" {
+		p, err := decodeCursor(req.GetAfterCursor())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		after = p
+	} else {
+		// Events of transactions that are still running are created after the stream started
+		xactID, err := s.querier.OldestRunningTransactionID(ctx)
+		if err != nil {
+			return err
+		}
+		after = position{xactID: xactID}
+	}
+
+	s.log.WithFields(logrus.Fields{"after_xact_id": after.xactID, "after_id": after.id}).Debug("streaming team events")
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			events, err := s.querier.ListTeamEventsAfter(ctx, grpceventssql.ListTeamEventsAfterParams{
+				AfterXactID: after.xactID,
+				AfterID:     after.id,
+				Limit:       batchSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			// The pruned position is read after the events, so events pruned in between are never missed
+			pruned, err := s.querier.PrunedTeamEventPosition(ctx)
+			if err != nil {
+				return err
+			}
+			if after.less(position{xactID: pruned.XactID, id: pruned.ID}) {
+				return status.Error(codes.OutOfRange, "events after the cursor have been deleted, restart the stream without a cursor")
+			}
+
+			for _, event := range events {
+				if err := stream.Send(toProtoTeamEvent(event)); err != nil {
+					return err
+				}
+				after = position{xactID: event.XactID, id: event.ID}
+			}
+
+			if len(events) < batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notifications:
+		case <-ticker.C:
+		}
+	}
+}
+
+func toProtoTeamEvent(event *grpceventssql.TeamEvent) *protoapi.TeamEvent {
+	ret := protoapi.TeamEvent_builder{
+		Cursor:    encodeCursor(position{xactID: event.XactID, id: event.ID}),
+		Type:      toProtoTeamEventType(event.EventType),
+		TeamSlug:  event.TeamSlug.String(),
+		CreatedAt: timestamppb.New(event.CreatedAt.Time),
+	}
+	if event.UserID != nil {
+		ret.UserId = new(event.UserID.String())
+	}
+	return ret.Build()
+}
+
+func toProtoTeamEventType(eventType string) protoapi.TeamEventType {
+	switch eventType {
+	case "TEAM_CREATED":
+		return protoapi.TeamEventType_TEAM_EVENT_TEAM_CREATED
+	case "TEAM_UPDATED":
+		return protoapi.TeamEventType_TEAM_EVENT_TEAM_UPDATED
+	case "TEAM_DELETED":
+		return protoapi.TeamEventType_TEAM_EVENT_TEAM_DELETED
+	case "MEMBER_ADDED":
+		return protoapi.TeamEventType_TEAM_EVENT_MEMBER_ADDED
+	case "MEMBER_UPDATED":
+		return protoapi.TeamEventType_TEAM_EVENT_MEMBER_UPDATED
+	case "MEMBER_REMOVED":
+		return protoapi.TeamEventType_TEAM_EVENT_MEMBER_REMOVED
+	default:
+		return protoapi.TeamEventType_TEAM_EVENT_UNSPECIFIED
+	}
+}
+
+// position is the position of an event in the stream. Events are ordered by the transaction that created them, and
+// then by id.
+type position struct {
+	xactID int64
+	id     int64
+}
+
+func (p position) less(o position) bool {
+	return p.xactID < o.xactID || (p.xactID == o.xactID && p.id < o.id)
+}
+
+func encodeCursor(p position) string {
+	return base64.URLEncoding.EncodeToString([]byte(cursorPrefix + strconv.FormatInt(p.xactID, 10) + ":" + strconv.FormatInt(p.id, 10)))
+}
+
+func decodeCursor(cursor string) (position, error) {
+	b, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return position{}, err
+	}
+
+	value, ok := strings.CutPrefix(string(b), cursorPrefix)
+	if !ok {
+		return position{}, errors.New("unknown cursor type")
+	}
+
+	xactID, id, ok := strings.Cut(value, ":")
+	if !ok {
+		return position{}, errors.New("missing event id")
+	}
+
+	var p position
+	if p.xactID, err = strconv.ParseInt(xactID, 10, 64); err != nil {
+		return position{}, err
+	}
+	if p.id, err = strconv.ParseInt(id, 10, 64); err != nil {
+		return position{}, err
+	}
+	return p, nil
+}
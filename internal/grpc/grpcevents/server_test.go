@@ -0,0 +1,46 @@
+package grpcevents
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	p, err := decodeCursor(encodeCursor(position{xactID: 42, id: 1337}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p != (position{xactID: 42, id: 1337}) {
+		t.Errorf("expected {42 1337}, got %v", p)
+	}
+
+	for _, cursor := range []string{
+		"not base64!",
+		base64.URLEncoding.EncodeToString([]byte("deployments:1:1")),
+		base64.URLEncoding.EncodeToString([]byte(cursorPrefix + "1337")),
+		base64.URLEncoding.EncodeToString([]byte(cursorPrefix + "abc:1")),
+		base64.URLEncoding.EncodeToString([]byte(cursorPrefix + "1:abc")),
+	} {
+		if _, err := decodeCursor(cursor); err == nil {
+			t.Errorf("expected error for cursor %q", cursor)
+		}
+	}
+}
+
+func TestPosition_Less(t *testing.T) {
+	tests := []struct {
+		a, b position
+		want bool
+	}{
+		{position{1, 5}, position{2, 1}, true},
+		{position{1, 1}, position{1, 2}, true},
+		{position{1, 2}, position{1, 2}, false},
+		{position{2, 1}, position{1, 5}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.less(tt.b); got != tt.want {
+			t.Errorf("%v.less(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
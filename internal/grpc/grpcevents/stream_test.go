@@ -0,0 +1,283 @@
+//go:build integration_test
+
+package grpcevents
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/database/notify"
+	"github.com/nais/api/internal/grpc/grpcevents/grpceventssql"
+	"github.com/nais/api/pkg/apiclient/protoapi"
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *protoapi.TeamEvent
+}
+
+func (f *fakeStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeStream) Send(event *protoapi.TeamEvent) error {
+	select {
+	case f.events <- event:
+		return nil
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	}
+}
+
+func TestStreamTeamEvents(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("events are streamed in order and the stream can be resumed", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		s, ctx := newTestServer(ctx, t, pool, log)
+
+		start := lastPosition(ctx, t, pool)
+		addMember(ctx, t, pool, "user-1@example.com")
+		addMember(ctx, t, pool, "user-2@example.com")
+
+		events, _ := stream(ctx, s, encodeCursor(start))
+		first := receive(t, events)
+		second := receive(t, events)
+		if first.GetType() != protoapi.TeamEventType_TEAM_EVENT_MEMBER_ADDED || second.GetType() != protoapi.TeamEventType_TEAM_EVENT_MEMBER_ADDED {
+			t.Fatalf("expected two member added events, got %v and %v", first.GetType(), second.GetType())
+		}
+
+		// Events created while the stream is open are sent as well
+		if _, err := pool.Exec(ctx, "UPDATE teams SET purpose = 'new purpose' WHERE slug = 'team'"); err != nil {
+			t.Fatal(err)
+		}
+		if got := receive(t, events); got.GetType() != protoapi.TeamEventType_TEAM_EVENT_TEAM_UPDATED {
+			t.Fatalf("expected team updated event, got %v", got.GetType())
+		}
+
+		resumed, _ := stream(ctx, s, first.GetCursor())
+		if got := receive(t, resumed); got.GetCursor() != second.GetCursor() {
+			t.Fatalf("expected stream to resume at %q, got %q", second.GetCursor(), got.GetCursor())
+		}
+	})
+
+	t.Run("events of running transactions are streamed before events of later transactions", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		s, ctx := newTestServer(ctx, t, pool, log)
+
+		start := lastPosition(ctx, t, pool)
+
+		early, err := pool.Begin(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer early.Rollback(ctx)
+		earlyUser := addMember(ctx, t, early, "early@example.com")
+
+		lateUser := addMember(ctx, t, pool, "late@example.com")
+
+		events, _ := stream(ctx, s, encodeCursor(start))
+		select {
+		case got := <-events:
+			t.Fatalf("expected no events while an earlier transaction is running, got %v", got)
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		if err := early.Commit(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, want := range []uuid.UUID{earlyUser, lateUser} {
+			if got := receive(t, events); got.GetUserId() != want.String() {
+				t.Fatalf("expected event of user %v, got %v", want, got.GetUserId())
+			}
+		}
+	})
+
+	t.Run("stream fails when events after the cursor have been pruned", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		s, ctx := newTestServer(ctx, t, pool, log)
+
+		start := lastPosition(ctx, t, pool)
+		addMember(ctx, t, pool, "user-1@example.com")
+		if _, err := pool.Exec(ctx, "UPDATE team_events SET created_at = NOW() - INTERVAL '60 days'"); err != nil {
+			t.Fatal(err)
+		}
+		addMember(ctx, t, pool, "user-2@example.com")
+
+		if err := prune(ctx, grpceventssql.New(pool), time.Now().Add(-retention), log); err != nil {
+			t.Fatal(err)
+		}
+
+		_, errs := stream(ctx, s, encodeCursor(start))
+		select {
+		case err := <-errs:
+			if status.Code(err) != codes.OutOfRange {
+				t.Fatalf("expected OutOfRange, got %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for stream to fail")
+		}
+
+		// Resuming after the last pruned event is fine
+		events, _ := stream(ctx, s, encodeCursor(lastPrunedPosition(ctx, t, pool)))
+		if got := receive(t, events); got.GetType() != protoapi.TeamEventType_TEAM_EVENT_MEMBER_ADDED {
+			t.Fatalf("expected member added event, got %v", got.GetType())
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		s, ctx := newTestServer(ctx, t, pool, log)
+
+		_, errs := stream(ctx, s, "invalid")
+		if err := <-errs; status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func newTestServer(ctx context.Context, t *testing.T, pool *pgxpool.Pool, log logrus.FieldLogger) (*Server, context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	t.Cleanup(cancel)
+
+	notifier := notify.New(pool, log)
+	go notifier.Run(ctx)
+
+	return NewServer(pool, notifier, log), ctx
+}
+
+// stream starts streaming events after the cursor, and returns the events received and the error the stream ended
+// with
+func stream(ctx context.Context, s *Server, cursor string) (<-chan *protoapi.TeamEvent, <-chan error) {
+	events := make(chan *protoapi.TeamEvent, 10)
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- s.StreamTeamEvents(
+			protoapi.StreamTeamEventsRequest_builder{AfterCursor: cursor}.Build(),
+			&fakeStream{ctx: ctx, events: events},
+		)
+	}()
+
+	return events, errs
+}
+
+func receive(t *testing.T, events <-chan *protoapi.TeamEvent) *protoapi.TeamEvent {
+	t.Helper()
+
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return nil
+}
+
+// addMember creates a user and adds it as a member of the team
+func addMember(ctx context.Context, t *testing.T, db grpceventssql.DBTX, email string) uuid.UUID {
+	t.Helper()
+
+	var id uuid.UUID
+	if err := db.QueryRow(ctx, "INSERT INTO users (name, email, external_id) VALUES ($1, $1, $1) RETURNING id", email).Scan(&id); err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	if _, err := db.Exec(ctx, "INSERT INTO user_roles (role_name, user_id, target_team_slug) VALUES ('Team member', $1, 'team')", id); err != nil {
+		t.Fatalf("failed to add member: %v", err)
+	}
+
+	return id
+}
+
+func lastPosition(ctx context.Context, t *testing.T, pool *pgxpool.Pool) position {
+	t.Helper()
+
+	var p position
+	if err := pool.QueryRow(ctx, "SELECT xact_id, id FROM team_events ORDER BY xact_id DESC, id DESC LIMIT 1").Scan(&p.xactID, &p.id); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func lastPrunedPosition(ctx context.Context, t *testing.T, pool *pgxpool.Pool) position {
+	t.Helper()
+
+	var p position
+	if err := pool.QueryRow(ctx, "SELECT xact_id, id FROM team_events_pruned").Scan(&p.xactID, &p.id); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func startPostgresql(ctx context.Context, t *testing.T, log logrus.FieldLogger) (container *postgres.PostgresContainer, dsn string, err error) {
+	container, err = postgres.Run(
+		ctx,
+		"docker.io/postgres:16-alpine",
+		postgres.WithDatabase("test"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		postgres.WithSQLDriver("pgx"),
+		postgres.BasicWaitStrategies(),
+	)
+	defer testcontainers.CleanupContainer(t, container)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to start container: %w", err)
+	}
+
+	dsn, err = container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get connection string: %w", err)
+	}
+
+	pool, err := database.NewPool(ctx, dsn, log, true)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create pool: %w", err)
+	}
+
+	if _, err := pool.Exec(ctx, "INSERT INTO teams (slug, purpose, slack_channel) VALUES ('team', 'purpose', '#channel')"); err != nil {
+		pool.Close()
+		return nil, "", fmt.Errorf("failed to insert team: %w", err)
+	}
+	pool.Close()
+
+	if err := container.Snapshot(ctx); err != nil {
+		return nil, "", fmt.Errorf("failed to snapshot: %w", err)
+	}
+
+	return container, dsn, nil
+}
+
+func getConnection(ctx context.Context, t *testing.T, container *postgres.PostgresContainer, dsn string, log logrus.FieldLogger) *pgxpool.Pool {
+	pool, _ := database.NewPool(ctx, dsn, log, false)
+
+	t.Cleanup(func() {
+		pool.Close()
+		if err := container.Restore(ctx); err != nil {
+			t.Fatalf("failed to restore database: %v", err)
+		}
+	})
+
+	return pool
+}
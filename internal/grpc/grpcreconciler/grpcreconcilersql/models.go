@@ -23,4 +23,14 @@ type ReconcilerState struct {
 	Value          []byte
 	CreatedAt      pgtype.Timestamptz
 	UpdatedAt      pgtype.Timestamptz
+	Revision       int32
+}
+
+type ReconcilerStateRevision struct {
+	ID             uuid.UUID
+	ReconcilerName string
+	TeamSlug       slug.Slug
+	Revision       int32
+	Value          []byte
+	CreatedAt      pgtype.Timestamptz
 }
@@ -11,12 +11,20 @@ import (
 type Querier interface {
 	ClearErrorsForTeam(ctx context.Context, arg ClearErrorsForTeamParams) error
 	Count(ctx context.Context) (int64, error)
+	CreateStateRevision(ctx context.Context, arg CreateStateRevisionParams) error
 	DeleteConfig(ctx context.Context, arg DeleteConfigParams) error
 	DeleteStateForTeam(ctx context.Context, arg DeleteStateForTeamParams) error
 	Get(ctx context.Context, name string) (*Reconciler, error)
 	GetConfig(ctx context.Context, arg GetConfigParams) ([]*GetConfigRow, error)
 	GetStateForTeam(ctx context.Context, arg GetStateForTeamParams) (*ReconcilerState, error)
+	GetStateRevision(ctx context.Context, arg GetStateRevisionParams) (*ReconcilerStateRevision, error)
 	List(ctx context.Context, arg ListParams) ([]*Reconciler, error)
+	// Locks the state of a reconciler for a team until the end of the transaction, so that revisions are created one at a
+	// time. If the team has no state, an empty state with revision 0 is created, to be replaced by the saved state.
+	LockStateForTeam(ctx context.Context, arg LockStateForTeamParams) (*ReconcilerState, error)
+	NextStateRevision(ctx context.Context, arg NextStateRevisionParams) (int32, error)
+	// Removes the revisions of the state of a reconciler for a team that are older than the given revision.
+	PruneStateRevisions(ctx context.Context, arg PruneStateRevisionsParams) error
 	SetErrorForTeam(ctx context.Context, arg SetErrorForTeamParams) error
 	SetLastSuccessfulSyncForTeam(ctx context.Context, argSlug slug.Slug) error
 	Upsert(ctx context.Context, arg UpsertParams) (*Reconciler, error)
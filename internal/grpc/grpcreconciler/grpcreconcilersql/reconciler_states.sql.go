@@ -9,6 +9,30 @@ import (
 	"github.com/nais/api/internal/slug"
 )
 
+const createStateRevision = `-- name: CreateStateRevision :exec
+INSERT INTO
+	reconciler_state_revisions (reconciler_name, team_slug, revision, value)
+VALUES
+	($1, $2, $3, $4)
+`
+
+type CreateStateRevisionParams struct {
+	ReconcilerName string
+	TeamSlug       slug.Slug
+	Revision       int32
+	Value          []byte
+}
+
+func (q *Queries) CreateStateRevision(ctx context.Context, arg CreateStateRevisionParams) error {
+	_, err := q.db.Exec(ctx, createStateRevision,
+		arg.ReconcilerName,
+		arg.TeamSlug,
+		arg.Revision,
+		arg.Value,
+	)
+	return err
+}
+
 const deleteStateForTeam = `-- name: DeleteStateForTeam :exec
 DELETE FROM reconciler_states
 WHERE
@@ -28,7 +52,7 @@ func (q *Queries) DeleteStateForTeam(ctx context.Context, arg DeleteStateForTeam
 
 const getStateForTeam = `-- name: GetStateForTeam :one
 SELECT
-	id, reconciler_name, team_slug, value, created_at, updated_at
+	id, reconciler_name, team_slug, value, created_at, updated_at, revision
 FROM
 	reconciler_states
 WHERE
@@ -51,30 +75,145 @@ func (q *Queries) GetStateForTeam(ctx context.Context, arg GetStateForTeamParams
 		&i.Value,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+	)
+	return &i, err
+}
+
+const getStateRevision = `-- name: GetStateRevision :one
+SELECT
+	id, reconciler_name, team_slug, revision, value, created_at
+FROM
+	reconciler_state_revisions
+WHERE
+	reconciler_name = $1
+	AND team_slug = $2
+	AND revision = $3
+`
+
+type GetStateRevisionParams struct {
+	ReconcilerName string
+	TeamSlug       slug.Slug
+	Revision       int32
+}
+
+func (q *Queries) GetStateRevision(ctx context.Context, arg GetStateRevisionParams) (*ReconcilerStateRevision, error) {
+	row := q.db.QueryRow(ctx, getStateRevision, arg.ReconcilerName, arg.TeamSlug, arg.Revision)
+	var i ReconcilerStateRevision
+	err := row.Scan(
+		&i.ID,
+		&i.ReconcilerName,
+		&i.TeamSlug,
+		&i.Revision,
+		&i.Value,
+		&i.CreatedAt,
 	)
 	return &i, err
 }
 
+const lockStateForTeam = `-- name: LockStateForTeam :one
+INSERT INTO
+	reconciler_states (reconciler_name, team_slug, value, revision)
+VALUES
+	($1, $2, '', 0)
+ON CONFLICT (reconciler_name, team_slug) DO UPDATE
+SET
+	reconciler_name = EXCLUDED.reconciler_name
+RETURNING
+	id, reconciler_name, team_slug, value, created_at, updated_at, revision
+`
+
+type LockStateForTeamParams struct {
+	ReconcilerName string
+	TeamSlug       slug.Slug
+}
+
+// Locks the state of a reconciler for a team until the end of the transaction, so that revisions are created one at a
+// time. If the team has no state, an empty state with revision 0 is created, to be replaced by the saved state.
+func (q *Queries) LockStateForTeam(ctx context.Context, arg LockStateForTeamParams) (*ReconcilerState, error) {
+	row := q.db.QueryRow(ctx, lockStateForTeam, arg.ReconcilerName, arg.TeamSlug)
+	var i ReconcilerState
+	err := row.Scan(
+		&i.ID,
+		&i.ReconcilerName,
+		&i.TeamSlug,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+	)
+	return &i, err
+}
+
+const nextStateRevision = `-- name: NextStateRevision :one
+SELECT
+	(COALESCE(MAX(revision), 0) + 1)::INTEGER
+FROM
+	reconciler_state_revisions
+WHERE
+	reconciler_name = $1
+	AND team_slug = $2
+`
+
+type NextStateRevisionParams struct {
+	ReconcilerName string
+	TeamSlug       slug.Slug
+}
+
+func (q *Queries) NextStateRevision(ctx context.Context, arg NextStateRevisionParams) (int32, error) {
+	row := q.db.QueryRow(ctx, nextStateRevision, arg.ReconcilerName, arg.TeamSlug)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const pruneStateRevisions = `-- name: PruneStateRevisions :exec
+DELETE FROM reconciler_state_revisions
+WHERE
+	reconciler_name = $1
+	AND team_slug = $2
+	AND revision < $3::INTEGER
+`
+
+type PruneStateRevisionsParams struct {
+	ReconcilerName string
+	TeamSlug       slug.Slug
+	MinRevision    int32
+}
+
+// Removes the revisions of the state of a reconciler for a team that are older than the given revision.
+func (q *Queries) PruneStateRevisions(ctx context.Context, arg PruneStateRevisionsParams) error {
+	_, err := q.db.Exec(ctx, pruneStateRevisions, arg.ReconcilerName, arg.TeamSlug, arg.MinRevision)
+	return err
+}
+
 const upsertState = `-- name: UpsertState :one
 INSERT INTO
-	reconciler_states (reconciler_name, team_slug, value)
+	reconciler_states (reconciler_name, team_slug, value, revision)
 VALUES
-	($1, $2, $3)
+	($1, $2, $3, $4)
 ON CONFLICT (reconciler_name, team_slug) DO UPDATE
 SET
-	value = EXCLUDED.value
+	value = EXCLUDED.value,
+	revision = EXCLUDED.revision
 RETURNING
-	id, reconciler_name, team_slug, value, created_at, updated_at
+	id, reconciler_name, team_slug, value, created_at, updated_at, revision
 `
 
 type UpsertStateParams struct {
 	ReconcilerName string
 	TeamSlug       slug.Slug
 	Value          []byte
+	Revision       int32
 }
 
 func (q *Queries) UpsertState(ctx context.Context, arg UpsertStateParams) (*ReconcilerState, error) {
-	row := q.db.QueryRow(ctx, upsertState, arg.ReconcilerName, arg.TeamSlug, arg.Value)
+	row := q.db.QueryRow(ctx, upsertState,
+		arg.ReconcilerName,
+		arg.TeamSlug,
+		arg.Value,
+		arg.Revision,
+	)
 	var i ReconcilerState
 	err := row.Scan(
 		&i.ID,
@@ -83,6 +222,7 @@ func (q *Queries) UpsertState(ctx context.Context, arg UpsertStateParams) (*Reco
 		&i.Value,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
 	)
 	return &i, err
 }
@@ -1,11 +1,12 @@
 -- name: UpsertState :one
 INSERT INTO
-	reconciler_states (reconciler_name, team_slug, value)
+	reconciler_states (reconciler_name, team_slug, value, revision)
 VALUES
-	(@reconciler_name, @team_slug, @value)
+	(@reconciler_name, @team_slug, @value, @revision)
 ON CONFLICT (reconciler_name, team_slug) DO UPDATE
 SET
-	value = EXCLUDED.value
+	value = EXCLUDED.value,
+	revision = EXCLUDED.revision
 RETURNING
 	*
 ;
@@ -26,3 +27,54 @@ WHERE
 	reconciler_name = @reconciler_name
 	AND team_slug = @team_slug
 ;
+
+-- name: LockStateForTeam :one
+-- Locks the state of a reconciler for a team until the end of the transaction, so that revisions are created one at a
+-- time. If the team has no state, an empty state with revision 0 is created, to be replaced by the saved state.
+INSERT INTO
+	reconciler_states (reconciler_name, team_slug, value, revision)
+VALUES
+	(@reconciler_name, @team_slug, '', 0)
+ON CONFLICT (reconciler_name, team_slug) DO UPDATE
+SET
+	reconciler_name = EXCLUDED.reconciler_name
+RETURNING
+	*
+;
+
+-- name: NextStateRevision :one
+SELECT
+	(COALESCE(MAX(revision), 0) + 1)::INTEGER
+FROM
+	reconciler_state_revisions
+WHERE
+	reconciler_name = @reconciler_name
+	AND team_slug = @team_slug
+;
+
+-- name: CreateStateRevision :exec
+INSERT INTO
+	reconciler_state_revisions (reconciler_name, team_slug, revision, value)
+VALUES
+	(@reconciler_name, @team_slug, @revision, @value)
+;
+
+-- name: PruneStateRevisions :exec
+-- Removes the revisions of the state of a reconciler for a team that are older than the given revision.
+DELETE FROM reconciler_state_revisions
+WHERE
+	reconciler_name = @reconciler_name
+	AND team_slug = @team_slug
+	AND revision < @min_revision::INTEGER
+;
+
+-- name: GetStateRevision :one
+SELECT
+	*
+FROM
+	reconciler_state_revisions
+WHERE
+	reconciler_name = @reconciler_name
+	AND team_slug = @team_slug
+	AND revision = @revision
+;
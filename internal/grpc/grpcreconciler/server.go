@@ -1,6 +1,7 @@
 package grpcreconciler
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -18,6 +19,10 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// maxStateRevisions is the number of revisions of the state of a reconciler for a team that is kept. Older revisions are
+// removed when a new revision is saved.
+const maxStateRevisions = 20
+
 type Server struct {
 	pool    *pgxpool.Pool
 	querier *grpcreconcilersql.Queries
@@ -184,11 +189,7 @@ func (s *Server) SaveState(ctx context.Context, req *protoapi.SaveReconcilerStat
 		return nil, status.Errorf(codes.InvalidArgument, "state is required")
 	}
 
-	if _, err := s.querier.UpsertState(ctx, grpcreconcilersql.UpsertStateParams{
-		ReconcilerName: req.ReconcilerName,
-		TeamSlug:       slug.Slug(req.TeamSlug),
-		Value:          req.Value,
-	}); err != nil {
+	if _, err := s.saveState(ctx, req.ReconcilerName, slug.Slug(req.TeamSlug), req.Value); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to save reconciler state")
 	}
 
@@ -230,6 +231,123 @@ func (s *Server) DeleteState(ctx context.Context, req *protoapi.DeleteReconciler
 	return &protoapi.DeleteReconcilerStateResponse{}, nil
 }
 
+func (s *Server) StateRevision(ctx context.Context, req *protoapi.GetReconcilerStateRevisionRequest) (*protoapi.GetReconcilerStateRevisionResponse, error) {
+	rev, err := s.getStateRevision(ctx, req.ReconcilerName, req.TeamSlug, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protoapi.GetReconcilerStateRevisionResponse{
+		Revision: toProtoReconcilerStateRevision(rev),
+	}, nil
+}
+
+func (s *Server) RestoreStateRevision(ctx context.Context, req *protoapi.RestoreReconcilerStateRevisionRequest) (*protoapi.RestoreReconcilerStateRevisionResponse, error) {
+	rev, err := s.getStateRevision(ctx, req.ReconcilerName, req.TeamSlug, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.saveState(ctx, rev.ReconcilerName, rev.TeamSlug, rev.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore reconciler state")
+	}
+
+	return &protoapi.RestoreReconcilerStateRevisionResponse{
+		State: toProtoReconcilerState(state),
+	}, nil
+}
+
+func (s *Server) getStateRevision(ctx context.Context, reconcilerName, teamSlug string, revision int32) (*grpcreconcilersql.ReconcilerStateRevision, error) {
+	switch {
+	case reconcilerName == "":
+		return nil, status.Errorf(codes.InvalidArgument, "reconcilerName is required")
+	case teamSlug == "":
+		return nil, status.Errorf(codes.InvalidArgument, "teamSlug is required")
+	case revision < 1:
+		return nil, status.Errorf(codes.InvalidArgument, "revision must be a positive number")
+	}
+
+	rev, err := s.querier.GetStateRevision(ctx, grpcreconcilersql.GetStateRevisionParams{
+		ReconcilerName: reconcilerName,
+		TeamSlug:       slug.Slug(teamSlug),
+		Revision:       revision,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "state revision not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reconciler state revision")
+	}
+
+	return rev, nil
+}
+
+// saveState stores the state of a reconciler for a team. A new revision is created when the value differs from the
+// current state, and revisions beyond maxStateRevisions are removed.
+func (s *Server) saveState(ctx context.Context, reconcilerName string, teamSlug slug.Slug, value []byte) (*grpcreconcilersql.ReconcilerState, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	querier := s.querier.WithTx(tx)
+
+	current, err := querier.LockStateForTeam(ctx, grpcreconcilersql.LockStateForTeamParams{
+		ReconcilerName: reconcilerName,
+		TeamSlug:       teamSlug,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A state without a revision has just been created by the lock, and is not returned even if the value is empty
+	if current.Revision > 0 && bytes.Equal(current.Value, value) {
+		return current, nil
+	}
+
+	revision, err := querier.NextStateRevision(ctx, grpcreconcilersql.NextStateRevisionParams{
+		ReconcilerName: reconcilerName,
+		TeamSlug:       teamSlug,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := querier.CreateStateRevision(ctx, grpcreconcilersql.CreateStateRevisionParams{
+		ReconcilerName: reconcilerName,
+		TeamSlug:       teamSlug,
+		Revision:       revision,
+		Value:          value,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := querier.PruneStateRevisions(ctx, grpcreconcilersql.PruneStateRevisionsParams{
+		ReconcilerName: reconcilerName,
+		TeamSlug:       teamSlug,
+		MinRevision:    revision - maxStateRevisions + 1,
+	}); err != nil {
+		return nil, err
+	}
+
+	state, err := querier.UpsertState(ctx, grpcreconcilersql.UpsertStateParams{
+		ReconcilerName: reconcilerName,
+		TeamSlug:       teamSlug,
+		Value:          value,
+		Revision:       revision,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
 func (s *Server) syncReconcilerConfig(ctx context.Context, reconcilerName string, configs []*protoapi.ReconcilerConfigSpec) error {
 	cfg, err := s.querier.GetConfig(ctx, grpcreconcilersql.GetConfigParams{
 		IncludeSecret:  false,
@@ -289,6 +407,17 @@ func toProtoReconcilerState(res *grpcreconcilersql.ReconcilerState) *protoapi.Re
 		Value:          res.Value,
 		CreatedAt:      timestamppb.New(res.CreatedAt.Time),
 		UpdatedAt:      timestamppb.New(res.UpdatedAt.Time),
+		Revision:       res.Revision,
+	}
+}
+
+func toProtoReconcilerStateRevision(res *grpcreconcilersql.ReconcilerStateRevision) *protoapi.ReconcilerStateRevision {
+	return &protoapi.ReconcilerStateRevision{
+		ReconcilerName: res.ReconcilerName,
+		TeamSlug:       string(res.TeamSlug),
+		Revision:       res.Revision,
+		Value:          res.Value,
+		CreatedAt:      timestamppb.New(res.CreatedAt.Time),
 	}
 }
 
@@ -0,0 +1,310 @@
+//go:build integration_test
+
+package grpcreconciler_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/grpc/grpcreconciler"
+	"github.com/nais/api/pkg/apiclient/protoapi"
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	reconcilerName = "test-reconciler"
+	teamSlug       = "team"
+)
+
+func TestReconcilersServer_SaveState(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("revision is only created when state changes", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		srv := grpcreconciler.NewServer(pool)
+
+		for _, v := range []string{"a", "a", "b"} {
+			saveState(ctx, t, srv, v)
+		}
+
+		resp, err := srv.State(ctx, &protoapi.GetReconcilerStateRequest{ReconcilerName: reconcilerName, TeamSlug: teamSlug})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp.State.Revision != 2 {
+			t.Errorf("expected revision 2, got %d", resp.State.Revision)
+		}
+
+		if string(resp.State.Value) != "b" {
+			t.Errorf("expected value %q, got %q", "b", resp.State.Value)
+		}
+	})
+
+	t.Run("old revisions are removed", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		srv := grpcreconciler.NewServer(pool)
+
+		for i := range 25 {
+			saveState(ctx, t, srv, fmt.Sprintf("value-%d", i))
+		}
+
+		var count, oldest int
+		stmt := "SELECT COUNT(*), MIN(revision) FROM reconciler_state_revisions WHERE reconciler_name = $1 AND team_slug = $2"
+		if err := pool.QueryRow(ctx, stmt, reconcilerName, teamSlug).Scan(&count, &oldest); err != nil {
+			t.Fatalf("failed to count revisions: %v", err)
+		}
+
+		if count != 20 {
+			t.Errorf("expected 20 revisions, got %d", count)
+		}
+
+		if oldest != 6 {
+			t.Errorf("expected oldest revision to be 6, got %d", oldest)
+		}
+	})
+
+	t.Run("concurrent changes get consecutive revisions", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		srv := grpcreconciler.NewServer(pool)
+
+		const changes = 10
+		errs := make([]error, changes)
+		var wg sync.WaitGroup
+		for i := range changes {
+			wg.Go(func() {
+				_, errs[i] = srv.SaveState(ctx, &protoapi.SaveReconcilerStateRequest{
+					ReconcilerName: reconcilerName,
+					TeamSlug:       teamSlug,
+					Value:          []byte(fmt.Sprintf("value-%d", i)),
+				})
+			})
+		}
+		wg.Wait()
+
+		for i, err := range errs {
+			if err != nil {
+				t.Errorf("change %d: %v", i, err)
+			}
+		}
+
+		var count, latest int
+		stmt := "SELECT COUNT(*), MAX(revision) FROM reconciler_state_revisions WHERE reconciler_name = $1 AND team_slug = $2"
+		if err := pool.QueryRow(ctx, stmt, reconcilerName, teamSlug).Scan(&count, &latest); err != nil {
+			t.Fatalf("failed to count revisions: %v", err)
+		}
+
+		if count != changes || latest != changes {
+			t.Errorf("expected %d revisions with latest revision %d, got %d with latest revision %d", changes, changes, count, latest)
+		}
+
+		resp, err := srv.State(ctx, &protoapi.GetReconcilerStateRequest{ReconcilerName: reconcilerName, TeamSlug: teamSlug})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp.State.Revision != changes {
+			t.Errorf("expected state to be revision %d, got %d", changes, resp.State.Revision)
+		}
+	})
+
+	t.Run("empty state is saved", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		srv := grpcreconciler.NewServer(pool)
+
+		saveState(ctx, t, srv, "")
+
+		resp, err := srv.State(ctx, &protoapi.GetReconcilerStateRequest{ReconcilerName: reconcilerName, TeamSlug: teamSlug})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp.State.Revision != 1 {
+			t.Errorf("expected revision 1, got %d", resp.State.Revision)
+		}
+	})
+}
+
+func TestReconcilersServer_StateRevision(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("missing revision", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+
+		resp, err := grpcreconciler.NewServer(pool).StateRevision(ctx, &protoapi.GetReconcilerStateRevisionRequest{
+			ReconcilerName: reconcilerName,
+			TeamSlug:       teamSlug,
+		})
+		if resp != nil {
+			t.Error("expected response to be nil")
+		}
+
+		if s, ok := status.FromError(err); !ok || s.Code() != codes.InvalidArgument {
+			t.Errorf("expected status code %v, got %v", codes.InvalidArgument, err)
+		}
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+
+		resp, err := grpcreconciler.NewServer(pool).StateRevision(ctx, &protoapi.GetReconcilerStateRevisionRequest{
+			ReconcilerName: reconcilerName,
+			TeamSlug:       teamSlug,
+			Revision:       1,
+		})
+		if resp != nil {
+			t.Error("expected response to be nil")
+		}
+
+		if s, ok := status.FromError(err); !ok || s.Code() != codes.NotFound {
+			t.Errorf("expected status code %v, got %v", codes.NotFound, err)
+		}
+	})
+
+	t.Run("get revision", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		srv := grpcreconciler.NewServer(pool)
+
+		saveState(ctx, t, srv, "first")
+		saveState(ctx, t, srv, "second")
+
+		resp, err := srv.StateRevision(ctx, &protoapi.GetReconcilerStateRevisionRequest{
+			ReconcilerName: reconcilerName,
+			TeamSlug:       teamSlug,
+			Revision:       1,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(resp.Revision.Value) != "first" {
+			t.Errorf("expected value %q, got %q", "first", resp.Revision.Value)
+		}
+	})
+}
+
+func TestReconcilersServer_RestoreStateRevision(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("restore revision", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		srv := grpcreconciler.NewServer(pool)
+
+		saveState(ctx, t, srv, "first")
+		saveState(ctx, t, srv, "second")
+
+		resp, err := srv.RestoreStateRevision(ctx, &protoapi.RestoreReconcilerStateRevisionRequest{
+			ReconcilerName: reconcilerName,
+			TeamSlug:       teamSlug,
+			Revision:       1,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(resp.State.Value) != "first" {
+			t.Errorf("expected value %q, got %q", "first", resp.State.Value)
+		}
+
+		if resp.State.Revision != 3 {
+			t.Errorf("expected restored state to be revision 3, got %d", resp.State.Revision)
+		}
+	})
+}
+
+func saveState(ctx context.Context, t *testing.T, srv *grpcreconciler.Server, value string) {
+	t.Helper()
+
+	if _, err := srv.SaveState(ctx, &protoapi.SaveReconcilerStateRequest{
+		ReconcilerName: reconcilerName,
+		TeamSlug:       teamSlug,
+		Value:          []byte(value),
+	}); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+}
+
+func startPostgresql(ctx context.Context, t *testing.T, log logrus.FieldLogger) (container *postgres.PostgresContainer, dsn string, err error) {
+	container, err = postgres.Run(
+		ctx,
+		"docker.io/postgres:16-alpine",
+		postgres.WithDatabase("test"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		postgres.WithSQLDriver("pgx"),
+		postgres.BasicWaitStrategies(),
+	)
+	defer testcontainers.CleanupContainer(t, container)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to start container: %w", err)
+	}
+
+	dsn, err = container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get connection string: %w", err)
+	}
+
+	pool, err := database.NewPool(ctx, dsn, log, true)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create pool: %w", err)
+	}
+
+	stmts := []string{
+		"INSERT INTO reconcilers (name, display_name, description) VALUES ('" + reconcilerName + "', 'Test reconciler', 'description')",
+		"INSERT INTO teams (slug, purpose, slack_channel) VALUES ('" + teamSlug + "', 'purpose', '#channel')",
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			pool.Close()
+			return nil, "", fmt.Errorf("failed to insert test data: %w", err)
+		}
+	}
+	pool.Close()
+
+	if err := container.Snapshot(ctx); err != nil {
+		return nil, "", fmt.Errorf("failed to snapshot: %w", err)
+	}
+
+	return container, dsn, nil
+}
+
+func getConnection(ctx context.Context, t *testing.T, container *postgres.PostgresContainer, dsn string, log logrus.FieldLogger) *pgxpool.Pool {
+	pool, _ := database.NewPool(ctx, dsn, log, false)
+
+	t.Cleanup(func() {
+		pool.Close()
+		if err := container.Restore(ctx); err != nil {
+			t.Fatalf("failed to restore database: %v", err)
+		}
+	})
+
+	return pool
+}
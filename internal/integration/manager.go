@@ -10,23 +10,29 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/apply"
 	"github.com/nais/api/internal/auth/authz"
 	"github.com/nais/api/internal/auth/middleware"
 	"github.com/nais/api/internal/cmd/api"
 	"github.com/nais/api/internal/database"
 	"github.com/nais/api/internal/database/notify"
+	"github.com/nais/api/internal/duration"
 	"github.com/nais/api/internal/environment"
 	"github.com/nais/api/internal/graph"
 	"github.com/nais/api/internal/graph/gengql"
 	apiRunner "github.com/nais/api/internal/integration/runner"
+	"github.com/nais/api/internal/issue"
 	"github.com/nais/api/internal/issue/checker"
 	"github.com/nais/api/internal/kubernetes"
 	"github.com/nais/api/internal/kubernetes/watcher"
 	"github.com/nais/api/internal/kubernetes/watchers"
 	"github.com/nais/api/internal/loki"
+	"github.com/nais/api/internal/persistence/aivencredentials"
 	"github.com/nais/api/internal/persistence/sqlinstance"
 	"github.com/nais/api/internal/rest"
 	"github.com/nais/api/internal/servicemaintenance"
@@ -37,6 +43,7 @@ import (
 	"github.com/nais/api/internal/user"
 	"github.com/nais/api/internal/vulnerability"
 	"github.com/nais/api/internal/workload/logging"
+	"github.com/nais/api/internal/workload/secret"
 	testmanager "github.com/nais/tester/lua"
 	"github.com/nais/tester/lua/runner"
 	"github.com/nais/tester/lua/spec"
@@ -79,6 +86,58 @@ func clusters() []string {
 	return []string{"dev", "staging", "dev-fss", "dev-gcp"}
 }
 
+// applyWhitelist returns the apply whitelist used by the tests. The staging environment
+// overrides the built-in resources.
+func applyWhitelist() (*apply.Whitelist, error) {
+	return apply.NewWhitelist(apply.WhitelistConfig{
+		Environments: map[string]apply.EnvironmentWhitelistConfig{
+			"staging": {
+				Allow: []apply.ResourceConfig{
+					{APIVersion: "example.com/v1", Kind: "Widget", Resource: "widgets"},
+				},
+				Deny: []apply.AllowedResource{
+					{APIVersion: "v1", Kind: "Secret"},
+				},
+			},
+		},
+	}, clusters(), nil)
+}
+
+// applyValidators returns the validators run by the apply endpoint in the tests.
+func applyValidators() ([]apply.Validator, error) {
+	policy, err := apply.NewPolicyValidator(apply.PolicyRules{
+		{Name: "disallow-latest-tag"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []apply.Validator{policy}, nil
+}
+
+// customIssueChecks returns the custom issue checks run by the issue checker in the tests.
+func customIssueChecks() checker.CustomChecks {
+	return checker.CustomChecks{
+		{
+			Name:       "missing-owner-label",
+			Resource:   checker.CustomCheckResourceApplication,
+			Expression: `object.metadata.?labels[?"test.nais.io/custom-check"].hasValue() && !object.metadata.?labels[?"owner"].hasValue()`,
+			Severity:   issue.SeverityWarning,
+			Message:    `Application {{ .object.metadata.name }} in {{ .environment }} has no owner label`,
+		},
+	}
+}
+
+// credentialPolicy returns the credential policy used by the issue checker in the tests.
+func credentialPolicy() checker.CredentialPolicy {
+	const day = duration.Duration(24 * time.Hour)
+	return checker.CredentialPolicy{
+		ServiceAccountTokenExpiryWarning: 14 * day,
+		ServiceAccountTokenMaxUnused:     90 * day,
+		AivenCredentialMaxAge:            30 * day,
+		SecretRotationWindow:             365 * day,
+	}
+}
+
 func newManager(_ context.Context, container *postgres.PostgresContainer, connStr string, skipSetup bool) testmanager.SetupFunc {
 	if skipSetup {
 		return func(ctx context.Context, _ string, _ any) (retCtx context.Context, runners []spec.Runner, close func(), err error) {
@@ -152,13 +211,19 @@ func newManager(_ context.Context, container *postgres.PostgresContainer, connSt
 			return ctx, nil, nil, err
 		}
 
-		gqlRunner, gqlCleanup, contextDependencies, err := newGQLRunner(ctx, config, pool, topic, watchers, watcherMgr, clusterConfig, fakeAivenClient, lokiClient)
+		applyHandler, err := newApplyHandler(k8sRunner, log)
 		if err != nil {
 			done()
 			return ctx, nil, nil, err
 		}
 
-		restRunner, err := newRestRunner(ctx, pool, clusterConfig, k8sRunner, contextDependencies, log)
+		gqlRunner, gqlCleanup, contextDependencies, err := newGQLRunner(ctx, dir, config, pool, topic, watchers, watcherMgr, clusterConfig, fakeAivenClient, lokiClient, applyHandler)
+		if err != nil {
+			done()
+			return ctx, nil, nil, err
+		}
+
+		restRunner, err := newRestRunner(ctx, pool, contextDependencies, applyHandler, log)
 		if err != nil {
 			done()
 			return ctx, nil, nil, err
@@ -185,6 +250,10 @@ func newManager(_ context.Context, container *postgres.PostgresContainer, connSt
 				Tenant:         "tenant",
 				Clusters:       clusters(),
 				BifrostClient:  unleash.NewFakeBifrostClient(watchers.UnleashWatcher),
+				CustomChecks:   customIssueChecks(),
+
+				CredentialPolicy:        credentialPolicy(),
+				AivenApplicationWatcher: aivencredentials.NewWatcher(ctx, watcherMgr),
 			},
 			pool,
 			watchers,
@@ -210,16 +279,33 @@ func newManager(_ context.Context, container *postgres.PostgresContainer, connSt
 
 const testPreSharedKey = "test-pre-shared-key"
 
-func newRestRunner(ctx context.Context, pool *pgxpool.Pool, clusterConfig kubernetes.ClusterConfigMap, k8sRunner *apiRunner.K8s, contextDependencies func(http.Handler) http.Handler, logger logrus.FieldLogger) (spec.Runner, error) {
+// newApplyHandler returns the handler used by both the apply endpoint and the GraphQL API in the tests.
+func newApplyHandler(k8sRunner *apiRunner.K8s, logger logrus.FieldLogger) (*apply.Handler, error) {
+	whitelist, err := applyWhitelist()
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := applyValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient := func(cluster string, _ slug.Slug) (dynamic.Interface, error) {
+		return k8sRunner.DynamicClient(cluster)
+	}
+
+	return apply.NewHandler(dynamicClient, whitelist, logger, apply.WithValidators(validators...)), nil
+}
+
+func newRestRunner(ctx context.Context, pool *pgxpool.Pool, contextDependencies func(http.Handler) http.Handler, applyHandler *apply.Handler, logger logrus.FieldLogger) (spec.Runner, error) {
 	router := rest.MakeRouter(ctx, rest.Config{
 		Pool:              pool,
 		PreSharedKey:      testPreSharedKey,
 		ContextMiddleware: contextDependencies,
-		DynamicClient: func(cluster string, _ slug.Slug) (dynamic.Interface, error) {
-			return k8sRunner.DynamicClient(cluster)
-		},
-		Fakes: rest.Fakes{WithInsecureUserHeader: true},
-		Log:   logger,
+		ApplyHandler:      applyHandler,
+		Fakes:             rest.Fakes{WithInsecureUserHeader: true},
+		Log:               logger,
 	})
 
 	return runner.NewRestRunner(router), nil
@@ -227,6 +313,7 @@ func newRestRunner(ctx context.Context, pool *pgxpool.Pool, clusterConfig kubern
 
 func newGQLRunner(
 	ctx context.Context,
+	dir string,
 	config *Config,
 	pool *pgxpool.Pool,
 	topic graph.PubsubTopic,
@@ -235,6 +322,7 @@ func newGQLRunner(
 	clusterConfig kubernetes.ClusterConfigMap,
 	fakeAivenClient *aiven.FakeAivenClient,
 	lokiClient loki.Client,
+	applyHandler *apply.Handler,
 ) (spec.Runner, func(), func(http.Handler) http.Handler, error) {
 	log := logrus.New()
 	log.Out = io.Discard
@@ -249,6 +337,12 @@ func newGQLRunner(
 		return nil, nil, nil, err
 	}
 
+	// Secret versions are encrypted with a fixed test key.
+	secretVersionCipher, err := secret.NewVersionCipher("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	notifierCtx, notifyCancel := context.WithCancel(ctx)
 	notifier := notify.New(pool, log, notify.WithRetries(0))
 	go notifier.Run(notifierCtx)
@@ -290,6 +384,10 @@ func newGQLRunner(
 		lokiClient,
 		"test-audit-project", // auditLogProjectID for testing
 		"test-location",      // auditLogLocation for testing
+		applyHandler,
+		secretVersionCipher,
+		secret.AccessApprovalPolicy{Environments: []string{"dev-fss"}},
+		secret.ExternalSources{"file": secret.NewFileSource(filepath.Join(dir, "external_secrets"))},
 		log,
 	)
 	if err != nil {
@@ -0,0 +1,238 @@
+package issue
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/api/internal/graph/ident"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/issue/issuesql"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/validate"
+)
+
+// resourceNamePatternRegex matches valid suppression rule patterns: resource names, where * matches any sequence of
+// characters.
+var resourceNamePatternRegex = regexp.MustCompile(`^[a-z0-9*][a-z0-9.*-]*$`)
+
+type IssueAcknowledgementKind string
+
+const (
+	IssueAcknowledgementKindAcknowledged IssueAcknowledgementKind = "ACKNOWLEDGED"
+	IssueAcknowledgementKindSnoozed      IssueAcknowledgementKind = "SNOOZED"
+	IssueAcknowledgementKindSuppressed   IssueAcknowledgementKind = "SUPPRESSED"
+)
+
+var AllIssueAcknowledgementKind = []IssueAcknowledgementKind{
+	IssueAcknowledgementKindAcknowledged,
+	IssueAcknowledgementKindSnoozed,
+	IssueAcknowledgementKindSuppressed,
+}
+
+func (e IssueAcknowledgementKind) IsValid() bool {
+	switch e {
+	case IssueAcknowledgementKindAcknowledged, IssueAcknowledgementKindSnoozed, IssueAcknowledgementKindSuppressed:
+		return true
+	}
+	return false
+}
+
+func (e IssueAcknowledgementKind) String() string {
+	return string(e)
+}
+
+func (e *IssueAcknowledgementKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IssueAcknowledgementKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IssueAcknowledgementKind", str)
+	}
+	return nil
+}
+
+func (e IssueAcknowledgementKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type IssueAcknowledgement struct {
+	Kind      IssueAcknowledgementKind `json:"kind"`
+	Reason    string                   `json:"reason"`
+	Actor     string                   `json:"actor"`
+	CreatedAt time.Time                `json:"createdAt"`
+	Until     *time.Time               `json:"until,omitempty"`
+
+	SuppressionRuleID *uuid.UUID `json:"-"`
+}
+
+type IssueSuppressionRule struct {
+	UUID                uuid.UUID `json:"-"`
+	TeamSlug            slug.Slug `json:"-"`
+	IssueType           IssueType `json:"issueType"`
+	ResourceNamePattern string    `json:"resourceNamePattern"`
+	Reason              string    `json:"reason"`
+	Actor               string    `json:"actor"`
+	CreatedAt           time.Time `json:"createdAt"`
+}
+
+func (IssueSuppressionRule) IsNode() {}
+
+func (r *IssueSuppressionRule) ID() ident.Ident {
+	return newSuppressionRuleIdent(r.UUID)
+}
+
+type (
+	IssueSuppressionRuleConnection = pagination.Connection[*IssueSuppressionRule]
+	IssueSuppressionRuleEdge       = pagination.Edge[*IssueSuppressionRule]
+)
+
+type AcknowledgeIssueInput struct {
+	IssueID ident.Ident `json:"issueID"`
+	Reason  string      `json:"reason"`
+}
+
+func (i *AcknowledgeIssueInput) Validate() error {
+	verr := validate.New()
+
+	i.Reason = strings.TrimSpace(i.Reason)
+	if i.Reason == "" {
+		verr.Add("reason", "A reason is required.")
+	}
+
+	return verr.NilIfEmpty()
+}
+
+type AcknowledgeIssuePayload struct {
+	Issue Issue `json:"issue"`
+}
+
+type SnoozeIssueInput struct {
+	IssueID ident.Ident `json:"issueID"`
+	Until   time.Time   `json:"until"`
+	Reason  string      `json:"reason"`
+}
+
+func (i *SnoozeIssueInput) Validate() error {
+	verr := validate.New()
+
+	i.Reason = strings.TrimSpace(i.Reason)
+	if i.Reason == "" {
+		verr.Add("reason", "A reason is required.")
+	}
+
+	if !i.Until.After(time.Now()) {
+		verr.Add("until", "The issue must be snoozed until a time in the future.")
+	}
+
+	return verr.NilIfEmpty()
+}
+
+type SnoozeIssuePayload struct {
+	Issue Issue `json:"issue"`
+}
+
+type RemoveIssueAcknowledgementInput struct {
+	IssueID ident.Ident `json:"issueID"`
+}
+
+type RemoveIssueAcknowledgementPayload struct {
+	Issue Issue `json:"issue"`
+}
+
+type CreateIssueSuppressionRuleInput struct {
+	TeamSlug            slug.Slug `json:"teamSlug"`
+	IssueType           IssueType `json:"issueType"`
+	ResourceNamePattern string    `json:"resourceNamePattern"`
+	Reason              string    `json:"reason"`
+}
+
+func (i *CreateIssueSuppressionRuleInput) Validate() error {
+	verr := validate.New()
+
+	i.ResourceNamePattern = strings.TrimSpace(i.ResourceNamePattern)
+	if !resourceNamePatternRegex.MatchString(i.ResourceNamePattern) {
+		verr.Add("resourceNamePattern", "The pattern must consist of lowercase letters, numbers, hyphens, dots and the wildcard *.")
+	}
+
+	i.Reason = strings.TrimSpace(i.Reason)
+	if i.Reason == "" {
+		verr.Add("reason", "A reason is required.")
+	}
+
+	return verr.NilIfEmpty()
+}
+
+type CreateIssueSuppressionRulePayload struct {
+	SuppressionRule *IssueSuppressionRule `json:"suppressionRule"`
+}
+
+type DeleteIssueSuppressionRuleInput struct {
+	ID ident.Ident `json:"id"`
+}
+
+type DeleteIssueSuppressionRulePayload struct {
+	Success bool `json:"success"`
+}
+
+// toAcknowledgement returns the active acknowledgement of the issue, or nil if it is not acknowledged. An explicit
+// acknowledgement takes precedence over a suppression rule.
+func toAcknowledgement(issue *issuesql.IssuesWithAcknowledgement) *IssueAcknowledgement {
+	if !issue.Acknowledged {
+		return nil
+	}
+
+	if issue.AcknowledgedAt.Valid && (!issue.AcknowledgedUntil.Valid || issue.AcknowledgedUntil.Time.After(time.Now())) {
+		ret := &IssueAcknowledgement{
+			Kind:      IssueAcknowledgementKindAcknowledged,
+			CreatedAt: issue.AcknowledgedAt.Time,
+		}
+		if issue.AcknowledgementReason != nil {
+			ret.Reason = *issue.AcknowledgementReason
+		}
+		if issue.AcknowledgedBy != nil {
+			ret.Actor = *issue.AcknowledgedBy
+		}
+		if issue.AcknowledgedUntil.Valid {
+			ret.Kind = IssueAcknowledgementKindSnoozed
+			ret.Until = &issue.AcknowledgedUntil.Time
+		}
+		return ret
+	}
+
+	if issue.SuppressionRuleID != nil {
+		ret := &IssueAcknowledgement{
+			Kind:              IssueAcknowledgementKindSuppressed,
+			CreatedAt:         issue.SuppressedAt.Time,
+			SuppressionRuleID: issue.SuppressionRuleID,
+		}
+		if issue.SuppressionReason != nil {
+			ret.Reason = *issue.SuppressionReason
+		}
+		if issue.SuppressedBy != nil {
+			ret.Actor = *issue.SuppressedBy
+		}
+		return ret
+	}
+
+	return nil
+}
+
+func toGraphSuppressionRule(r *issuesql.IssueSuppressionRule) *IssueSuppressionRule {
+	return &IssueSuppressionRule{
+		UUID:                r.ID,
+		TeamSlug:            r.TeamSlug,
+		IssueType:           IssueType(r.IssueType),
+		ResourceNamePattern: r.ResourceNamePattern,
+		Reason:              r.Reason,
+		Actor:               r.CreatedBy,
+		CreatedAt:           r.CreatedAt.Time,
+	}
+}
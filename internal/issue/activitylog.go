@@ -0,0 +1,122 @@
+package issue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nais/api/internal/activitylog"
+)
+
+const (
+	activityLogEntryResourceTypeIssue activitylog.ActivityLogEntryResourceType = "ISSUE"
+
+	activityLogEntryActionAcknowledged           activitylog.ActivityLogEntryAction = "ACKNOWLEDGED"
+	activityLogEntryActionSnoozed                activitylog.ActivityLogEntryAction = "SNOOZED"
+	activityLogEntryActionAcknowledgementRemoved activitylog.ActivityLogEntryAction = "ACKNOWLEDGEMENT_REMOVED"
+	activityLogEntryActionSuppressionRuleCreated activitylog.ActivityLogEntryAction = "SUPPRESSION_RULE_CREATED"
+	activityLogEntryActionSuppressionRuleDeleted activitylog.ActivityLogEntryAction = "SUPPRESSION_RULE_DELETED"
+)
+
+func init() {
+	activitylog.RegisterTransformer(activityLogEntryResourceTypeIssue, func(entry activitylog.GenericActivityLogEntry) (activitylog.ActivityLogEntry, error) {
+		switch entry.Action {
+		case activityLogEntryActionAcknowledged, activityLogEntryActionSnoozed:
+			data, err := activitylog.UnmarshalData[IssueAcknowledgedActivityLogEntryData](entry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal issue acknowledged activity log entry data: %w", err)
+			}
+			message := "Acknowledged issue"
+			if entry.Action == activityLogEntryActionSnoozed {
+				message = "Snoozed issue"
+			}
+			return IssueAcknowledgedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage(message),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionAcknowledgementRemoved:
+			data, err := activitylog.UnmarshalData[IssueAcknowledgedActivityLogEntryData](entry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal issue acknowledgement removed activity log entry data: %w", err)
+			}
+			return IssueAcknowledgementRemovedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Removed issue acknowledgement"),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionSuppressionRuleCreated:
+			data, err := activitylog.UnmarshalData[IssueSuppressionRuleActivityLogEntryData](entry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal issue suppression rule created activity log entry data: %w", err)
+			}
+			return IssueSuppressionRuleCreatedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Created issue suppression rule"),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionSuppressionRuleDeleted:
+			data, err := activitylog.UnmarshalData[IssueSuppressionRuleActivityLogEntryData](entry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal issue suppression rule deleted activity log entry data: %w", err)
+			}
+			return IssueSuppressionRuleDeletedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Deleted issue suppression rule"),
+				Data:                    data,
+			}, nil
+		default:
+			return nil, fmt.Errorf("unsupported issue activity log entry action: %q", entry.Action)
+		}
+	})
+
+	activitylog.RegisterFilter("ISSUE_ACKNOWLEDGED", activityLogEntryActionAcknowledged, activityLogEntryResourceTypeIssue)
+	activitylog.RegisterFilter("ISSUE_SNOOZED", activityLogEntryActionSnoozed, activityLogEntryResourceTypeIssue)
+	activitylog.RegisterFilter("ISSUE_ACKNOWLEDGEMENT_REMOVED", activityLogEntryActionAcknowledgementRemoved, activityLogEntryResourceTypeIssue)
+	activitylog.RegisterFilter("ISSUE_SUPPRESSION_RULE_CREATED", activityLogEntryActionSuppressionRuleCreated, activityLogEntryResourceTypeIssue)
+	activitylog.RegisterFilter("ISSUE_SUPPRESSION_RULE_DELETED", activityLogEntryActionSuppressionRuleDeleted, activityLogEntryResourceTypeIssue)
+}
+
+type IssueAcknowledgedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *IssueAcknowledgedActivityLogEntryData `json:"data"`
+}
+
+func (e IssueAcknowledgedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type IssueAcknowledgementRemovedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *IssueAcknowledgedActivityLogEntryData `json:"data"`
+}
+
+func (e IssueAcknowledgementRemovedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type IssueAcknowledgedActivityLogEntryData struct {
+	IssueType    IssueType    `json:"issueType"`
+	ResourceType ResourceType `json:"resourceType"`
+	Reason       string       `json:"reason,omitempty"`
+	Until        *time.Time   `json:"until,omitempty"`
+}
+
+type IssueSuppressionRuleCreatedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *IssueSuppressionRuleActivityLogEntryData `json:"data"`
+}
+
+func (e IssueSuppressionRuleCreatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type IssueSuppressionRuleDeletedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *IssueSuppressionRuleActivityLogEntryData `json:"data"`
+}
+
+func (e IssueSuppressionRuleDeletedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type IssueSuppressionRuleActivityLogEntryData struct {
+	IssueType           IssueType `json:"issueType"`
+	ResourceNamePattern string    `json:"resourceNamePattern"`
+	Reason              string    `json:"reason"`
+}
@@ -18,6 +18,10 @@ type Aiven struct {
 	log          logrus.FieldLogger
 }
 
+func (a Aiven) IssueTypes() []issue.IssueType {
+	return []issue.IssueType{issue.IssueTypeOpenSearch, issue.IssueTypeValkey}
+}
+
 func (a Aiven) Run(ctx context.Context) ([]Issue, error) {
 	ret := make([]Issue, 0)
 
@@ -6,15 +6,20 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nais/api/internal/environmentmapper"
 	"github.com/nais/api/internal/issue"
 	"github.com/nais/api/internal/issue/checker/checkersql"
+	"github.com/nais/api/internal/kubernetes/watcher"
 	"github.com/nais/api/internal/kubernetes/watchers"
 	"github.com/nais/api/internal/leaderelection"
 	"github.com/nais/api/internal/persistence/sqlinstance"
 	"github.com/nais/api/internal/thirdparty/aiven"
 	"github.com/nais/api/internal/unleash"
+	"github.com/nais/api/internal/utilization"
+	aiven_nais_io_v1 "github.com/nais/liberator/pkg/apis/aiven.nais.io/v1"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -25,11 +30,14 @@ const checkInterval = 1 * time.Minute
 
 type check interface {
 	Run(ctx context.Context) ([]Issue, error)
+	// IssueTypes returns the issue types reported by the check. Issues of these types are not resolved when the check
+	// fails.
+	IssueTypes() []issue.IssueType
 }
 
 type Checker struct {
 	checks        []check
-	db            checkersql.Querier
+	pool          *pgxpool.Pool
 	log           logrus.FieldLogger
 	durationGauge metric.Float64Gauge
 	issuesGauge   metric.Int64Gauge
@@ -42,10 +50,23 @@ type Config struct {
 	Tenant         string
 	Clusters       []string
 	BifrostClient  unleash.BifrostClient
+	CustomChecks   CustomChecks
+	// UtilizationClient is used to compare the resources requested by applications with their usage. The check is
+	// disabled when nil.
+	UtilizationClient utilization.ResourceUsageClient
+	// CredentialPolicy configures when service account tokens, temporary Aiven credentials and secrets are
+	// considered to be expiring or stale.
+	CredentialPolicy CredentialPolicy
+	// AivenApplicationWatcher is used to find temporary Aiven credentials. It is only needed, and should only be
+	// started, when CredentialPolicy.AivenCredentialMaxAge is set.
+	AivenApplicationWatcher *watcher.Watcher[*aiven_nais_io_v1.AivenApplication]
 }
 
 type Issue struct {
-	IssueType    issue.IssueType
+	IssueType issue.IssueType
+	// Key distinguishes issues of the same type for the same resource. It is part of the identity of the issue, so it
+	// must be stable across checker runs.
+	Key          string
 	ResourceName string
 	ResourceType issue.ResourceType
 	Team         string
@@ -66,7 +87,7 @@ func New(config Config, pool *pgxpool.Pool, watchers *watchers.Watchers, fakeEna
 		return nil, fmt.Errorf("create issue count gauge: %w", err)
 	}
 	checker := &Checker{
-		db:            checkersql.New(pool),
+		pool:          pool,
 		log:           log,
 		durationGauge: d,
 		issuesGauge:   i,
@@ -78,11 +99,30 @@ func New(config Config, pool *pgxpool.Pool, watchers *watchers.Watchers, fakeEna
 		v13s = &fakeV13sClient{}
 	}
 
+	custom, err := NewCustom(config.CustomChecks, watchers, log.WithField("check", "Custom"))
+	if err != nil {
+		return nil, fmt.Errorf("create custom issue checks: %w", err)
+	}
+
 	checker.checks = []check{
 		Aiven{aivenClient: config.AivenClient, tenant: config.Tenant, environments: envs, log: log.WithField("check", "Aiven")},
 		SQLInstance{Client: config.CloudSQLClient, SQLInstanceWatcher: watchers.SqlInstanceWatcher, Log: log.WithField("check", "SQLInstance")},
 		Workload{AppWatcher: *watchers.AppWatcher, IngressWatcher: *watchers.IngressWatcher, JobWatcher: *watchers.JobWatcher, PodWatcher: *watchers.PodWatcher, RunWatcher: *watchers.RunWatcher, V13sClient: v13s, log: log.WithField("check", "Workload")},
 		Unleash{UnleashWatcher: watchers.UnleashWatcher, BifrostClient: config.BifrostClient, Log: log.WithField("check", "Unleash")},
+		custom,
+	}
+
+	if config.UtilizationClient != nil {
+		checker.checks = append(checker.checks, &Utilization{AppWatcher: *watchers.AppWatcher, Client: config.UtilizationClient, log: log.WithField("check", "Utilization")})
+	}
+
+	if config.CredentialPolicy.Enabled() {
+		checker.checks = append(checker.checks, Credentials{
+			Policy:                  config.CredentialPolicy,
+			Tokens:                  checkersql.New(pool),
+			SecretWatcher:           watchers.SecretWatcher,
+			AivenApplicationWatcher: config.AivenApplicationWatcher,
+		})
 	}
 
 	return checker, nil
@@ -114,11 +154,15 @@ func (c *Checker) runChecks(ctx context.Context) {
 
 	totalTime := time.Now()
 	var issues []Issue
+	var failedIssueTypes []string
 	for _, ch := range c.checks {
 		checkTime := time.Now()
 		checkIssues, err := ch.Run(ctx)
 		if err != nil {
-			c.log.WithError(err).Error("run check")
+			c.log.WithError(err).WithField("check", fmt.Sprintf("%T", ch)).Error("run check")
+			for _, t := range ch.IssueTypes() {
+				failedIssueTypes = append(failedIssueTypes, string(t))
+			}
 		}
 		c.durationGauge.Record(ctx, time.Since(checkTime).Seconds(), metric.WithAttributes(attribute.String("operation", fmt.Sprintf("%T", ch))))
 		issues = append(issues, checkIssues...)
@@ -126,16 +170,44 @@ func (c *Checker) runChecks(ctx context.Context) {
 	}
 	c.durationGauge.Record(ctx, time.Since(totalTime).Seconds(), metric.WithAttributes(attribute.String("operation", "all_checks")))
 
-	batchIssues := make([]checkersql.BatchInsertIssuesParams, 0)
+	dbTime := time.Now()
+	if err := c.storeIssues(ctx, issues, failedIssueTypes); err != nil {
+		c.log.WithError(err).Error("store issues")
+	}
+	c.durationGauge.Record(ctx, time.Since(dbTime).Seconds(), metric.WithAttributes(attribute.String("operation", "db")))
+	c.recordIssues(ctx, issues)
+	c.log.WithField("issues", len(issues)).Debug("issue checker finished")
+}
+
+// storeIssues upserts the issues found in this run, keyed on their type, resource, team and environment, and records
+// them in the issue history. Issues not found in this run are deleted and marked as resolved in the history, except
+// issues of the failedIssueTypes, as issues of a failed check would otherwise be resolved spuriously.
+func (c *Checker) storeIssues(ctx context.Context, issues []Issue, failedIssueTypes []string) error {
+	seenAt := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	type identity struct {
+		issueType, key, resourceName, resourceType, team, env string
+	}
+	seen := make(map[identity]struct{}, len(issues))
+
+	batchIssues := make([]checkersql.BatchUpsertIssuesParams, 0, len(issues))
 	for _, i := range issues {
+		id := identity{string(i.IssueType), i.Key, i.ResourceName, string(i.ResourceType), i.Team, i.Env}
+		if _, ok := seen[id]; ok {
+			c.log.WithField("issue", id).Debug("skipping duplicate issue")
+			continue
+		}
+		seen[id] = struct{}{}
+
 		d, err := json.Marshal(i.IssueDetails)
 		if err != nil {
 			c.log.WithError(err).Error("marshal issue details")
 			continue
 		}
 
-		batchIssues = append(batchIssues, checkersql.BatchInsertIssuesParams{
+		batchIssues = append(batchIssues, checkersql.BatchUpsertIssuesParams{
 			IssueType:    string(i.IssueType),
+			IssueKey:     i.Key,
 			ResourceName: i.ResourceName,
 			ResourceType: string(i.ResourceType),
 			Team:         i.Team,
@@ -143,24 +215,39 @@ func (c *Checker) runChecks(ctx context.Context) {
 			Severity:     checkersql.SeverityLevel(i.Severity),
 			Message:      i.Message,
 			IssueDetails: d,
+			SeenAt:       seenAt,
 		})
 	}
 
-	dbTime := time.Now()
+	return pgx.BeginFunc(ctx, c.pool, func(tx pgx.Tx) error {
+		q := checkersql.New(tx)
 
-	err := c.db.DeleteIssues(ctx)
-	if err != nil {
-		c.log.WithError(err).Error("delete existing issues")
-	}
+		var batchErr error
+		q.BatchUpsertIssues(ctx, batchIssues).Exec(func(i int, err error) {
+			if err != nil && batchErr == nil {
+				batchErr = fmt.Errorf("upsert issue %d: %w", i, err)
+			}
+		})
+		if batchErr != nil {
+			return batchErr
+		}
+
+		if err := q.UpsertIssueHistory(ctx, seenAt); err != nil {
+			return fmt.Errorf("update issue history: %w", err)
+		}
 
-	c.db.BatchInsertIssues(ctx, batchIssues).Exec(func(i int, err error) {
+		resolved, err := q.ResolveIssues(ctx, checkersql.ResolveIssuesParams{
+			SeenAt:           seenAt,
+			FailedIssueTypes: failedIssueTypes,
+		})
 		if err != nil {
-			c.log.WithField("index", i).WithError(err).Error("insert issue")
+			return fmt.Errorf("resolve issues: %w", err)
 		}
+		if resolved > 0 {
+			c.log.WithField("resolved", resolved).Debug("resolved issues")
+		}
+		return nil
 	})
-	c.durationGauge.Record(ctx, time.Since(dbTime).Seconds(), metric.WithAttributes(attribute.String("operation", "db")))
-	c.recordIssues(ctx, issues)
-	c.log.WithField("issues", len(issues)).Debug("issue checker finished")
 }
 
 func (c *Checker) recordIssues(ctx context.Context, issues []Issue) {
@@ -0,0 +1,183 @@
+//go:build integration_test
+
+package checker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/issue"
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type fakeCheck struct {
+	issueType issue.IssueType
+	issues    []Issue
+	err       error
+}
+
+func (f *fakeCheck) Run(context.Context) ([]Issue, error) {
+	return f.issues, f.err
+}
+
+func (f *fakeCheck) IssueTypes() []issue.IssueType {
+	return []issue.IssueType{f.issueType}
+}
+
+func TestRunChecks(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("failing check only keeps its own issues", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+
+		failing := &fakeCheck{
+			issueType: issue.IssueTypeDeprecatedIngress,
+			issues:    []Issue{newTestIssue(issue.IssueTypeDeprecatedIngress)},
+		}
+		succeeding := &fakeCheck{
+			issueType: issue.IssueTypeNoRunningInstances,
+			issues:    []Issue{newTestIssue(issue.IssueTypeNoRunningInstances)},
+		}
+		c := newTestChecker(t, pool, log, failing, succeeding)
+
+		c.RunChecksOnce(ctx)
+		if got, want := issueTypes(ctx, t, pool), []string{"DEPRECATED_INGRESS", "NO_RUNNING_INSTANCES"}; !slices.Equal(got, want) {
+			t.Fatalf("expected issues %v, got %v", want, got)
+		}
+
+		failing.issues, failing.err = nil, errors.New("check failed")
+		succeeding.issues = nil
+
+		c.RunChecksOnce(ctx)
+		if got, want := issueTypes(ctx, t, pool), []string{"DEPRECATED_INGRESS"}; !slices.Equal(got, want) {
+			t.Fatalf("expected issues %v, got %v", want, got)
+		}
+
+		failing.err = nil
+
+		c.RunChecksOnce(ctx)
+		if got := issueTypes(ctx, t, pool); len(got) != 0 {
+			t.Fatalf("expected all issues to be resolved, got %v", got)
+		}
+	})
+}
+
+func newTestChecker(t *testing.T, pool *pgxpool.Pool, log logrus.FieldLogger, checks ...check) *Checker {
+	t.Helper()
+
+	meter := noop.NewMeterProvider().Meter("test")
+	d, err := meter.Float64Gauge("duration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	i, err := meter.Int64Gauge("issues")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Checker{
+		checks:        checks,
+		pool:          pool,
+		log:           log,
+		durationGauge: d,
+		issuesGauge:   i,
+	}
+}
+
+func newTestIssue(issueType issue.IssueType) Issue {
+	return Issue{
+		IssueType:    issueType,
+		ResourceName: "app",
+		ResourceType: issue.ResourceTypeApplication,
+		Team:         "team",
+		Env:          "dev",
+		Severity:     issue.SeverityWarning,
+		Message:      "message",
+	}
+}
+
+func issueTypes(ctx context.Context, t *testing.T, pool *pgxpool.Pool) []string {
+	t.Helper()
+
+	rows, err := pool.Query(ctx, "SELECT issue_type FROM issues ORDER BY issue_type")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var ret []string
+	for rows.Next() {
+		var issueType string
+		if err := rows.Scan(&issueType); err != nil {
+			t.Fatal(err)
+		}
+		ret = append(ret, issueType)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	return ret
+}
+
+func startPostgresql(ctx context.Context, t *testing.T, log logrus.FieldLogger) (container *postgres.PostgresContainer, dsn string, err error) {
+	container, err = postgres.Run(
+		ctx,
+		"docker.io/postgres:16-alpine",
+		postgres.WithDatabase("test"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		postgres.WithSQLDriver("pgx"),
+		postgres.BasicWaitStrategies(),
+	)
+	defer testcontainers.CleanupContainer(t, container)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to start container: %w", err)
+	}
+
+	dsn, err = container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get connection string: %w", err)
+	}
+
+	pool, err := database.NewPool(ctx, dsn, log, true)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create pool: %w", err)
+	}
+	pool.Close()
+
+	if err := container.Snapshot(ctx); err != nil {
+		return nil, "", fmt.Errorf("failed to snapshot: %w", err)
+	}
+
+	return container, dsn, nil
+}
+
+func getConnection(ctx context.Context, t *testing.T, container *postgres.PostgresContainer, dsn string, log logrus.FieldLogger) *pgxpool.Pool {
+	pool, _ := database.NewPool(ctx, dsn, log, false)
+
+	t.Cleanup(func() {
+		pool.Close()
+		if err := container.Restore(ctx); err != nil {
+			t.Fatalf("failed to restore database: %v", err)
+		}
+	})
+
+	return pool
+}
@@ -8,23 +8,27 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 var (
 	ErrBatchAlreadyClosed = errors.New("batch already closed")
 )
 
-const batchInsertIssues = `-- name: BatchInsertIssues :batchexec
+const batchUpsertIssues = `-- name: BatchUpsertIssues :batchexec
 INSERT INTO
 	issues (
 		issue_type,
+		issue_key,
 		resource_name,
 		resource_type,
 		team,
 		env,
 		severity,
 		message,
-		issue_details
+		issue_details,
+		first_seen,
+		last_seen
 	)
 VALUES
 	(
@@ -35,18 +39,28 @@ VALUES
 		$5,
 		$6,
 		$7,
-		$8
+		$8,
+		$9,
+		$10,
+		$10
 	)
+ON CONFLICT (issue_type, resource_type, resource_name, team, env, issue_key) DO UPDATE
+SET
+	severity = EXCLUDED.severity,
+	message = EXCLUDED.message,
+	issue_details = EXCLUDED.issue_details,
+	last_seen = EXCLUDED.last_seen
 `
 
-type BatchInsertIssuesBatchResults struct {
+type BatchUpsertIssuesBatchResults struct {
 	br     pgx.BatchResults
 	tot    int
 	closed bool
 }
 
-type BatchInsertIssuesParams struct {
+type BatchUpsertIssuesParams struct {
 	IssueType    string
+	IssueKey     string
 	ResourceName string
 	ResourceType string
 	Team         string
@@ -54,13 +68,15 @@ type BatchInsertIssuesParams struct {
 	Severity     SeverityLevel
 	Message      string
 	IssueDetails []byte
+	SeenAt       pgtype.Timestamptz
 }
 
-func (q *Queries) BatchInsertIssues(ctx context.Context, arg []BatchInsertIssuesParams) *BatchInsertIssuesBatchResults {
+func (q *Queries) BatchUpsertIssues(ctx context.Context, arg []BatchUpsertIssuesParams) *BatchUpsertIssuesBatchResults {
 	batch := &pgx.Batch{}
 	for _, a := range arg {
 		vals := []interface{}{
 			a.IssueType,
+			a.IssueKey,
 			a.ResourceName,
 			a.ResourceType,
 			a.Team,
@@ -68,14 +84,15 @@ func (q *Queries) BatchInsertIssues(ctx context.Context, arg []BatchInsertIssues
 			a.Severity,
 			a.Message,
 			a.IssueDetails,
+			a.SeenAt,
 		}
-		batch.Queue(batchInsertIssues, vals...)
+		batch.Queue(batchUpsertIssues, vals...)
 	}
 	br := q.db.SendBatch(ctx, batch)
-	return &BatchInsertIssuesBatchResults{br, len(arg), false}
+	return &BatchUpsertIssuesBatchResults{br, len(arg), false}
 }
 
-func (b *BatchInsertIssuesBatchResults) Exec(f func(int, error)) {
+func (b *BatchUpsertIssuesBatchResults) Exec(f func(int, error)) {
 	defer b.br.Close()
 	for t := 0; t < b.tot; t++ {
 		if b.closed {
@@ -91,7 +108,7 @@ func (b *BatchInsertIssuesBatchResults) Exec(f func(int, error)) {
 	}
 }
 
-func (b *BatchInsertIssuesBatchResults) Close() error {
+func (b *BatchUpsertIssuesBatchResults) Close() error {
 	b.closed = true
 	return b.br.Close()
 }
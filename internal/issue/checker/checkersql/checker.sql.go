@@ -5,13 +5,146 @@ package checkersql
 
 import (
 	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const deleteIssues = `-- name: DeleteIssues :exec
-DELETE FROM issues
+const listTeamServiceAccountTokens = `-- name: ListTeamServiceAccountTokens :many
+SELECT
+	service_account_tokens.id,
+	service_account_tokens.name,
+	service_account_tokens.created_at,
+	service_account_tokens.last_used_at,
+	service_account_tokens.expires_at,
+	service_accounts.id AS service_account_id,
+	service_accounts.name AS service_account_name,
+	service_accounts.team_slug::TEXT AS team_slug
+FROM
+	service_account_tokens
+	JOIN service_accounts ON service_accounts.id = service_account_tokens.service_account_id
+WHERE
+	service_accounts.team_slug IS NOT NULL
+ORDER BY
+	service_accounts.team_slug,
+	service_accounts.name,
+	service_account_tokens.name
+`
+
+type ListTeamServiceAccountTokensRow struct {
+	ID                 uuid.UUID
+	Name               string
+	CreatedAt          pgtype.Timestamptz
+	LastUsedAt         pgtype.Timestamptz
+	ExpiresAt          pgtype.Date
+	ServiceAccountID   uuid.UUID
+	ServiceAccountName string
+	TeamSlug           string
+}
+
+func (q *Queries) ListTeamServiceAccountTokens(ctx context.Context) ([]*ListTeamServiceAccountTokensRow, error) {
+	rows, err := q.db.Query(ctx, listTeamServiceAccountTokens)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListTeamServiceAccountTokensRow{}
+	for rows.Next() {
+		var i ListTeamServiceAccountTokensRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.ServiceAccountID,
+			&i.ServiceAccountName,
+			&i.TeamSlug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveIssues = `-- name: ResolveIssues :execrows
+WITH
+	resolved AS (
+		DELETE FROM issues
+		WHERE
+			last_seen < $1
+			AND issue_type <> ALL (COALESCE($2::TEXT[], '{}'))
+		RETURNING
+			id
+	)
+UPDATE issue_history
+SET
+	resolved_at = $1
+WHERE
+	id IN (
+		SELECT
+			id
+		FROM
+			resolved
+	)
+`
+
+type ResolveIssuesParams struct {
+	SeenAt           pgtype.Timestamptz
+	FailedIssueTypes []string
+}
+
+func (q *Queries) ResolveIssues(ctx context.Context, arg ResolveIssuesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, resolveIssues, arg.SeenAt, arg.FailedIssueTypes)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertIssueHistory = `-- name: UpsertIssueHistory :exec
+INSERT INTO
+	issue_history (
+		id,
+		issue_type,
+		issue_key,
+		resource_name,
+		resource_type,
+		team,
+		env,
+		severity,
+		message,
+		first_seen,
+		last_seen
+	)
+SELECT
+	id,
+	issue_type,
+	issue_key,
+	resource_name,
+	resource_type,
+	team,
+	env,
+	severity,
+	message,
+	first_seen,
+	last_seen
+FROM
+	issues
+WHERE
+	issues.last_seen = $1
+ON CONFLICT (id) DO UPDATE
+SET
+	severity = EXCLUDED.severity,
+	message = EXCLUDED.message,
+	last_seen = EXCLUDED.last_seen
 `
 
-func (q *Queries) DeleteIssues(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, deleteIssues)
+func (q *Queries) UpsertIssueHistory(ctx context.Context, seenAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, upsertIssueHistory, seenAt)
 	return err
 }
@@ -4,11 +4,15 @@ package checkersql
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
-	BatchInsertIssues(ctx context.Context, arg []BatchInsertIssuesParams) *BatchInsertIssuesBatchResults
-	DeleteIssues(ctx context.Context) error
+	BatchUpsertIssues(ctx context.Context, arg []BatchUpsertIssuesParams) *BatchUpsertIssuesBatchResults
+	ListTeamServiceAccountTokens(ctx context.Context) ([]*ListTeamServiceAccountTokensRow, error)
+	ResolveIssues(ctx context.Context, arg ResolveIssuesParams) (int64, error)
+	UpsertIssueHistory(ctx context.Context, seenAt pgtype.Timestamptz) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,238 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nais/api/internal/duration"
+	"github.com/nais/api/internal/environmentmapper"
+	"github.com/nais/api/internal/issue"
+	"github.com/nais/api/internal/issue/checker/checkersql"
+	"github.com/nais/api/internal/kubernetes"
+	"github.com/nais/api/internal/kubernetes/watcher"
+	"github.com/nais/api/internal/persistence/aivencredentials"
+	"github.com/nais/api/internal/workload/secret"
+	aiven_nais_io_v1 "github.com/nais/liberator/pkg/apis/aiven.nais.io/v1"
+)
+
+// CredentialPolicy configures when credentials are considered to be expiring or stale. Each check is disabled when
+// its duration is zero.
+type CredentialPolicy struct {
+	// ServiceAccountTokenExpiryWarning is how long before a service account token expires an issue is raised.
+	ServiceAccountTokenExpiryWarning duration.Duration `json:"serviceAccountTokenExpiryWarning"`
+
+	// ServiceAccountTokenMaxUnused is how long a service account token can go unused before an issue is raised.
+	// Tokens that have never been used are measured from when they were created.
+	ServiceAccountTokenMaxUnused duration.Duration `json:"serviceAccountTokenMaxUnused"`
+
+	// AivenCredentialMaxAge is the maximum age of temporary Aiven credentials created through the API.
+	AivenCredentialMaxAge duration.Duration `json:"aivenCredentialMaxAge"`
+
+	// SecretRotationWindow is how long a secret managed through the API can go without being updated.
+	SecretRotationWindow duration.Duration `json:"secretRotationWindow"`
+}
+
+var _ json.Unmarshaler = (*CredentialPolicy)(nil)
+
+func (p *CredentialPolicy) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	type credentialPolicy CredentialPolicy
+	var policy credentialPolicy
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&policy); err != nil {
+		return fmt.Errorf("unmarshalling credential policy: %w", err)
+	}
+
+	*p = CredentialPolicy(policy)
+	return nil
+}
+
+// Enabled reports whether any of the credential checks are enabled.
+func (p CredentialPolicy) Enabled() bool {
+	return p.ServiceAccountTokenExpiryWarning > 0 ||
+		p.ServiceAccountTokenMaxUnused > 0 ||
+		p.AivenCredentialMaxAge > 0 ||
+		p.SecretRotationWindow > 0
+}
+
+type serviceAccountTokenLister interface {
+	ListTeamServiceAccountTokens(ctx context.Context) ([]*checkersql.ListTeamServiceAccountTokensRow, error)
+}
+
+// Credentials raises issues for service account tokens, temporary Aiven credentials and secrets that are expiring or
+// have not been rotated according to the credential policy.
+type Credentials struct {
+	Policy                  CredentialPolicy
+	Tokens                  serviceAccountTokenLister
+	SecretWatcher           *watcher.Watcher[*secret.Secret]
+	AivenApplicationWatcher *watcher.Watcher[*aiven_nais_io_v1.AivenApplication]
+
+	now func() time.Time
+}
+
+func (c Credentials) IssueTypes() []issue.IssueType {
+	return []issue.IssueType{
+		issue.IssueTypeServiceAccountTokenExpiring,
+		issue.IssueTypeServiceAccountTokenUnused,
+		issue.IssueTypeStaleAivenCredential,
+		issue.IssueTypeStaleSecret,
+	}
+}
+
+func (c Credentials) Run(ctx context.Context) ([]Issue, error) {
+	now := time.Now()
+	if c.now != nil {
+		now = c.now()
+	}
+
+	ret := make([]Issue, 0)
+	if c.Policy.ServiceAccountTokenExpiryWarning > 0 || c.Policy.ServiceAccountTokenMaxUnused > 0 {
+		tokens, err := c.Tokens.ListTeamServiceAccountTokens(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list service account tokens: %w", err)
+		}
+		for _, token := range tokens {
+			ret = append(ret, c.serviceAccountTokenIssues(token, now)...)
+		}
+	}
+
+	if c.Policy.AivenCredentialMaxAge > 0 && c.AivenApplicationWatcher != nil {
+		for _, app := range c.AivenApplicationWatcher.All() {
+			if iss := c.aivenCredentialIssue(app.Obj, environmentmapper.EnvironmentName(app.Cluster), now); iss != nil {
+				ret = append(ret, *iss)
+			}
+		}
+	}
+
+	if c.Policy.SecretRotationWindow > 0 {
+		for _, s := range c.SecretWatcher.All() {
+			if iss := c.secretIssue(s.Obj, now); iss != nil {
+				ret = append(ret, *iss)
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+func (c Credentials) serviceAccountTokenIssues(token *checkersql.ListTeamServiceAccountTokensRow, now time.Time) []Issue {
+	details := issue.ServiceAccountTokenIssueDetails{
+		ServiceAccountID:      token.ServiceAccountID,
+		ServiceAccountTokenID: token.ID,
+	}
+	base := Issue{
+		Key:          token.ID.String(),
+		ResourceName: token.ServiceAccountName,
+		ResourceType: issue.ResourceTypeServiceAccount,
+		Team:         token.TeamSlug,
+		IssueDetails: details,
+	}
+
+	ret := make([]Issue, 0)
+	expired := token.ExpiresAt.Valid && !now.Before(token.ExpiresAt.Time)
+	if warning := time.Duration(c.Policy.ServiceAccountTokenExpiryWarning); warning > 0 && token.ExpiresAt.Valid && token.ExpiresAt.Time.Sub(now) <= warning {
+		iss := base
+		iss.IssueType = issue.IssueTypeServiceAccountTokenExpiring
+		iss.Severity = issue.SeverityWarning
+		if expired {
+			iss.Message = fmt.Sprintf("Token %q of service account %q expired on %s", token.Name, token.ServiceAccountName, token.ExpiresAt.Time.Format(time.DateOnly))
+		} else {
+			iss.Message = fmt.Sprintf("Token %q of service account %q expires on %s", token.Name, token.ServiceAccountName, token.ExpiresAt.Time.Format(time.DateOnly))
+		}
+		ret = append(ret, iss)
+	}
+
+	if maxUnused := time.Duration(c.Policy.ServiceAccountTokenMaxUnused); maxUnused > 0 && !expired {
+		lastUsed := token.CreatedAt.Time
+		if token.LastUsedAt.Valid {
+			lastUsed = token.LastUsedAt.Time
+		}
+		if now.Sub(lastUsed) > maxUnused {
+			iss := base
+			iss.IssueType = issue.IssueTypeServiceAccountTokenUnused
+			iss.Severity = issue.SeverityTodo
+			if token.LastUsedAt.Valid {
+				iss.Message = fmt.Sprintf("Token %q of service account %q has not been used for %d days", token.Name, token.ServiceAccountName, days(now.Sub(lastUsed)))
+			} else {
+				iss.Message = fmt.Sprintf("Token %q of service account %q has not been used since it was created %d days ago", token.Name, token.ServiceAccountName, days(now.Sub(lastUsed)))
+			}
+			ret = append(ret, iss)
+		}
+	}
+
+	return ret
+}
+
+func (c Credentials) aivenCredentialIssue(app *aiven_nais_io_v1.AivenApplication, env string, now time.Time) *Issue {
+	if !aivencredentials.IsCredential(app) {
+		return nil
+	}
+
+	// The AivenApplication is updated in place when the same user creates new credentials for the same service, so
+	// the credentials are as old as the last modification.
+	createdAt := app.GetCreationTimestamp().Time
+	if t, err := time.Parse(time.RFC3339, app.GetAnnotations()[kubernetes.AnnotationLastModifiedAt]); err == nil {
+		createdAt = t
+	}
+	age := now.Sub(createdAt)
+	if age <= time.Duration(c.Policy.AivenCredentialMaxAge) {
+		return nil
+	}
+
+	details := issue.StaleAivenCredentialIssueDetails{
+		Service:   aivencredentials.CredentialService(app),
+		CreatedAt: createdAt,
+		ExpiresAt: aivencredentials.CredentialExpiresAt(app),
+	}
+	if by, ok := app.GetAnnotations()[kubernetes.AnnotationLastModifiedBy]; ok {
+		details.CreatedBy = &by
+	}
+
+	return &Issue{
+		IssueType:    issue.IssueTypeStaleAivenCredential,
+		ResourceName: app.GetName(),
+		ResourceType: issue.ResourceTypeAivenCredential,
+		Team:         app.GetNamespace(),
+		Env:          env,
+		Severity:     issue.SeverityWarning,
+		Message:      fmt.Sprintf("Temporary %s credentials %q were created %d days ago", details.Service, app.GetName(), days(age)),
+		IssueDetails: details,
+	}
+}
+
+func (c Credentials) secretIssue(s *secret.Secret, now time.Time) *Issue {
+	// Secrets without a modification timestamp were not created through the API, so their age is unknown.
+	if s.LastModifiedAt == nil {
+		return nil
+	}
+
+	age := now.Sub(*s.LastModifiedAt)
+	if age <= time.Duration(c.Policy.SecretRotationWindow) {
+		return nil
+	}
+
+	return &Issue{
+		IssueType:    issue.IssueTypeStaleSecret,
+		ResourceName: s.Name,
+		ResourceType: issue.ResourceTypeSecret,
+		Team:         s.TeamSlug.String(),
+		Env:          s.EnvironmentName,
+		Severity:     issue.SeverityTodo,
+		Message:      fmt.Sprintf("Secret %q has not been updated for %d days", s.Name, days(age)),
+		IssueDetails: issue.StaleSecretIssueDetails{
+			LastModifiedAt: *s.LastModifiedAt,
+			LastModifiedBy: s.ModifiedByUserEmail,
+		},
+	}
+}
+
+func days(d time.Duration) int {
+	return int(d / (24 * time.Hour))
+}
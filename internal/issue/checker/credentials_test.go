@@ -0,0 +1,196 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/duration"
+	"github.com/nais/api/internal/issue"
+	"github.com/nais/api/internal/issue/checker/checkersql"
+	"github.com/nais/api/internal/kubernetes"
+	"github.com/nais/api/internal/workload/secret"
+	aiven_nais_io_v1 "github.com/nais/liberator/pkg/apis/aiven.nais.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type stubTokenLister []*checkersql.ListTeamServiceAccountTokensRow
+
+func (s stubTokenLister) ListTeamServiceAccountTokens(context.Context) ([]*checkersql.ListTeamServiceAccountTokensRow, error) {
+	return s, nil
+}
+
+const day = 24 * time.Hour
+
+func TestCredentialPolicy_UnmarshalJSON(t *testing.T) {
+	var policy CredentialPolicy
+	if err := json.Unmarshal([]byte(`{"serviceAccountTokenExpiryWarning":"14d","secretRotationWindow":"720h"}`), &policy); err != nil {
+		t.Fatal(err)
+	}
+	if policy.ServiceAccountTokenExpiryWarning != duration.Duration(14*day) || policy.SecretRotationWindow != duration.Duration(30*day) {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+	if policy.ServiceAccountTokenMaxUnused != 0 || policy.AivenCredentialMaxAge != 0 {
+		t.Errorf("expected unset durations to be zero: %+v", policy)
+	}
+	if !policy.Enabled() {
+		t.Error("expected policy to be enabled")
+	}
+
+	if err := json.Unmarshal([]byte(`{"secretRotationWindows":"30d"}`), &policy); err == nil {
+		t.Error("expected error for unknown field")
+	}
+
+	if (CredentialPolicy{}).Enabled() {
+		t.Error("expected empty policy to be disabled")
+	}
+}
+
+func TestCredentials_ServiceAccountTokens(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	date := func(t time.Time) pgtype.Date { return pgtype.Date{Time: t, Valid: true} }
+	timestamp := func(t time.Time) pgtype.Timestamptz { return pgtype.Timestamptz{Time: t, Valid: true} }
+	token := func(name string, mod func(*checkersql.ListTeamServiceAccountTokensRow)) *checkersql.ListTeamServiceAccountTokensRow {
+		row := &checkersql.ListTeamServiceAccountTokensRow{
+			ID:                 uuid.New(),
+			Name:               name,
+			CreatedAt:          timestamp(now.Add(-10 * day)),
+			LastUsedAt:         timestamp(now.Add(-day)),
+			ServiceAccountID:   uuid.New(),
+			ServiceAccountName: "deployer",
+			TeamSlug:           "team1",
+		}
+		mod(row)
+		return row
+	}
+
+	c := Credentials{
+		Policy: CredentialPolicy{
+			ServiceAccountTokenExpiryWarning: duration.Duration(14 * day),
+			ServiceAccountTokenMaxUnused:     duration.Duration(90 * day),
+		},
+		Tokens: stubTokenLister{
+			token("healthy", func(r *checkersql.ListTeamServiceAccountTokensRow) {
+				r.ExpiresAt = date(now.Add(60 * day))
+			}),
+			token("expiring", func(r *checkersql.ListTeamServiceAccountTokensRow) {
+				r.ExpiresAt = date(now.Add(3 * day))
+			}),
+			token("unused", func(r *checkersql.ListTeamServiceAccountTokensRow) {
+				r.LastUsedAt = timestamp(now.Add(-100 * day))
+			}),
+			token("never-used", func(r *checkersql.ListTeamServiceAccountTokensRow) {
+				r.CreatedAt = timestamp(now.Add(-120 * day))
+				r.LastUsedAt = pgtype.Timestamptz{}
+			}),
+			token("expired", func(r *checkersql.ListTeamServiceAccountTokensRow) {
+				r.ExpiresAt = date(now.Add(-2 * day))
+				r.LastUsedAt = timestamp(now.Add(-100 * day))
+			}),
+		},
+		now: func() time.Time { return now },
+	}
+
+	issues, err := c.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]issue.IssueType{}
+	for _, i := range issues {
+		if i.Env != "" || i.Team != "team1" || i.ResourceType != issue.ResourceTypeServiceAccount || i.ResourceName != "deployer" {
+			t.Errorf("unexpected resource: %+v", i)
+		}
+		got[i.Message] = i.IssueType
+	}
+
+	want := map[string]issue.IssueType{
+		`Token "expiring" of service account "deployer" expires on 2026-06-04`:                                 issue.IssueTypeServiceAccountTokenExpiring,
+		`Token "unused" of service account "deployer" has not been used for 100 days`:                          issue.IssueTypeServiceAccountTokenUnused,
+		`Token "never-used" of service account "deployer" has not been used since it was created 120 days ago`: issue.IssueTypeServiceAccountTokenUnused,
+		`Token "expired" of service account "deployer" expired on 2026-05-30`:                                  issue.IssueTypeServiceAccountTokenExpiring,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d issues, got %d: %v", len(want), len(got), got)
+	}
+	for msg, typ := range want {
+		if got[msg] != typ {
+			t.Errorf("expected %s issue %q, got %v", typ, msg, got)
+		}
+	}
+}
+
+func TestCredentials_AivenCredential(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	c := Credentials{Policy: CredentialPolicy{AivenCredentialMaxAge: duration.Duration(30 * day)}}
+
+	app := func(modifiedAt time.Time, labels map[string]string) *aiven_nais_io_v1.AivenApplication {
+		return &aiven_nais_io_v1.AivenApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tmp-opensearch-abc123",
+				Namespace: "team1",
+				Labels:    labels,
+				Annotations: map[string]string{
+					kubernetes.AnnotationLastModifiedAt: modifiedAt.Format(time.RFC3339),
+					kubernetes.AnnotationLastModifiedBy: "user@example.com",
+				},
+			},
+			Spec: aiven_nais_io_v1.AivenApplicationSpec{
+				OpenSearch: &aiven_nais_io_v1.OpenSearchSpec{Instance: "opensearch-team1-search"},
+			},
+		}
+	}
+	credentialLabels := map[string]string{
+		"nais.io/managed-by":            "console",
+		"euthanaisa.nais.io/kill-after": "1780000000",
+	}
+
+	if iss := c.aivenCredentialIssue(app(now.Add(-5*day), credentialLabels), "dev", now); iss != nil {
+		t.Errorf("expected no issue for recent credentials, got %+v", iss)
+	}
+	if iss := c.aivenCredentialIssue(app(now.Add(-40*day), map[string]string{"nais.io/managed-by": "console"}), "dev", now); iss != nil {
+		t.Errorf("expected no issue for AivenApplication not created for credentials, got %+v", iss)
+	}
+
+	iss := c.aivenCredentialIssue(app(now.Add(-40*day), credentialLabels), "dev", now)
+	if iss == nil {
+		t.Fatal("expected issue for old credentials")
+	}
+	if iss.IssueType != issue.IssueTypeStaleAivenCredential || iss.Env != "dev" || iss.Team != "team1" || iss.ResourceName != "tmp-opensearch-abc123" {
+		t.Errorf("unexpected issue: %+v", iss)
+	}
+	details, ok := iss.IssueDetails.(issue.StaleAivenCredentialIssueDetails)
+	if !ok {
+		t.Fatalf("unexpected issue details: %T", iss.IssueDetails)
+	}
+	if details.Service != "OpenSearch" || details.CreatedBy == nil || *details.CreatedBy != "user@example.com" || details.ExpiresAt == nil || details.ExpiresAt.Unix() != 1780000000 {
+		t.Errorf("unexpected details: %+v", details)
+	}
+}
+
+func TestCredentials_Secret(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	c := Credentials{Policy: CredentialPolicy{SecretRotationWindow: duration.Duration(365 * day)}}
+
+	s := func(modifiedAt *time.Time) *secret.Secret {
+		return &secret.Secret{Name: "db-password", TeamSlug: "team1", EnvironmentName: "dev", LastModifiedAt: modifiedAt}
+	}
+
+	if iss := c.secretIssue(s(nil), now); iss != nil {
+		t.Errorf("expected no issue for secret without modification time, got %+v", iss)
+	}
+	if iss := c.secretIssue(s(new(now.Add(-30*day))), now); iss != nil {
+		t.Errorf("expected no issue for recently updated secret, got %+v", iss)
+	}
+
+	iss := c.secretIssue(s(new(now.Add(-400*day))), now)
+	if iss == nil {
+		t.Fatal("expected issue for stale secret")
+	}
+	if iss.IssueType != issue.IssueTypeStaleSecret || iss.ResourceType != issue.ResourceTypeSecret || iss.Message != `Secret "db-password" has not been updated for 400 days` {
+		t.Errorf("unexpected issue: %+v", iss)
+	}
+}
@@ -0,0 +1,347 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/google/cel-go/cel"
+	"github.com/nais/api/internal/environmentmapper"
+	"github.com/nais/api/internal/issue"
+	"github.com/nais/api/internal/kubernetes/watchers"
+	"github.com/sirupsen/logrus"
+)
+
+// CustomCheckResource is the kind of object a custom check is evaluated against.
+type CustomCheckResource string
+
+const (
+	CustomCheckResourceApplication CustomCheckResource = "Application"
+	CustomCheckResourceJob         CustomCheckResource = "Job"
+	CustomCheckResourcePod         CustomCheckResource = "Pod"
+	CustomCheckResourceSQLInstance CustomCheckResource = "SqlInstance"
+)
+
+var allCustomCheckResources = []CustomCheckResource{
+	CustomCheckResourceApplication,
+	CustomCheckResourceJob,
+	CustomCheckResourcePod,
+	CustomCheckResourceSQLInstance,
+}
+
+// CustomCheckDefinition is an issue check defined in configuration.
+type CustomCheckDefinition struct {
+	// Name identifies the check. Issues raised by the check are of type CUSTOM, with the name of the check.
+	Name string `json:"name"`
+
+	// Resource is the kind of object the check is evaluated against. Issues raised for pods are attached to the
+	// application or job owning the pod.
+	Resource CustomCheckResource `json:"resource"`
+
+	// Environments limits the check to the given environments. The check applies to all environments when empty.
+	Environments []string `json:"environments,omitempty"`
+
+	// Expression is a CEL expression evaluated with the object as `object` and the environment name as
+	// `environment`. An issue is raised when the expression evaluates to true.
+	Expression string `json:"expression"`
+
+	// Severity of the issues raised by the check.
+	Severity issue.Severity `json:"severity"`
+
+	// Message is a text/template for the message of the issues, executed with the same variables as the expression.
+	Message string `json:"message"`
+}
+
+// CustomChecks is the set of custom checks configured for the issue checker.
+type CustomChecks []CustomCheckDefinition
+
+var _ json.Unmarshaler = (*CustomChecks)(nil)
+
+func (c *CustomChecks) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	checks := make([]CustomCheckDefinition, 0)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&checks); err != nil {
+		return fmt.Errorf("unmarshalling custom issue checks: %w", err)
+	}
+
+	names := map[string]struct{}{}
+	for _, check := range checks {
+		if check.Name == "" {
+			return fmt.Errorf("custom issue check is missing a name")
+		}
+		if _, ok := names[check.Name]; ok {
+			return fmt.Errorf("duplicate custom issue check: %q", check.Name)
+		}
+		names[check.Name] = struct{}{}
+
+		if !slices.Contains(allCustomCheckResources, check.Resource) {
+			return fmt.Errorf("custom issue check %q has an unsupported resource: %q", check.Name, check.Resource)
+		}
+		if check.Expression == "" {
+			return fmt.Errorf("custom issue check is missing an expression: %q", check.Name)
+		}
+		if !check.Severity.IsValid() {
+			return fmt.Errorf("custom issue check %q has an invalid severity: %q", check.Name, check.Severity)
+		}
+		if check.Message == "" {
+			return fmt.Errorf("custom issue check is missing a message: %q", check.Name)
+		}
+	}
+
+	*c = checks
+	return nil
+}
+
+// Custom runs the checks defined in configuration against the objects held by the watchers.
+type Custom struct {
+	checks   []compiledCustomCheck
+	watchers *watchers.Watchers
+	log      logrus.FieldLogger
+}
+
+type compiledCustomCheck struct {
+	CustomCheckDefinition
+	program cel.Program
+	message *template.Template
+}
+
+// customCheckTarget is an object a custom check is evaluated against, and the resource issues are attached to.
+type customCheckTarget struct {
+	resourceName string
+	resourceType issue.ResourceType
+	team         string
+	env          string
+	object       map[string]any
+}
+
+func NewCustom(checks CustomChecks, watchers *watchers.Watchers, log logrus.FieldLogger) (*Custom, error) {
+	compiled, err := compileCustomChecks(checks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Custom{
+		checks:   compiled,
+		watchers: watchers,
+		log:      log,
+	}, nil
+}
+
+func compileCustomChecks(checks CustomChecks) ([]compiledCustomCheck, error) {
+	env, err := cel.NewEnv(
+		cel.OptionalTypes(),
+		cel.Variable("object", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("environment", cel.StringType),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create CEL environment: %w", err)
+	}
+
+	ret := make([]compiledCustomCheck, 0, len(checks))
+	for _, check := range checks {
+		ast, issues := env.Compile(check.Expression)
+		if issues.Err() != nil {
+			return nil, fmt.Errorf("compile custom issue check %q: %w", check.Name, issues.Err())
+		}
+		if t := ast.OutputType(); !t.IsExactType(cel.BoolType) && !t.IsExactType(cel.DynType) {
+			return nil, fmt.Errorf("custom issue check %q must evaluate to a bool, got %s", check.Name, t)
+		}
+
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("create program for custom issue check %q: %w", check.Name, err)
+		}
+
+		message, err := template.New(check.Name).Option("missingkey=zero").Parse(check.Message)
+		if err != nil {
+			return nil, fmt.Errorf("parse message of custom issue check %q: %w", check.Name, err)
+		}
+
+		ret = append(ret, compiledCustomCheck{CustomCheckDefinition: check, program: program, message: message})
+	}
+
+	return ret, nil
+}
+
+func (c *Custom) IssueTypes() []issue.IssueType {
+	return []issue.IssueType{issue.IssueTypeCustom}
+}
+
+func (c *Custom) Run(ctx context.Context) ([]Issue, error) {
+	targets := map[CustomCheckResource][]customCheckTarget{}
+	ret := make([]Issue, 0)
+	for _, check := range c.checks {
+		if _, ok := targets[check.Resource]; !ok {
+			t, err := c.targets(check.Resource)
+			if err != nil {
+				return nil, err
+			}
+			targets[check.Resource] = t
+		}
+
+		for _, target := range targets[check.Resource] {
+			if len(check.Environments) > 0 && !slices.Contains(check.Environments, target.env) {
+				continue
+			}
+
+			iss, err := check.evaluate(ctx, target)
+			if err != nil {
+				// Expressions commonly fail for objects lacking the fields they refer to, so a failing evaluation
+				// does not raise an issue, nor fail the other checks.
+				c.log.WithError(err).WithField("custom_check", check.Name).Warn("evaluate custom issue check")
+				continue
+			}
+			if iss != nil {
+				ret = append(ret, *iss)
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+func (c compiledCustomCheck) evaluate(ctx context.Context, target customCheckTarget) (*Issue, error) {
+	vars := map[string]any{
+		"object":      target.object,
+		"environment": target.env,
+	}
+
+	out, _, err := c.program.ContextEval(ctx, vars)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate %q for %s %s/%s: %w", c.Name, target.resourceType, target.team, target.resourceName, err)
+	}
+
+	raise, ok := out.Value().(bool)
+	if !ok {
+		return nil, fmt.Errorf("%q evaluated to %s, expected bool", c.Name, out.Type().TypeName())
+	}
+	if !raise {
+		return nil, nil
+	}
+
+	var message strings.Builder
+	if err := c.message.Execute(&message, vars); err != nil {
+		return nil, fmt.Errorf("execute message template of %q: %w", c.Name, err)
+	}
+
+	return &Issue{
+		IssueType:    issue.IssueTypeCustom,
+		Key:          c.Name,
+		ResourceName: target.resourceName,
+		ResourceType: target.resourceType,
+		Team:         target.team,
+		Env:          target.env,
+		Severity:     c.Severity,
+		Message:      message.String(),
+		IssueDetails: issue.CustomIssueDetails{
+			CheckName: c.Name,
+		},
+	}, nil
+}
+
+func (c *Custom) targets(resource CustomCheckResource) ([]customCheckTarget, error) {
+	var ret []customCheckTarget
+	switch resource {
+	case CustomCheckResourceApplication:
+		for _, app := range c.watchers.AppWatcher.All() {
+			obj, err := toObject(app.Obj)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, customCheckTarget{
+				resourceName: app.Obj.GetName(),
+				resourceType: issue.ResourceTypeApplication,
+				team:         app.Obj.GetNamespace(),
+				env:          environmentmapper.EnvironmentName(app.Cluster),
+				object:       obj,
+			})
+		}
+	case CustomCheckResourceJob:
+		for _, job := range c.watchers.JobWatcher.All() {
+			obj, err := toObject(job.Obj)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, customCheckTarget{
+				resourceName: job.Obj.GetName(),
+				resourceType: issue.ResourceTypeJob,
+				team:         job.Obj.GetNamespace(),
+				env:          environmentmapper.EnvironmentName(job.Cluster),
+				object:       obj,
+			})
+		}
+	case CustomCheckResourcePod:
+		for _, pod := range c.watchers.PodWatcher.All() {
+			name, resourceType, ok := c.podOwner(pod.Cluster, pod.Obj.GetNamespace(), pod.Obj.GetLabels()["app"])
+			if !ok {
+				continue
+			}
+			obj, err := toObject(pod.Obj)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, customCheckTarget{
+				resourceName: name,
+				resourceType: resourceType,
+				team:         pod.Obj.GetNamespace(),
+				env:          environmentmapper.EnvironmentName(pod.Cluster),
+				object:       obj,
+			})
+		}
+	case CustomCheckResourceSQLInstance:
+		for _, instance := range c.watchers.SqlInstanceWatcher.All() {
+			obj, err := toObject(instance.Obj)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, customCheckTarget{
+				resourceName: instance.Obj.Name,
+				resourceType: issue.ResourceTypeSQLInstance,
+				team:         instance.Obj.TeamSlug.String(),
+				env:          instance.Obj.EnvironmentName,
+				object:       obj,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported custom issue check resource: %q", resource)
+	}
+
+	return ret, nil
+}
+
+// podOwner returns the workload owning a pod, based on the app label set on all pods of nais workloads.
+func (c *Custom) podOwner(cluster, namespace, name string) (string, issue.ResourceType, bool) {
+	if name == "" {
+		return "", "", false
+	}
+	if _, err := c.watchers.AppWatcher.Get(cluster, namespace, name); err == nil {
+		return name, issue.ResourceTypeApplication, true
+	}
+	if _, err := c.watchers.JobWatcher.Get(cluster, namespace, name); err == nil {
+		return name, issue.ResourceTypeJob, true
+	}
+	return "", "", false
+}
+
+// toObject converts an object to the generic representation expressions and message templates are evaluated against.
+func toObject(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal object: %w", err)
+	}
+
+	ret := map[string]any{}
+	if err := json.Unmarshal(b, &ret); err != nil {
+		return nil, fmt.Errorf("unmarshal object: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,154 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nais/api/internal/issue"
+	nais_io_v1alpha1 "github.com/nais/liberator/pkg/apis/nais.io/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCustomChecks_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{
+			name:  "valid check",
+			input: `[{"name":"no-limit","resource":"Application","expression":"true","severity":"WARNING","message":"msg"}]`,
+		},
+		{
+			name:  "empty",
+			input: `null`,
+		},
+		{
+			name:    "missing name",
+			input:   `[{"resource":"Application","expression":"true","severity":"WARNING","message":"msg"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate name",
+			input:   `[{"name":"a","resource":"Job","expression":"true","severity":"WARNING","message":"msg"},{"name":"a","resource":"Pod","expression":"true","severity":"WARNING","message":"msg"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported resource",
+			input:   `[{"name":"a","resource":"Secret","expression":"true","severity":"WARNING","message":"msg"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid severity",
+			input:   `[{"name":"a","resource":"Application","expression":"true","severity":"HIGH","message":"msg"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			input:   `[{"name":"a","resource":"Application","expresion":"true","severity":"WARNING","message":"msg"}]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got CustomChecks
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected error, got %+v", got)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCompileCustomChecks(t *testing.T) {
+	if _, err := compileCustomChecks(CustomChecks{{Name: "a", Expression: "size(object)", Message: "msg"}}); err == nil {
+		t.Error("expected error for expression not evaluating to a bool")
+	}
+	if _, err := compileCustomChecks(CustomChecks{{Name: "a", Expression: "object.metadata.name ==", Message: "msg"}}); err == nil {
+		t.Error("expected error for invalid expression")
+	}
+	if _, err := compileCustomChecks(CustomChecks{{Name: "a", Expression: "true", Message: "{{ .object"}}); err == nil {
+		t.Error("expected error for invalid message template")
+	}
+}
+
+func TestCustomCheck_Evaluate(t *testing.T) {
+	checks, err := compileCustomChecks(CustomChecks{
+		{
+			Name:       "missing-owner-label",
+			Resource:   CustomCheckResourceApplication,
+			Expression: `environment == "prod" && !object.metadata.?labels[?"owner"].hasValue()`,
+			Severity:   issue.SeverityWarning,
+			Message:    `Application {{ .object.metadata.name }} in {{ .environment }} has no owner label`,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check := checks[0]
+
+	target := func(env string, labels map[string]string) customCheckTarget {
+		obj, err := toObject(&nais_io_v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "team1", Labels: labels},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return customCheckTarget{
+			resourceName: "myapp",
+			resourceType: issue.ResourceTypeApplication,
+			team:         "team1",
+			env:          env,
+			object:       obj,
+		}
+	}
+
+	t.Run("raises issue when expression is true", func(t *testing.T) {
+		got, err := check.evaluate(context.Background(), target("prod", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got == nil {
+			t.Fatal("expected issue, got nil")
+		}
+		if got.IssueType != issue.IssueTypeCustom || got.Key != "missing-owner-label" {
+			t.Errorf("unexpected issue type or key: %s %q", got.IssueType, got.Key)
+		}
+		if got.ResourceName != "myapp" || got.ResourceType != issue.ResourceTypeApplication || got.Team != "team1" || got.Env != "prod" {
+			t.Errorf("unexpected resource: %+v", got)
+		}
+		if got.Severity != issue.SeverityWarning {
+			t.Errorf("expected severity WARNING, got %s", got.Severity)
+		}
+		if want := "Application myapp in prod has no owner label"; got.Message != want {
+			t.Errorf("expected message %q, got %q", want, got.Message)
+		}
+		details, ok := got.IssueDetails.(issue.CustomIssueDetails)
+		if !ok || details.CheckName != "missing-owner-label" {
+			t.Errorf("unexpected issue details: %+v", got.IssueDetails)
+		}
+	})
+
+	t.Run("no issue when expression is false", func(t *testing.T) {
+		got, err := check.evaluate(context.Background(), target("prod", map[string]string{"owner": "someone"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != nil {
+			t.Errorf("expected no issue, got %+v", got)
+		}
+
+		got, err = check.evaluate(context.Background(), target("dev", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != nil {
+			t.Errorf("expected no issue, got %+v", got)
+		}
+	})
+}
@@ -1,28 +1,117 @@
--- name: BatchInsertIssues :batchexec
+-- name: BatchUpsertIssues :batchexec
 INSERT INTO
 	issues (
 		issue_type,
+		issue_key,
 		resource_name,
 		resource_type,
 		team,
 		env,
 		severity,
 		message,
-		issue_details
+		issue_details,
+		first_seen,
+		last_seen
 	)
 VALUES
 	(
 		@issue_type,
+		@issue_key,
 		@resource_name,
 		@resource_type,
 		@team,
 		@env,
 		@severity,
 		@message,
-		@issue_details
+		@issue_details,
+		@seen_at,
+		@seen_at
 	)
+ON CONFLICT (issue_type, resource_type, resource_name, team, env, issue_key) DO UPDATE
+SET
+	severity = EXCLUDED.severity,
+	message = EXCLUDED.message,
+	issue_details = EXCLUDED.issue_details,
+	last_seen = EXCLUDED.last_seen
 ;
 
--- name: DeleteIssues :exec
-DELETE FROM issues
+-- name: UpsertIssueHistory :exec
+INSERT INTO
+	issue_history (
+		id,
+		issue_type,
+		issue_key,
+		resource_name,
+		resource_type,
+		team,
+		env,
+		severity,
+		message,
+		first_seen,
+		last_seen
+	)
+SELECT
+	id,
+	issue_type,
+	issue_key,
+	resource_name,
+	resource_type,
+	team,
+	env,
+	severity,
+	message,
+	first_seen,
+	last_seen
+FROM
+	issues
+WHERE
+	issues.last_seen = @seen_at
+ON CONFLICT (id) DO UPDATE
+SET
+	severity = EXCLUDED.severity,
+	message = EXCLUDED.message,
+	last_seen = EXCLUDED.last_seen
+;
+
+-- name: ResolveIssues :execrows
+WITH
+	resolved AS (
+		DELETE FROM issues
+		WHERE
+			last_seen < @seen_at
+			AND issue_type <> ALL (COALESCE(@failed_issue_types::TEXT[], '{}'))
+		RETURNING
+			id
+	)
+UPDATE issue_history
+SET
+	resolved_at = @seen_at
+WHERE
+	id IN (
+		SELECT
+			id
+		FROM
+			resolved
+	)
+;
+
+-- name: ListTeamServiceAccountTokens :many
+SELECT
+	service_account_tokens.id,
+	service_account_tokens.name,
+	service_account_tokens.created_at,
+	service_account_tokens.last_used_at,
+	service_account_tokens.expires_at,
+	service_accounts.id AS service_account_id,
+	service_accounts.name AS service_account_name,
+	service_accounts.team_slug::TEXT AS team_slug
+FROM
+	service_account_tokens
+	JOIN service_accounts ON service_accounts.id = service_account_tokens.service_account_id
+WHERE
+	service_accounts.team_slug IS NOT NULL
+ORDER BY
+	service_accounts.team_slug,
+	service_accounts.name,
+	service_account_tokens.name
 ;
@@ -24,6 +24,10 @@ type SQLInstance struct {
 	Log                logrus.FieldLogger
 }
 
+func (s SQLInstance) IssueTypes() []issue.IssueType {
+	return []issue.IssueType{issue.IssueTypeSqlInstanceState, issue.IssueTypeSqlInstanceVersion}
+}
+
 func (s SQLInstance) Run(ctx context.Context) ([]Issue, error) {
 	ret := make([]Issue, 0)
 
@@ -20,6 +20,10 @@ type Unleash struct {
 	Log            logrus.FieldLogger
 }
 
+func (u Unleash) IssueTypes() []issue.IssueType {
+	return []issue.IssueType{issue.IssueTypeUnleashReleaseChannel}
+}
+
 func (u Unleash) Run(ctx context.Context) ([]Issue, error) {
 	if u.UnleashWatcher == nil || !u.UnleashWatcher.Enabled() {
 		u.Log.Debug("unleash watcher not enabled, skipping unleash issue check")
@@ -35,6 +35,20 @@ type Workload struct {
 	log logrus.FieldLogger
 }
 
+func (w Workload) IssueTypes() []issue.IssueType {
+	return []issue.IssueType{
+		issue.IssueTypeDeprecatedIngress,
+		issue.IssueTypeDeprecatedRegistry,
+		issue.IssueTypeNoRunningInstances,
+		issue.IssueTypeApplicationRestartLoop,
+		issue.IssueTypeWorkloadProblem,
+		issue.IssueTypeLastRunFailed,
+		issue.IssueTypeVulnerableImage,
+		issue.IssueTypeMissingSBOM,
+		issue.IssueTypeExternalIngressCriticalVulnerability,
+	}
+}
+
 func (w Workload) Run(ctx context.Context) ([]Issue, error) {
 	var ret []Issue
 	for _, app := range w.AppWatcher.All() {
@@ -21,6 +21,7 @@ func (w Workload) workloadProblems(wl NaisWorkload, env string, resourceType iss
 	for _, problem := range *status.Problems {
 		ret = append(ret, &Issue{
 			IssueType:    issue.IssueTypeWorkloadProblem,
+			Key:          problemKey(problem),
 			ResourceName: wl.GetName(),
 			ResourceType: resourceType,
 			Team:         wl.GetNamespace(),
@@ -38,6 +39,15 @@ func (w Workload) workloadProblems(wl NaisWorkload, env string, resourceType iss
 	return ret
 }
 
+// problemKey identifies a problem among the problems of a workload. Problems are keyed on their source when known, as
+// the message may change while the problem persists.
+func problemKey(problem nais_io_v1.Problem) string {
+	if problem.Source != nil && *problem.Source != "" {
+		return string(problem.Type) + ":" + *problem.Source
+	}
+	return string(problem.Type) + ":" + problem.Message
+}
+
 func problemEndOfLife(eol *string) *scalar.Date {
 	if eol == nil || *eol == "" {
 		return nil
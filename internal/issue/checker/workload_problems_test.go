@@ -94,4 +94,21 @@ func TestWorkloadProblems(t *testing.T) {
 			t.Errorf("expected endOfLife to be preserved, got %v", eol)
 		}
 	})
+	t.Run("problems are keyed on source when known, otherwise on message", func(t *testing.T) {
+		app := appWithProblems("keyed",
+			nais_io_v1.Problem{Type: nais_io_v1.ProblemKindError, Message: "boom", Source: new(".spec.image")},
+			nais_io_v1.Problem{Type: nais_io_v1.ProblemKindWarning, Message: "watch out"},
+		)
+
+		got := w.workloadProblems(app, "dev", issue.ResourceTypeApplication)
+		if len(got) != 2 {
+			t.Fatalf("expected 2 issues, got %d", len(got))
+		}
+		if got[0].Key != "Error:.spec.image" {
+			t.Errorf("unexpected key: %s", got[0].Key)
+		}
+		if got[1].Key != "Warning:watch out" {
+			t.Errorf("unexpected key: %s", got[1].Key)
+		}
+	})
 }
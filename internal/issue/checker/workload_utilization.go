@@ -0,0 +1,222 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/nais/api/internal/environmentmapper"
+	"github.com/nais/api/internal/issue"
+	"github.com/nais/api/internal/kubernetes/watcher"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/utilization"
+	nais_io_v1alpha1 "github.com/nais/liberator/pkg/apis/nais.io/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// utilizationRefreshInterval is how often the utilization of workloads is fetched. The queries span the last
+	// day, so the issues are reused between refreshes.
+	utilizationRefreshInterval = time.Hour
+
+	// overprovisionedUsageRatio is the ratio of peak usage to requested resources below which a workload is
+	// considered over-provisioned.
+	overprovisionedUsageRatio = 0.2
+
+	// Small requests are never reported as over-provisioned, no matter how little of them is used.
+	minOverprovisionedCPUCores    = 0.5
+	minOverprovisionedMemoryBytes = 512 * 1024 * 1024
+
+	// minOOMKills is the number of times instances must have been killed for exceeding their memory limit during
+	// the last day for the workload to be considered to repeatedly hit its memory limit.
+	minOOMKills = 3
+)
+
+// Utilization compares the resources requested by applications with their observed usage.
+type Utilization struct {
+	AppWatcher watcher.Watcher[*nais_io_v1alpha1.Application]
+	Client     utilization.ResourceUsageClient
+
+	log logrus.FieldLogger
+
+	mu        sync.Mutex
+	issues    []Issue
+	refreshed time.Time
+}
+
+type utilizationKey struct {
+	env      string
+	team     slug.Slug
+	workload string
+}
+
+func (u *Utilization) IssueTypes() []issue.IssueType {
+	return []issue.IssueType{issue.IssueTypeOverprovisionedWorkload, issue.IssueTypeMemoryLimitReached}
+}
+
+func (u *Utilization) Run(ctx context.Context) ([]Issue, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.issues != nil && time.Since(u.refreshed) < utilizationRefreshInterval {
+		return u.issues, nil
+	}
+
+	issues, err := u.run(utilization.NewLoaderContext(ctx, u.Client, u.log))
+	if err != nil {
+		// Keep reporting the previous issues, so they are not resolved because the metrics are unavailable.
+		return u.issues, err
+	}
+
+	u.issues = issues
+	u.refreshed = time.Now()
+	return issues, nil
+}
+
+func (u *Utilization) run(ctx context.Context) ([]Issue, error) {
+	apps := map[utilizationKey]struct{}{}
+	for _, app := range u.AppWatcher.All() {
+		apps[utilizationKey{
+			env:      environmentmapper.EnvironmentName(app.Cluster),
+			team:     slug.Slug(app.Obj.GetNamespace()),
+			workload: app.Obj.GetName(),
+		}] = struct{}{}
+	}
+
+	cpu, err := utilization.ForAllWorkloads(ctx, utilization.UtilizationResourceTypeCPU)
+	if err != nil {
+		return nil, fmt.Errorf("fetch CPU utilization: %w", err)
+	}
+	memory, err := utilization.ForAllWorkloads(ctx, utilization.UtilizationResourceTypeMemory)
+	if err != nil {
+		return nil, fmt.Errorf("fetch memory utilization: %w", err)
+	}
+	oomKills, err := utilization.OOMKillsForAllWorkloads(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch OOM kills: %w", err)
+	}
+
+	memoryByKey := map[utilizationKey]*utilization.WorkloadUtilizationData{}
+	for _, m := range memory {
+		memoryByKey[utilizationKey{env: m.EnvironmentName, team: m.TeamSlug, workload: m.WorkloadName}] = m
+	}
+
+	ret := make([]Issue, 0)
+	for _, c := range cpu {
+		key := utilizationKey{env: c.EnvironmentName, team: c.TeamSlug, workload: c.WorkloadName}
+		if _, ok := apps[key]; !ok {
+			continue
+		}
+		m, ok := memoryByKey[key]
+		if !ok || !overprovisioned(c, m) {
+			continue
+		}
+
+		iss, err := u.overprovisionedIssue(ctx, key, c, m)
+		if err != nil {
+			u.log.WithError(err).WithField("workload", key.workload).Warn("fetch resource recommendations")
+			continue
+		}
+		ret = append(ret, *iss)
+	}
+
+	for _, o := range oomKills {
+		key := utilizationKey{env: o.EnvironmentName, team: o.TeamSlug, workload: o.WorkloadName}
+		if _, ok := apps[key]; !ok || o.Count < minOOMKills {
+			continue
+		}
+
+		iss, err := u.memoryLimitReachedIssue(ctx, key, o)
+		if err != nil {
+			u.log.WithError(err).WithField("workload", key.workload).Warn("fetch memory limit recommendation")
+			continue
+		}
+		ret = append(ret, *iss)
+	}
+
+	return ret, nil
+}
+
+// overprovisioned returns whether the workload uses only a small fraction of a substantial CPU or memory request.
+func overprovisioned(cpu, memory *utilization.WorkloadUtilizationData) bool {
+	cpuOverprovisioned := cpu.Requested >= minOverprovisionedCPUCores && cpu.Used < cpu.Requested*overprovisionedUsageRatio
+	memoryOverprovisioned := memory.Requested >= minOverprovisionedMemoryBytes && memory.Used < memory.Requested*overprovisionedUsageRatio
+	return cpuOverprovisioned || memoryOverprovisioned
+}
+
+func (u *Utilization) overprovisionedIssue(ctx context.Context, key utilizationKey, cpu, memory *utilization.WorkloadUtilizationData) (*Issue, error) {
+	rec, err := utilization.WorkloadResourceRecommendations(ctx, key.env, key.team, key.workload)
+	if err != nil {
+		return nil, err
+	}
+	recCPU, err := rec.CPURequestCores(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recMemory, err := rec.MemoryRequestBytes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Issue{
+		IssueType:    issue.IssueTypeOverprovisionedWorkload,
+		ResourceName: key.workload,
+		ResourceType: issue.ResourceTypeApplication,
+		Team:         key.team.String(),
+		Env:          key.env,
+		Severity:     issue.SeverityTodo,
+		Message: fmt.Sprintf(
+			"Application requests %.2f CPU cores and %d MiB memory per instance, but has used at most %.2f cores and %d MiB during the last day",
+			cpu.Requested, mib(memory.Requested), cpu.Used, mib(memory.Used),
+		),
+		IssueDetails: issue.OverprovisionedWorkloadIssueDetails{
+			CPURequestCores:               cpu.Requested,
+			CPUPeakUsageCores:             cpu.Used,
+			RecommendedCPURequestCores:    recCPU,
+			MemoryRequestBytes:            int64(memory.Requested),
+			MemoryPeakUsageBytes:          int64(memory.Used),
+			RecommendedMemoryRequestBytes: recMemory,
+		},
+	}, nil
+}
+
+func (u *Utilization) memoryLimitReachedIssue(ctx context.Context, key utilizationKey, oomKills *utilization.WorkloadOOMKills) (*Issue, error) {
+	limit, err := utilization.WorkloadResourceLimit(ctx, key.env, key.team, key.workload, utilization.UtilizationResourceTypeMemory)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := utilization.WorkloadResourceRecommendations(ctx, key.env, key.team, key.workload)
+	if err != nil {
+		return nil, err
+	}
+	recLimit, err := rec.MemoryLimitBytes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var limitBytes *int64
+	if limit != nil {
+		limitBytes = new(int64(*limit))
+	}
+
+	return &Issue{
+		IssueType:    issue.IssueTypeMemoryLimitReached,
+		ResourceName: key.workload,
+		ResourceType: issue.ResourceTypeApplication,
+		Team:         key.team.String(),
+		Env:          key.env,
+		Severity:     issue.SeverityWarning,
+		Message:      fmt.Sprintf("Application instances have been killed %d times during the last day for exceeding their memory limit", oomKills.Count),
+		IssueDetails: issue.MemoryLimitReachedIssueDetails{
+			OOMKillCount:                oomKills.Count,
+			MemoryLimitBytes:            limitBytes,
+			RecommendedMemoryLimitBytes: recLimit,
+		},
+	}, nil
+}
+
+func mib(bytes float64) int64 {
+	return int64(math.Round(bytes / (1024 * 1024)))
+}
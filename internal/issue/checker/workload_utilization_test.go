@@ -0,0 +1,134 @@
+package checker
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/nais/api/internal/issue"
+	"github.com/nais/api/internal/thirdparty/promclient"
+	"github.com/nais/api/internal/utilization"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	prom "github.com/prometheus/common/model"
+	"github.com/sirupsen/logrus"
+)
+
+// stubUsageClient answers every query containing a key of values with the value of the key.
+type stubUsageClient struct {
+	values map[string]float64
+}
+
+func (s stubUsageClient) Query(_ context.Context, _ string, query string, _ ...promclient.QueryOption) (prom.Vector, error) {
+	for k, v := range s.values {
+		if strings.Contains(query, k) {
+			return prom.Vector{{Value: prom.SampleValue(v)}}, nil
+		}
+	}
+	return prom.Vector{}, nil
+}
+
+func (s stubUsageClient) QueryAll(ctx context.Context, query string, opts ...promclient.QueryOption) (prom.Vector, error) {
+	return s.Query(ctx, "", query, opts...)
+}
+
+func (s stubUsageClient) QueryRange(context.Context, string, string, promv1.Range) (prom.Value, promv1.Warnings, error) {
+	return prom.Matrix{}, nil, nil
+}
+
+func TestOverprovisioned(t *testing.T) {
+	const gib = 1024 * 1024 * 1024
+
+	tests := []struct {
+		name   string
+		cpu    utilization.WorkloadUtilizationData
+		memory utilization.WorkloadUtilizationData
+		want   bool
+	}{
+		{
+			name:   "well provisioned",
+			cpu:    utilization.WorkloadUtilizationData{Requested: 1, Used: 0.6},
+			memory: utilization.WorkloadUtilizationData{Requested: gib, Used: 0.7 * gib},
+		},
+		{
+			name:   "cpu over-provisioned",
+			cpu:    utilization.WorkloadUtilizationData{Requested: 2, Used: 0.1},
+			memory: utilization.WorkloadUtilizationData{Requested: gib, Used: 0.7 * gib},
+			want:   true,
+		},
+		{
+			name:   "memory over-provisioned",
+			cpu:    utilization.WorkloadUtilizationData{Requested: 1, Used: 0.6},
+			memory: utilization.WorkloadUtilizationData{Requested: 4 * gib, Used: 0.5 * gib},
+			want:   true,
+		},
+		{
+			name:   "small requests are ignored",
+			cpu:    utilization.WorkloadUtilizationData{Requested: 0.2, Used: 0.01},
+			memory: utilization.WorkloadUtilizationData{Requested: 256 * 1024 * 1024, Used: 16 * 1024 * 1024},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := overprovisioned(&tt.cpu, &tt.memory); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUtilizationIssueDetails(t *testing.T) {
+	const mib = 1024 * 1024
+
+	client := stubUsageClient{values: map[string]float64{
+		"rate(container_cpu_usage_seconds_total": 0.3,
+		"quantile_over_time(0.8":                 100 * mib,
+		"quantile_over_time(\n\t\t\t0.95":        300 * mib,
+		`resource="memory", unit="byte"`:         256 * mib,
+	}}
+	u := &Utilization{Client: client, log: logrus.New()}
+	ctx := utilization.NewLoaderContext(context.Background(), client, u.log)
+	key := utilizationKey{env: "dev", team: "team1", workload: "myapp"}
+
+	t.Run("over-provisioned", func(t *testing.T) {
+		got, err := u.overprovisionedIssue(ctx, key,
+			&utilization.WorkloadUtilizationData{Requested: 2, Used: 0.25},
+			&utilization.WorkloadUtilizationData{Requested: 2048 * mib, Used: 120 * mib},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.IssueType != issue.IssueTypeOverprovisionedWorkload || got.ResourceType != issue.ResourceTypeApplication || got.ResourceName != "myapp" {
+			t.Errorf("unexpected issue: %+v", got)
+		}
+
+		details, ok := got.IssueDetails.(issue.OverprovisionedWorkloadIssueDetails)
+		if !ok {
+			t.Fatalf("unexpected issue details: %T", got.IssueDetails)
+		}
+		if details.CPURequestCores != 2 || details.CPUPeakUsageCores != 0.25 || details.RecommendedCPURequestCores != 0.3 {
+			t.Errorf("unexpected CPU details: %+v", details)
+		}
+		if details.MemoryRequestBytes != 2048*mib || details.MemoryPeakUsageBytes != 120*mib || details.RecommendedMemoryRequestBytes != 128*mib {
+			t.Errorf("unexpected memory details: %+v", details)
+		}
+	})
+
+	t.Run("memory limit reached", func(t *testing.T) {
+		got, err := u.memoryLimitReachedIssue(ctx, key, &utilization.WorkloadOOMKills{Count: 5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.IssueType != issue.IssueTypeMemoryLimitReached || got.Severity != issue.SeverityWarning {
+			t.Errorf("unexpected issue: %+v", got)
+		}
+
+		details, ok := got.IssueDetails.(issue.MemoryLimitReachedIssueDetails)
+		if !ok {
+			t.Fatalf("unexpected issue details: %T", got.IssueDetails)
+		}
+		if details.OOMKillCount != 5 || details.MemoryLimitBytes == nil || *details.MemoryLimitBytes != 256*mib || details.RecommendedMemoryLimitBytes != 320*mib {
+			t.Errorf("unexpected details: %+v", details)
+		}
+	})
+}
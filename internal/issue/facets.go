@@ -33,6 +33,7 @@ func ComputeFacets(ctx context.Context, teamSlug slug.Slug, scope *IssueScope, f
 		if filter.Severity != nil {
 			params.Severity = new(issuesql.SeverityLevel(*filter.Severity))
 		}
+		params.Acknowledged = filter.Acknowledged
 	}
 
 	rows, err := db(ctx).FacetsForIssues(ctx, params)
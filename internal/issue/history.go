@@ -0,0 +1,177 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/issue/issuesql"
+	"github.com/nais/api/internal/slug"
+)
+
+type (
+	IssueHistoryEdge = pagination.Edge[*IssueHistoryEntry]
+)
+
+type IssueHistoryConnection struct {
+	pagination.Connection[*IssueHistoryEntry]
+
+	teamSlug slug.Slug
+	filter   *IssueHistoryFilter
+}
+
+func (c *IssueHistoryConnection) GetTeamSlug() slug.Slug         { return c.teamSlug }
+func (c *IssueHistoryConnection) GetFilter() *IssueHistoryFilter { return c.filter }
+
+type IssueHistoryEntry struct {
+	IssueType    IssueType    `json:"issueType"`
+	ResourceType ResourceType `json:"resourceType"`
+	ResourceName string       `json:"resourceName"`
+	Severity     Severity     `json:"severity"`
+	Message      string       `json:"message"`
+	FirstSeen    time.Time    `json:"firstSeen"`
+	LastSeen     time.Time    `json:"lastSeen"`
+	ResolvedAt   *time.Time   `json:"resolvedAt,omitempty"`
+
+	// IssueID is the id of the issue while it is unresolved. Resolved issues are deleted.
+	IssueID         string    `json:"-"`
+	TeamSlug        slug.Slug `json:"-"`
+	EnvironmentName string    `json:"-"`
+}
+
+type IssueHistoryFilter struct {
+	// Filter by resource name.
+	ResourceName *string `json:"resourceName,omitempty"`
+	// Filter by resource type.
+	ResourceType *ResourceType `json:"resourceType,omitempty"`
+	// Filter by environment.
+	Environments []string `json:"environments,omitempty"`
+	// Filter by issue type.
+	IssueType *IssueType `json:"issueType,omitempty"`
+	// Only include resolved issues when true, or unresolved issues when false.
+	Resolved *bool `json:"resolved,omitempty"`
+	// Only include issues that were seen, or resolved, at or after this time.
+	Since *time.Time `json:"since,omitempty"`
+}
+
+type IssueTypeMeanTimeToResolve struct {
+	IssueType         IssueType `json:"issueType"`
+	ResolvedCount     int       `json:"resolvedCount"`
+	MeanTimeToResolve float64   `json:"meanTimeToResolve"`
+}
+
+func ListIssueHistory(ctx context.Context, teamSlug slug.Slug, page *pagination.Pagination, filter *IssueHistoryFilter) (*IssueHistoryConnection, error) {
+	params := issuesql.ListIssueHistoryParams{
+		Team:   teamSlug.String(),
+		Offset: page.Offset(),
+		Limit:  page.Limit(),
+	}
+
+	if filter != nil {
+		if len(filter.Environments) > 0 {
+			params.Env = filter.Environments
+		}
+		params.IssueType = (*string)(filter.IssueType)
+		params.ResourceType = (*string)(filter.ResourceType)
+		params.ResourceName = filter.ResourceName
+		params.Resolved = filter.Resolved
+		params.Since = timestamptz(filter.Since)
+	}
+
+	ret, err := db(ctx).ListIssueHistory(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+
+	conn := pagination.NewConvertConnection(ret, page, total, func(from *issuesql.ListIssueHistoryRow) *IssueHistoryEntry {
+		return toIssueHistoryEntry(&from.IssueHistory)
+	})
+
+	return &IssueHistoryConnection{
+		Connection: *conn,
+		teamSlug:   teamSlug,
+		filter:     filter,
+	}, nil
+}
+
+// MeanTimeToResolve returns the mean time to resolve per issue type, for resolved issues of the team matching the
+// filter. The resolved filter is ignored.
+func MeanTimeToResolve(ctx context.Context, teamSlug slug.Slug, filter *IssueHistoryFilter) ([]*IssueTypeMeanTimeToResolve, error) {
+	params := issuesql.MeanTimeToResolveParams{
+		Team: teamSlug.String(),
+	}
+
+	if filter != nil {
+		if len(filter.Environments) > 0 {
+			params.Env = filter.Environments
+		}
+		params.IssueType = (*string)(filter.IssueType)
+		params.ResourceType = (*string)(filter.ResourceType)
+		params.ResourceName = filter.ResourceName
+		params.Since = timestamptz(filter.Since)
+	}
+
+	rows, err := db(ctx).MeanTimeToResolve(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*IssueTypeMeanTimeToResolve, len(rows))
+	for i, row := range rows {
+		ret[i] = &IssueTypeMeanTimeToResolve{
+			IssueType:         IssueType(row.IssueType),
+			ResolvedCount:     int(row.ResolvedCount),
+			MeanTimeToResolve: row.MeanSeconds,
+		}
+	}
+	return ret, nil
+}
+
+// GetForHistoryEntry returns the issue of an unresolved history entry, or nil if the issue is resolved.
+func GetForHistoryEntry(ctx context.Context, entry *IssueHistoryEntry) (Issue, error) {
+	if entry.ResolvedAt != nil || entry.IssueID == "" {
+		return nil, nil
+	}
+
+	iss, err := get(ctx, newIdent(entry.IssueID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Resolved since the history entry was read
+		return nil, nil
+	}
+	return iss, err
+}
+
+func toIssueHistoryEntry(h *issuesql.IssueHistory) *IssueHistoryEntry {
+	ret := &IssueHistoryEntry{
+		IssueType:       IssueType(h.IssueType),
+		ResourceType:    ResourceType(h.ResourceType),
+		ResourceName:    h.ResourceName,
+		Severity:        Severity(h.Severity),
+		Message:         h.Message,
+		FirstSeen:       h.FirstSeen.Time,
+		LastSeen:        h.LastSeen.Time,
+		TeamSlug:        slug.Slug(h.Team),
+		EnvironmentName: h.Env,
+	}
+	if h.ResolvedAt.Valid {
+		ret.ResolvedAt = &h.ResolvedAt.Time
+	} else {
+		ret.IssueID = h.ID.String()
+	}
+	return ret
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
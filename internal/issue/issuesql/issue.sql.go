@@ -8,8 +8,92 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/slug"
 )
 
+const acknowledgeIssue = `-- name: AcknowledgeIssue :exec
+UPDATE issues
+SET
+	acknowledged_by = $1,
+	acknowledged_at = NOW(),
+	acknowledgement_reason = $2,
+	acknowledged_until = $3
+WHERE
+	id = $4
+`
+
+type AcknowledgeIssueParams struct {
+	AcknowledgedBy *string
+	Reason         *string
+	Until          pgtype.Timestamptz
+	ID             uuid.UUID
+}
+
+func (q *Queries) AcknowledgeIssue(ctx context.Context, arg AcknowledgeIssueParams) error {
+	_, err := q.db.Exec(ctx, acknowledgeIssue,
+		arg.AcknowledgedBy,
+		arg.Reason,
+		arg.Until,
+		arg.ID,
+	)
+	return err
+}
+
+const createIssueSuppressionRule = `-- name: CreateIssueSuppressionRule :one
+INSERT INTO
+	issue_suppression_rules (team_slug, issue_type, resource_name_pattern, reason, created_by)
+VALUES
+	(
+		$1,
+		$2,
+		$3,
+		$4,
+		$5
+	)
+RETURNING
+	id, team_slug, issue_type, resource_name_pattern, reason, created_by, created_at
+`
+
+type CreateIssueSuppressionRuleParams struct {
+	TeamSlug            slug.Slug
+	IssueType           string
+	ResourceNamePattern string
+	Reason              string
+	CreatedBy           string
+}
+
+func (q *Queries) CreateIssueSuppressionRule(ctx context.Context, arg CreateIssueSuppressionRuleParams) (*IssueSuppressionRule, error) {
+	row := q.db.QueryRow(ctx, createIssueSuppressionRule,
+		arg.TeamSlug,
+		arg.IssueType,
+		arg.ResourceNamePattern,
+		arg.Reason,
+		arg.CreatedBy,
+	)
+	var i IssueSuppressionRule
+	err := row.Scan(
+		&i.ID,
+		&i.TeamSlug,
+		&i.IssueType,
+		&i.ResourceNamePattern,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const deleteIssueSuppressionRule = `-- name: DeleteIssueSuppressionRule :exec
+DELETE FROM issue_suppression_rules
+WHERE
+	id = $1
+`
+
+func (q *Queries) DeleteIssueSuppressionRule(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteIssueSuppressionRule, id)
+	return err
+}
+
 const facetsForIssues = `-- name: FacetsForIssues :many
 SELECT
 	severity,
@@ -38,22 +122,27 @@ SELECT
 				$5::TEXT IS NULL
 				OR resource_name = $5::TEXT
 			)
+			AND (
+				$6::BOOLEAN IS NULL
+				OR acknowledged = $6::BOOLEAN
+			)
 	) AS filtered_count
 FROM
-	issues
+	issues_with_acknowledgement
 WHERE
-	team = $6
-	AND (
-		$7::TEXT IS NULL
-		OR resource_type = $7::TEXT
-	)
+	team = $7
+	AND env <> ''
 	AND (
 		$8::TEXT IS NULL
-		OR resource_name = $8::TEXT
+		OR resource_type = $8::TEXT
 	)
 	AND (
 		$9::TEXT IS NULL
-		OR env = $9::TEXT
+		OR resource_name = $9::TEXT
+	)
+	AND (
+		$10::TEXT IS NULL
+		OR env = $10::TEXT
 	)
 GROUP BY
 	severity,
@@ -73,6 +162,7 @@ type FacetsForIssuesParams struct {
 	Severity           *SeverityLevel
 	FilterResourceType *string
 	FilterResourceName *string
+	Acknowledged       *bool
 	Team               string
 	ScopeResourceType  *string
 	ScopeResourceName  *string
@@ -94,6 +184,7 @@ func (q *Queries) FacetsForIssues(ctx context.Context, arg FacetsForIssuesParams
 		arg.Severity,
 		arg.FilterResourceType,
 		arg.FilterResourceName,
+		arg.Acknowledged,
 		arg.Team,
 		arg.ScopeResourceType,
 		arg.ScopeResourceName,
@@ -125,16 +216,16 @@ func (q *Queries) FacetsForIssues(ctx context.Context, arg FacetsForIssuesParams
 
 const getIssueByID = `-- name: GetIssueByID :one
 SELECT
-	id, issue_type, resource_name, resource_type, team, env, severity, message, issue_details, created_at
+	id, issue_type, resource_name, resource_type, team, env, severity, message, issue_details, created_at, issue_key, first_seen, last_seen, acknowledged_by, acknowledged_at, acknowledgement_reason, acknowledged_until, suppression_rule_id, suppression_reason, suppressed_by, suppressed_at, acknowledged
 FROM
-	issues
+	issues_with_acknowledgement
 WHERE
 	id = $1
 `
 
-func (q *Queries) GetIssueByID(ctx context.Context, id uuid.UUID) (*Issue, error) {
+func (q *Queries) GetIssueByID(ctx context.Context, id uuid.UUID) (*IssuesWithAcknowledgement, error) {
 	row := q.db.QueryRow(ctx, getIssueByID, id)
-	var i Issue
+	var i IssuesWithAcknowledgement
 	err := row.Scan(
 		&i.ID,
 		&i.IssueType,
@@ -146,6 +237,42 @@ func (q *Queries) GetIssueByID(ctx context.Context, id uuid.UUID) (*Issue, error
 		&i.Message,
 		&i.IssueDetails,
 		&i.CreatedAt,
+		&i.IssueKey,
+		&i.FirstSeen,
+		&i.LastSeen,
+		&i.AcknowledgedBy,
+		&i.AcknowledgedAt,
+		&i.AcknowledgementReason,
+		&i.AcknowledgedUntil,
+		&i.SuppressionRuleID,
+		&i.SuppressionReason,
+		&i.SuppressedBy,
+		&i.SuppressedAt,
+		&i.Acknowledged,
+	)
+	return &i, err
+}
+
+const getIssueSuppressionRule = `-- name: GetIssueSuppressionRule :one
+SELECT
+	id, team_slug, issue_type, resource_name_pattern, reason, created_by, created_at
+FROM
+	issue_suppression_rules
+WHERE
+	id = $1
+`
+
+func (q *Queries) GetIssueSuppressionRule(ctx context.Context, id uuid.UUID) (*IssueSuppressionRule, error) {
+	row := q.db.QueryRow(ctx, getIssueSuppressionRule, id)
+	var i IssueSuppressionRule
+	err := row.Scan(
+		&i.ID,
+		&i.TeamSlug,
+		&i.IssueType,
+		&i.ResourceNamePattern,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.CreatedAt,
 	)
 	return &i, err
 }
@@ -188,14 +315,175 @@ func (q *Queries) GetSeverityScoreForWorkload(ctx context.Context, arg GetSeveri
 	return severity_score, err
 }
 
+const listIssueHistory = `-- name: ListIssueHistory :many
+SELECT
+	issue_history.id, issue_history.issue_type, issue_history.issue_key, issue_history.resource_name, issue_history.resource_type, issue_history.team, issue_history.env, issue_history.severity, issue_history.message, issue_history.first_seen, issue_history.last_seen, issue_history.resolved_at,
+	COUNT(*) OVER () AS total_count
+FROM
+	issue_history
+WHERE
+	team = $1
+	AND env <> ''
+	AND (
+		$2::TEXT[] IS NULL
+		OR env = ANY ($2::TEXT[])
+	)
+	AND (
+		$3::TEXT IS NULL
+		OR issue_type = $3::TEXT
+	)
+	AND (
+		$4::TEXT IS NULL
+		OR resource_type = $4::TEXT
+	)
+	AND (
+		$5::TEXT IS NULL
+		OR resource_name = $5::TEXT
+	)
+	AND (
+		$6::BOOLEAN IS NULL
+		OR (resolved_at IS NOT NULL) = $6::BOOLEAN
+	)
+	AND (
+		$7::TIMESTAMPTZ IS NULL
+		OR COALESCE(resolved_at, last_seen) >= $7::TIMESTAMPTZ
+	)
+ORDER BY
+	first_seen DESC,
+	id
+OFFSET
+	$8
+LIMIT
+	$9
+`
+
+type ListIssueHistoryParams struct {
+	Team         string
+	Env          []string
+	IssueType    *string
+	ResourceType *string
+	ResourceName *string
+	Resolved     *bool
+	Since        pgtype.Timestamptz
+	Offset       int32
+	Limit        int32
+}
+
+type ListIssueHistoryRow struct {
+	IssueHistory IssueHistory
+	TotalCount   int64
+}
+
+func (q *Queries) ListIssueHistory(ctx context.Context, arg ListIssueHistoryParams) ([]*ListIssueHistoryRow, error) {
+	rows, err := q.db.Query(ctx, listIssueHistory,
+		arg.Team,
+		arg.Env,
+		arg.IssueType,
+		arg.ResourceType,
+		arg.ResourceName,
+		arg.Resolved,
+		arg.Since,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListIssueHistoryRow{}
+	for rows.Next() {
+		var i ListIssueHistoryRow
+		if err := rows.Scan(
+			&i.IssueHistory.ID,
+			&i.IssueHistory.IssueType,
+			&i.IssueHistory.IssueKey,
+			&i.IssueHistory.ResourceName,
+			&i.IssueHistory.ResourceType,
+			&i.IssueHistory.Team,
+			&i.IssueHistory.Env,
+			&i.IssueHistory.Severity,
+			&i.IssueHistory.Message,
+			&i.IssueHistory.FirstSeen,
+			&i.IssueHistory.LastSeen,
+			&i.IssueHistory.ResolvedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueSuppressionRules = `-- name: ListIssueSuppressionRules :many
+SELECT
+	issue_suppression_rules.id, issue_suppression_rules.team_slug, issue_suppression_rules.issue_type, issue_suppression_rules.resource_name_pattern, issue_suppression_rules.reason, issue_suppression_rules.created_by, issue_suppression_rules.created_at,
+	COUNT(*) OVER () AS total_count
+FROM
+	issue_suppression_rules
+WHERE
+	team_slug = $1
+ORDER BY
+	issue_type,
+	resource_name_pattern
+OFFSET
+	$2
+LIMIT
+	$3
+`
+
+type ListIssueSuppressionRulesParams struct {
+	TeamSlug slug.Slug
+	Offset   int32
+	Limit    int32
+}
+
+type ListIssueSuppressionRulesRow struct {
+	IssueSuppressionRule IssueSuppressionRule
+	TotalCount           int64
+}
+
+func (q *Queries) ListIssueSuppressionRules(ctx context.Context, arg ListIssueSuppressionRulesParams) ([]*ListIssueSuppressionRulesRow, error) {
+	rows, err := q.db.Query(ctx, listIssueSuppressionRules, arg.TeamSlug, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListIssueSuppressionRulesRow{}
+	for rows.Next() {
+		var i ListIssueSuppressionRulesRow
+		if err := rows.Scan(
+			&i.IssueSuppressionRule.ID,
+			&i.IssueSuppressionRule.TeamSlug,
+			&i.IssueSuppressionRule.IssueType,
+			&i.IssueSuppressionRule.ResourceNamePattern,
+			&i.IssueSuppressionRule.Reason,
+			&i.IssueSuppressionRule.CreatedBy,
+			&i.IssueSuppressionRule.CreatedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listIssues = `-- name: ListIssues :many
 SELECT
-	id, issue_type, resource_name, resource_type, team, env, severity, message, issue_details, created_at,
+	issues_with_acknowledgement.id, issues_with_acknowledgement.issue_type, issues_with_acknowledgement.resource_name, issues_with_acknowledgement.resource_type, issues_with_acknowledgement.team, issues_with_acknowledgement.env, issues_with_acknowledgement.severity, issues_with_acknowledgement.message, issues_with_acknowledgement.issue_details, issues_with_acknowledgement.created_at, issues_with_acknowledgement.issue_key, issues_with_acknowledgement.first_seen, issues_with_acknowledgement.last_seen, issues_with_acknowledgement.acknowledged_by, issues_with_acknowledgement.acknowledged_at, issues_with_acknowledgement.acknowledgement_reason, issues_with_acknowledgement.acknowledged_until, issues_with_acknowledgement.suppression_rule_id, issues_with_acknowledgement.suppression_reason, issues_with_acknowledgement.suppressed_by, issues_with_acknowledgement.suppressed_at, issues_with_acknowledgement.acknowledged,
 	COUNT(*) OVER () AS total_count
 FROM
-	issues
+	issues_with_acknowledgement
 WHERE
 	team = $1
+	AND env <> ''
 	AND (
 		$2::TEXT[] IS NULL
 		OR env = ANY ($2::TEXT[])
@@ -216,43 +504,47 @@ WHERE
 		$6::TEXT IS NULL
 		OR resource_name = $6::TEXT
 	)
+	AND (
+		$7::BOOLEAN IS NULL
+		OR acknowledged = $7::BOOLEAN
+	)
 ORDER BY
 	CASE
-		WHEN $7::TEXT = 'env:asc' THEN env
+		WHEN $8::TEXT = 'env:asc' THEN env
 	END ASC,
 	CASE
-		WHEN $7::TEXT = 'env:desc' THEN env
+		WHEN $8::TEXT = 'env:desc' THEN env
 	END DESC,
 	CASE
-		WHEN $7::TEXT = 'issue_type:asc' THEN issue_type
+		WHEN $8::TEXT = 'issue_type:asc' THEN issue_type
 	END ASC,
 	CASE
-		WHEN $7::TEXT = 'issue_type:desc' THEN issue_type
+		WHEN $8::TEXT = 'issue_type:desc' THEN issue_type
 	END DESC,
 	CASE
-		WHEN $7::TEXT = 'resource_type:asc' THEN resource_type
+		WHEN $8::TEXT = 'resource_type:asc' THEN resource_type
 	END ASC,
 	CASE
-		WHEN $7::TEXT = 'resource_type:desc' THEN resource_type
+		WHEN $8::TEXT = 'resource_type:desc' THEN resource_type
 	END DESC,
 	CASE
-		WHEN $7::TEXT = 'resource_name:asc' THEN resource_name
+		WHEN $8::TEXT = 'resource_name:asc' THEN resource_name
 	END ASC,
 	CASE
-		WHEN $7::TEXT = 'resource_name:desc' THEN resource_name
+		WHEN $8::TEXT = 'resource_name:desc' THEN resource_name
 	END DESC,
 	CASE
-		WHEN $7::TEXT = 'severity:asc' THEN severity
+		WHEN $8::TEXT = 'severity:asc' THEN severity
 	END ASC,
 	CASE
-		WHEN $7::TEXT = 'severity:desc' THEN severity
+		WHEN $8::TEXT = 'severity:desc' THEN severity
 	END DESC,
 	severity DESC,
 	id
 OFFSET
-	$8
-LIMIT
 	$9
+LIMIT
+	$10
 `
 
 type ListIssuesParams struct {
@@ -262,25 +554,18 @@ type ListIssuesParams struct {
 	Severity     *SeverityLevel
 	ResourceType *string
 	ResourceName *string
+	Acknowledged *bool
 	OrderBy      string
 	Offset       int32
 	Limit        int32
 }
 
 type ListIssuesRow struct {
-	ID           uuid.UUID
-	IssueType    string
-	ResourceName string
-	ResourceType string
-	Team         string
-	Env          string
-	Severity     SeverityLevel
-	Message      string
-	IssueDetails []byte
-	CreatedAt    pgtype.Timestamptz
-	TotalCount   int64
+	IssuesWithAcknowledgement IssuesWithAcknowledgement
+	TotalCount                int64
 }
 
+// Issues for resources not tied to an environment, such as service account tokens, are listed separately.
 func (q *Queries) ListIssues(ctx context.Context, arg ListIssuesParams) ([]*ListIssuesRow, error) {
 	rows, err := q.db.Query(ctx, listIssues,
 		arg.Team,
@@ -289,6 +574,7 @@ func (q *Queries) ListIssues(ctx context.Context, arg ListIssuesParams) ([]*List
 		arg.Severity,
 		arg.ResourceType,
 		arg.ResourceName,
+		arg.Acknowledged,
 		arg.OrderBy,
 		arg.Offset,
 		arg.Limit,
@@ -301,16 +587,108 @@ func (q *Queries) ListIssues(ctx context.Context, arg ListIssuesParams) ([]*List
 	for rows.Next() {
 		var i ListIssuesRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.IssueType,
-			&i.ResourceName,
-			&i.ResourceType,
-			&i.Team,
-			&i.Env,
-			&i.Severity,
-			&i.Message,
-			&i.IssueDetails,
-			&i.CreatedAt,
+			&i.IssuesWithAcknowledgement.ID,
+			&i.IssuesWithAcknowledgement.IssueType,
+			&i.IssuesWithAcknowledgement.ResourceName,
+			&i.IssuesWithAcknowledgement.ResourceType,
+			&i.IssuesWithAcknowledgement.Team,
+			&i.IssuesWithAcknowledgement.Env,
+			&i.IssuesWithAcknowledgement.Severity,
+			&i.IssuesWithAcknowledgement.Message,
+			&i.IssuesWithAcknowledgement.IssueDetails,
+			&i.IssuesWithAcknowledgement.CreatedAt,
+			&i.IssuesWithAcknowledgement.IssueKey,
+			&i.IssuesWithAcknowledgement.FirstSeen,
+			&i.IssuesWithAcknowledgement.LastSeen,
+			&i.IssuesWithAcknowledgement.AcknowledgedBy,
+			&i.IssuesWithAcknowledgement.AcknowledgedAt,
+			&i.IssuesWithAcknowledgement.AcknowledgementReason,
+			&i.IssuesWithAcknowledgement.AcknowledgedUntil,
+			&i.IssuesWithAcknowledgement.SuppressionRuleID,
+			&i.IssuesWithAcknowledgement.SuppressionReason,
+			&i.IssuesWithAcknowledgement.SuppressedBy,
+			&i.IssuesWithAcknowledgement.SuppressedAt,
+			&i.IssuesWithAcknowledgement.Acknowledged,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServiceAccountTokenIssues = `-- name: ListServiceAccountTokenIssues :many
+SELECT
+	issues_with_acknowledgement.id, issues_with_acknowledgement.issue_type, issues_with_acknowledgement.resource_name, issues_with_acknowledgement.resource_type, issues_with_acknowledgement.team, issues_with_acknowledgement.env, issues_with_acknowledgement.severity, issues_with_acknowledgement.message, issues_with_acknowledgement.issue_details, issues_with_acknowledgement.created_at, issues_with_acknowledgement.issue_key, issues_with_acknowledgement.first_seen, issues_with_acknowledgement.last_seen, issues_with_acknowledgement.acknowledged_by, issues_with_acknowledgement.acknowledged_at, issues_with_acknowledgement.acknowledgement_reason, issues_with_acknowledgement.acknowledged_until, issues_with_acknowledgement.suppression_rule_id, issues_with_acknowledgement.suppression_reason, issues_with_acknowledgement.suppressed_by, issues_with_acknowledgement.suppressed_at, issues_with_acknowledgement.acknowledged,
+	COUNT(*) OVER () AS total_count
+FROM
+	issues_with_acknowledgement
+WHERE
+	team = $1
+	AND resource_type = $2
+ORDER BY
+	severity DESC,
+	resource_name,
+	id
+OFFSET
+	$3
+LIMIT
+	$4
+`
+
+type ListServiceAccountTokenIssuesParams struct {
+	Team         string
+	ResourceType string
+	Offset       int32
+	Limit        int32
+}
+
+type ListServiceAccountTokenIssuesRow struct {
+	IssuesWithAcknowledgement IssuesWithAcknowledgement
+	TotalCount                int64
+}
+
+func (q *Queries) ListServiceAccountTokenIssues(ctx context.Context, arg ListServiceAccountTokenIssuesParams) ([]*ListServiceAccountTokenIssuesRow, error) {
+	rows, err := q.db.Query(ctx, listServiceAccountTokenIssues,
+		arg.Team,
+		arg.ResourceType,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListServiceAccountTokenIssuesRow{}
+	for rows.Next() {
+		var i ListServiceAccountTokenIssuesRow
+		if err := rows.Scan(
+			&i.IssuesWithAcknowledgement.ID,
+			&i.IssuesWithAcknowledgement.IssueType,
+			&i.IssuesWithAcknowledgement.ResourceName,
+			&i.IssuesWithAcknowledgement.ResourceType,
+			&i.IssuesWithAcknowledgement.Team,
+			&i.IssuesWithAcknowledgement.Env,
+			&i.IssuesWithAcknowledgement.Severity,
+			&i.IssuesWithAcknowledgement.Message,
+			&i.IssuesWithAcknowledgement.IssueDetails,
+			&i.IssuesWithAcknowledgement.CreatedAt,
+			&i.IssuesWithAcknowledgement.IssueKey,
+			&i.IssuesWithAcknowledgement.FirstSeen,
+			&i.IssuesWithAcknowledgement.LastSeen,
+			&i.IssuesWithAcknowledgement.AcknowledgedBy,
+			&i.IssuesWithAcknowledgement.AcknowledgedAt,
+			&i.IssuesWithAcknowledgement.AcknowledgementReason,
+			&i.IssuesWithAcknowledgement.AcknowledgedUntil,
+			&i.IssuesWithAcknowledgement.SuppressionRuleID,
+			&i.IssuesWithAcknowledgement.SuppressionReason,
+			&i.IssuesWithAcknowledgement.SuppressedBy,
+			&i.IssuesWithAcknowledgement.SuppressedAt,
+			&i.IssuesWithAcknowledgement.Acknowledged,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -322,3 +700,98 @@ func (q *Queries) ListIssues(ctx context.Context, arg ListIssuesParams) ([]*List
 	}
 	return items, nil
 }
+
+const meanTimeToResolve = `-- name: MeanTimeToResolve :many
+SELECT
+	issue_type,
+	COUNT(*) AS resolved_count,
+	AVG(EXTRACT(EPOCH FROM resolved_at - first_seen))::DOUBLE PRECISION AS mean_seconds
+FROM
+	issue_history
+WHERE
+	team = $1
+	AND env <> ''
+	AND resolved_at IS NOT NULL
+	AND (
+		$2::TEXT[] IS NULL
+		OR env = ANY ($2::TEXT[])
+	)
+	AND (
+		$3::TEXT IS NULL
+		OR issue_type = $3::TEXT
+	)
+	AND (
+		$4::TEXT IS NULL
+		OR resource_type = $4::TEXT
+	)
+	AND (
+		$5::TEXT IS NULL
+		OR resource_name = $5::TEXT
+	)
+	AND (
+		$6::TIMESTAMPTZ IS NULL
+		OR resolved_at >= $6::TIMESTAMPTZ
+	)
+GROUP BY
+	issue_type
+ORDER BY
+	issue_type
+`
+
+type MeanTimeToResolveParams struct {
+	Team         string
+	Env          []string
+	IssueType    *string
+	ResourceType *string
+	ResourceName *string
+	Since        pgtype.Timestamptz
+}
+
+type MeanTimeToResolveRow struct {
+	IssueType     string
+	ResolvedCount int64
+	MeanSeconds   float64
+}
+
+func (q *Queries) MeanTimeToResolve(ctx context.Context, arg MeanTimeToResolveParams) ([]*MeanTimeToResolveRow, error) {
+	rows, err := q.db.Query(ctx, meanTimeToResolve,
+		arg.Team,
+		arg.Env,
+		arg.IssueType,
+		arg.ResourceType,
+		arg.ResourceName,
+		arg.Since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*MeanTimeToResolveRow{}
+	for rows.Next() {
+		var i MeanTimeToResolveRow
+		if err := rows.Scan(&i.IssueType, &i.ResolvedCount, &i.MeanSeconds); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeIssueAcknowledgement = `-- name: RemoveIssueAcknowledgement :exec
+UPDATE issues
+SET
+	acknowledged_by = NULL,
+	acknowledged_at = NULL,
+	acknowledgement_reason = NULL,
+	acknowledged_until = NULL
+WHERE
+	id = $1
+`
+
+func (q *Queries) RemoveIssueAcknowledgement(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, removeIssueAcknowledgement, id)
+	return err
+}
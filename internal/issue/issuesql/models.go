@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/api/internal/slug"
 )
 
 type SeverityLevel string
@@ -71,15 +72,52 @@ func AllSeverityLevelValues() []SeverityLevel {
 	}
 }
 
-type Issue struct {
+type IssueHistory struct {
 	ID           uuid.UUID
 	IssueType    string
+	IssueKey     string
 	ResourceName string
 	ResourceType string
 	Team         string
 	Env          string
 	Severity     SeverityLevel
 	Message      string
-	IssueDetails []byte
-	CreatedAt    pgtype.Timestamptz
+	FirstSeen    pgtype.Timestamptz
+	LastSeen     pgtype.Timestamptz
+	ResolvedAt   pgtype.Timestamptz
+}
+
+type IssueSuppressionRule struct {
+	ID                  uuid.UUID
+	TeamSlug            slug.Slug
+	IssueType           string
+	ResourceNamePattern string
+	Reason              string
+	CreatedBy           string
+	CreatedAt           pgtype.Timestamptz
+}
+
+type IssuesWithAcknowledgement struct {
+	ID                    uuid.UUID
+	IssueType             string
+	ResourceName          string
+	ResourceType          string
+	Team                  string
+	Env                   string
+	Severity              SeverityLevel
+	Message               string
+	IssueDetails          []byte
+	CreatedAt             pgtype.Timestamptz
+	IssueKey              string
+	FirstSeen             pgtype.Timestamptz
+	LastSeen              pgtype.Timestamptz
+	AcknowledgedBy        *string
+	AcknowledgedAt        pgtype.Timestamptz
+	AcknowledgementReason *string
+	AcknowledgedUntil     pgtype.Timestamptz
+	SuppressionRuleID     *uuid.UUID
+	SuppressionReason     *string
+	SuppressedBy          *string
+	SuppressedAt          pgtype.Timestamptz
+	Acknowledged          bool
 }
@@ -9,10 +9,20 @@ import (
 )
 
 type Querier interface {
+	AcknowledgeIssue(ctx context.Context, arg AcknowledgeIssueParams) error
+	CreateIssueSuppressionRule(ctx context.Context, arg CreateIssueSuppressionRuleParams) (*IssueSuppressionRule, error)
+	DeleteIssueSuppressionRule(ctx context.Context, id uuid.UUID) error
 	FacetsForIssues(ctx context.Context, arg FacetsForIssuesParams) ([]*FacetsForIssuesRow, error)
-	GetIssueByID(ctx context.Context, id uuid.UUID) (*Issue, error)
+	GetIssueByID(ctx context.Context, id uuid.UUID) (*IssuesWithAcknowledgement, error)
+	GetIssueSuppressionRule(ctx context.Context, id uuid.UUID) (*IssueSuppressionRule, error)
 	GetSeverityScoreForWorkload(ctx context.Context, arg GetSeverityScoreForWorkloadParams) (int64, error)
+	ListIssueHistory(ctx context.Context, arg ListIssueHistoryParams) ([]*ListIssueHistoryRow, error)
+	ListIssueSuppressionRules(ctx context.Context, arg ListIssueSuppressionRulesParams) ([]*ListIssueSuppressionRulesRow, error)
+	// Issues for resources not tied to an environment, such as service account tokens, are listed separately.
 	ListIssues(ctx context.Context, arg ListIssuesParams) ([]*ListIssuesRow, error)
+	ListServiceAccountTokenIssues(ctx context.Context, arg ListServiceAccountTokenIssuesParams) ([]*ListServiceAccountTokenIssuesRow, error)
+	MeanTimeToResolve(ctx context.Context, arg MeanTimeToResolveParams) ([]*MeanTimeToResolveRow, error)
+	RemoveIssueAcknowledgement(ctx context.Context, id uuid.UUID) error
 }
 
 var _ Querier = (*Queries)(nil)
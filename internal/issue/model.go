@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/nais/api/internal/graph/ident"
 	"github.com/nais/api/internal/graph/model"
 	"github.com/nais/api/internal/graph/pagination"
@@ -28,6 +29,10 @@ type Base struct {
 	EnvironmentName string       `json:"-"`
 	ResourceName    string       `json:"-"`
 	ResourceType    ResourceType `json:"-"`
+	FirstSeen       time.Time    `json:"firstSeen"`
+	LastSeen        time.Time    `json:"lastSeen"`
+	// Acknowledgement is the active acknowledgement of the issue, if any.
+	Acknowledgement *IssueAcknowledgement `json:"acknowledgement,omitempty"`
 }
 
 type OpenSearchIssue struct {
@@ -126,8 +131,16 @@ const (
 	ResourceTypeApplication ResourceType = "APPLICATION"
 	ResourceTypeJob         ResourceType = "JOB"
 	ResourceTypeUnleash     ResourceType = "UNLEASH"
+
+	ResourceTypeAivenCredential ResourceType = "AIVEN_CREDENTIAL"
+	ResourceTypeSecret          ResourceType = "SECRET"
 )
 
+// ResourceTypeServiceAccount is the resource type of service account token issues. Service account tokens are not
+// tied to an environment, so their issues are not listed with the other issues, and the resource type is not part of
+// the ResourceType enum.
+const ResourceTypeServiceAccount ResourceType = "SERVICE_ACCOUNT"
+
 var AllResourceType = []ResourceType{
 	ResourceTypeOpensearch,
 	ResourceTypeValkey,
@@ -135,11 +148,14 @@ var AllResourceType = []ResourceType{
 	ResourceTypeApplication,
 	ResourceTypeJob,
 	ResourceTypeUnleash,
+	ResourceTypeAivenCredential,
+	ResourceTypeSecret,
 }
 
 func (e ResourceType) IsValid() bool {
 	switch e {
-	case ResourceTypeOpensearch, ResourceTypeValkey, ResourceTypeSQLInstance, ResourceTypeApplication, ResourceTypeJob, ResourceTypeUnleash:
+	case ResourceTypeOpensearch, ResourceTypeValkey, ResourceTypeSQLInstance, ResourceTypeApplication, ResourceTypeJob, ResourceTypeUnleash,
+		ResourceTypeAivenCredential, ResourceTypeSecret:
 		return true
 	}
 	return false
@@ -210,6 +226,13 @@ const (
 	IssueTypeExternalIngressCriticalVulnerability IssueType = "EXTERNAL_INGRESS_CRITICAL_VULNERABILITY"
 	IssueTypeUnleashReleaseChannel                IssueType = "UNLEASH_RELEASE_CHANNEL"
 	IssueTypeApplicationRestartLoop               IssueType = "APPLICATION_RESTART_LOOP"
+	IssueTypeCustom                               IssueType = "CUSTOM"
+	IssueTypeOverprovisionedWorkload              IssueType = "OVERPROVISIONED_WORKLOAD"
+	IssueTypeMemoryLimitReached                   IssueType = "MEMORY_LIMIT_REACHED"
+	IssueTypeServiceAccountTokenExpiring          IssueType = "SERVICE_ACCOUNT_TOKEN_EXPIRING"
+	IssueTypeServiceAccountTokenUnused            IssueType = "SERVICE_ACCOUNT_TOKEN_UNUSED"
+	IssueTypeStaleAivenCredential                 IssueType = "STALE_AIVEN_CREDENTIAL"
+	IssueTypeStaleSecret                          IssueType = "STALE_SECRET"
 )
 
 var AllIssueType = []IssueType{
@@ -229,6 +252,13 @@ var AllIssueType = []IssueType{
 	IssueTypeExternalIngressCriticalVulnerability,
 	IssueTypeUnleashReleaseChannel,
 	IssueTypeApplicationRestartLoop,
+	IssueTypeCustom,
+	IssueTypeOverprovisionedWorkload,
+	IssueTypeMemoryLimitReached,
+	IssueTypeServiceAccountTokenExpiring,
+	IssueTypeServiceAccountTokenUnused,
+	IssueTypeStaleAivenCredential,
+	IssueTypeStaleSecret,
 }
 
 func (e IssueType) IsValid() bool {
@@ -238,7 +268,9 @@ func (e IssueType) IsValid() bool {
 		IssueTypeNoRunningInstances, IssueTypeLastRunFailed, IssueTypeWorkloadProblem,
 		IssueTypeInvalidSpec, IssueTypeFailedSynchronization, IssueTypeVulnerableImage,
 		IssueTypeMissingSBOM, IssueTypeExternalIngressCriticalVulnerability,
-		IssueTypeUnleashReleaseChannel, IssueTypeApplicationRestartLoop:
+		IssueTypeUnleashReleaseChannel, IssueTypeApplicationRestartLoop, IssueTypeCustom,
+		IssueTypeOverprovisionedWorkload, IssueTypeMemoryLimitReached, IssueTypeServiceAccountTokenExpiring,
+		IssueTypeServiceAccountTokenUnused, IssueTypeStaleAivenCredential, IssueTypeStaleSecret:
 		return true
 	}
 	return false
@@ -524,6 +556,8 @@ type ResourceIssueFilter struct {
 	Severity *Severity `json:"severity,omitempty"`
 	// Filter by issue type.
 	IssueType *IssueType `json:"issueType,omitempty"`
+	// Only include acknowledged issues when true, or unacknowledged issues when false.
+	Acknowledged *bool `json:"acknowledged,omitempty"`
 }
 
 type IssueFilter struct {
@@ -553,3 +587,132 @@ type ApplicationRestartLoopIssue struct {
 func (ApplicationRestartLoopIssue) IsIssue() {}
 
 func (ApplicationRestartLoopIssue) IsNode() {}
+
+// CustomIssueDetails holds details about an issue raised by a check defined in configuration.
+type CustomIssueDetails struct {
+	CheckName string `json:"checkName"`
+}
+
+// CustomIssue is an issue raised by a check defined in configuration.
+type CustomIssue struct {
+	Base
+	CustomIssueDetails
+}
+
+func (CustomIssue) IsIssue() {}
+
+func (CustomIssue) IsNode() {}
+
+// OverprovisionedWorkloadIssueDetails holds the requested resources of a workload, its peak usage during the last
+// day, and the recommended requests.
+type OverprovisionedWorkloadIssueDetails struct {
+	CPURequestCores               float64 `json:"cpuRequestCores"`
+	CPUPeakUsageCores             float64 `json:"cpuPeakUsageCores"`
+	RecommendedCPURequestCores    float64 `json:"recommendedCpuRequestCores"`
+	MemoryRequestBytes            int64   `json:"memoryRequestBytes"`
+	MemoryPeakUsageBytes          int64   `json:"memoryPeakUsageBytes"`
+	RecommendedMemoryRequestBytes int64   `json:"recommendedMemoryRequestBytes"`
+}
+
+// OverprovisionedWorkloadIssue is an issue raised when a workload requests far more resources than it uses.
+type OverprovisionedWorkloadIssue struct {
+	Base
+	OverprovisionedWorkloadIssueDetails
+}
+
+func (OverprovisionedWorkloadIssue) IsIssue() {}
+
+func (OverprovisionedWorkloadIssue) IsNode() {}
+
+// MemoryLimitReachedIssueDetails holds the number of times instances of a workload have been killed for exceeding
+// their memory limit during the last day, and the recommended memory limit.
+type MemoryLimitReachedIssueDetails struct {
+	OOMKillCount                int    `json:"oomKillCount"`
+	MemoryLimitBytes            *int64 `json:"memoryLimitBytes,omitempty"`
+	RecommendedMemoryLimitBytes int64  `json:"recommendedMemoryLimitBytes"`
+}
+
+// MemoryLimitReachedIssue is an issue raised when instances of a workload are repeatedly killed for exceeding their
+// memory limit.
+type MemoryLimitReachedIssue struct {
+	Base
+	MemoryLimitReachedIssueDetails
+}
+
+func (MemoryLimitReachedIssue) IsIssue() {}
+
+func (MemoryLimitReachedIssue) IsNode() {}
+
+// ServiceAccountTokenIssue is an issue with a service account token. Service account tokens are not tied to an
+// environment, so these issues are stored without an environment and do not implement Issue.
+type ServiceAccountTokenIssue interface {
+	model.Node
+	IsServiceAccountTokenIssue()
+}
+
+type (
+	ServiceAccountTokenIssueConnection = pagination.Connection[ServiceAccountTokenIssue]
+	ServiceAccountTokenIssueEdge       = pagination.Edge[ServiceAccountTokenIssue]
+)
+
+// ServiceAccountTokenIssueDetails identifies the service account token an issue is raised for.
+type ServiceAccountTokenIssueDetails struct {
+	ServiceAccountID      uuid.UUID `json:"serviceAccountId"`
+	ServiceAccountTokenID uuid.UUID `json:"serviceAccountTokenId"`
+}
+
+// ServiceAccountTokenExpiringIssue is an issue raised when a service account token is about to expire.
+type ServiceAccountTokenExpiringIssue struct {
+	Base
+	ServiceAccountTokenIssueDetails
+}
+
+func (ServiceAccountTokenExpiringIssue) IsServiceAccountTokenIssue() {}
+
+func (ServiceAccountTokenExpiringIssue) IsNode() {}
+
+// ServiceAccountTokenUnusedIssue is an issue raised when a service account token has not been used for a long time.
+type ServiceAccountTokenUnusedIssue struct {
+	Base
+	ServiceAccountTokenIssueDetails
+}
+
+func (ServiceAccountTokenUnusedIssue) IsServiceAccountTokenIssue() {}
+
+func (ServiceAccountTokenUnusedIssue) IsNode() {}
+
+// StaleAivenCredentialIssueDetails holds details about temporary Aiven credentials created through the API.
+type StaleAivenCredentialIssueDetails struct {
+	Service   string     `json:"service"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// StaleAivenCredentialIssue is an issue raised when temporary Aiven credentials are older than the credential policy
+// allows.
+type StaleAivenCredentialIssue struct {
+	Base
+	StaleAivenCredentialIssueDetails
+}
+
+func (StaleAivenCredentialIssue) IsIssue() {}
+
+func (StaleAivenCredentialIssue) IsNode() {}
+
+// StaleSecretIssueDetails holds details about a secret that has not been rotated.
+type StaleSecretIssueDetails struct {
+	LastModifiedAt time.Time `json:"lastModifiedAt"`
+	LastModifiedBy *string   `json:"lastModifiedBy,omitempty"`
+}
+
+// StaleSecretIssue is an issue raised when a secret has not been updated within the rotation window of the credential
+// policy.
+type StaleSecretIssue struct {
+	Base
+	StaleSecretIssueDetails
+}
+
+func (StaleSecretIssue) IsIssue() {}
+
+func (StaleSecretIssue) IsNode() {}
@@ -1,6 +1,9 @@
 package issue
 
 import (
+	"fmt"
+
+	"github.com/google/uuid"
 	"github.com/nais/api/internal/graph/ident"
 )
 
@@ -8,12 +11,27 @@ type identType int
 
 const (
 	identKey identType = iota
+	identKeySuppressionRule
 )
 
 func init() {
 	ident.RegisterIdentType(identKey, "I", GetByIdent)
+	ident.RegisterIdentType(identKeySuppressionRule, "ISR", GetSuppressionRuleByIdent)
 }
 
 func newIdent(id string) ident.Ident {
 	return ident.NewIdent(identKey, id)
 }
+
+func newSuppressionRuleIdent(id uuid.UUID) ident.Ident {
+	return ident.NewIdent(identKeySuppressionRule, id.String())
+}
+
+func parseSuppressionRuleIdent(id ident.Ident) (uuid.UUID, error) {
+	parts := id.Parts()
+	if len(parts) != 1 {
+		return uuid.Nil, fmt.Errorf("invalid issue suppression rule ident")
+	}
+
+	return uuid.Parse(parts[0])
+}
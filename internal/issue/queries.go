@@ -3,11 +3,19 @@ package issue
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/graph/apierror"
 	"github.com/nais/api/internal/graph/ident"
+	"github.com/nais/api/internal/graph/model"
 	"github.com/nais/api/internal/graph/pagination"
 	"github.com/nais/api/internal/issue/issuesql"
 	"github.com/nais/api/internal/persistence/sqlinstance"
@@ -30,12 +38,22 @@ func fromContext(ctx context.Context) *dependencies {
 	return ctx.Value(depKey).(*dependencies)
 }
 
-func GetByIdent(ctx context.Context, id ident.Ident) (Issue, error) {
-	uid, err := uuid.Parse(id.String())
+// GetByIdent returns the issue with the given ID, which is either an Issue or a ServiceAccountTokenIssue.
+func GetByIdent(ctx context.Context, id ident.Ident) (model.Node, error) {
+	issue, err := getByIdent(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	issue, err := db(ctx).GetIssueByID(ctx, uid)
+
+	if ResourceType(issue.ResourceType) == ResourceTypeServiceAccount {
+		return convertServiceAccountTokenIssue(issue)
+	}
+
+	return convert(issue)
+}
+
+func get(ctx context.Context, id ident.Ident) (Issue, error) {
+	issue, err := getByIdent(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +87,7 @@ func ListIssues(ctx context.Context, teamSlug slug.Slug, page *pagination.Pagina
 		if filter.Severity != nil {
 			params.Severity = new(issuesql.SeverityLevel(*filter.Severity))
 		}
+		params.Acknowledged = filter.Acknowledged
 	}
 
 	ret, err := db(ctx).ListIssues(ctx, params)
@@ -82,19 +101,7 @@ func ListIssues(ctx context.Context, teamSlug slug.Slug, page *pagination.Pagina
 	}
 
 	conn, err := pagination.NewConvertConnectionWithError(ret, page, total, func(from *issuesql.ListIssuesRow) (Issue, error) {
-		iss := &issuesql.Issue{
-			ID:           from.ID,
-			IssueType:    from.IssueType,
-			ResourceName: from.ResourceName,
-			ResourceType: from.ResourceType,
-			Team:         from.Team,
-			Env:          from.Env,
-			Severity:     from.Severity,
-			Message:      from.Message,
-			IssueDetails: from.IssueDetails,
-			CreatedAt:    from.CreatedAt,
-		}
-		return convert(iss)
+		return convert(&from.IssuesWithAcknowledgement)
 	})
 	if err != nil {
 		return nil, err
@@ -108,8 +115,29 @@ func ListIssues(ctx context.Context, teamSlug slug.Slug, page *pagination.Pagina
 	}, nil
 }
 
-func convert(issue *issuesql.Issue) (Issue, error) {
-	base := Base{
+func ListServiceAccountTokenIssues(ctx context.Context, teamSlug slug.Slug, page *pagination.Pagination) (*ServiceAccountTokenIssueConnection, error) {
+	ret, err := db(ctx).ListServiceAccountTokenIssues(ctx, issuesql.ListServiceAccountTokenIssuesParams{
+		Team:         teamSlug.String(),
+		ResourceType: ResourceTypeServiceAccount.String(),
+		Offset:       page.Offset(),
+		Limit:        page.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+
+	return pagination.NewConvertConnectionWithError(ret, page, total, func(from *issuesql.ListServiceAccountTokenIssuesRow) (ServiceAccountTokenIssue, error) {
+		return convertServiceAccountTokenIssue(&from.IssuesWithAcknowledgement)
+	})
+}
+
+func toBase(issue *issuesql.IssuesWithAcknowledgement) Base {
+	return Base{
 		ID:              newIdent(issue.ID.String()),
 		ResourceName:    issue.ResourceName,
 		ResourceType:    ResourceType(issue.ResourceType),
@@ -117,7 +145,14 @@ func convert(issue *issuesql.Issue) (Issue, error) {
 		TeamSlug:        slug.Slug(issue.Team),
 		Severity:        Severity(issue.Severity),
 		Message:         issue.Message,
+		FirstSeen:       issue.FirstSeen.Time,
+		LastSeen:        issue.LastSeen.Time,
+		Acknowledgement: toAcknowledgement(issue),
 	}
+}
+
+func convert(issue *issuesql.IssuesWithAcknowledgement) (Issue, error) {
+	base := toBase(issue)
 
 	switch IssueType(issue.IssueType) {
 	case IssueTypeOpenSearch:
@@ -233,13 +268,88 @@ func convert(issue *issuesql.Issue) (Issue, error) {
 			Base:                               base,
 			ApplicationRestartLoopIssueDetails: *d,
 		}, nil
+	case IssueTypeCustom:
+		d, err := unmarshal[CustomIssueDetails](issue.IssueDetails)
+		if err != nil {
+			return nil, err
+		}
+		return &CustomIssue{
+			Base:               base,
+			CustomIssueDetails: *d,
+		}, nil
+	case IssueTypeOverprovisionedWorkload:
+		d, err := unmarshal[OverprovisionedWorkloadIssueDetails](issue.IssueDetails)
+		if err != nil {
+			return nil, err
+		}
+		return &OverprovisionedWorkloadIssue{
+			Base:                                base,
+			OverprovisionedWorkloadIssueDetails: *d,
+		}, nil
+	case IssueTypeMemoryLimitReached:
+		d, err := unmarshal[MemoryLimitReachedIssueDetails](issue.IssueDetails)
+		if err != nil {
+			return nil, err
+		}
+		return &MemoryLimitReachedIssue{
+			Base:                           base,
+			MemoryLimitReachedIssueDetails: *d,
+		}, nil
+	case IssueTypeStaleAivenCredential:
+		d, err := unmarshal[StaleAivenCredentialIssueDetails](issue.IssueDetails)
+		if err != nil {
+			return nil, err
+		}
+		return &StaleAivenCredentialIssue{
+			Base:                             base,
+			StaleAivenCredentialIssueDetails: *d,
+		}, nil
+	case IssueTypeStaleSecret:
+		d, err := unmarshal[StaleSecretIssueDetails](issue.IssueDetails)
+		if err != nil {
+			return nil, err
+		}
+		return &StaleSecretIssue{
+			Base:                    base,
+			StaleSecretIssueDetails: *d,
+		}, nil
 	}
 
 	return nil, fmt.Errorf("unknown issue type: %s", issue.IssueType)
 }
 
+func convertServiceAccountTokenIssue(issue *issuesql.IssuesWithAcknowledgement) (ServiceAccountTokenIssue, error) {
+	base := toBase(issue)
+
+	d, err := unmarshal[ServiceAccountTokenIssueDetails](issue.IssueDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	switch IssueType(issue.IssueType) {
+	case IssueTypeServiceAccountTokenExpiring:
+		return &ServiceAccountTokenExpiringIssue{
+			Base:                            base,
+			ServiceAccountTokenIssueDetails: *d,
+		}, nil
+	case IssueTypeServiceAccountTokenUnused:
+		return &ServiceAccountTokenUnusedIssue{
+			Base:                            base,
+			ServiceAccountTokenIssueDetails: *d,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown service account token issue type: %s", issue.IssueType)
+}
+
 func db(ctx context.Context) *issuesql.Queries {
-	return fromContext(ctx).db
+	q := fromContext(ctx).db
+
+	if tx := database.TransactionFromContext(ctx); tx != nil {
+		return q.WithTx(tx)
+	}
+
+	return q
 }
 
 func unmarshal[T any](data []byte) (*T, error) {
@@ -249,3 +359,240 @@ func unmarshal[T any](data []byte) (*T, error) {
 	}
 	return &t, nil
 }
+
+// GetTeamSlug returns the slug of the team the issue belongs to.
+func GetTeamSlug(ctx context.Context, id ident.Ident) (slug.Slug, error) {
+	iss, err := getByIdent(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return slug.Slug(iss.Team), nil
+}
+
+func Acknowledge(ctx context.Context, input AcknowledgeIssueInput) (Issue, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	return acknowledge(ctx, input.IssueID, input.Reason, nil)
+}
+
+func Snooze(ctx context.Context, input SnoozeIssueInput) (Issue, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	return acknowledge(ctx, input.IssueID, input.Reason, &input.Until)
+}
+
+func acknowledge(ctx context.Context, id ident.Ident, reason string, until *time.Time) (Issue, error) {
+	action := activityLogEntryActionAcknowledged
+	if until != nil {
+		action = activityLogEntryActionSnoozed
+	}
+
+	var ret Issue
+	err := database.Transaction(ctx, func(ctx context.Context) error {
+		iss, err := getByIdent(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if ResourceType(iss.ResourceType) == ResourceTypeServiceAccount {
+			return apierror.Errorf("Issues for service account tokens can not be acknowledged.")
+		}
+
+		actor := authz.ActorFromContext(ctx).User
+		if err := db(ctx).AcknowledgeIssue(ctx, issuesql.AcknowledgeIssueParams{
+			ID:             iss.ID,
+			AcknowledgedBy: new(actor.Identity()),
+			Reason:         &reason,
+			Until:          timestamptz(until),
+		}); err != nil {
+			return err
+		}
+
+		if err := activitylog.Create(ctx, activitylog.CreateInput{
+			Action:          action,
+			Actor:           actor,
+			ResourceType:    activityLogEntryResourceTypeIssue,
+			ResourceName:    iss.ResourceName,
+			TeamSlug:        new(slug.Slug(iss.Team)),
+			EnvironmentName: &iss.Env,
+			Data: &IssueAcknowledgedActivityLogEntryData{
+				IssueType:    IssueType(iss.IssueType),
+				ResourceType: ResourceType(iss.ResourceType),
+				Reason:       reason,
+				Until:        until,
+			},
+		}); err != nil {
+			return err
+		}
+
+		ret, err = get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func RemoveAcknowledgement(ctx context.Context, input RemoveIssueAcknowledgementInput) (Issue, error) {
+	var ret Issue
+	err := database.Transaction(ctx, func(ctx context.Context) error {
+		iss, err := getByIdent(ctx, input.IssueID)
+		if err != nil {
+			return err
+		}
+
+		if ResourceType(iss.ResourceType) == ResourceTypeServiceAccount {
+			return apierror.Errorf("Issues for service account tokens can not be acknowledged.")
+		}
+
+		if err := db(ctx).RemoveIssueAcknowledgement(ctx, iss.ID); err != nil {
+			return err
+		}
+
+		if err := activitylog.Create(ctx, activitylog.CreateInput{
+			Action:          activityLogEntryActionAcknowledgementRemoved,
+			Actor:           authz.ActorFromContext(ctx).User,
+			ResourceType:    activityLogEntryResourceTypeIssue,
+			ResourceName:    iss.ResourceName,
+			TeamSlug:        new(slug.Slug(iss.Team)),
+			EnvironmentName: &iss.Env,
+			Data: &IssueAcknowledgedActivityLogEntryData{
+				IssueType:    IssueType(iss.IssueType),
+				ResourceType: ResourceType(iss.ResourceType),
+			},
+		}); err != nil {
+			return err
+		}
+
+		ret, err = get(ctx, input.IssueID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func GetSuppressionRuleByIdent(ctx context.Context, id ident.Ident) (*IssueSuppressionRule, error) {
+	uid, err := parseSuppressionRuleIdent(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := db(ctx).GetIssueSuppressionRule(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphSuppressionRule(rule), nil
+}
+
+func GetSuppressionRule(ctx context.Context, id uuid.UUID) (*IssueSuppressionRule, error) {
+	rule, err := db(ctx).GetIssueSuppressionRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphSuppressionRule(rule), nil
+}
+
+func ListSuppressionRules(ctx context.Context, teamSlug slug.Slug, page *pagination.Pagination) (*IssueSuppressionRuleConnection, error) {
+	ret, err := db(ctx).ListIssueSuppressionRules(ctx, issuesql.ListIssueSuppressionRulesParams{
+		TeamSlug: teamSlug,
+		Offset:   page.Offset(),
+		Limit:    page.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+
+	return pagination.NewConvertConnection(ret, page, total, func(from *issuesql.ListIssueSuppressionRulesRow) *IssueSuppressionRule {
+		return toGraphSuppressionRule(&from.IssueSuppressionRule)
+	}), nil
+}
+
+func CreateSuppressionRule(ctx context.Context, input CreateIssueSuppressionRuleInput) (*IssueSuppressionRule, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	var ret *IssueSuppressionRule
+	err := database.Transaction(ctx, func(ctx context.Context) error {
+		actor := authz.ActorFromContext(ctx).User
+		rule, err := db(ctx).CreateIssueSuppressionRule(ctx, issuesql.CreateIssueSuppressionRuleParams{
+			TeamSlug:            input.TeamSlug,
+			IssueType:           string(input.IssueType),
+			ResourceNamePattern: input.ResourceNamePattern,
+			Reason:              input.Reason,
+			CreatedBy:           actor.Identity(),
+		})
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return apierror.Errorf("A suppression rule for %s issues matching %q already exists.", input.IssueType, input.ResourceNamePattern)
+			}
+			return err
+		}
+		ret = toGraphSuppressionRule(rule)
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:       activityLogEntryActionSuppressionRuleCreated,
+			Actor:        actor,
+			ResourceType: activityLogEntryResourceTypeIssue,
+			ResourceName: input.ResourceNamePattern,
+			TeamSlug:     new(input.TeamSlug),
+			Data: &IssueSuppressionRuleActivityLogEntryData{
+				IssueType:           input.IssueType,
+				ResourceNamePattern: input.ResourceNamePattern,
+				Reason:              input.Reason,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func DeleteSuppressionRule(ctx context.Context, rule *IssueSuppressionRule) error {
+	return database.Transaction(ctx, func(ctx context.Context) error {
+		if err := db(ctx).DeleteIssueSuppressionRule(ctx, rule.UUID); err != nil {
+			return err
+		}
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:       activityLogEntryActionSuppressionRuleDeleted,
+			Actor:        authz.ActorFromContext(ctx).User,
+			ResourceType: activityLogEntryResourceTypeIssue,
+			ResourceName: rule.ResourceNamePattern,
+			TeamSlug:     new(rule.TeamSlug),
+			Data: &IssueSuppressionRuleActivityLogEntryData{
+				IssueType:           rule.IssueType,
+				ResourceNamePattern: rule.ResourceNamePattern,
+				Reason:              rule.Reason,
+			},
+		})
+	})
+}
+
+func getByIdent(ctx context.Context, id ident.Ident) (*issuesql.IssuesWithAcknowledgement, error) {
+	uid, err := uuid.Parse(id.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return db(ctx).GetIssueByID(ctx, uid)
+}
@@ -2,7 +2,7 @@
 SELECT
 	*
 FROM
-	issues
+	issues_with_acknowledgement
 WHERE
 	id = @id
 ;
@@ -27,13 +27,15 @@ WHERE
 ;
 
 -- name: ListIssues :many
+-- Issues for resources not tied to an environment, such as service account tokens, are listed separately.
 SELECT
-	*,
+	sqlc.embed(issues_with_acknowledgement),
 	COUNT(*) OVER () AS total_count
 FROM
-	issues
+	issues_with_acknowledgement
 WHERE
 	team = @team
+	AND env <> ''
 	AND (
 		sqlc.narg('env')::TEXT[] IS NULL
 		OR env = ANY (sqlc.narg('env')::TEXT[])
@@ -54,6 +56,10 @@ WHERE
 		sqlc.narg('resource_name')::TEXT IS NULL
 		OR resource_name = sqlc.narg('resource_name')::TEXT
 	)
+	AND (
+		sqlc.narg('acknowledged')::BOOLEAN IS NULL
+		OR acknowledged = sqlc.narg('acknowledged')::BOOLEAN
+	)
 ORDER BY
 	CASE
 		WHEN @order_by::TEXT = 'env:asc' THEN env
@@ -93,6 +99,25 @@ LIMIT
 	sqlc.arg('limit')
 ;
 
+-- name: ListServiceAccountTokenIssues :many
+SELECT
+	sqlc.embed(issues_with_acknowledgement),
+	COUNT(*) OVER () AS total_count
+FROM
+	issues_with_acknowledgement
+WHERE
+	team = @team
+	AND resource_type = @resource_type
+ORDER BY
+	severity DESC,
+	resource_name,
+	id
+OFFSET
+	sqlc.arg('offset')
+LIMIT
+	sqlc.arg('limit')
+;
+
 -- name: FacetsForIssues :many
 SELECT
 	severity,
@@ -121,11 +146,16 @@ SELECT
 				sqlc.narg('filter_resource_name')::TEXT IS NULL
 				OR resource_name = sqlc.narg('filter_resource_name')::TEXT
 			)
+			AND (
+				sqlc.narg('acknowledged')::BOOLEAN IS NULL
+				OR acknowledged = sqlc.narg('acknowledged')::BOOLEAN
+			)
 	) AS filtered_count
 FROM
-	issues
+	issues_with_acknowledgement
 WHERE
 	team = @team
+	AND env <> ''
 	AND (
 		sqlc.narg('scope_resource_type')::TEXT IS NULL
 		OR resource_type = sqlc.narg('scope_resource_type')::TEXT
@@ -149,3 +179,151 @@ ORDER BY
 	env,
 	issue_type
 ;
+
+-- name: ListIssueHistory :many
+SELECT
+	sqlc.embed(issue_history),
+	COUNT(*) OVER () AS total_count
+FROM
+	issue_history
+WHERE
+	team = @team
+	AND env <> ''
+	AND (
+		sqlc.narg('env')::TEXT[] IS NULL
+		OR env = ANY (sqlc.narg('env')::TEXT[])
+	)
+	AND (
+		sqlc.narg('issue_type')::TEXT IS NULL
+		OR issue_type = sqlc.narg('issue_type')::TEXT
+	)
+	AND (
+		sqlc.narg('resource_type')::TEXT IS NULL
+		OR resource_type = sqlc.narg('resource_type')::TEXT
+	)
+	AND (
+		sqlc.narg('resource_name')::TEXT IS NULL
+		OR resource_name = sqlc.narg('resource_name')::TEXT
+	)
+	AND (
+		sqlc.narg('resolved')::BOOLEAN IS NULL
+		OR (resolved_at IS NOT NULL) = sqlc.narg('resolved')::BOOLEAN
+	)
+	AND (
+		sqlc.narg('since')::TIMESTAMPTZ IS NULL
+		OR COALESCE(resolved_at, last_seen) >= sqlc.narg('since')::TIMESTAMPTZ
+	)
+ORDER BY
+	first_seen DESC,
+	id
+OFFSET
+	sqlc.arg('offset')
+LIMIT
+	sqlc.arg('limit')
+;
+
+-- name: MeanTimeToResolve :many
+SELECT
+	issue_type,
+	COUNT(*) AS resolved_count,
+	AVG(EXTRACT(EPOCH FROM resolved_at - first_seen))::DOUBLE PRECISION AS mean_seconds
+FROM
+	issue_history
+WHERE
+	team = @team
+	AND env <> ''
+	AND resolved_at IS NOT NULL
+	AND (
+		sqlc.narg('env')::TEXT[] IS NULL
+		OR env = ANY (sqlc.narg('env')::TEXT[])
+	)
+	AND (
+		sqlc.narg('issue_type')::TEXT IS NULL
+		OR issue_type = sqlc.narg('issue_type')::TEXT
+	)
+	AND (
+		sqlc.narg('resource_type')::TEXT IS NULL
+		OR resource_type = sqlc.narg('resource_type')::TEXT
+	)
+	AND (
+		sqlc.narg('resource_name')::TEXT IS NULL
+		OR resource_name = sqlc.narg('resource_name')::TEXT
+	)
+	AND (
+		sqlc.narg('since')::TIMESTAMPTZ IS NULL
+		OR resolved_at >= sqlc.narg('since')::TIMESTAMPTZ
+	)
+GROUP BY
+	issue_type
+ORDER BY
+	issue_type
+;
+
+-- name: AcknowledgeIssue :exec
+UPDATE issues
+SET
+	acknowledged_by = @acknowledged_by,
+	acknowledged_at = NOW(),
+	acknowledgement_reason = @reason,
+	acknowledged_until = sqlc.narg('until')
+WHERE
+	id = @id
+;
+
+-- name: RemoveIssueAcknowledgement :exec
+UPDATE issues
+SET
+	acknowledged_by = NULL,
+	acknowledged_at = NULL,
+	acknowledgement_reason = NULL,
+	acknowledged_until = NULL
+WHERE
+	id = @id
+;
+
+-- name: ListIssueSuppressionRules :many
+SELECT
+	sqlc.embed(issue_suppression_rules),
+	COUNT(*) OVER () AS total_count
+FROM
+	issue_suppression_rules
+WHERE
+	team_slug = @team_slug
+ORDER BY
+	issue_type,
+	resource_name_pattern
+OFFSET
+	sqlc.arg('offset')
+LIMIT
+	sqlc.arg('limit')
+;
+
+-- name: GetIssueSuppressionRule :one
+SELECT
+	*
+FROM
+	issue_suppression_rules
+WHERE
+	id = @id
+;
+
+-- name: CreateIssueSuppressionRule :one
+INSERT INTO
+	issue_suppression_rules (team_slug, issue_type, resource_name_pattern, reason, created_by)
+VALUES
+	(
+		@team_slug,
+		@issue_type,
+		@resource_name_pattern,
+		@reason,
+		@created_by
+	)
+RETURNING
+	*
+;
+
+-- name: DeleteIssueSuppressionRule :exec
+DELETE FROM issue_suppression_rules
+WHERE
+	id = @id
+;
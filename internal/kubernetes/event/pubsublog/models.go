@@ -11,3 +11,7 @@ type ClusterAuditActivityLogEntry struct {
 	activitylog.GenericActivityLogEntry
 	Data *ClusterAuditActivityLogEntryData
 }
+
+func (e ClusterAuditActivityLogEntry) GetData() any {
+	return e.Data
+}
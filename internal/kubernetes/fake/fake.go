@@ -3,11 +3,15 @@ package fake
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/fs"
 	"maps"
+	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -17,7 +21,9 @@ import (
 	nais_io_v1alpha1 "github.com/nais/liberator/pkg/apis/nais.io/v1alpha1"
 	data_nais_io_v1 "github.com/nais/pgrator/pkg/api/datav1"
 	unleash_nais_io_v1 "github.com/nais/unleasherator/api/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -217,8 +223,35 @@ func NewDynamicClient(scheme *runtime.Scheme) *dynfake.FakeDynamicClient {
 			unleash_nais_io_v1.GroupVersion.WithResource("remoteunleashes"):       "RemoteUnleashList",
 			data_nais_io_v1.GroupVersion.WithResource("postgres"):                 "PostgresList",
 			nais_io_v1alpha1.GroupVersion.WithResource("tunnels"):                 "TunnelList",
+
+			// Resources that can be applied (and pruned) through the apply endpoint, but are not in the scheme.
+			{Group: "aiven.io", Version: "v1alpha1", Resource: "serviceintegrations"}:                 "ServiceIntegrationList",
+			{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}:               "HorizontalPodAutoscalerList",
+			{Group: "bigquery.cnrm.cloud.google.com", Version: "v1beta1", Resource: "bigquerytables"}: "BigQueryTableList",
+			{Group: "iam.cnrm.cloud.google.com", Version: "v1beta1", Resource: "iampolicymembers"}:    "IAMPolicyMemberList",
+			{Group: "krakend.nais.io", Version: "v1", Resource: "apiendpoints"}:                       "ApiEndpointsList",
+			{Group: "krakend.nais.io", Version: "v1", Resource: "krakends"}:                           "KrakendList",
+			{Group: "logging.cnrm.cloud.google.com", Version: "v1beta1", Resource: "logginglogsinks"}: "LoggingLogSinkList",
+			{Group: "monitoring.coreos.com", Version: "v1", Resource: "probes"}:                       "ProbeList",
+			{Group: "monitoring.coreos.com", Version: "v1", Resource: "prometheusrules"}:              "PrometheusRuleList",
+			{Group: "monitoring.coreos.com", Version: "v1", Resource: "servicemonitors"}:              "ServiceMonitorList",
+			{Group: "monitoring.coreos.com", Version: "v1alpha1", Resource: "alertmanagerconfigs"}:    "AlertmanagerConfigList",
+			{Group: "nais.io", Version: "v1", Resource: "opensearches"}:                               "OpenSearchList",
+			{Group: "nais.io", Version: "v1", Resource: "valkeys"}:                                    "ValkeyList",
+			{Group: "nais.io", Version: "v1alpha1", Resource: "alerts"}:                               "AlertsList",
+			{Group: "nais.io", Version: "v1alpha1", Resource: "naisjobs"}:                             "NaisjobList",
+			{Group: "pubsub.cnrm.cloud.google.com", Version: "v1beta1", Resource: "pubsubtopics"}:     "PubSubTopicList",
 		})
 
+	// The tracker does not set resource versions, so set them on create like the API server does. The object is
+	// created by the default reactor.
+	client.PrependReactor("create", "*", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		if createAction, ok := action.(k8stesting.CreateAction); ok {
+			setInitialResourceVersion(createAction.GetObject())
+		}
+		return false, nil, nil
+	})
+
 	client.PrependReactor("patch", "*", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		patchAction, ok := action.(k8stesting.PatchAction)
 		if !ok {
@@ -255,6 +288,10 @@ func NewDynamicClient(scheme *runtime.Scheme) *dynfake.FakeDynamicClient {
 
 		modifiedJSON, err := patch.Apply(objJSON)
 		if err != nil {
+			if errors.Is(err, jsonpatch.ErrTestFailed) {
+				// The API server responds with 422 Unprocessable Entity when a test operation fails
+				return true, nil, k8serrors.NewGenericServerResponse(http.StatusUnprocessableEntity, "patch", gvr.GroupResource(), name, err.Error(), 0, false)
+			}
 			return true, nil, fmt.Errorf("applying patch: %w", err)
 		}
 
@@ -269,6 +306,7 @@ func NewDynamicClient(scheme *runtime.Scheme) *dynfake.FakeDynamicClient {
 		if modified.GetKind() == "" {
 			modified.SetKind(original.GetKind())
 		}
+		modified.SetResourceVersion(nextResourceVersion(original.GetResourceVersion()))
 
 		if err := client.Tracker().Update(gvr, modified, ns); err != nil {
 			return true, nil, fmt.Errorf("updating object: %w", err)
@@ -296,8 +334,16 @@ func NewDynamicClient(scheme *runtime.Scheme) *dynfake.FakeDynamicClient {
 			return true, nil, fmt.Errorf("unmarshaling apply patch: %w", err)
 		}
 
+		dryRun := false
+		if impl, ok := action.(k8stesting.PatchActionImpl); ok {
+			dryRun = slices.Contains(impl.GetPatchOptions().DryRun, metav1.DryRunAll)
+		}
+
 		existing, err := client.Tracker().Get(gvr, ns, name)
 		if err != nil {
+			if dryRun {
+				return true, desired, nil
+			}
 			if err := client.Tracker().Create(gvr, desired, ns); err != nil {
 				return true, nil, fmt.Errorf("creating object via apply: %w", err)
 			}
@@ -326,6 +372,10 @@ func NewDynamicClient(scheme *runtime.Scheme) *dynfake.FakeDynamicClient {
 			}
 		}
 
+		if dryRun {
+			return true, merged, nil
+		}
+
 		if err := client.Tracker().Update(gvr, merged, ns); err != nil {
 			return true, nil, fmt.Errorf("updating object via apply: %w", err)
 		}
@@ -367,6 +417,7 @@ func AddObjectToDynamicClient(scheme *runtime.Scheme, fc *dynfake.FakeDynamicCli
 
 			gvr.Resource = depluralized(gvr.Resource)
 			ns := obj.(namespaced).GetNamespace()
+			setInitialResourceVersion(obj)
 			if err := fc.Tracker().Create(gvr, obj, ns); err != nil {
 				panic(err)
 			}
@@ -374,6 +425,21 @@ func AddObjectToDynamicClient(scheme *runtime.Scheme, fc *dynfake.FakeDynamicCli
 	}
 }
 
+// setInitialResourceVersion sets the resource version of a new object, unless it is already set.
+func setInitialResourceVersion(obj runtime.Object) {
+	o, ok := obj.(metav1.Object)
+	if !ok || o.GetResourceVersion() != "" {
+		return
+	}
+	o.SetResourceVersion("1")
+}
+
+// nextResourceVersion returns the resource version of an object after it has been changed.
+func nextResourceVersion(resourceVersion string) string {
+	v, _ := strconv.Atoi(resourceVersion)
+	return strconv.Itoa(v + 1)
+}
+
 func newDynamicClient(scheme *runtime.Scheme, objs ...runtime.Object) dynamic.Interface {
 	fc := NewDynamicClient(scheme)
 	AddObjectToDynamicClient(scheme, fc, objs...)
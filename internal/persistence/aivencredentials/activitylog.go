@@ -41,6 +41,10 @@ type CredentialsActivityLogEntry struct {
 	Data *CredentialsActivityLogEntryData `json:"data"`
 }
 
+func (e CredentialsActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type CredentialsActivityLogEntryData struct {
 	Permission string `json:"permission,omitempty"`
 	TTL        string `json:"ttl"`
@@ -42,6 +42,8 @@ const (
 	pollTimeout  = 60 * time.Second
 
 	MaxTTLDefault = 30 * 24 * time.Hour // 30 days — used by OpenSearch and Valkey
+
+	labelKillAfter = "euthanaisa.nais.io/kill-after"
 )
 
 // CredentialRequest captures all the parameters needed to create credentials for any Aiven service.
@@ -120,7 +122,7 @@ func createOrUpdateAivenApplication(ctx context.Context, client dynamic.Interfac
 	res.SetAnnotations(kubernetes.WithCommonAnnotations(nil, actor.Identity()))
 	kubernetes.SetManagedByConsoleLabel(res)
 	labels := res.GetLabels()
-	labels["euthanaisa.nais.io/kill-after"] = strconv.FormatInt(expiresAt.Unix(), 10)
+	labels[labelKillAfter] = strconv.FormatInt(expiresAt.Unix(), 10)
 	res.SetLabels(labels)
 	res.Object["spec"] = spec
 
@@ -0,0 +1,54 @@
+package aivencredentials
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/nais/api/internal/kubernetes"
+	"github.com/nais/api/internal/kubernetes/watcher"
+	aiven_nais_io_v1 "github.com/nais/liberator/pkg/apis/aiven.nais.io/v1"
+)
+
+// NewWatcher watches the AivenApplications managed by Console, which includes the ones created for temporary
+// credentials.
+func NewWatcher(ctx context.Context, mgr *watcher.Manager) *watcher.Watcher[*aiven_nais_io_v1.AivenApplication] {
+	w := watcher.Watch(
+		mgr,
+		&aiven_nais_io_v1.AivenApplication{},
+		watcher.WithInformerFilter(kubernetes.IsManagedByConsoleLabelSelector()),
+	)
+	w.Start(ctx)
+	return w
+}
+
+// IsCredential returns whether the AivenApplication was created for temporary credentials.
+func IsCredential(app *aiven_nais_io_v1.AivenApplication) bool {
+	_, ok := app.GetLabels()[labelKillAfter]
+	return ok && kubernetes.HasManagedByConsoleLabel(app)
+}
+
+// CredentialService returns the name of the Aiven service the credentials of the AivenApplication are for.
+func CredentialService(app *aiven_nais_io_v1.AivenApplication) string {
+	switch {
+	case app.Spec.Kafka != nil:
+		return "Kafka"
+	case app.Spec.OpenSearch != nil:
+		return "OpenSearch"
+	case len(app.Spec.Valkey) > 0:
+		return "Valkey"
+	default:
+		return "Aiven"
+	}
+}
+
+// CredentialExpiresAt returns when the credentials of the AivenApplication expire, if known.
+func CredentialExpiresAt(app *aiven_nais_io_v1.AivenApplication) *time.Time {
+	if app.Spec.ExpiresAt != nil {
+		return &app.Spec.ExpiresAt.Time
+	}
+	if unix, err := strconv.ParseInt(app.GetLabels()[labelKillAfter], 10, 64); err == nil {
+		return new(time.Unix(unix, 0).UTC())
+	}
+	return nil
+}
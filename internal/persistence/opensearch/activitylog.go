@@ -59,6 +59,10 @@ type OpenSearchUpdatedActivityLogEntry struct {
 	Data *OpenSearchUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e OpenSearchUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type OpenSearchUpdatedActivityLogEntryData struct {
 	UpdatedFields []*OpenSearchUpdatedActivityLogEntryDataUpdatedField `json:"updatedFields"`
 }
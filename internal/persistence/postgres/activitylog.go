@@ -54,6 +54,10 @@ type PostgresGrantAccessActivityLogEntry struct {
 	Data *PostgresGrantAccessActivityLogEntryData `json:"data"`
 }
 
+func (e PostgresGrantAccessActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type PostgresGrantAccessActivityLogEntryData struct {
 	Grantee string    `json:"grantee,string"`
 	Until   time.Time `json:"until"`
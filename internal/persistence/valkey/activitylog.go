@@ -60,6 +60,10 @@ type ValkeyUpdatedActivityLogEntry struct {
 	Data *ValkeyUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e ValkeyUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ValkeyUpdatedActivityLogEntryData struct {
 	UpdatedFields []*ValkeyUpdatedActivityLogEntryDataUpdatedField `json:"updatedFields"`
 }
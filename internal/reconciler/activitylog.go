@@ -62,6 +62,10 @@ type ReconcilerConfiguredActivityLogEntry struct {
 	Data *ReconcilerConfiguredActivityLogEntryData `json:"data"`
 }
 
+func (e ReconcilerConfiguredActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ReconcilerConfiguredActivityLogEntryData struct {
 	UpdatedKeys []string `json:"updatedKeys"`
 }
@@ -1,7 +1,9 @@
 package reconciler
 
 import (
+	"encoding/base64"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/nais/api/internal/graph/ident"
@@ -15,6 +17,9 @@ type (
 	ReconcilerEdge            = pagination.Edge[*Reconciler]
 	ReconcilerErrorConnection = pagination.Connection[*ReconcilerError]
 	ReconcilerErrorEdge       = pagination.Edge[*ReconcilerError]
+
+	ReconcilerStateRevisionConnection = pagination.Connection[*ReconcilerStateRevision]
+	ReconcilerStateRevisionEdge       = pagination.Edge[*ReconcilerStateRevision]
 )
 
 type Reconciler struct {
@@ -90,6 +95,28 @@ func toGraphReconcilerError(row *reconcilersql.ReconcilerError) *ReconcilerError
 	}
 }
 
+type ReconcilerStateRevision struct {
+	Revision      int       `json:"revision"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Value         string    `json:"value"`
+	Base64Encoded bool      `json:"base64Encoded"`
+}
+
+func toGraphReconcilerStateRevision(row *reconcilersql.ReconcilerStateRevision) *ReconcilerStateRevision {
+	ret := &ReconcilerStateRevision{
+		Revision:  int(row.Revision),
+		CreatedAt: row.CreatedAt.Time,
+		Value:     string(row.Value),
+	}
+
+	if !utf8.Valid(row.Value) {
+		ret.Value = base64.StdEncoding.EncodeToString(row.Value)
+		ret.Base64Encoded = true
+	}
+
+	return ret
+}
+
 type ConfigureReconcilerInput struct {
 	Name   string                   `json:"name"`
 	Config []*ReconcilerConfigInput `json:"config"`
@@ -14,6 +14,7 @@ import (
 	"github.com/nais/api/internal/graph/ident"
 	"github.com/nais/api/internal/graph/pagination"
 	"github.com/nais/api/internal/reconciler/reconcilersql"
+	"github.com/nais/api/internal/slug"
 )
 
 func Get(ctx context.Context, name string) (*Reconciler, error) {
@@ -216,3 +217,24 @@ func GetErrors(ctx context.Context, reconcilerName string, page *pagination.Pagi
 		return toGraphReconcilerError(&from.ReconcilerError)
 	}), nil
 }
+
+func GetStateRevisions(ctx context.Context, reconcilerName string, teamSlug slug.Slug, page *pagination.Pagination) (*ReconcilerStateRevisionConnection, error) {
+	ret, err := db(ctx).ListStateRevisions(ctx, reconcilersql.ListStateRevisionsParams{
+		ReconcilerName: reconcilerName,
+		TeamSlug:       teamSlug,
+		Offset:         page.Offset(),
+		Limit:          page.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+
+	return pagination.NewConvertConnection(ret, page, total, func(from *reconcilersql.ListStateRevisionsRow) *ReconcilerStateRevision {
+		return toGraphReconcilerStateRevision(&from.ReconcilerStateRevision)
+	}), nil
+}
@@ -121,3 +121,20 @@ LIMIT
 OFFSET
 	sqlc.arg('offset')
 ;
+
+-- name: ListStateRevisions :many
+SELECT
+	sqlc.embed(reconciler_state_revisions),
+	COUNT(*) OVER () AS total_count
+FROM
+	reconciler_state_revisions
+WHERE
+	reconciler_name = @reconciler_name
+	AND team_slug = @team_slug
+ORDER BY
+	revision DESC
+LIMIT
+	sqlc.arg('limit')
+OFFSET
+	sqlc.arg('offset')
+;
@@ -24,3 +24,12 @@ type ReconcilerError struct {
 	ErrorMessage  string
 	TeamSlug      slug.Slug
 }
+
+type ReconcilerStateRevision struct {
+	ID             uuid.UUID
+	ReconcilerName string
+	TeamSlug       slug.Slug
+	Revision       int32
+	Value          []byte
+	CreatedAt      pgtype.Timestamptz
+}
@@ -19,6 +19,7 @@ type Querier interface {
 	ListByNames(ctx context.Context, names []string) ([]*Reconciler, error)
 	ListEnabledReconcilers(ctx context.Context) ([]*Reconciler, error)
 	ListReconcilerErrors(ctx context.Context, arg ListReconcilerErrorsParams) ([]*ListReconcilerErrorsRow, error)
+	ListStateRevisions(ctx context.Context, arg ListStateRevisionsParams) ([]*ListStateRevisionsRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/nais/api/internal/slug"
 )
 
 const configure = `-- name: Configure :exec
@@ -367,3 +368,65 @@ func (q *Queries) ListReconcilerErrors(ctx context.Context, arg ListReconcilerEr
 	}
 	return items, nil
 }
+
+const listStateRevisions = `-- name: ListStateRevisions :many
+SELECT
+	reconciler_state_revisions.id, reconciler_state_revisions.reconciler_name, reconciler_state_revisions.team_slug, reconciler_state_revisions.revision, reconciler_state_revisions.value, reconciler_state_revisions.created_at,
+	COUNT(*) OVER () AS total_count
+FROM
+	reconciler_state_revisions
+WHERE
+	reconciler_name = $1
+	AND team_slug = $2
+ORDER BY
+	revision DESC
+LIMIT
+	$4
+OFFSET
+	$3
+`
+
+type ListStateRevisionsParams struct {
+	ReconcilerName string
+	TeamSlug       slug.Slug
+	Offset         int32
+	Limit          int32
+}
+
+type ListStateRevisionsRow struct {
+	ReconcilerStateRevision ReconcilerStateRevision
+	TotalCount              int64
+}
+
+func (q *Queries) ListStateRevisions(ctx context.Context, arg ListStateRevisionsParams) ([]*ListStateRevisionsRow, error) {
+	rows, err := q.db.Query(ctx, listStateRevisions,
+		arg.ReconcilerName,
+		arg.TeamSlug,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListStateRevisionsRow{}
+	for rows.Next() {
+		var i ListStateRevisionsRow
+		if err := rows.Scan(
+			&i.ReconcilerStateRevision.ID,
+			&i.ReconcilerStateRevision.ReconcilerName,
+			&i.ReconcilerStateRevision.TeamSlug,
+			&i.ReconcilerStateRevision.Revision,
+			&i.ReconcilerStateRevision.Value,
+			&i.ReconcilerStateRevision.CreatedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/nais/api/internal/apply"
 	"github.com/nais/api/internal/auth/authn"
 	"github.com/nais/api/internal/auth/middleware"
+	"github.com/nais/api/internal/rest/restactivitylogapi"
 	"github.com/nais/api/internal/rest/restteamsapi"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -26,7 +27,8 @@ type Config struct {
 	ListenAddress string
 	Pool          *pgxpool.Pool
 	PreSharedKey  string
-	DynamicClient apply.DynamicClientFactory
+	// ApplyHandler handles requests to the apply endpoint. The same handler is used by the GraphQL API.
+	ApplyHandler *apply.Handler
 	// ContextMiddleware sets up the request context with all loaders and
 	// dependencies needed by the apply handler (authz, activitylog, etc.).
 	// In production this is the middleware returned by ConfigureGraph.
@@ -84,7 +86,7 @@ func MakeRouter(ctx context.Context, cfg Config) *chi.Mux {
 		})
 	}
 
-	// Apply route with user authentication.
+	// Apply and activity log export routes with user authentication.
 	router.Group(func(r chi.Router) {
 		r.Use(cfg.ContextMiddleware)
 
@@ -112,8 +114,8 @@ func MakeRouter(ctx context.Context, cfg Config) *chi.Mux {
 			middleware.RequireAuthenticatedUser(),
 		)
 
-		handler := apply.NewHandler(cfg.DynamicClient, cfg.Log)
-		r.Post("/api/v1/teams/{teamSlug}/environments/{environment}/apply", handler.ServeHTTP)
+		r.Post("/api/v1/teams/{teamSlug}/environments/{environment}/apply", cfg.ApplyHandler.ServeHTTP)
+		r.Get("/api/v1/teams/{teamSlug}/activity-log", restactivitylogapi.ExportHandler(cfg.Log))
 	})
 
 	return router
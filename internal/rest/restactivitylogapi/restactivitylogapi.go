@@ -0,0 +1,212 @@
+package restactivitylogapi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/rest/resterror"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/team"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	FormatNDJSON = "ndjson"
+	FormatCSV    = "csv"
+)
+
+// flushInterval is the number of entries written between each flush of the response
+const flushInterval = 100
+
+var csvHeader = []string{
+	"id",
+	"createdAt",
+	"actor",
+	"action",
+	"resourceType",
+	"resourceName",
+	"teamSlug",
+	"environmentName",
+	"message",
+	"data",
+}
+
+// ExportHandler streams the activity log of a team as NDJSON or CSV. The time range is given by the optional from
+// and to query parameters, as RFC 3339 timestamps or dates. The format is given by the format query parameter, and
+// defaults to NDJSON. Archived entries are included, before the other entries, when the archived query parameter is
+// true.
+//
+// The request context must be set up with the activitylog and authz loaders.
+func ExportHandler(log logrus.FieldLogger) http.HandlerFunc {
+	return func(rsp http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		teamSlug := slug.Slug(req.PathValue("teamSlug"))
+
+		if err := teamSlug.Validate(); err != nil {
+			resterror.Wrap(http.StatusBadRequest, err).Write(rsp)
+			return
+		}
+
+		if err := authz.CanReadActivityLogs(ctx, &teamSlug); err != nil {
+			if errors.As(err, &authz.ErrMissingAuthorization{}) {
+				resterror.Wrap(http.StatusForbidden, err).Write(rsp)
+				return
+			}
+			log.WithError(err).Error("failed to check authorization")
+			resterror.Wrap(http.StatusInternalServerError, err).Write(rsp)
+			return
+		}
+
+		exists, err := team.Exists(ctx, teamSlug)
+		if err != nil {
+			log.WithError(err).Error("failed to lookup team")
+			resterror.Wrap(http.StatusInternalServerError, err).Write(rsp)
+			return
+		}
+		if !exists {
+			resterror.Wrap(http.StatusNotFound, team.ErrNotFound{}).Write(rsp)
+			return
+		}
+
+		from, err := parseTime(req, "from")
+		if err != nil {
+			resterror.Wrap(http.StatusBadRequest, err).Write(rsp)
+			return
+		}
+
+		to, err := parseTime(req, "to")
+		if err != nil {
+			resterror.Wrap(http.StatusBadRequest, err).Write(rsp)
+			return
+		}
+
+		var archived bool
+		if v := req.URL.Query().Get("archived"); v != "" {
+			archived, err = strconv.ParseBool(v)
+			if err != nil {
+				resterror.Wrap(http.StatusBadRequest, fmt.Errorf("invalid archived: %q", v)).Write(rsp)
+				return
+			}
+		}
+
+		format := req.URL.Query().Get("format")
+		if format == "" {
+			format = FormatNDJSON
+		}
+
+		var contentType string
+		switch format {
+		case FormatNDJSON:
+			contentType = "application/x-ndjson"
+		case FormatCSV:
+			contentType = "text/csv; charset=utf-8"
+		default:
+			resterror.Wrap(http.StatusBadRequest, fmt.Errorf("unsupported format %q, must be one of %q or %q", format, FormatNDJSON, FormatCSV)).Write(rsp)
+			return
+		}
+
+		rsp.Header().Set("Content-Type", contentType)
+		rsp.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-activity-log.%s", teamSlug, format)))
+		rsp.WriteHeader(http.StatusOK)
+
+		var (
+			write func(*activitylog.ExportEntry) error
+			flush func() error
+		)
+		if format == FormatCSV {
+			w := csv.NewWriter(rsp)
+			write = func(entry *activitylog.ExportEntry) error { return writeCSV(w, entry) }
+			flush = func() error {
+				w.Flush()
+				return w.Error()
+			}
+
+			if err := w.Write(csvHeader); err != nil {
+				log.WithError(err).Error("failed to write csv header")
+				return
+			}
+		} else {
+			enc := json.NewEncoder(rsp)
+			write = func(entry *activitylog.ExportEntry) error { return enc.Encode(entry) }
+			flush = func() error { return nil }
+		}
+
+		rc := http.NewResponseController(rsp)
+		n := 0
+		writeEntry := func(entry *activitylog.ExportEntry) error {
+			if err := write(entry); err != nil {
+				return err
+			}
+
+			n++
+			if n%flushInterval == 0 {
+				if err := flush(); err != nil {
+					return err
+				}
+				if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if archived {
+			err = activitylog.ExportArchivedForTeam(ctx, teamSlug, from, to, writeEntry)
+		}
+		if err == nil {
+			err = activitylog.ExportForTeam(ctx, teamSlug, from, to, writeEntry)
+		}
+		if err == nil {
+			err = flush()
+		}
+		if err != nil {
+			// The status code has already been sent, so the client will only see a truncated response.
+			log.WithError(err).WithField("team_slug", teamSlug).Error("failed to export activity log")
+		}
+	}
+}
+
+// writeCSV writes the entry as a CSV record. The flattened data is written as a JSON object in a single column, as the
+// set of data fields differs between entries.
+func writeCSV(w *csv.Writer, entry *activitylog.ExportEntry) error {
+	data, err := json.Marshal(entry.Data)
+	if err != nil {
+		return err
+	}
+
+	return w.Write([]string{
+		entry.ID.String(),
+		entry.CreatedAt.Format(time.RFC3339Nano),
+		entry.Actor,
+		entry.Action,
+		entry.ResourceType,
+		entry.ResourceName,
+		entry.TeamSlug,
+		entry.EnvironmentName,
+		entry.Message,
+		string(data),
+	})
+}
+
+// parseTime parses the query parameter as an RFC 3339 timestamp or a date. Returns nil if the parameter is not set.
+func parseTime(req *http.Request, name string) (*time.Time, error) {
+	v := req.URL.Query().Get(name)
+	if v == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid %s: %q, must be an RFC 3339 timestamp or a date (YYYY-MM-DD)", name, v)
+}
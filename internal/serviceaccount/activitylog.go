@@ -153,6 +153,10 @@ type RoleAssignedToServiceAccountActivityLogEntry struct {
 	Data *RoleAssignedToServiceAccountActivityLogEntryData `json:"data"`
 }
 
+func (e RoleAssignedToServiceAccountActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type RoleAssignedToServiceAccountActivityLogEntryData struct {
 	RoleName string `json:"roleName"`
 }
@@ -162,6 +166,10 @@ type RoleRevokedFromServiceAccountActivityLogEntry struct {
 	Data *RoleRevokedFromServiceAccountActivityLogEntryData `json:"data"`
 }
 
+func (e RoleRevokedFromServiceAccountActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type RoleRevokedFromServiceAccountActivityLogEntryData struct {
 	RoleName string `json:"roleName"`
 }
@@ -179,6 +187,10 @@ type ServiceAccountTokenCreatedActivityLogEntry struct {
 	Data *ServiceAccountTokenCreatedActivityLogEntryData `json:"data"`
 }
 
+func (e ServiceAccountTokenCreatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ServiceAccountTokenCreatedActivityLogEntryData struct {
 	TokenName string `json:"tokenName"`
 }
@@ -188,11 +200,19 @@ type ServiceAccountTokenDeletedActivityLogEntry struct {
 	Data *ServiceAccountTokenDeletedActivityLogEntryData `json:"data"`
 }
 
+func (e ServiceAccountTokenDeletedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ServiceAccountTokenUpdatedActivityLogEntry struct {
 	activitylog.GenericActivityLogEntry
 	Data *ServiceAccountTokenUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e ServiceAccountTokenUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ServiceAccountTokenUpdatedActivityLogEntryData struct {
 	UpdatedFields []*ServiceAccountTokenUpdatedActivityLogEntryDataUpdatedField `json:"updatedFields"`
 }
@@ -208,6 +228,10 @@ type ServiceAccountUpdatedActivityLogEntry struct {
 	Data *ServiceAccountUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e ServiceAccountUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ServiceAccountUpdatedActivityLogEntryData struct {
 	UpdatedFields []*ServiceAccountUpdatedActivityLogEntryDataUpdatedField `json:"updatedFields"`
 }
@@ -227,6 +251,10 @@ type ServiceAccountWorkloadBindingAddedActivityLogEntry struct {
 	Data *ServiceAccountWorkloadBindingAddedActivityLogEntryData `json:"data"`
 }
 
+func (e ServiceAccountWorkloadBindingAddedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ServiceAccountWorkloadBindingAddedActivityLogEntryData struct {
 	TeamSlug     slug.Slug `json:"teamSlug"`
 	WorkloadName string    `json:"workloadName"`
@@ -237,6 +265,10 @@ type ServiceAccountWorkloadBindingRemovedActivityLogEntry struct {
 	Data *ServiceAccountWorkloadBindingRemovedActivityLogEntryData `json:"data"`
 }
 
+func (e ServiceAccountWorkloadBindingRemovedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ServiceAccountWorkloadBindingRemovedActivityLogEntryData struct {
 	TeamSlug     slug.Slug `json:"teamSlug"`
 	WorkloadName string    `json:"workloadName"`
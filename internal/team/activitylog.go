@@ -10,11 +10,16 @@ import (
 )
 
 const (
-	activityLogEntryResourceTypeTeam        activitylog.ActivityLogEntryResourceType = "TEAM"
-	activityLogEntryActionCreateDeleteKey   activitylog.ActivityLogEntryAction       = "CREATE_DELETE_KEY"
-	activityLogEntryActionConfirmDeleteKey  activitylog.ActivityLogEntryAction       = "CONFIRM_DELETE_KEY"
-	activityLogEntryActionSetMemberRole     activitylog.ActivityLogEntryAction       = "SET_MEMBER_ROLE"
-	activityLogEntryActionUpdateEnvironment activitylog.ActivityLogEntryAction       = "UPDATE_ENVIRONMENT"
+	activityLogEntryResourceTypeTeam            activitylog.ActivityLogEntryResourceType = "TEAM"
+	activityLogEntryActionCreateDeleteKey       activitylog.ActivityLogEntryAction       = "CREATE_DELETE_KEY"
+	activityLogEntryActionConfirmDeleteKey      activitylog.ActivityLogEntryAction       = "CONFIRM_DELETE_KEY"
+	activityLogEntryActionSetMemberRole         activitylog.ActivityLogEntryAction       = "SET_MEMBER_ROLE"
+	activityLogEntryActionUpdateEnvironment     activitylog.ActivityLogEntryAction       = "UPDATE_ENVIRONMENT"
+	activityLogEntryActionCreateCustomRole      activitylog.ActivityLogEntryAction       = "CREATE_CUSTOM_ROLE"
+	activityLogEntryActionUpdateCustomRole      activitylog.ActivityLogEntryAction       = "UPDATE_CUSTOM_ROLE"
+	activityLogEntryActionDeleteCustomRole      activitylog.ActivityLogEntryAction       = "DELETE_CUSTOM_ROLE"
+	activityLogEntryActionSetMemberCustomRole   activitylog.ActivityLogEntryAction       = "SET_MEMBER_CUSTOM_ROLE"
+	activityLogEntryActionSetMemberEnvironments activitylog.ActivityLogEntryAction       = "SET_MEMBER_ENVIRONMENTS"
 )
 
 func init() {
@@ -92,6 +97,61 @@ func init() {
 				GenericActivityLogEntry: entry.WithMessage("Update environment"),
 				Data:                    data,
 			}, nil
+		case activityLogEntryActionCreateCustomRole:
+			data, err := activitylog.TransformData(entry, func(data *TeamCustomRoleCreatedActivityLogEntryData) *TeamCustomRoleCreatedActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+			return TeamCustomRoleCreatedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Create custom role"),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionUpdateCustomRole:
+			data, err := activitylog.TransformData(entry, func(data *TeamCustomRoleUpdatedActivityLogEntryData) *TeamCustomRoleUpdatedActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+			return TeamCustomRoleUpdatedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Update custom role"),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionDeleteCustomRole:
+			data, err := activitylog.TransformData(entry, func(data *TeamCustomRoleDeletedActivityLogEntryData) *TeamCustomRoleDeletedActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+			return TeamCustomRoleDeletedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Delete custom role"),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionSetMemberCustomRole:
+			data, err := activitylog.TransformData(entry, func(data *TeamMemberSetCustomRoleActivityLogEntryData) *TeamMemberSetCustomRoleActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+			return TeamMemberSetCustomRoleActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Set member custom role"),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionSetMemberEnvironments:
+			data, err := activitylog.TransformData(entry, func(data *TeamMemberSetEnvironmentsActivityLogEntryData) *TeamMemberSetEnvironmentsActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+			return TeamMemberSetEnvironmentsActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Set member environments"),
+				Data:                    data,
+			}, nil
 		default:
 			return nil, fmt.Errorf("unsupported team activity log entry action: %q", entry.Action)
 		}
@@ -105,6 +165,11 @@ func init() {
 	activitylog.RegisterFilter("TEAM_MEMBER_REMOVED", activitylog.ActivityLogEntryActionRemoved, activityLogEntryResourceTypeTeam)
 	activitylog.RegisterFilter("TEAM_MEMBER_SET_ROLE", activityLogEntryActionSetMemberRole, activityLogEntryResourceTypeTeam)
 	activitylog.RegisterFilter("TEAM_ENVIRONMENT_UPDATED", activityLogEntryActionUpdateEnvironment, activityLogEntryResourceTypeTeam)
+	activitylog.RegisterFilter("TEAM_CUSTOM_ROLE_CREATED", activityLogEntryActionCreateCustomRole, activityLogEntryResourceTypeTeam)
+	activitylog.RegisterFilter("TEAM_CUSTOM_ROLE_UPDATED", activityLogEntryActionUpdateCustomRole, activityLogEntryResourceTypeTeam)
+	activitylog.RegisterFilter("TEAM_CUSTOM_ROLE_DELETED", activityLogEntryActionDeleteCustomRole, activityLogEntryResourceTypeTeam)
+	activitylog.RegisterFilter("TEAM_MEMBER_SET_CUSTOM_ROLE", activityLogEntryActionSetMemberCustomRole, activityLogEntryResourceTypeTeam)
+	activitylog.RegisterFilter("TEAM_MEMBER_SET_ENVIRONMENTS", activityLogEntryActionSetMemberEnvironments, activityLogEntryResourceTypeTeam)
 }
 
 type TeamCreatedActivityLogEntry struct {
@@ -116,6 +181,10 @@ type TeamUpdatedActivityLogEntry struct {
 	Data *TeamUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e TeamUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type TeamUpdatedActivityLogEntryData struct {
 	UpdatedFields []*TeamUpdatedActivityLogEntryDataUpdatedField `json:"updatedFields"`
 }
@@ -139,6 +208,10 @@ type TeamMemberAddedActivityLogEntry struct {
 	Data *TeamMemberAddedActivityLogEntryData `json:"data"`
 }
 
+func (e TeamMemberAddedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type TeamMemberAddedActivityLogEntryData struct {
 	Role      TeamMemberRole `json:"role"`
 	UserUUID  uuid.UUID      `json:"userID"`
@@ -154,6 +227,10 @@ type TeamMemberRemovedActivityLogEntry struct {
 	Data *TeamMemberRemovedActivityLogEntryData `json:"data"`
 }
 
+func (e TeamMemberRemovedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type TeamMemberRemovedActivityLogEntryData struct {
 	UserUUID  uuid.UUID `json:"userID"`
 	UserEmail string    `json:"userEmail"`
@@ -168,6 +245,10 @@ type TeamMemberSetRoleActivityLogEntry struct {
 	Data *TeamMemberSetRoleActivityLogEntryData `json:"data"`
 }
 
+func (e TeamMemberSetRoleActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type TeamMemberSetRoleActivityLogEntryData struct {
 	Role      TeamMemberRole `json:"role"`
 	UserUUID  uuid.UUID      `json:"userID"`
@@ -183,6 +264,10 @@ type TeamEnvironmentUpdatedActivityLogEntry struct {
 	Data *TeamEnvironmentUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e TeamEnvironmentUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type TeamEnvironmentUpdatedActivityLogEntryData struct {
 	UpdatedFields []*TeamEnvironmentUpdatedActivityLogEntryDataUpdatedField `json:"updatedFields"`
 }
@@ -192,3 +277,82 @@ type TeamEnvironmentUpdatedActivityLogEntryDataUpdatedField struct {
 	OldValue *string `json:"oldValue"`
 	NewValue *string `json:"newValue"`
 }
+
+type TeamCustomRoleCreatedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *TeamCustomRoleCreatedActivityLogEntryData `json:"data"`
+}
+
+func (e TeamCustomRoleCreatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type TeamCustomRoleCreatedActivityLogEntryData struct {
+	Name           string   `json:"name"`
+	Authorizations []string `json:"authorizations"`
+}
+
+type TeamCustomRoleUpdatedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *TeamCustomRoleUpdatedActivityLogEntryData `json:"data"`
+}
+
+func (e TeamCustomRoleUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type TeamCustomRoleUpdatedActivityLogEntryData struct {
+	Name           string   `json:"name"`
+	Authorizations []string `json:"authorizations"`
+}
+
+type TeamCustomRoleDeletedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *TeamCustomRoleDeletedActivityLogEntryData `json:"data"`
+}
+
+func (e TeamCustomRoleDeletedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type TeamCustomRoleDeletedActivityLogEntryData struct {
+	Name string `json:"name"`
+}
+
+type TeamMemberSetCustomRoleActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *TeamMemberSetCustomRoleActivityLogEntryData `json:"data"`
+}
+
+func (e TeamMemberSetCustomRoleActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type TeamMemberSetCustomRoleActivityLogEntryData struct {
+	CustomRoleName *string   `json:"customRoleName"`
+	UserUUID       uuid.UUID `json:"userID"`
+	UserEmail      string    `json:"userEmail"`
+}
+
+func (t TeamMemberSetCustomRoleActivityLogEntryData) UserID() ident.Ident {
+	return user.NewIdent(t.UserUUID)
+}
+
+type TeamMemberSetEnvironmentsActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *TeamMemberSetEnvironmentsActivityLogEntryData `json:"data"`
+}
+
+func (e TeamMemberSetEnvironmentsActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type TeamMemberSetEnvironmentsActivityLogEntryData struct {
+	Environments []string  `json:"environments"`
+	UserUUID     uuid.UUID `json:"userID"`
+	UserEmail    string    `json:"userEmail"`
+}
+
+func (t TeamMemberSetEnvironmentsActivityLogEntryData) UserID() ident.Ident {
+	return user.NewIdent(t.UserUUID)
+}
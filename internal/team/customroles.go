@@ -0,0 +1,422 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/graph/apierror"
+	"github.com/nais/api/internal/graph/ident"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/team/teamsql"
+	"github.com/nais/api/internal/validate"
+)
+
+type (
+	CustomTeamRoleConnection = pagination.Connection[*CustomTeamRole]
+	CustomTeamRoleEdge       = pagination.Edge[*CustomTeamRole]
+)
+
+const customTeamRoleNameMaxLength = 80
+
+// CustomTeamRole is a role defined by a team. Members with a custom role are granted the authorizations of the custom
+// role instead of the authorizations of their team role.
+type CustomTeamRole struct {
+	UUID           uuid.UUID `json:"-"`
+	TeamSlug       slug.Slug `json:"-"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Authorizations []string  `json:"authorizations"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+func (CustomTeamRole) IsNode() {}
+
+func (r CustomTeamRole) ID() ident.Ident {
+	return newCustomTeamRoleIdent(r.TeamSlug, r.Name)
+}
+
+// CustomTeamRoleAuthorization is an authorization that can be granted by a custom team role.
+type CustomTeamRoleAuthorization struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CreateCustomTeamRoleInput struct {
+	TeamSlug       slug.Slug `json:"teamSlug"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Authorizations []string  `json:"authorizations"`
+}
+
+func (i *CreateCustomTeamRoleInput) Validate(ctx context.Context) error {
+	verr := validate.New()
+	i.Name = strings.TrimSpace(i.Name)
+	i.Description = strings.TrimSpace(i.Description)
+
+	if i.Name == "" || len(i.Name) > customTeamRoleNameMaxLength {
+		verr.Add("name", "The name must contain at least 1 character and at most %d characters.", customTeamRoleNameMaxLength)
+	}
+
+	if err := validateCustomRoleAuthorizations(ctx, verr, i.Authorizations); err != nil {
+		return err
+	}
+
+	return verr.NilIfEmpty()
+}
+
+type CreateCustomTeamRolePayload struct {
+	CustomRole *CustomTeamRole `json:"customRole"`
+}
+
+type UpdateCustomTeamRoleInput struct {
+	TeamSlug       slug.Slug `json:"teamSlug"`
+	Name           string    `json:"name"`
+	Description    *string   `json:"description"`
+	Authorizations []string  `json:"authorizations"`
+}
+
+func (i *UpdateCustomTeamRoleInput) Validate(ctx context.Context) error {
+	verr := validate.New()
+
+	if i.Description != nil {
+		i.Description = new(strings.TrimSpace(*i.Description))
+	}
+
+	if i.Authorizations != nil {
+		if err := validateCustomRoleAuthorizations(ctx, verr, i.Authorizations); err != nil {
+			return err
+		}
+	}
+
+	return verr.NilIfEmpty()
+}
+
+type UpdateCustomTeamRolePayload struct {
+	CustomRole *CustomTeamRole `json:"customRole"`
+}
+
+type DeleteCustomTeamRoleInput struct {
+	TeamSlug slug.Slug `json:"teamSlug"`
+	Name     string    `json:"name"`
+}
+
+type DeleteCustomTeamRolePayload struct {
+	CustomRoleDeleted *bool `json:"customRoleDeleted"`
+}
+
+type SetTeamMemberCustomRoleInput struct {
+	TeamSlug       slug.Slug `json:"teamSlug"`
+	UserEmail      string    `json:"userEmail"`
+	CustomRoleName *string   `json:"customRoleName"`
+	UserID         uuid.UUID `json:"-"`
+}
+
+type SetTeamMemberCustomRolePayload struct {
+	Member *TeamMember `json:"member"`
+}
+
+// validateCustomRoleAuthorizations makes sure a custom role only grants authorizations that team owners have, so a
+// custom role can never be used to gain more access to a team than an owner of the team has.
+func validateCustomRoleAuthorizations(ctx context.Context, verr *validate.ValidationErrors, authorizations []string) error {
+	if len(authorizations) == 0 {
+		verr.Add("authorizations", "A custom role must grant at least one authorization.")
+		return nil
+	}
+
+	allowed, err := db(ctx).ListCustomRoleAuthorizations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range authorizations {
+		if !slices.ContainsFunc(allowed, func(a *teamsql.Authorization) bool { return a.Name == name }) {
+			verr.Add("authorizations", "%q can not be granted by a custom role.", name)
+		}
+	}
+
+	return nil
+}
+
+func toGraphCustomTeamRole(m *teamsql.TeamCustomRole, authorizations []string) *CustomTeamRole {
+	return &CustomTeamRole{
+		UUID:           m.ID,
+		TeamSlug:       m.TeamSlug,
+		Name:           m.Name,
+		Description:    m.Description,
+		Authorizations: authorizations,
+		CreatedAt:      m.CreatedAt.Time,
+		UpdatedAt:      m.UpdatedAt.Time,
+	}
+}
+
+func ListCustomRoles(ctx context.Context, teamSlug slug.Slug, page *pagination.Pagination) (*CustomTeamRoleConnection, error) {
+	ret, err := db(ctx).ListCustomRoles(ctx, teamsql.ListCustomRolesParams{
+		TeamSlug: teamSlug,
+		Offset:   page.Offset(),
+		Limit:    page.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+	return pagination.NewConvertConnection(ret, page, total, func(from *teamsql.ListCustomRolesRow) *CustomTeamRole {
+		return toGraphCustomTeamRole(&from.TeamCustomRole, from.Authorizations)
+	}), nil
+}
+
+func GetCustomRole(ctx context.Context, teamSlug slug.Slug, name string) (*CustomTeamRole, error) {
+	m, err := db(ctx).GetCustomRole(ctx, teamsql.GetCustomRoleParams{
+		TeamSlug: teamSlug,
+		Name:     name,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.Errorf("The team has no custom role named %q.", name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return toGraphCustomTeamRole(&m.TeamCustomRole, m.Authorizations), nil
+}
+
+func GetCustomRoleByID(ctx context.Context, id uuid.UUID) (*CustomTeamRole, error) {
+	m, err := db(ctx).GetCustomRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphCustomTeamRole(&m.TeamCustomRole, m.Authorizations), nil
+}
+
+func GetCustomRoleByIdent(ctx context.Context, id ident.Ident) (*CustomTeamRole, error) {
+	teamSlug, name, err := parseCustomTeamRoleIdent(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCustomRole(ctx, teamSlug, name)
+}
+
+// ListCustomRoleAuthorizations returns the authorizations that can be granted by custom team roles.
+func ListCustomRoleAuthorizations(ctx context.Context) ([]*CustomTeamRoleAuthorization, error) {
+	ret, err := db(ctx).ListCustomRoleAuthorizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizations := make([]*CustomTeamRoleAuthorization, len(ret))
+	for i, a := range ret {
+		authorizations[i] = &CustomTeamRoleAuthorization{
+			Name:        a.Name,
+			Description: a.Description,
+		}
+	}
+	return authorizations, nil
+}
+
+func CreateCustomRole(ctx context.Context, input CreateCustomTeamRoleInput, actor *authz.Actor) (*CustomTeamRole, error) {
+	if err := input.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	err := database.Transaction(ctx, func(ctx context.Context) error {
+		role, err := db(ctx).CreateCustomRole(ctx, teamsql.CreateCustomRoleParams{
+			TeamSlug:    input.TeamSlug,
+			Name:        input.Name,
+			Description: input.Description,
+		})
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return apierror.Errorf("The team already has a custom role named %q.", input.Name)
+			}
+			return err
+		}
+
+		err = db(ctx).AddCustomRoleAuthorizations(ctx, teamsql.AddCustomRoleAuthorizationsParams{
+			CustomRoleID:   role.ID,
+			Authorizations: input.Authorizations,
+		})
+		if err != nil {
+			return err
+		}
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:       activityLogEntryActionCreateCustomRole,
+			Actor:        actor.User,
+			ResourceType: activityLogEntryResourceTypeTeam,
+			ResourceName: input.TeamSlug.String(),
+			TeamSlug:     new(input.TeamSlug),
+			Data: &TeamCustomRoleCreatedActivityLogEntryData{
+				Name:           input.Name,
+				Authorizations: input.Authorizations,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCustomRole(ctx, input.TeamSlug, input.Name)
+}
+
+func UpdateCustomRole(ctx context.Context, input UpdateCustomTeamRoleInput, actor *authz.Actor) (*CustomTeamRole, error) {
+	existing, err := GetCustomRole(ctx, input.TeamSlug, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := input.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	err = database.Transaction(ctx, func(ctx context.Context) error {
+		err := db(ctx).UpdateCustomRole(ctx, teamsql.UpdateCustomRoleParams{
+			ID:          existing.UUID,
+			Description: input.Description,
+		})
+		if err != nil {
+			return err
+		}
+
+		authorizations := existing.Authorizations
+		if input.Authorizations != nil {
+			authorizations = input.Authorizations
+			if err := db(ctx).RemoveCustomRoleAuthorizations(ctx, existing.UUID); err != nil {
+				return err
+			}
+
+			err = db(ctx).AddCustomRoleAuthorizations(ctx, teamsql.AddCustomRoleAuthorizationsParams{
+				CustomRoleID:   existing.UUID,
+				Authorizations: input.Authorizations,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:       activityLogEntryActionUpdateCustomRole,
+			Actor:        actor.User,
+			ResourceType: activityLogEntryResourceTypeTeam,
+			ResourceName: input.TeamSlug.String(),
+			TeamSlug:     new(input.TeamSlug),
+			Data: &TeamCustomRoleUpdatedActivityLogEntryData{
+				Name:           input.Name,
+				Authorizations: authorizations,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCustomRole(ctx, input.TeamSlug, input.Name)
+}
+
+// DeleteCustomRole deletes a custom role. Members with the custom role are granted the authorizations of their team
+// role again.
+func DeleteCustomRole(ctx context.Context, input DeleteCustomTeamRoleInput, actor *authz.Actor) error {
+	existing, err := GetCustomRole(ctx, input.TeamSlug, input.Name)
+	if err != nil {
+		return err
+	}
+
+	return database.Transaction(ctx, func(ctx context.Context) error {
+		if err := db(ctx).DeleteCustomRole(ctx, existing.UUID); err != nil {
+			return err
+		}
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:       activityLogEntryActionDeleteCustomRole,
+			Actor:        actor.User,
+			ResourceType: activityLogEntryResourceTypeTeam,
+			ResourceName: input.TeamSlug.String(),
+			TeamSlug:     new(input.TeamSlug),
+			Data: &TeamCustomRoleDeletedActivityLogEntryData{
+				Name: input.Name,
+			},
+		})
+	})
+}
+
+// SetMemberCustomRole assigns a custom role to a member of the team. When input.CustomRoleName is nil the custom role
+// of the member is removed, and the member is granted the authorizations of the member's team role again.
+func SetMemberCustomRole(ctx context.Context, input SetTeamMemberCustomRoleInput, actor *authz.Actor) (*TeamMember, error) {
+	m, err := db(ctx).GetMember(ctx, teamsql.GetMemberParams{
+		TeamSlug: input.TeamSlug,
+		UserID:   input.UserID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.Errorf("User is not a member of the team.")
+	} else if err != nil {
+		return nil, err
+	}
+
+	var customRoleID *uuid.UUID
+	if input.CustomRoleName != nil {
+		role, err := GetCustomRole(ctx, input.TeamSlug, *input.CustomRoleName)
+		if err != nil {
+			return nil, err
+		}
+		customRoleID = &role.UUID
+	}
+
+	err = database.Transaction(ctx, func(ctx context.Context) error {
+		_, err := db(ctx).SetMemberCustomRole(ctx, teamsql.SetMemberCustomRoleParams{
+			CustomRoleID: customRoleID,
+			UserID:       input.UserID,
+			TeamSlug:     input.TeamSlug,
+		})
+		if err != nil {
+			return err
+		}
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:       activityLogEntryActionSetMemberCustomRole,
+			Actor:        actor.User,
+			ResourceType: activityLogEntryResourceTypeTeam,
+			ResourceName: input.TeamSlug.String(),
+			TeamSlug:     new(input.TeamSlug),
+			Data: &TeamMemberSetCustomRoleActivityLogEntryData{
+				CustomRoleName: input.CustomRoleName,
+				UserUUID:       input.UserID,
+				UserEmail:      input.UserEmail,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TeamMember{
+		Role:         teamMemberRoleFromSqlTeamRole(m.RoleName),
+		TeamSlug:     input.TeamSlug,
+		UserID:       input.UserID,
+		CustomRoleID: customRoleID,
+	}, nil
+}
+
+func parseCustomTeamRoleIdent(id ident.Ident) (slug.Slug, string, error) {
+	parts := id.Parts()
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid custom team role ident")
+	}
+
+	return slug.Slug(parts[0]), parts[1], nil
+}
@@ -142,24 +142,32 @@ func toGraphTeam(m *teamsql.Team) *Team {
 
 func toGraphTeamMember(m *teamsql.ListMembersRow) *TeamMember {
 	return &TeamMember{
-		Role:     teamMemberRoleFromSqlTeamRole(m.UserRole.RoleName),
-		TeamSlug: *m.UserRole.TargetTeamSlug,
-		UserID:   m.User.ID,
+		Role:         teamMemberRoleFromSqlTeamRole(m.UserRole.RoleName),
+		TeamSlug:     *m.UserRole.TargetTeamSlug,
+		UserID:       m.User.ID,
+		CustomRoleID: m.UserRole.CustomRoleID,
+		Environments: m.UserRole.Environments,
 	}
 }
 
 func toGraphUserTeam(m *teamsql.ListForUserRow) *TeamMember {
 	return &TeamMember{
-		Role:     teamMemberRoleFromSqlTeamRole(m.UserRole.RoleName),
-		TeamSlug: *m.UserRole.TargetTeamSlug,
-		UserID:   m.User.ID,
+		Role:         teamMemberRoleFromSqlTeamRole(m.UserRole.RoleName),
+		TeamSlug:     *m.UserRole.TargetTeamSlug,
+		UserID:       m.User.ID,
+		CustomRoleID: m.UserRole.CustomRoleID,
+		Environments: m.UserRole.Environments,
 	}
 }
 
 type TeamMember struct {
-	Role     TeamMemberRole
-	TeamSlug slug.Slug `json:"-"`
-	UserID   uuid.UUID `json:"-"`
+	Role         TeamMemberRole
+	TeamSlug     slug.Slug  `json:"-"`
+	UserID       uuid.UUID  `json:"-"`
+	CustomRoleID *uuid.UUID `json:"-"`
+	// Environments limits the environment bound authorizations of the member to the listed environments. Nil means all
+	// environments.
+	Environments []string `json:"environments"`
 }
 
 type TeamMemberRole string
@@ -498,6 +506,42 @@ type SetTeamMemberRolePayload struct {
 	Member *TeamMember `json:"member"`
 }
 
+type SetTeamMemberEnvironmentsInput struct {
+	TeamSlug     slug.Slug `json:"teamSlug"`
+	UserEmail    string    `json:"userEmail"`
+	Environments []string  `json:"environments"`
+	UserID       uuid.UUID `json:"-"`
+}
+
+func (i *SetTeamMemberEnvironmentsInput) Validate(ctx context.Context) error {
+	if i.Environments == nil {
+		return nil
+	}
+
+	verr := validate.New()
+	if len(i.Environments) == 0 {
+		verr.Add("environments", "At least one environment must be set. Use null to give the member access to all environments.")
+		return verr
+	}
+
+	envs, err := ListTeamEnvironments(ctx, i.TeamSlug)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range i.Environments {
+		if !slices.ContainsFunc(envs, func(e *TeamEnvironment) bool { return e.EnvironmentName == name }) {
+			verr.Add("environments", "Environment %q does not exist.", name)
+		}
+	}
+
+	return verr.NilIfEmpty()
+}
+
+type SetTeamMemberEnvironmentsPayload struct {
+	Member *TeamMember `json:"member"`
+}
+
 type UpdateTeamEnvironmentInput struct {
 	Slug               slug.Slug `json:"slug"`
 	EnvironmentName    string    `json:"environmentName"`
@@ -16,6 +16,7 @@ const (
 
 	identTeam identType = iota
 	identTeamEnvironment
+	identCustomTeamRole
 )
 
 func init() {
@@ -28,6 +29,7 @@ func init() {
 
 	ident.RegisterIdentType(identTeam, "T", GetByIdent)
 	ident.RegisterIdentType(identTeamEnvironment, "TE", GetTeamEnvironmentByIdent)
+	ident.RegisterIdentType(identCustomTeamRole, "TCR", GetCustomRoleByIdent)
 }
 
 // newTeamIdent creates a new identifier for a specific team
@@ -39,6 +41,10 @@ func newTeamEnvironmentIdent(slug slug.Slug, envName string) ident.Ident {
 	return ident.NewIdent(identTeamEnvironment, slug.String(), envName)
 }
 
+func newCustomTeamRoleIdent(slug slug.Slug, name string) ident.Ident {
+	return ident.NewIdent(identCustomTeamRole, slug.String(), name)
+}
+
 // parseTeamIdent returns the team slug from a team identifier. If the identifier is invalid, an error is returned.
 func parseTeamIdent(id ident.Ident) (slug.Slug, error) {
 	parts := id.Parts()
@@ -221,9 +221,11 @@ func GetMemberByEmail(ctx context.Context, teamSlug slug.Slug, email string) (*T
 		return nil, err
 	}
 	return &TeamMember{
-		Role:     teamMemberRoleFromSqlTeamRole(m.RoleName),
-		TeamSlug: teamSlug,
-		UserID:   m.ID,
+		Role:         teamMemberRoleFromSqlTeamRole(m.RoleName),
+		TeamSlug:     teamSlug,
+		UserID:       m.ID,
+		CustomRoleID: m.CustomRoleID,
+		Environments: m.Environments,
 	}, nil
 }
 
@@ -436,6 +438,30 @@ func SetMemberRole(ctx context.Context, input SetTeamMemberRoleInput, actor *aut
 			return err
 		}
 
+		// The environment scope limits the access of the member, so it is kept when the role changes
+		if m.Environments != nil {
+			err = db(ctx).SetMemberEnvironments(ctx, teamsql.SetMemberEnvironmentsParams{
+				Environments: m.Environments,
+				UserID:       input.UserID,
+				TeamSlug:     input.TeamSlug,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// The custom role replaces the authorizations of the role, so it is kept when the role changes
+		if m.CustomRoleID != nil {
+			_, err = db(ctx).SetMemberCustomRole(ctx, teamsql.SetMemberCustomRoleParams{
+				CustomRoleID: m.CustomRoleID,
+				UserID:       input.UserID,
+				TeamSlug:     input.TeamSlug,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
 		return activitylog.Create(ctx, activitylog.CreateInput{
 			Action:       activityLogEntryActionSetMemberRole,
 			Actor:        actor.User,
@@ -451,6 +477,59 @@ func SetMemberRole(ctx context.Context, input SetTeamMemberRoleInput, actor *aut
 	})
 }
 
+// SetMemberEnvironments limits the environment bound authorizations of a team member to the given environments. When
+// input.Environments is nil the member is given access to all environments of the team.
+func SetMemberEnvironments(ctx context.Context, input SetTeamMemberEnvironmentsInput, actor *authz.Actor) (*TeamMember, error) {
+	m, err := db(ctx).GetMember(ctx, teamsql.GetMemberParams{
+		TeamSlug: input.TeamSlug,
+		UserID:   input.UserID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.Errorf("User is not a member of the team.")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := input.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	err = database.Transaction(ctx, func(ctx context.Context) error {
+		err := db(ctx).SetMemberEnvironments(ctx, teamsql.SetMemberEnvironmentsParams{
+			Environments: input.Environments,
+			UserID:       input.UserID,
+			TeamSlug:     input.TeamSlug,
+		})
+		if err != nil {
+			return err
+		}
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:       activityLogEntryActionSetMemberEnvironments,
+			Actor:        actor.User,
+			ResourceType: activityLogEntryResourceTypeTeam,
+			ResourceName: input.TeamSlug.String(),
+			TeamSlug:     new(input.TeamSlug),
+			Data: &TeamMemberSetEnvironmentsActivityLogEntryData{
+				Environments: input.Environments,
+				UserUUID:     input.UserID,
+				UserEmail:    input.UserEmail,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TeamMember{
+		Role:         teamMemberRoleFromSqlTeamRole(m.RoleName),
+		TeamSlug:     input.TeamSlug,
+		UserID:       input.UserID,
+		CustomRoleID: m.CustomRoleID,
+		Environments: input.Environments,
+	}, nil
+}
+
 func UserIsOwner(ctx context.Context, teamSlug slug.Slug, userID uuid.UUID) (bool, error) {
 	return db(ctx).UserIsOwner(ctx, teamsql.UserIsOwnerParams{
 		UserID:   userID,
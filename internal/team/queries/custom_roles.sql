@@ -0,0 +1,138 @@
+-- name: ListCustomRoles :many
+SELECT
+	sqlc.embed(team_custom_roles),
+	COALESCE(
+		(
+			SELECT
+				ARRAY_AGG(
+					cra.authorization_name
+					ORDER BY
+						cra.authorization_name
+				)
+			FROM
+				team_custom_role_authorizations cra
+			WHERE
+				cra.custom_role_id = team_custom_roles.id
+		),
+		'{}'
+	)::TEXT[] AS authorizations,
+	COUNT(*) OVER () AS total_count
+FROM
+	team_custom_roles
+WHERE
+	team_slug = @team_slug::slug
+ORDER BY
+	name ASC
+LIMIT
+	sqlc.arg('limit')
+OFFSET
+	sqlc.arg('offset')
+;
+
+-- name: GetCustomRole :one
+SELECT
+	sqlc.embed(team_custom_roles),
+	COALESCE(
+		(
+			SELECT
+				ARRAY_AGG(
+					cra.authorization_name
+					ORDER BY
+						cra.authorization_name
+				)
+			FROM
+				team_custom_role_authorizations cra
+			WHERE
+				cra.custom_role_id = team_custom_roles.id
+		),
+		'{}'
+	)::TEXT[] AS authorizations
+FROM
+	team_custom_roles
+WHERE
+	team_slug = @team_slug::slug
+	AND name = @name
+;
+
+-- name: GetCustomRoleByID :one
+SELECT
+	sqlc.embed(team_custom_roles),
+	COALESCE(
+		(
+			SELECT
+				ARRAY_AGG(
+					cra.authorization_name
+					ORDER BY
+						cra.authorization_name
+				)
+			FROM
+				team_custom_role_authorizations cra
+			WHERE
+				cra.custom_role_id = team_custom_roles.id
+		),
+		'{}'
+	)::TEXT[] AS authorizations
+FROM
+	team_custom_roles
+WHERE
+	id = @id
+;
+
+-- name: CreateCustomRole :one
+INSERT INTO
+	team_custom_roles (team_slug, name, description)
+VALUES
+	(@team_slug, @name, @description)
+RETURNING
+	*
+;
+
+-- name: UpdateCustomRole :exec
+UPDATE team_custom_roles
+SET
+	description = COALESCE(sqlc.narg(description), description)
+WHERE
+	id = @id
+;
+
+-- name: DeleteCustomRole :exec
+DELETE FROM team_custom_roles
+WHERE
+	id = @id
+;
+
+-- name: RemoveCustomRoleAuthorizations :exec
+DELETE FROM team_custom_role_authorizations
+WHERE
+	custom_role_id = @custom_role_id
+;
+
+-- name: AddCustomRoleAuthorizations :exec
+INSERT INTO
+	team_custom_role_authorizations (custom_role_id, authorization_name)
+SELECT
+	@custom_role_id,
+	UNNEST(@authorizations::TEXT[])
+;
+
+-- name: ListCustomRoleAuthorizations :many
+-- Custom roles can only grant authorizations that are granted to team owners.
+SELECT
+	a.*
+FROM
+	authorizations a
+	JOIN role_authorizations ra ON ra.authorization_name = a.name
+WHERE
+	ra.role_name = 'Team owner'
+ORDER BY
+	a.name ASC
+;
+
+-- name: SetMemberCustomRole :execrows
+UPDATE user_roles
+SET
+	custom_role_id = sqlc.narg(custom_role_id)
+WHERE
+	user_id = @user_id
+	AND target_team_slug = @team_slug::slug
+;
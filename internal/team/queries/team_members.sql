@@ -64,7 +64,9 @@ OFFSET
 -- name: GetMember :one
 SELECT
 	users.*,
-	user_roles.role_name
+	user_roles.role_name,
+	user_roles.custom_role_id,
+	user_roles.environments
 FROM
 	user_roles
 	JOIN teams ON teams.slug = user_roles.target_team_slug
@@ -77,7 +79,9 @@ WHERE
 -- name: GetMemberByEmail :one
 SELECT
 	users.*,
-	user_roles.role_name
+	user_roles.role_name,
+	user_roles.custom_role_id,
+	user_roles.environments
 FROM
 	user_roles
 	JOIN teams ON teams.slug = user_roles.target_team_slug
@@ -129,3 +133,12 @@ SELECT
 			AND role_name = 'Team owner'
 	)
 ;
+
+-- name: SetMemberEnvironments :exec
+UPDATE user_roles
+SET
+	environments = sqlc.narg(environments)::TEXT[]
+WHERE
+	user_id = @user_id
+	AND target_team_slug = @team_slug::slug
+;
@@ -8,6 +8,11 @@ import (
 	"github.com/nais/api/internal/slug"
 )
 
+type Authorization struct {
+	Name        string
+	Description string
+}
+
 type Team struct {
 	Slug                 slug.Slug
 	Purpose              string
@@ -30,6 +35,15 @@ type TeamAllEnvironment struct {
 	SlackAlertsChannel string
 }
 
+type TeamCustomRole struct {
+	ID          uuid.UUID
+	TeamSlug    slug.Slug
+	Name        string
+	Description string
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
 type TeamDeleteKey struct {
 	Key         uuid.UUID
 	TeamSlug    slug.Slug
@@ -51,4 +65,6 @@ type UserRole struct {
 	RoleName       string
 	UserID         uuid.UUID
 	TargetTeamSlug *slug.Slug
+	CustomRoleID   *uuid.UUID
+	Environments   []string
 }
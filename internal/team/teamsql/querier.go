@@ -10,12 +10,17 @@ import (
 )
 
 type Querier interface {
+	AddCustomRoleAuthorizations(ctx context.Context, arg AddCustomRoleAuthorizationsParams) error
 	AddMember(ctx context.Context, arg AddMemberParams) error
 	ConfirmDeleteKey(ctx context.Context, key uuid.UUID) error
 	Create(ctx context.Context, arg CreateParams) (*Team, error)
+	CreateCustomRole(ctx context.Context, arg CreateCustomRoleParams) (*TeamCustomRole, error)
 	CreateDeleteKey(ctx context.Context, arg CreateDeleteKeyParams) (*TeamDeleteKey, error)
+	DeleteCustomRole(ctx context.Context, id uuid.UUID) error
 	Exists(ctx context.Context, argSlug slug.Slug) (bool, error)
 	Get(ctx context.Context, argSlug slug.Slug) (*Team, error)
+	GetCustomRole(ctx context.Context, arg GetCustomRoleParams) (*GetCustomRoleRow, error)
+	GetCustomRoleByID(ctx context.Context, id uuid.UUID) (*GetCustomRoleByIDRow, error)
 	GetDeleteKey(ctx context.Context, arg GetDeleteKeyParams) (*TeamDeleteKey, error)
 	GetEnvironment(ctx context.Context, arg GetEnvironmentParams) (*TeamAllEnvironment, error)
 	GetMember(ctx context.Context, arg GetMemberParams) (*GetMemberRow, error)
@@ -25,6 +30,9 @@ type Querier interface {
 	ListAllForSearch(ctx context.Context) ([]*ListAllForSearchRow, error)
 	ListAllSlugs(ctx context.Context) ([]slug.Slug, error)
 	ListBySlugs(ctx context.Context, slugs []slug.Slug) ([]*Team, error)
+	// Custom roles can only grant authorizations that are granted to team owners.
+	ListCustomRoleAuthorizations(ctx context.Context) ([]*Authorization, error)
+	ListCustomRoles(ctx context.Context, arg ListCustomRolesParams) ([]*ListCustomRolesRow, error)
 	ListEnvironmentsBySlug(ctx context.Context, argSlug slug.Slug) ([]*TeamAllEnvironment, error)
 	// ListEnvironmentsBySlugsAndEnvNames returns a slice of team environments for a list of teams/envs, excluding
 	// deleted teams.
@@ -33,11 +41,15 @@ type Querier interface {
 	ListForUser(ctx context.Context, arg ListForUserParams) ([]*ListForUserRow, error)
 	ListGoogleGroupByTeamSlugs(ctx context.Context, teamSlugs []slug.Slug) ([]string, error)
 	ListMembers(ctx context.Context, arg ListMembersParams) ([]*ListMembersRow, error)
+	RemoveCustomRoleAuthorizations(ctx context.Context, customRoleID uuid.UUID) error
 	RemoveMember(ctx context.Context, arg RemoveMemberParams) error
 	RemoveSlackAlertsChannel(ctx context.Context, arg RemoveSlackAlertsChannelParams) error
 	SetDeleteKeyConfirmedAt(ctx context.Context, argSlug slug.Slug) error
+	SetMemberCustomRole(ctx context.Context, arg SetMemberCustomRoleParams) (int64, error)
+	SetMemberEnvironments(ctx context.Context, arg SetMemberEnvironmentsParams) error
 	SlugAvailable(ctx context.Context, argSlug slug.Slug) (bool, error)
 	Update(ctx context.Context, arg UpdateParams) (*Team, error)
+	UpdateCustomRole(ctx context.Context, arg UpdateCustomRoleParams) error
 	UpdateExternalReferences(ctx context.Context, arg UpdateExternalReferencesParams) error
 	UpsertEnvironment(ctx context.Context, arg UpsertEnvironmentParams) error
 	UserIsMember(ctx context.Context, arg UserIsMemberParams) (bool, error)
@@ -32,7 +32,9 @@ func (q *Queries) AddMember(ctx context.Context, arg AddMemberParams) error {
 const getMember = `-- name: GetMember :one
 SELECT
 	users.id, users.email, users.name, users.external_id, users.admin,
-	user_roles.role_name
+	user_roles.role_name,
+	user_roles.custom_role_id,
+	user_roles.environments
 FROM
 	user_roles
 	JOIN teams ON teams.slug = user_roles.target_team_slug
@@ -48,12 +50,14 @@ type GetMemberParams struct {
 }
 
 type GetMemberRow struct {
-	ID         uuid.UUID
-	Email      string
-	Name       string
-	ExternalID string
-	Admin      bool
-	RoleName   string
+	ID           uuid.UUID
+	Email        string
+	Name         string
+	ExternalID   string
+	Admin        bool
+	RoleName     string
+	CustomRoleID *uuid.UUID
+	Environments []string
 }
 
 func (q *Queries) GetMember(ctx context.Context, arg GetMemberParams) (*GetMemberRow, error) {
@@ -66,6 +70,8 @@ func (q *Queries) GetMember(ctx context.Context, arg GetMemberParams) (*GetMembe
 		&i.ExternalID,
 		&i.Admin,
 		&i.RoleName,
+		&i.CustomRoleID,
+		&i.Environments,
 	)
 	return &i, err
 }
@@ -73,7 +79,9 @@ func (q *Queries) GetMember(ctx context.Context, arg GetMemberParams) (*GetMembe
 const getMemberByEmail = `-- name: GetMemberByEmail :one
 SELECT
 	users.id, users.email, users.name, users.external_id, users.admin,
-	user_roles.role_name
+	user_roles.role_name,
+	user_roles.custom_role_id,
+	user_roles.environments
 FROM
 	user_roles
 	JOIN teams ON teams.slug = user_roles.target_team_slug
@@ -89,12 +97,14 @@ type GetMemberByEmailParams struct {
 }
 
 type GetMemberByEmailRow struct {
-	ID         uuid.UUID
-	Email      string
-	Name       string
-	ExternalID string
-	Admin      bool
-	RoleName   string
+	ID           uuid.UUID
+	Email        string
+	Name         string
+	ExternalID   string
+	Admin        bool
+	RoleName     string
+	CustomRoleID *uuid.UUID
+	Environments []string
 }
 
 func (q *Queries) GetMemberByEmail(ctx context.Context, arg GetMemberByEmailParams) (*GetMemberByEmailRow, error) {
@@ -107,6 +117,8 @@ func (q *Queries) GetMemberByEmail(ctx context.Context, arg GetMemberByEmailPara
 		&i.ExternalID,
 		&i.Admin,
 		&i.RoleName,
+		&i.CustomRoleID,
+		&i.Environments,
 	)
 	return &i, err
 }
@@ -114,7 +126,7 @@ func (q *Queries) GetMemberByEmail(ctx context.Context, arg GetMemberByEmailPara
 const listForUser = `-- name: ListForUser :many
 SELECT
 	users.id, users.email, users.name, users.external_id, users.admin,
-	user_roles.id, user_roles.role_name, user_roles.user_id, user_roles.target_team_slug,
+	user_roles.id, user_roles.role_name, user_roles.user_id, user_roles.target_team_slug, user_roles.custom_role_id, user_roles.environments,
 	COUNT(*) OVER () AS total_count
 FROM
 	user_roles
@@ -173,6 +185,8 @@ func (q *Queries) ListForUser(ctx context.Context, arg ListForUserParams) ([]*Li
 			&i.UserRole.RoleName,
 			&i.UserRole.UserID,
 			&i.UserRole.TargetTeamSlug,
+			&i.UserRole.CustomRoleID,
+			&i.UserRole.Environments,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -188,7 +202,7 @@ func (q *Queries) ListForUser(ctx context.Context, arg ListForUserParams) ([]*Li
 const listMembers = `-- name: ListMembers :many
 SELECT
 	users.id, users.email, users.name, users.external_id, users.admin,
-	user_roles.id, user_roles.role_name, user_roles.user_id, user_roles.target_team_slug,
+	user_roles.id, user_roles.role_name, user_roles.user_id, user_roles.target_team_slug, user_roles.custom_role_id, user_roles.environments,
 	COUNT(*) OVER () AS total_count
 FROM
 	user_roles
@@ -260,6 +274,8 @@ func (q *Queries) ListMembers(ctx context.Context, arg ListMembersParams) ([]*Li
 			&i.UserRole.RoleName,
 			&i.UserRole.UserID,
 			&i.UserRole.TargetTeamSlug,
+			&i.UserRole.CustomRoleID,
+			&i.UserRole.Environments,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -289,6 +305,26 @@ func (q *Queries) RemoveMember(ctx context.Context, arg RemoveMemberParams) erro
 	return err
 }
 
+const setMemberEnvironments = `-- name: SetMemberEnvironments :exec
+UPDATE user_roles
+SET
+	environments = $1::TEXT[]
+WHERE
+	user_id = $2
+	AND target_team_slug = $3::slug
+`
+
+type SetMemberEnvironmentsParams struct {
+	Environments []string
+	UserID       uuid.UUID
+	TeamSlug     slug.Slug
+}
+
+func (q *Queries) SetMemberEnvironments(ctx context.Context, arg SetMemberEnvironmentsParams) error {
+	_, err := q.db.Exec(ctx, setMemberEnvironments, arg.Environments, arg.UserID, arg.TeamSlug)
+	return err
+}
+
 const userIsMember = `-- name: UserIsMember :one
 SELECT
 	EXISTS (
@@ -64,11 +64,19 @@ type TunnelCreatedActivityLogEntry struct {
 	Data TunnelCreatedActivityLogEntryData `json:"data"`
 }
 
+func (e TunnelCreatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type TunnelDeletedActivityLogEntry struct {
 	activitylog.GenericActivityLogEntry
 	Data TunnelDeletedActivityLogEntryData `json:"data"`
 }
 
+func (e TunnelDeletedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type TunnelCreatedActivityLogEntryData struct {
 	TunnelName string `json:"tunnelName"`
 	TargetHost string `json:"targetHost"`
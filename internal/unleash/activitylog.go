@@ -57,6 +57,10 @@ type UnleashInstanceUpdatedActivityLogEntry struct {
 	Data *UnleashInstanceUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e UnleashInstanceUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type UnleashInstanceUpdatedActivityLogEntryData struct {
 	RevokedTeamSlug       *slug.Slug `json:"revokedTeamSlug"`
 	AllowedTeamSlug       *slug.Slug `json:"allowedTeamSlug"`
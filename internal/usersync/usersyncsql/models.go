@@ -8,7 +8,6 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/nais/api/internal/slug"
 )
 
 type UsersyncLogEntryAction string
@@ -86,13 +85,6 @@ type User struct {
 	Admin      bool
 }
 
-type UserRole struct {
-	ID             int32
-	RoleName       string
-	UserID         uuid.UUID
-	TargetTeamSlug *slug.Slug
-}
-
 type UsersyncLogEntry struct {
 	ID           uuid.UUID
 	CreatedAt    pgtype.Timestamptz
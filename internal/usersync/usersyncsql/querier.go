@@ -19,7 +19,7 @@ type Querier interface {
 	ListGlobalAdmins(ctx context.Context) ([]*User, error)
 	ListLogEntries(ctx context.Context, arg ListLogEntriesParams) ([]*UsersyncLogEntry, error)
 	ListLogEntriesByIDs(ctx context.Context, ids []uuid.UUID) ([]*UsersyncLogEntry, error)
-	ListRoles(ctx context.Context) ([]*UserRole, error)
+	ListRoles(ctx context.Context) ([]*ListRolesRow, error)
 	RevokeGlobalAdmin(ctx context.Context, id uuid.UUID) error
 	RevokeGlobalRole(ctx context.Context, arg RevokeGlobalRoleParams) error
 	Update(ctx context.Context, arg UpdateParams) error
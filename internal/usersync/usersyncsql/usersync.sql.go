@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/nais/api/internal/slug"
 )
 
 const assignGlobalAdmin = `-- name: AssignGlobalAdmin :exec
@@ -314,15 +315,22 @@ ORDER BY
 	role_name ASC
 `
 
-func (q *Queries) ListRoles(ctx context.Context) ([]*UserRole, error) {
+type ListRolesRow struct {
+	ID             int32
+	RoleName       string
+	UserID         uuid.UUID
+	TargetTeamSlug *slug.Slug
+}
+
+func (q *Queries) ListRoles(ctx context.Context) ([]*ListRolesRow, error) {
 	rows, err := q.db.Query(ctx, listRoles)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []*UserRole{}
+	items := []*ListRolesRow{}
 	for rows.Next() {
-		var i UserRole
+		var i ListRolesRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.RoleName,
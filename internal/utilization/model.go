@@ -81,6 +81,14 @@ type WorkloadUtilizationData struct {
 	WorkloadName    string    `json:"-"`
 }
 
+// WorkloadOOMKills is the number of times instances of a workload have been killed for exceeding their memory limit.
+type WorkloadOOMKills struct {
+	TeamSlug        slug.Slug
+	EnvironmentName string
+	WorkloadName    string
+	Count           int
+}
+
 type WorkloadUtilizationSeriesInput struct {
 	Start        time.Time               `json:"start"`
 	End          time.Time               `json:"end"`
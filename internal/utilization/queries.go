@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/nais/api/internal/environmentmapper"
 	"github.com/nais/api/internal/slug"
 	"github.com/nais/api/internal/team"
 	"github.com/nais/api/internal/thirdparty/promclient"
@@ -38,6 +39,12 @@ const (
 	TeamsMemoryRequest = `sum by (k8s_cluster_name, namespace, owner_kind) (kube_pod_container_resource_requests{namespace!~%q, container!~%q, resource="memory",unit="byte"} * on(pod, namespace, k8s_cluster_name) group_left(owner_kind) kube_pod_owner{owner_kind="ReplicaSet"})`
 	TeamsMemoryUsage   = `sum by (k8s_cluster_name, namespace, owner_kind) (container_memory_working_set_bytes{namespace!~%q, container!~%q} * on(pod, namespace, k8s_cluster_name) group_left(owner_kind) kube_pod_owner{owner_kind="ReplicaSet"})`
 
+	WorkloadsCPURequest    = `max by (k8s_cluster_name, namespace, container) (kube_pod_container_resource_requests{namespace!~%q, container!~%q, resource="cpu",unit="core"})`
+	WorkloadsCPUPeak       = `max by (k8s_cluster_name, namespace, container) (max_over_time(rate(container_cpu_usage_seconds_total{namespace!~%q, container!~%q}[5m])[1d:5m]))`
+	WorkloadsMemoryRequest = `max by (k8s_cluster_name, namespace, container) (kube_pod_container_resource_requests{namespace!~%q, container!~%q, resource="memory",unit="byte"})`
+	WorkloadsMemoryPeak    = `max by (k8s_cluster_name, namespace, container) (max_over_time(container_memory_working_set_bytes{namespace!~%q, container!~%q}[1d]))`
+	WorkloadsOOMKills      = `sum by (k8s_cluster_name, namespace, container) (increase(kube_pod_container_status_restarts_total{namespace!~%q, container!~%q}[1d]) * on (k8s_cluster_name, namespace, pod, container) group_left () (kube_pod_container_status_last_terminated_reason{reason="OOMKilled"} == 1))`
+
 	cpuRequestRecommendation = `max(
 		avg_over_time(
 		  rate(container_cpu_usage_seconds_total{k8s_cluster_name=%q,namespace=%q, container=%q}[5m])[1w:5m]
@@ -203,6 +210,80 @@ func ForTeam(ctx context.Context, teamSlug slug.Slug, resourceType UtilizationRe
 	return ret, nil
 }
 
+// ForAllWorkloads returns the resources requested by an instance of each workload, and the peak usage of an instance
+// during the last day.
+func ForAllWorkloads(ctx context.Context, resourceType UtilizationResourceType) ([]*WorkloadUtilizationData, error) {
+	reqQ := WorkloadsMemoryRequest
+	peakQ := WorkloadsMemoryPeak
+
+	if resourceType == UtilizationResourceTypeCPU {
+		reqQ = WorkloadsCPURequest
+		peakQ = WorkloadsCPUPeak
+	}
+
+	c := fromContext(ctx).client
+
+	requested, err := c.QueryAll(ctx, fmt.Sprintf(reqQ, ignoredNamespaces, ignoredContainers))
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct{ env, team, workload string }
+	ret := []*WorkloadUtilizationData{}
+	byKey := map[key]*WorkloadUtilizationData{}
+	for _, sample := range requested {
+		data := &WorkloadUtilizationData{
+			TeamSlug:        slug.Slug(sample.Metric["namespace"]),
+			WorkloadName:    string(sample.Metric["container"]),
+			EnvironmentName: environmentmapper.EnvironmentName(string(sample.Metric["k8s_cluster_name"])),
+			Requested:       float64(sample.Value),
+		}
+		ret = append(ret, data)
+		byKey[key{data.EnvironmentName, string(data.TeamSlug), data.WorkloadName}] = data
+	}
+
+	peak, err := c.QueryAll(ctx, fmt.Sprintf(peakQ, ignoredNamespaces, ignoredContainers))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sample := range peak {
+		env := environmentmapper.EnvironmentName(string(sample.Metric["k8s_cluster_name"]))
+		if data, ok := byKey[key{env, string(sample.Metric["namespace"]), string(sample.Metric["container"])}]; ok {
+			data.Used = float64(sample.Value)
+		}
+	}
+
+	return ret, nil
+}
+
+// OOMKillsForAllWorkloads returns the number of times instances of each workload have been killed for exceeding
+// their memory limit during the last day. Workloads without any such kills are omitted.
+func OOMKillsForAllWorkloads(ctx context.Context) ([]*WorkloadOOMKills, error) {
+	c := fromContext(ctx).client
+
+	v, err := c.QueryAll(ctx, fmt.Sprintf(WorkloadsOOMKills, ignoredNamespaces, ignoredContainers))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := []*WorkloadOOMKills{}
+	for _, sample := range v {
+		count := int(math.Round(float64(sample.Value)))
+		if count == 0 {
+			continue
+		}
+		ret = append(ret, &WorkloadOOMKills{
+			TeamSlug:        slug.Slug(sample.Metric["namespace"]),
+			WorkloadName:    string(sample.Metric["container"]),
+			EnvironmentName: environmentmapper.EnvironmentName(string(sample.Metric["k8s_cluster_name"])),
+			Count:           count,
+		})
+	}
+
+	return ret, nil
+}
+
 func WorkloadResourceRequest(ctx context.Context, env string, teamSlug slug.Slug, workloadName string, resourceType UtilizationResourceType) (float64, error) {
 	q := AppMemoryRequest
 	if resourceType == UtilizationResourceTypeCPU {
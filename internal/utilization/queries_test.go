@@ -0,0 +1,86 @@
+package utilization
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nais/api/internal/environmentmapper"
+	"github.com/nais/api/internal/thirdparty/promclient"
+	prom "github.com/prometheus/common/model"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// fakeClient returns the samples registered for a query from QueryAll
+type fakeClient struct {
+	ResourceUsageClient
+	samples map[string]prom.Vector
+}
+
+func (f *fakeClient) QueryAll(_ context.Context, query string, _ ...promclient.QueryOption) (prom.Vector, error) {
+	return f.samples[query], nil
+}
+
+func sample(cluster, namespace, container string, value float64) *prom.Sample {
+	return &prom.Sample{
+		Metric: prom.Metric{
+			"k8s_cluster_name": prom.LabelValue(cluster),
+			"namespace":        prom.LabelValue(namespace),
+			"container":        prom.LabelValue(container),
+		},
+		Value: prom.SampleValue(value),
+	}
+}
+
+func TestForAllWorkloads(t *testing.T) {
+	environmentmapper.SetMapping(environmentmapper.EnvironmentMapping{"dev": "dev-gcp"})
+	defer environmentmapper.SetMapping(nil)
+
+	client := &fakeClient{samples: map[string]prom.Vector{
+		query(WorkloadsMemoryRequest): {sample("dev", "team", "app", 100), sample("prod", "team", "app", 200)},
+		query(WorkloadsMemoryPeak):    {sample("dev", "team", "app", 50), sample("prod", "team", "app", 150)},
+	}}
+	log, _ := logrustest.NewNullLogger()
+	ctx := NewLoaderContext(context.Background(), client, log)
+
+	got, err := ForAllWorkloads(ctx, UtilizationResourceTypeMemory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*WorkloadUtilizationData{
+		{TeamSlug: "team", EnvironmentName: "dev-gcp", WorkloadName: "app", Requested: 100, Used: 50},
+		{TeamSlug: "team", EnvironmentName: "prod", WorkloadName: "app", Requested: 200, Used: 150},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("diff -want +got:\n%s", diff)
+	}
+}
+
+func TestOOMKillsForAllWorkloads(t *testing.T) {
+	environmentmapper.SetMapping(environmentmapper.EnvironmentMapping{"dev": "dev-gcp"})
+	defer environmentmapper.SetMapping(nil)
+
+	client := &fakeClient{samples: map[string]prom.Vector{
+		query(WorkloadsOOMKills): {sample("dev", "team", "app", 2), sample("prod", "team", "app", 0)},
+	}}
+	log, _ := logrustest.NewNullLogger()
+	ctx := NewLoaderContext(context.Background(), client, log)
+
+	got, err := OOMKillsForAllWorkloads(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*WorkloadOOMKills{
+		{TeamSlug: "team", EnvironmentName: "dev-gcp", WorkloadName: "app", Count: 2},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("diff -want +got:\n%s", diff)
+	}
+}
+
+func query(q string) string {
+	return fmt.Sprintf(q, ignoredNamespaces, ignoredContainers)
+}
@@ -35,6 +35,10 @@ type VulnerabilityUpdatedActivityLogEntry struct {
 	Data *VulnerabilityActivityLogEntryData `json:"data"`
 }
 
+func (e VulnerabilityUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type VulnerabilityActivityLogEntryData struct {
 	Identifier          string                         `json:"identifier"`
 	Severity            ImageVulnerabilitySeverity     `json:"severity"`
@@ -101,6 +101,10 @@ type ApplicationScaledActivityLogEntry struct {
 	Data *ApplicationScaledActivityLogEntryData `json:"data"`
 }
 
+func (e ApplicationScaledActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ApplicationScaledActivityLogEntryData struct {
 	NewSize   int              `json:"newSize,string"`
 	Direction ScalingDirection `json:"direction"`
@@ -112,12 +116,20 @@ type ApplicationCreatedActivityLogEntry struct {
 	Data *activitylog.GenericKubernetesResourceActivityLogEntryData `json:"data"`
 }
 
+func (e ApplicationCreatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ApplicationUpdatedActivityLogEntry struct {
 	activitylog.GenericActivityLogEntry
 
 	Data *ApplicationUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e ApplicationUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ApplicationUpdatedActivityLogEntryData struct {
 	ChangedFields     []*activitylog.ResourceChangedField `json:"changedFields"`
 	GitHubActorClaims *activitylog.GitHubActorClaims      `json:"gitHubActorClaims,omitempty"`
@@ -50,6 +50,10 @@ type ConfigUpdatedActivityLogEntry struct {
 	Data *ConfigUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e ConfigUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type ConfigUpdatedActivityLogEntryData struct {
 	UpdatedFields []*ConfigUpdatedActivityLogEntryDataUpdatedField `json:"updatedFields"`
 }
@@ -101,18 +101,30 @@ type JobRunDeletedActivityLogEntry struct {
 	Data *JobRunDeletedActivityLogEntryData
 }
 
+func (e JobRunDeletedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type JobCreatedActivityLogEntry struct {
 	activitylog.GenericActivityLogEntry
 
 	Data *activitylog.GenericKubernetesResourceActivityLogEntryData `json:"data"`
 }
 
+func (e JobCreatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type JobUpdatedActivityLogEntry struct {
 	activitylog.GenericActivityLogEntry
 
 	Data *JobUpdatedActivityLogEntryData `json:"data"`
 }
 
+func (e JobUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type JobUpdatedActivityLogEntryData struct {
 	ChangedFields     []*activitylog.ResourceChangedField `json:"changedFields"`
 	GitHubActorClaims *activitylog.GitHubActorClaims      `json:"gitHubActorClaims,omitempty"`
@@ -0,0 +1,578 @@
+package secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/duration"
+	"github.com/nais/api/internal/graph/apierror"
+	"github.com/nais/api/internal/graph/ident"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/leaderelection"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/workload/secret/secretsql"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultAccessRequestTTL     = 24 * time.Hour
+	defaultAccessDuration       = 1 * time.Hour
+	accessRequestExpirySchedule = 1 * time.Minute
+)
+
+// AccessApprovalPolicy configures the environments where secret values can only be viewed after an access request has
+// been approved by another team owner.
+type AccessApprovalPolicy struct {
+	// Environments where viewing secret values requires an approved access request.
+	Environments []string `json:"environments"`
+
+	// RequestTTL is how long an access request can be pending before it expires. Defaults to 24 hours.
+	RequestTTL duration.Duration `json:"requestTTL"`
+
+	// AccessDuration is how long the requester can view the secret values after the request is approved. Defaults to
+	// 1 hour.
+	AccessDuration duration.Duration `json:"accessDuration"`
+}
+
+var _ json.Unmarshaler = (*AccessApprovalPolicy)(nil)
+
+func (p *AccessApprovalPolicy) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	type accessApprovalPolicy AccessApprovalPolicy
+	var policy accessApprovalPolicy
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&policy); err != nil {
+		return fmt.Errorf("unmarshalling secret access approval policy: %w", err)
+	}
+
+	*p = AccessApprovalPolicy(policy)
+	return nil
+}
+
+// RequiresApproval reports whether viewing secret values in the environment requires an approved access request.
+func (p AccessApprovalPolicy) RequiresApproval(environment string) bool {
+	return slices.Contains(p.Environments, environment)
+}
+
+func (p AccessApprovalPolicy) requestTTL() time.Duration {
+	if p.RequestTTL > 0 {
+		return time.Duration(p.RequestTTL)
+	}
+	return defaultAccessRequestTTL
+}
+
+func (p AccessApprovalPolicy) accessDuration() time.Duration {
+	if p.AccessDuration > 0 {
+		return time.Duration(p.AccessDuration)
+	}
+	return defaultAccessDuration
+}
+
+type SecretAccessRequestState string
+
+const (
+	SecretAccessRequestStatePending  SecretAccessRequestState = "PENDING"
+	SecretAccessRequestStateApproved SecretAccessRequestState = "APPROVED"
+	SecretAccessRequestStateDenied   SecretAccessRequestState = "DENIED"
+	SecretAccessRequestStateExpired  SecretAccessRequestState = "EXPIRED"
+)
+
+func (e SecretAccessRequestState) IsValid() bool {
+	switch e {
+	case SecretAccessRequestStatePending, SecretAccessRequestStateApproved, SecretAccessRequestStateDenied, SecretAccessRequestStateExpired:
+		return true
+	}
+	return false
+}
+
+func (e SecretAccessRequestState) String() string {
+	return string(e)
+}
+
+func (e *SecretAccessRequestState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	tmp := SecretAccessRequestState(str)
+	if !tmp.IsValid() {
+		return fmt.Errorf("%s is not a valid SecretAccessRequestState", str)
+	}
+
+	*e = tmp
+	return nil
+}
+
+func (e SecretAccessRequestState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SecretAccessRequest struct {
+	UUID            uuid.UUID                `json:"-"`
+	TeamSlug        slug.Slug                `json:"-"`
+	EnvironmentName string                   `json:"-"`
+	SecretName      string                   `json:"secretName"`
+	Requester       string                   `json:"requester"`
+	Reason          string                   `json:"reason"`
+	State           SecretAccessRequestState `json:"state"`
+	CreatedAt       time.Time                `json:"createdAt"`
+	HandledBy       *string                  `json:"handledBy"`
+	HandledAt       *time.Time               `json:"handledAt"`
+	HandlerComment  *string                  `json:"handlerComment"`
+	AccessExpiresAt *time.Time               `json:"accessExpiresAt"`
+}
+
+func (SecretAccessRequest) IsNode() {}
+
+func (r *SecretAccessRequest) ID() ident.Ident {
+	return newAccessRequestIdent(r.UUID)
+}
+
+type (
+	SecretAccessRequestConnection = pagination.Connection[*SecretAccessRequest]
+	SecretAccessRequestEdge       = pagination.Edge[*SecretAccessRequest]
+)
+
+type RequestSecretAccessInput struct {
+	Name        string    `json:"name"`
+	Environment string    `json:"environment"`
+	Team        slug.Slug `json:"team"`
+	Reason      string    `json:"reason"`
+}
+
+type RequestSecretAccessPayload struct {
+	AccessRequest *SecretAccessRequest `json:"accessRequest"`
+}
+
+type ApproveSecretAccessRequestInput struct {
+	ID      ident.Ident `json:"id"`
+	Comment *string     `json:"comment"`
+}
+
+type ApproveSecretAccessRequestPayload struct {
+	AccessRequest *SecretAccessRequest `json:"accessRequest"`
+}
+
+type DenySecretAccessRequestInput struct {
+	ID      ident.Ident `json:"id"`
+	Comment *string     `json:"comment"`
+}
+
+type DenySecretAccessRequestPayload struct {
+	AccessRequest *SecretAccessRequest `json:"accessRequest"`
+}
+
+func toGraphAccessRequest(r *secretsql.SecretAccessRequest) *SecretAccessRequest {
+	ret := &SecretAccessRequest{
+		UUID:            r.ID,
+		TeamSlug:        r.TeamSlug,
+		EnvironmentName: r.Environment,
+		SecretName:      r.SecretName,
+		Requester:       r.Requester,
+		Reason:          r.Reason,
+		State:           SecretAccessRequestState(r.State),
+		CreatedAt:       r.CreatedAt.Time,
+		HandledBy:       r.HandledBy,
+		HandlerComment:  r.HandlerComment,
+	}
+	if r.HandledAt.Valid {
+		ret.HandledAt = &r.HandledAt.Time
+	}
+	if r.AccessExpiresAt.Valid {
+		ret.AccessExpiresAt = &r.AccessExpiresAt.Time
+	}
+	return ret
+}
+
+// RequiresAccessApproval reports whether viewing secret values in the environment requires an approved access
+// request.
+func RequiresAccessApproval(ctx context.Context, environment string) bool {
+	return fromContext(ctx).accessApproval.RequiresApproval(environment)
+}
+
+func GetAccessRequestByIdent(ctx context.Context, id ident.Ident) (*SecretAccessRequest, error) {
+	uid, err := parseAccessRequestIdent(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := db(ctx).GetAccessRequestByID(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apierror.Errorf("The access request does not exist.")
+		}
+		return nil, err
+	}
+
+	return toGraphAccessRequest(r), nil
+}
+
+func ListAccessRequestsForTeam(ctx context.Context, teamSlug slug.Slug, page *pagination.Pagination, state *SecretAccessRequestState) (*SecretAccessRequestConnection, error) {
+	var s *string
+	if state != nil {
+		s = new(state.String())
+	}
+
+	ret, err := db(ctx).ListAccessRequestsForTeam(ctx, secretsql.ListAccessRequestsForTeamParams{
+		TeamSlug: teamSlug,
+		State:    s,
+		Offset:   page.Offset(),
+		Limit:    page.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+	return pagination.NewConvertConnection(ret, page, total, func(from *secretsql.ListAccessRequestsForTeamRow) *SecretAccessRequest {
+		return toGraphAccessRequest(&from.SecretAccessRequest)
+	}), nil
+}
+
+func ListAccessRequestsForSecret(ctx context.Context, teamSlug slug.Slug, environment, name string, page *pagination.Pagination) (*SecretAccessRequestConnection, error) {
+	ret, err := db(ctx).ListAccessRequestsForSecret(ctx, secretsql.ListAccessRequestsForSecretParams{
+		TeamSlug:    teamSlug,
+		Environment: environment,
+		SecretName:  name,
+		Offset:      page.Offset(),
+		Limit:       page.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+	return pagination.NewConvertConnection(ret, page, total, func(from *secretsql.ListAccessRequestsForSecretRow) *SecretAccessRequest {
+		return toGraphAccessRequest(&from.SecretAccessRequest)
+	}), nil
+}
+
+// RequestAccess requests access to view the values of a secret in an environment where access must be approved by
+// another team owner.
+func RequestAccess(ctx context.Context, input RequestSecretAccessInput) (*SecretAccessRequest, error) {
+	if len(input.Reason) < 10 {
+		return nil, apierror.Errorf("Reason must be at least 10 characters")
+	}
+
+	if err := authz.CanReadSecretValues(ctx, input.Team, input.Environment); err != nil {
+		return nil, err
+	}
+
+	if !RequiresAccessApproval(ctx, input.Environment) {
+		return nil, apierror.Errorf("Secret values in environment %q can be viewed without an access request.", input.Environment)
+	}
+
+	if _, err := Get(ctx, input.Team, input.Environment, input.Name); err != nil {
+		return nil, err
+	}
+
+	var ret *SecretAccessRequest
+	err := database.Transaction(ctx, func(ctx context.Context) error {
+		actor := authz.ActorFromContext(ctx).User
+		r, err := db(ctx).CreateAccessRequest(ctx, secretsql.CreateAccessRequestParams{
+			TeamSlug:    input.Team,
+			Environment: input.Environment,
+			SecretName:  input.Name,
+			Requester:   actor.Identity(),
+			Reason:      input.Reason,
+		})
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return apierror.Errorf("You already have a pending or approved access request for this secret.")
+			}
+			return err
+		}
+		ret = toGraphAccessRequest(r)
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:          activityLogEntryActionRequestSecretAccess,
+			Actor:           actor,
+			EnvironmentName: new(input.Environment),
+			ResourceType:    activityLogEntryResourceTypeSecret,
+			ResourceName:    input.Name,
+			TeamSlug:        new(input.Team),
+			Data: &SecretAccessRequestedActivityLogEntryData{
+				RequestID: r.ID.String(),
+				Reason:    input.Reason,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// ApproveAccessRequest approves a pending access request, and grants the requester time-limited access to view the
+// values of the secret. The request is claimed before access is granted, so concurrent approvals, denials and expiry of
+// the same request can never leave the requester with access that is not backed by an approved request.
+func ApproveAccessRequest(ctx context.Context, input ApproveSecretAccessRequestInput) (*SecretAccessRequest, error) {
+	l := fromContext(ctx)
+	request, err := getPendingAccessRequest(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := authz.ActorFromContext(ctx)
+	accessExpiresAt := time.Now().Add(l.accessApproval.accessDuration())
+
+	var (
+		ret         *SecretAccessRequest
+		elevationID string
+	)
+	err = database.Transaction(ctx, func(ctx context.Context) error {
+		// The row stays locked until the transaction ends, so the request can only be claimed once.
+		if _, err := db(ctx).ApproveAccessRequest(ctx, secretsql.ApproveAccessRequestParams{
+			ID:                  request.UUID,
+			HandledBy:           new(actor.User.Identity()),
+			HandlerComment:      input.Comment,
+			AccessExpiresAt:     pgtype.Timestamptz{Time: accessExpiresAt, Valid: true},
+			PendingCreatedAfter: pgtype.Timestamptz{Time: time.Now().Add(-l.accessApproval.requestTTL()), Valid: true},
+		}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierror.Errorf("The access request has already been handled.")
+			}
+			return err
+		}
+
+		id, err := createTemporaryRBAC(ctx, l, ViewSecretValuesInput{
+			Name:        request.SecretName,
+			Environment: request.EnvironmentName,
+			Team:        request.TeamSlug,
+			Reason:      request.Reason,
+		}, request.Requester, l.accessApproval.accessDuration())
+		if err != nil {
+			return fmt.Errorf("creating temporary RBAC: %w", err)
+		}
+		elevationID = id
+
+		r, err := db(ctx).SetAccessRequestElevation(ctx, secretsql.SetAccessRequestElevationParams{
+			ID:          request.UUID,
+			ElevationID: &elevationID,
+		})
+		if err != nil {
+			return err
+		}
+		ret = toGraphAccessRequest(r)
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:          activityLogEntryActionApproveSecretAccess,
+			Actor:           actor.User,
+			EnvironmentName: new(request.EnvironmentName),
+			ResourceType:    activityLogEntryResourceTypeSecret,
+			ResourceName:    request.SecretName,
+			TeamSlug:        new(request.TeamSlug),
+			Data: &SecretAccessApprovedActivityLogEntryData{
+				RequestID:       request.UUID.String(),
+				Requester:       request.Requester,
+				AccessExpiresAt: accessExpiresAt,
+				Comment:         input.Comment,
+			},
+		})
+	})
+	if err != nil {
+		if elevationID != "" {
+			// The approval was rolled back, so the access that was granted must be revoked.
+			if err := deleteTemporaryRBAC(context.WithoutCancel(ctx), l, request.EnvironmentName, request.TeamSlug, elevationID); err != nil {
+				l.log.WithError(err).WithField("elevation_id", elevationID).Error("unable to revoke access for rolled back approval")
+			}
+		}
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// DenyAccessRequest denies a pending access request.
+func DenyAccessRequest(ctx context.Context, input DenySecretAccessRequestInput) (*SecretAccessRequest, error) {
+	request, err := getPendingAccessRequest(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret *SecretAccessRequest
+	err = database.Transaction(ctx, func(ctx context.Context) error {
+		actor := authz.ActorFromContext(ctx).User
+		r, err := db(ctx).DenyAccessRequest(ctx, secretsql.DenyAccessRequestParams{
+			ID:             request.UUID,
+			HandledBy:      new(actor.Identity()),
+			HandlerComment: input.Comment,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierror.Errorf("The access request has already been handled.")
+			}
+			return err
+		}
+		ret = toGraphAccessRequest(r)
+
+		return activitylog.Create(ctx, activitylog.CreateInput{
+			Action:          activityLogEntryActionDenySecretAccess,
+			Actor:           actor,
+			EnvironmentName: new(request.EnvironmentName),
+			ResourceType:    activityLogEntryResourceTypeSecret,
+			ResourceName:    request.SecretName,
+			TeamSlug:        new(request.TeamSlug),
+			Data: &SecretAccessDeniedActivityLogEntryData{
+				RequestID: request.UUID.String(),
+				Requester: request.Requester,
+				Comment:   input.Comment,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// getPendingAccessRequest returns the access request if the actor is allowed to handle it and it is still pending.
+// Requesters can not handle their own requests.
+func getPendingAccessRequest(ctx context.Context, id ident.Ident) (*SecretAccessRequest, error) {
+	request, err := GetAccessRequestByIdent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.CanApproveSecretAccess(ctx, request.TeamSlug); err != nil {
+		return nil, err
+	}
+
+	if authz.ActorFromContext(ctx).User.Identity() == request.Requester {
+		return nil, apierror.Errorf("You can not handle your own access request.")
+	}
+
+	if request.State != SecretAccessRequestStatePending {
+		return nil, apierror.Errorf("The access request has already been handled.")
+	}
+
+	if time.Since(request.CreatedAt) > fromContext(ctx).accessApproval.requestTTL() {
+		return nil, apierror.Errorf("The access request has expired.")
+	}
+
+	return request, nil
+}
+
+// getActiveAccessGrant returns the elevation ID of the approved access request that allows the actor to view the
+// values of the secret.
+func getActiveAccessGrant(ctx context.Context, input ViewSecretValuesInput, actor *authz.Actor) (string, error) {
+	grant, err := db(ctx).GetActiveAccessGrant(ctx, secretsql.GetActiveAccessGrantParams{
+		TeamSlug:    input.Team,
+		Environment: input.Environment,
+		SecretName:  input.Name,
+		Requester:   actor.User.Identity(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", apierror.Errorf("Viewing secret values in environment %q requires an approved access request.", input.Environment)
+		}
+		return "", err
+	}
+
+	if grant.ElevationID == nil {
+		return "", fmt.Errorf("approved access request %s has no elevation", grant.ID)
+	}
+
+	return *grant.ElevationID, nil
+}
+
+// RunAccessRequestExpirer periodically expires pending access requests that were not handled in time, and approved
+// access requests where the access has ended. Each expired request is recorded in the activity log.
+func RunAccessRequestExpirer(ctx context.Context, pool *pgxpool.Pool, policy AccessApprovalPolicy, log logrus.FieldLogger) {
+	ctx = database.NewLoaderContext(ctx, pool)
+	ctx = activitylog.NewLoaderContext(ctx, pool, nil, log)
+	db := secretsql.New(pool)
+
+	for {
+		if leaderelection.IsLeader() {
+			expired, err := expireAccessRequests(ctx, db, time.Now().Add(-policy.requestTTL()))
+			if err != nil {
+				log.WithError(err).Error("expiring secret access requests")
+			} else if expired > 0 {
+				log.WithField("expired", expired).Debug("expired secret access requests")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(accessRequestExpirySchedule):
+		}
+	}
+}
+
+// expireAccessRequests expires access requests, and records each expired request in the activity log. It returns the
+// number of expired requests.
+func expireAccessRequests(ctx context.Context, querier *secretsql.Queries, pendingCreatedBefore time.Time) (int, error) {
+	var expired []*secretsql.SecretAccessRequest
+	err := database.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		expired, err = querier.WithTx(database.TransactionFromContext(ctx)).ExpireAccessRequests(ctx, pgtype.Timestamptz{Time: pendingCreatedBefore, Valid: true})
+		if err != nil {
+			return err
+		}
+
+		for _, request := range expired {
+			if err := activitylog.Create(ctx, activitylog.CreateInput{
+				Action:          activityLogEntryActionExpireSecretAccess,
+				Actor:           systemActor{},
+				EnvironmentName: new(request.Environment),
+				ResourceType:    activityLogEntryResourceTypeSecret,
+				ResourceName:    request.SecretName,
+				TeamSlug:        new(request.TeamSlug),
+				Data: &SecretAccessExpiredActivityLogEntryData{
+					RequestID:   request.ID.String(),
+					Requester:   request.Requester,
+					WasApproved: request.AccessExpiresAt.Valid,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(expired), nil
+}
+
+// systemActor is the actor of changes made by the API itself, and not on behalf of a user.
+type systemActor struct{}
+
+func (systemActor) GetID() uuid.UUID                                { return uuid.Nil }
+func (systemActor) Identity() string                                { return "system" }
+func (systemActor) IsServiceAccount() bool                          { return false }
+func (systemActor) IsAdmin() bool                                   { return false }
+func (systemActor) GCPTeamGroups(context.Context) ([]string, error) { return nil, nil }
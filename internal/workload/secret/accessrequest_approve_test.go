@@ -0,0 +1,241 @@
+//go:build integration_test
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/user"
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestApproveAccessRequest(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("concurrent approvals grant access once", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		k8sClient := newFakeRBACClient()
+
+		requestID := insertAccessRequest(ctx, t, pool)
+		approvers := []*user.User{
+			insertTeamOwner(ctx, t, pool, "approver-1@example.com"),
+			insertTeamOwner(ctx, t, pool, "approver-2@example.com"),
+		}
+
+		var (
+			wg        sync.WaitGroup
+			mu        sync.Mutex
+			approved  int
+			rejected  int
+			unhandled []error
+		)
+		for _, approver := range approvers {
+			wg.Go(func() {
+				ctx := newApprovalContext(ctx, pool, k8sClient, approver, log)
+				_, err := ApproveAccessRequest(ctx, ApproveSecretAccessRequestInput{ID: newAccessRequestIdent(requestID)})
+
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case err == nil:
+					approved++
+				case err.Error() == "The access request has already been handled.":
+					rejected++
+				default:
+					unhandled = append(unhandled, err)
+				}
+			})
+		}
+		wg.Wait()
+
+		if len(unhandled) > 0 {
+			t.Fatalf("unexpected errors: %v", unhandled)
+		}
+
+		if approved != 1 || rejected != 1 {
+			t.Fatalf("expected one approval and one rejection, got %d approvals and %d rejections", approved, rejected)
+		}
+
+		for _, gvr := range []schema.GroupVersionResource{roleGVR, roleBindingGVR} {
+			list, err := k8sClient.Resource(gvr).Namespace("team").List(ctx, v1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(list.Items) != 1 {
+				t.Errorf("expected exactly one %s, got %d", gvr.Resource, len(list.Items))
+			}
+		}
+
+		var elevationID string
+		if err := pool.QueryRow(ctx, "SELECT elevation_id FROM secret_access_requests WHERE id = $1", requestID).Scan(&elevationID); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := k8sClient.Resource(roleBindingGVR).Namespace("team").Get(ctx, elevationID, v1.GetOptions{}); err != nil {
+			t.Errorf("expected role binding of the approved request to exist: %v", err)
+		}
+	})
+
+	t.Run("access is revoked when approval can not be recorded", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+		k8sClient := newFakeRBACClient()
+
+		requestID := insertAccessRequest(ctx, t, pool)
+		approver := insertTeamOwner(ctx, t, pool, "approver@example.com")
+
+		// Removing the activity log table makes the transaction fail after the temporary RBAC has been created.
+		if _, err := pool.Exec(ctx, "ALTER TABLE activity_log_entries RENAME TO activity_log_entries_disabled"); err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := newApprovalContext(ctx, pool, k8sClient, approver, log)
+		if _, err := ApproveAccessRequest(ctx, ApproveSecretAccessRequestInput{ID: newAccessRequestIdent(requestID)}); err == nil {
+			t.Fatal("expected error")
+		}
+
+		for _, gvr := range []schema.GroupVersionResource{roleGVR, roleBindingGVR} {
+			list, err := k8sClient.Resource(gvr).Namespace("team").List(ctx, v1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(list.Items) != 0 {
+				t.Errorf("expected no %s, got %d", gvr.Resource, len(list.Items))
+			}
+		}
+
+		var state string
+		if err := pool.QueryRow(ctx, "SELECT state FROM secret_access_requests WHERE id = $1", requestID).Scan(&state); err != nil {
+			t.Fatal(err)
+		}
+
+		if state != "PENDING" {
+			t.Errorf("expected request to still be pending, got %s", state)
+		}
+	})
+}
+
+func newApprovalContext(ctx context.Context, pool *pgxpool.Pool, k8sClient dynamic.Interface, approver *user.User, log logrus.FieldLogger) context.Context {
+	ctx = database.NewLoaderContext(ctx, pool)
+	ctx = authz.NewLoaderContext(ctx, pool)
+	ctx = activitylog.NewLoaderContext(ctx, pool, nil, log)
+	ctx = NewLoaderContext(ctx, pool, nil, nil, map[string]dynamic.Interface{"dev": k8sClient}, []string{"dev"}, nil, AccessApprovalPolicy{Environments: []string{"dev"}}, nil, log)
+	return authz.ContextWithActor(ctx, approver, nil)
+}
+
+func newFakeRBACClient() *dynfake.FakeDynamicClient {
+	scheme := runtime.NewScheme()
+	for _, kind := range []string{"Role", "RoleList", "RoleBinding", "RoleBindingList"} {
+		scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: kind}, &unstructured.Unstructured{})
+	}
+	return dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		roleGVR:        "RoleList",
+		roleBindingGVR: "RoleBindingList",
+	})
+}
+
+func insertAccessRequest(ctx context.Context, t *testing.T, pool *pgxpool.Pool) uuid.UUID {
+	t.Helper()
+
+	var id uuid.UUID
+	stmt := `
+		INSERT INTO secret_access_requests (team_slug, environment, secret_name, requester, reason)
+		VALUES ('team', 'dev', 'database', 'requester@example.com', 'Debugging a production incident')
+		RETURNING id`
+	if err := pool.QueryRow(ctx, stmt).Scan(&id); err != nil {
+		t.Fatalf("failed to insert access request: %v", err)
+	}
+
+	return id
+}
+
+func insertTeamOwner(ctx context.Context, t *testing.T, pool *pgxpool.Pool, email string) *user.User {
+	t.Helper()
+
+	u := &user.User{Email: email, Name: email, ExternalID: email}
+	if err := pool.QueryRow(ctx, "INSERT INTO users (name, email, external_id) VALUES ($1, $2, $3) RETURNING id", u.Name, u.Email, u.ExternalID).Scan(&u.UUID); err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	if _, err := pool.Exec(ctx, "INSERT INTO user_roles (role_name, user_id, target_team_slug) VALUES ('Team owner', $1, 'team')", u.UUID); err != nil {
+		t.Fatalf("failed to assign team owner role: %v", err)
+	}
+
+	return u
+}
+
+func startPostgresql(ctx context.Context, t *testing.T, log logrus.FieldLogger) (container *postgres.PostgresContainer, dsn string, err error) {
+	container, err = postgres.Run(
+		ctx,
+		"docker.io/postgres:16-alpine",
+		postgres.WithDatabase("test"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		postgres.WithSQLDriver("pgx"),
+		postgres.BasicWaitStrategies(),
+	)
+	defer testcontainers.CleanupContainer(t, container)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to start container: %w", err)
+	}
+
+	dsn, err = container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get connection string: %w", err)
+	}
+
+	pool, err := database.NewPool(ctx, dsn, log, true)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create pool: %w", err)
+	}
+
+	if _, err := pool.Exec(ctx, "INSERT INTO teams (slug, purpose, slack_channel) VALUES ('team', 'purpose', '#channel')"); err != nil {
+		pool.Close()
+		return nil, "", fmt.Errorf("failed to insert team: %w", err)
+	}
+	pool.Close()
+
+	if err := container.Snapshot(ctx); err != nil {
+		return nil, "", fmt.Errorf("failed to snapshot: %w", err)
+	}
+
+	return container, dsn, nil
+}
+
+func getConnection(ctx context.Context, t *testing.T, container *postgres.PostgresContainer, dsn string, log logrus.FieldLogger) *pgxpool.Pool {
+	pool, _ := database.NewPool(ctx, dsn, log, false)
+
+	t.Cleanup(func() {
+		pool.Close()
+		if err := container.Restore(ctx); err != nil {
+			t.Fatalf("failed to restore database: %v", err)
+		}
+	})
+
+	return pool
+}
@@ -0,0 +1,75 @@
+//go:build integration_test
+
+package secret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/workload/secret/secretsql"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestExpireAccessRequests(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("expired requests are recorded in the activity log", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+
+		expiredID := insertAccessRequest(ctx, t, pool)
+		if _, err := pool.Exec(ctx, "UPDATE secret_access_requests SET created_at = NOW() - INTERVAL '2 days' WHERE id = $1", expiredID); err != nil {
+			t.Fatal(err)
+		}
+		pendingID := insertAccessRequest(ctx, t, pool)
+
+		ctx := database.NewLoaderContext(ctx, pool)
+		ctx = activitylog.NewLoaderContext(ctx, pool, nil, log)
+
+		expired, err := expireAccessRequests(ctx, secretsql.New(pool), time.Now().Add(-24*time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expired != 1 {
+			t.Fatalf("expected 1 expired request, got %d", expired)
+		}
+
+		for id, want := range map[string]string{expiredID.String(): "EXPIRED", pendingID.String(): "PENDING"} {
+			var state string
+			if err := pool.QueryRow(ctx, "SELECT state FROM secret_access_requests WHERE id = $1", id).Scan(&state); err != nil {
+				t.Fatal(err)
+			}
+			if state != want {
+				t.Errorf("request %s: expected state %s, got %s", id, want, state)
+			}
+		}
+
+		var (
+			actor, resourceName string
+			data                []byte
+		)
+		stmt := "SELECT actor, resource_name, data FROM activity_log_entries WHERE action = $1 AND resource_type = $2"
+		if err := pool.QueryRow(ctx, stmt, activityLogEntryActionExpireSecretAccess, activityLogEntryResourceTypeSecret).Scan(&actor, &resourceName, &data); err != nil {
+			t.Fatal(err)
+		}
+		if actor != "system" || resourceName != "database" {
+			t.Errorf("expected entry by system for secret database, got %s for %s", actor, resourceName)
+		}
+
+		got, err := activitylog.UnmarshalData[SecretAccessExpiredActivityLogEntryData](activitylog.GenericActivityLogEntry{Data: data})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.RequestID != expiredID.String() || got.Requester != "requester@example.com" || got.WasApproved {
+			t.Errorf("unexpected entry data: %+v", got)
+		}
+	})
+}
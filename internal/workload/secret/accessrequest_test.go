@@ -0,0 +1,44 @@
+package secret
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAccessApprovalPolicy_UnmarshalJSON(t *testing.T) {
+	var p AccessApprovalPolicy
+	if err := json.Unmarshal([]byte(`{"environments":["prod"],"requestTTL":"2d","accessDuration":"30m"}`), &p); err != nil {
+		t.Fatal(err)
+	}
+
+	if !p.RequiresApproval("prod") {
+		t.Error("expected prod to require approval")
+	}
+	if p.RequiresApproval("dev") {
+		t.Error("expected dev to not require approval")
+	}
+	if got := p.requestTTL(); got != 48*time.Hour {
+		t.Errorf("expected request TTL of 48h, got %v", got)
+	}
+	if got := p.accessDuration(); got != 30*time.Minute {
+		t.Errorf("expected access duration of 30m, got %v", got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"environment":["prod"]}`), &p); err == nil {
+		t.Error("expected error for unknown field")
+	}
+}
+
+func TestAccessApprovalPolicy_Defaults(t *testing.T) {
+	var p AccessApprovalPolicy
+	if p.RequiresApproval("prod") {
+		t.Error("expected empty policy to not require approval")
+	}
+	if got := p.requestTTL(); got != defaultAccessRequestTTL {
+		t.Errorf("expected default request TTL, got %v", got)
+	}
+	if got := p.accessDuration(); got != defaultAccessDuration {
+		t.Errorf("expected default access duration, got %v", got)
+	}
+}
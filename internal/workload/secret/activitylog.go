@@ -2,6 +2,7 @@ package secret
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/nais/api/internal/activitylog"
 )
@@ -12,6 +13,15 @@ const (
 	activityLogEntryActionUpdateSecretValue activitylog.ActivityLogEntryAction       = "UPDATE_SECRET_VALUE"
 	activityLogEntryActionRemoveSecretValue activitylog.ActivityLogEntryAction       = "REMOVE_SECRET_VALUE"
 	activityLogEntryActionViewSecretValues  activitylog.ActivityLogEntryAction       = "VIEW_SECRET_VALUES"
+	activityLogEntryActionRollbackSecret    activitylog.ActivityLogEntryAction       = "ROLLBACK_SECRET"
+
+	activityLogEntryActionRequestSecretAccess activitylog.ActivityLogEntryAction = "REQUEST_SECRET_ACCESS"
+	activityLogEntryActionApproveSecretAccess activitylog.ActivityLogEntryAction = "APPROVE_SECRET_ACCESS"
+	activityLogEntryActionDenySecretAccess    activitylog.ActivityLogEntryAction = "DENY_SECRET_ACCESS"
+	activityLogEntryActionExpireSecretAccess  activitylog.ActivityLogEntryAction = "EXPIRE_SECRET_ACCESS"
+
+	activityLogEntryActionSetSecretExternalSource    activitylog.ActivityLogEntryAction = "SET_SECRET_EXTERNAL_SOURCE"
+	activityLogEntryActionRemoveSecretExternalSource activitylog.ActivityLogEntryAction = "REMOVE_SECRET_EXTERNAL_SOURCE"
 )
 
 func init() {
@@ -85,6 +95,94 @@ func init() {
 				GenericActivityLogEntry: entry.WithMessage("Viewed secret values"),
 				Data:                    data,
 			}, nil
+		case activityLogEntryActionRollbackSecret:
+			data, err := activitylog.TransformData(entry, func(data *SecretRolledBackActivityLogEntryData) *SecretRolledBackActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return SecretRolledBackActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage(fmt.Sprintf("Rolled back secret to version %d", data.Version)),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionRequestSecretAccess:
+			data, err := activitylog.TransformData(entry, func(data *SecretAccessRequestedActivityLogEntryData) *SecretAccessRequestedActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return SecretAccessRequestedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage("Requested access to secret values"),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionApproveSecretAccess:
+			data, err := activitylog.TransformData(entry, func(data *SecretAccessApprovedActivityLogEntryData) *SecretAccessApprovedActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return SecretAccessApprovedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage(fmt.Sprintf("Approved access to secret values for %s", data.Requester)),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionDenySecretAccess:
+			data, err := activitylog.TransformData(entry, func(data *SecretAccessDeniedActivityLogEntryData) *SecretAccessDeniedActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return SecretAccessDeniedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage(fmt.Sprintf("Denied access to secret values for %s", data.Requester)),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionExpireSecretAccess:
+			data, err := activitylog.TransformData(entry, func(data *SecretAccessExpiredActivityLogEntryData) *SecretAccessExpiredActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			message := fmt.Sprintf("Access request to secret values for %s expired", data.Requester)
+			if data.WasApproved {
+				message = fmt.Sprintf("Access to secret values for %s expired", data.Requester)
+			}
+			return SecretAccessExpiredActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage(message),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionSetSecretExternalSource:
+			data, err := activitylog.TransformData(entry, func(data *SecretExternalSourceSetActivityLogEntryData) *SecretExternalSourceSetActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return SecretExternalSourceSetActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage(fmt.Sprintf("Set external source %s for secret", data.Source)),
+				Data:                    data,
+			}, nil
+		case activityLogEntryActionRemoveSecretExternalSource:
+			data, err := activitylog.TransformData(entry, func(data *SecretExternalSourceRemovedActivityLogEntryData) *SecretExternalSourceRemovedActivityLogEntryData {
+				return data
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return SecretExternalSourceRemovedActivityLogEntry{
+				GenericActivityLogEntry: entry.WithMessage(fmt.Sprintf("Removed external source %s from secret", data.Source)),
+				Data:                    data,
+			}, nil
 		default:
 			return nil, fmt.Errorf("unsupported secret activity log entry action: %q", entry.Action)
 		}
@@ -97,6 +195,13 @@ func init() {
 	activitylog.RegisterFilter("SECRET_VALUE_UPDATED", activityLogEntryActionUpdateSecretValue, activityLogEntryResourceTypeSecret)
 	activitylog.RegisterFilter("SECRET_VALUE_REMOVED", activityLogEntryActionRemoveSecretValue, activityLogEntryResourceTypeSecret)
 	activitylog.RegisterFilter("SECRET_VALUES_VIEWED", activityLogEntryActionViewSecretValues, activityLogEntryResourceTypeSecret)
+	activitylog.RegisterFilter("SECRET_ROLLED_BACK", activityLogEntryActionRollbackSecret, activityLogEntryResourceTypeSecret)
+	activitylog.RegisterFilter("SECRET_ACCESS_REQUESTED", activityLogEntryActionRequestSecretAccess, activityLogEntryResourceTypeSecret)
+	activitylog.RegisterFilter("SECRET_ACCESS_APPROVED", activityLogEntryActionApproveSecretAccess, activityLogEntryResourceTypeSecret)
+	activitylog.RegisterFilter("SECRET_ACCESS_DENIED", activityLogEntryActionDenySecretAccess, activityLogEntryResourceTypeSecret)
+	activitylog.RegisterFilter("SECRET_ACCESS_EXPIRED", activityLogEntryActionExpireSecretAccess, activityLogEntryResourceTypeSecret)
+	activitylog.RegisterFilter("SECRET_EXTERNAL_SOURCE_SET", activityLogEntryActionSetSecretExternalSource, activityLogEntryResourceTypeSecret)
+	activitylog.RegisterFilter("SECRET_EXTERNAL_SOURCE_REMOVED", activityLogEntryActionRemoveSecretExternalSource, activityLogEntryResourceTypeSecret)
 }
 
 type SecretCreatedActivityLogEntry struct {
@@ -108,6 +213,10 @@ type SecretUpdatedActivityLogEntry struct {
 	Data *SecretUpdatedActivityLogEntryData
 }
 
+func (e SecretUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type SecretUpdatedActivityLogEntryData struct {
 	UpdatedFields []*SecretUpdatedActivityLogEntryDataUpdatedField
 }
@@ -123,6 +232,10 @@ type SecretValueAddedActivityLogEntry struct {
 	Data *SecretValueAddedActivityLogEntryData
 }
 
+func (e SecretValueAddedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type SecretValueAddedActivityLogEntryData struct {
 	ValueName string
 }
@@ -132,6 +245,10 @@ type SecretValueUpdatedActivityLogEntry struct {
 	Data *SecretValueUpdatedActivityLogEntryData
 }
 
+func (e SecretValueUpdatedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type SecretValueUpdatedActivityLogEntryData struct {
 	ValueName string
 }
@@ -141,6 +258,10 @@ type SecretValueRemovedActivityLogEntry struct {
 	Data *SecretValueRemovedActivityLogEntryData
 }
 
+func (e SecretValueRemovedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type SecretValueRemovedActivityLogEntryData struct {
 	ValueName string
 }
@@ -154,7 +275,111 @@ type SecretValuesViewedActivityLogEntry struct {
 	Data *SecretValuesViewedActivityLogEntryData
 }
 
+func (e SecretValuesViewedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
 type SecretValuesViewedActivityLogEntryData struct {
 	Reason      string
 	ElevationID string
 }
+
+type SecretRolledBackActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *SecretRolledBackActivityLogEntryData
+}
+
+func (e SecretRolledBackActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type SecretRolledBackActivityLogEntryData struct {
+	Version int
+}
+
+type SecretAccessRequestedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *SecretAccessRequestedActivityLogEntryData
+}
+
+func (e SecretAccessRequestedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type SecretAccessRequestedActivityLogEntryData struct {
+	RequestID string
+	Reason    string
+}
+
+type SecretAccessApprovedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *SecretAccessApprovedActivityLogEntryData
+}
+
+func (e SecretAccessApprovedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type SecretAccessApprovedActivityLogEntryData struct {
+	RequestID       string
+	Requester       string
+	AccessExpiresAt time.Time
+	Comment         *string
+}
+
+type SecretAccessDeniedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *SecretAccessDeniedActivityLogEntryData
+}
+
+func (e SecretAccessDeniedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type SecretAccessDeniedActivityLogEntryData struct {
+	RequestID string
+	Requester string
+	Comment   *string
+}
+
+type SecretAccessExpiredActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *SecretAccessExpiredActivityLogEntryData
+}
+
+func (e SecretAccessExpiredActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type SecretAccessExpiredActivityLogEntryData struct {
+	RequestID   string
+	Requester   string
+	WasApproved bool
+}
+
+type SecretExternalSourceSetActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *SecretExternalSourceSetActivityLogEntryData
+}
+
+func (e SecretExternalSourceSetActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type SecretExternalSourceSetActivityLogEntryData struct {
+	Source    string
+	Reference string
+}
+
+type SecretExternalSourceRemovedActivityLogEntry struct {
+	activitylog.GenericActivityLogEntry
+	Data *SecretExternalSourceRemovedActivityLogEntryData
+}
+
+func (e SecretExternalSourceRemovedActivityLogEntry) GetData() any {
+	return e.Data
+}
+
+type SecretExternalSourceRemovedActivityLogEntryData struct {
+	Source string
+}
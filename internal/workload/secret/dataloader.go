@@ -7,11 +7,14 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
 	"github.com/nais/api/internal/graph/apierror"
 	"github.com/nais/api/internal/kubernetes"
 	"github.com/nais/api/internal/kubernetes/watcher"
 	"github.com/nais/api/internal/user"
+	"github.com/nais/api/internal/workload/secret/secretsql"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -26,13 +29,17 @@ const loadersKey ctxKey = iota
 // ClientCreator creates a client that impersonates the user (for read operations requiring elevation)
 type ClientCreator func(ctx context.Context, environment string) (dynamic.NamespaceableResourceInterface, error)
 
-func NewLoaderContext(ctx context.Context, watcher *watcher.Watcher[*Secret], clientCreator ClientCreator, k8sClients map[string]dynamic.Interface, environments []string, log logrus.FieldLogger) context.Context {
+func NewLoaderContext(ctx context.Context, pool *pgxpool.Pool, watcher *watcher.Watcher[*Secret], clientCreator ClientCreator, k8sClients map[string]dynamic.Interface, environments []string, versionCipher *VersionCipher, accessApproval AccessApprovalPolicy, externalSources ExternalSources, log logrus.FieldLogger) context.Context {
 	return context.WithValue(ctx, loadersKey, &loaders{
-		watcher:       watcher,
-		clientCreator: clientCreator,
-		k8sClients:    k8sClients,
-		log:           log,
-		environments:  environments,
+		internalQuerier: secretsql.New(pool),
+		watcher:         watcher,
+		clientCreator:   clientCreator,
+		k8sClients:      k8sClients,
+		log:             log,
+		environments:    environments,
+		versionCipher:   versionCipher,
+		accessApproval:  accessApproval,
+		externalSources: externalSources,
 	})
 }
 
@@ -105,11 +112,25 @@ func fromContext(ctx context.Context) *loaders {
 }
 
 type loaders struct {
-	watcher       *watcher.Watcher[*Secret]
-	clientCreator ClientCreator
-	k8sClients    map[string]dynamic.Interface
-	log           logrus.FieldLogger
-	environments  []string
+	internalQuerier *secretsql.Queries
+	watcher         *watcher.Watcher[*Secret]
+	clientCreator   ClientCreator
+	k8sClients      map[string]dynamic.Interface
+	log             logrus.FieldLogger
+	environments    []string
+	versionCipher   *VersionCipher
+	accessApproval  AccessApprovalPolicy
+	externalSources ExternalSources
+}
+
+func db(ctx context.Context) *secretsql.Queries {
+	l := fromContext(ctx)
+
+	if tx := database.TransactionFromContext(ctx); tx != nil {
+		return l.internalQuerier.WithTx(tx)
+	}
+
+	return l.internalQuerier
 }
 
 // Watcher returns the secret watcher
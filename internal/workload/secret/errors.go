@@ -23,3 +23,27 @@ func (errAlreadyExists) Error() string {
 }
 
 var ErrAlreadyExists = errAlreadyExists{}
+
+type errExternallyManaged struct{}
+
+func (errExternallyManaged) GraphError() string {
+	return "The secret is synced from an external source, unable to modify. Remove the external source to edit the secret in Console."
+}
+
+func (errExternallyManaged) Error() string {
+	return "externally managed secret"
+}
+
+var ErrExternallyManaged = errExternallyManaged{}
+
+type errModified struct{}
+
+func (errModified) GraphError() string {
+	return "The secret was modified by someone else while it was being changed. Try again."
+}
+
+func (errModified) Error() string {
+	return "secret modified concurrently"
+}
+
+var ErrModified = errModified{}
@@ -0,0 +1,479 @@
+package secret
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/graph/apierror"
+	"github.com/nais/api/internal/kubernetes/watcher"
+	"github.com/nais/api/internal/leaderelection"
+	"github.com/nais/api/internal/slug"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	annotationExternalSource             = "console.nais.io/external-source"
+	annotationExternalSourceReference    = "console.nais.io/external-source-reference"
+	annotationExternalSourceSyncStatus   = "console.nais.io/external-source-sync-status"
+	annotationExternalSourceLastSyncedAt = "console.nais.io/external-source-last-synced-at"
+	annotationExternalSourceSyncError    = "console.nais.io/external-source-sync-error"
+
+	externalSourceSyncSchedule = 5 * time.Minute
+)
+
+// ExternalSource is a backend outside of NAIS, such as a key management service or a vault, that the values of a
+// secret can be synced from.
+type ExternalSource interface {
+	// Fetch returns the values of the secret identified by the reference. References are relative to the team, and
+	// implementations must never return values that belong to another team.
+	Fetch(ctx context.Context, teamSlug slug.Slug, reference string) (map[string][]byte, error)
+}
+
+// ExternalSources are the configured external sources, by name.
+type ExternalSources map[string]ExternalSource
+
+// FileSource is an external source that reads secret values from JSON files in a directory, intended for local
+// development and testing. Each team has a subdirectory named after the team slug, the reference is the path of a file
+// relative to the team directory, and the file contains an object with the values of the secret as strings.
+type FileSource struct {
+	dir string
+}
+
+func NewFileSource(dir string) *FileSource {
+	return &FileSource{dir: dir}
+}
+
+func (f *FileSource) Fetch(_ context.Context, teamSlug slug.Slug, reference string) (map[string][]byte, error) {
+	// Opening the team directory as the root makes references that point outside of it, such as through "..", fail.
+	root, err := os.OpenRoot(filepath.Join(f.dir, teamSlug.String()))
+	if err != nil {
+		return nil, fmt.Errorf("opening source directory: %w", err)
+	}
+	defer root.Close()
+
+	file, err := root.Open(reference)
+	if err != nil {
+		return nil, fmt.Errorf("opening %q: %w", reference, err)
+	}
+	defer file.Close()
+
+	b, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %w", reference, err)
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", reference, err)
+	}
+
+	ret := make(map[string][]byte, len(values))
+	for k, v := range values {
+		ret[k] = []byte(v)
+	}
+	return ret, nil
+}
+
+type SecretExternalSourceSyncStatus string
+
+const (
+	SecretExternalSourceSyncStatusPending SecretExternalSourceSyncStatus = "PENDING"
+	SecretExternalSourceSyncStatusSynced  SecretExternalSourceSyncStatus = "SYNCED"
+	SecretExternalSourceSyncStatusFailed  SecretExternalSourceSyncStatus = "FAILED"
+)
+
+func (e SecretExternalSourceSyncStatus) IsValid() bool {
+	switch e {
+	case SecretExternalSourceSyncStatusPending, SecretExternalSourceSyncStatusSynced, SecretExternalSourceSyncStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e SecretExternalSourceSyncStatus) String() string {
+	return string(e)
+}
+
+func (e *SecretExternalSourceSyncStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	tmp := SecretExternalSourceSyncStatus(str)
+	if !tmp.IsValid() {
+		return fmt.Errorf("%s is not a valid SecretExternalSourceSyncStatus", str)
+	}
+
+	*e = tmp
+	return nil
+}
+
+func (e SecretExternalSourceSyncStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SecretExternalSource struct {
+	Source       string                         `json:"source"`
+	Reference    string                         `json:"reference"`
+	SyncStatus   SecretExternalSourceSyncStatus `json:"syncStatus"`
+	LastSyncedAt *time.Time                     `json:"lastSyncedAt"`
+	SyncError    *string                        `json:"syncError"`
+}
+
+type SetSecretExternalSourceInput struct {
+	Name        string    `json:"name"`
+	Environment string    `json:"environment"`
+	Team        slug.Slug `json:"team"`
+	Source      string    `json:"source"`
+	Reference   string    `json:"reference"`
+}
+
+type SetSecretExternalSourcePayload struct {
+	Secret *Secret `json:"secret"`
+}
+
+type RemoveSecretExternalSourceInput struct {
+	Name        string    `json:"name"`
+	Environment string    `json:"environment"`
+	Team        slug.Slug `json:"team"`
+}
+
+type RemoveSecretExternalSourcePayload struct {
+	Secret *Secret `json:"secret"`
+}
+
+// externalSourceFromAnnotations returns the external source of a secret, or nil if the secret is managed in Console.
+func externalSourceFromAnnotations(annotations map[string]string) *SecretExternalSource {
+	source, ok := annotations[annotationExternalSource]
+	if !ok {
+		return nil
+	}
+
+	ret := &SecretExternalSource{
+		Source:     source,
+		Reference:  annotations[annotationExternalSourceReference],
+		SyncStatus: SecretExternalSourceSyncStatusPending,
+	}
+
+	if status := SecretExternalSourceSyncStatus(annotations[annotationExternalSourceSyncStatus]); status.IsValid() {
+		ret.SyncStatus = status
+	}
+
+	if t, ok := annotations[annotationExternalSourceLastSyncedAt]; ok {
+		if tm, err := time.Parse(time.RFC3339, t); err == nil {
+			ret.LastSyncedAt = &tm
+		}
+	}
+
+	if msg, ok := annotations[annotationExternalSourceSyncError]; ok {
+		ret.SyncError = &msg
+	}
+
+	return ret
+}
+
+// referenceIsScopedToTeam reports whether a reference stays within the team it is resolved for, i.e. it is not absolute
+// and has no ".." elements.
+func referenceIsScopedToTeam(reference string) bool {
+	if strings.HasPrefix(reference, "/") || strings.HasPrefix(reference, `\`) {
+		return false
+	}
+
+	return !slices.Contains(strings.FieldsFunc(reference, func(r rune) bool { return r == '/' || r == '\\' }), "..")
+}
+
+func secretIsExternallyManaged(obj *unstructured.Unstructured) bool {
+	_, ok := obj.GetAnnotations()[annotationExternalSource]
+	return ok
+}
+
+// SetExternalSource makes the values of a secret synced from an external source. The values are synced immediately,
+// and the secret can not be edited in Console until the external source is removed.
+func SetExternalSource(ctx context.Context, input SetSecretExternalSourceInput) (*Secret, error) {
+	l := fromContext(ctx)
+	if len(l.externalSources) == 0 {
+		return nil, apierror.Errorf("No external secret sources are configured.")
+	}
+
+	if _, ok := l.externalSources[input.Source]; !ok {
+		return nil, apierror.Errorf("Unknown external source %q. Available sources: %s.", input.Source, strings.Join(slices.Sorted(maps.Keys(l.externalSources)), ", "))
+	}
+
+	if strings.TrimSpace(input.Reference) == "" {
+		return nil, apierror.Errorf("The reference to the secret in the external source must not be empty.")
+	}
+
+	if !referenceIsScopedToTeam(input.Reference) {
+		return nil, apierror.Errorf("The reference must be relative to the team, and can not point to secrets of other teams.")
+	}
+
+	client, err := l.Watcher().SystemAuthenticatedClient(ctx, input.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := client.Namespace(input.Team.String()).Get(ctx, input.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !secretIsManagedByConsole(obj) {
+		return nil, ErrUnmanaged
+	}
+
+	actor := authz.ActorFromContext(ctx)
+	mergedAnnotations := mergeAnnotations(obj, actor.User.Identity(), map[string]string{
+		annotationExternalSource:             input.Source,
+		annotationExternalSourceReference:    input.Reference,
+		annotationExternalSourceSyncStatus:   "",
+		annotationExternalSourceLastSyncedAt: "",
+		annotationExternalSourceSyncError:    "",
+	})
+
+	patched, err := patchAnnotations(ctx, client, obj, mergedAnnotations)
+	if err != nil {
+		return nil, err
+	}
+
+	synced, err := syncExternalSource(ctx, client, l.externalSources, patched)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordVersion(ctx, input.Team, input.Environment, input.Name, obj, synced, actor.User.Identity()); err != nil {
+		l.log.WithError(err).Errorf("unable to record secret version")
+	}
+
+	err = activitylog.Create(ctx, activitylog.CreateInput{
+		Action:          activityLogEntryActionSetSecretExternalSource,
+		Actor:           actor.User,
+		EnvironmentName: new(input.Environment),
+		ResourceType:    activityLogEntryResourceTypeSecret,
+		ResourceName:    input.Name,
+		TeamSlug:        new(input.Team),
+		Data: &SecretExternalSourceSetActivityLogEntryData{
+			Source:    input.Source,
+			Reference: input.Reference,
+		},
+	})
+	if err != nil {
+		l.log.WithError(err).Errorf("unable to create activity log entry")
+	}
+
+	return secretFromAPIResponse(synced, input.Environment)
+}
+
+// RemoveExternalSource stops syncing the values of a secret from its external source. The current values are kept, and
+// the secret can be edited in Console again.
+func RemoveExternalSource(ctx context.Context, input RemoveSecretExternalSourceInput) (*Secret, error) {
+	l := fromContext(ctx)
+	client, err := l.Watcher().SystemAuthenticatedClient(ctx, input.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := client.Namespace(input.Team.String()).Get(ctx, input.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !secretIsManagedByConsole(obj) {
+		return nil, ErrUnmanaged
+	}
+
+	if !secretIsExternallyManaged(obj) {
+		return nil, apierror.Errorf("The secret is not synced from an external source.")
+	}
+
+	source := obj.GetAnnotations()[annotationExternalSource]
+	actor := authz.ActorFromContext(ctx)
+	mergedAnnotations := mergeAnnotations(obj, actor.User.Identity(), map[string]string{
+		annotationExternalSource:             "",
+		annotationExternalSourceReference:    "",
+		annotationExternalSourceSyncStatus:   "",
+		annotationExternalSourceLastSyncedAt: "",
+		annotationExternalSourceSyncError:    "",
+	})
+
+	patched, err := patchAnnotations(ctx, client, obj, mergedAnnotations)
+	if err != nil {
+		return nil, err
+	}
+
+	err = activitylog.Create(ctx, activitylog.CreateInput{
+		Action:          activityLogEntryActionRemoveSecretExternalSource,
+		Actor:           actor.User,
+		EnvironmentName: new(input.Environment),
+		ResourceType:    activityLogEntryResourceTypeSecret,
+		ResourceName:    input.Name,
+		TeamSlug:        new(input.Team),
+		Data: &SecretExternalSourceRemovedActivityLogEntryData{
+			Source: source,
+		},
+	})
+	if err != nil {
+		l.log.WithError(err).Errorf("unable to create activity log entry")
+	}
+
+	return secretFromAPIResponse(patched, input.Environment)
+}
+
+func patchAnnotations(ctx context.Context, client dynamic.NamespaceableResourceInterface, obj *unstructured.Unstructured, annotations map[string]string) (*unstructured.Unstructured, error) {
+	patchBytes, err := json.Marshal([]map[string]any{
+		{"op": "replace", "path": "/metadata/annotations", "value": annotations},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling patch: %w", err)
+	}
+
+	patched, err := client.Namespace(obj.GetNamespace()).Patch(ctx, obj.GetName(), types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("patching secret: %w", err)
+	}
+	return patched, nil
+}
+
+// fetchExternalValues fetches the values of a secret from the named external source, and returns them base64 encoded as
+// stored in the Kubernetes object, along with the names of the values that are not valid UTF-8.
+func fetchExternalValues(ctx context.Context, sources ExternalSources, teamSlug slug.Slug, source, reference string) (map[string]string, []string, error) {
+	s, ok := sources[source]
+	if !ok {
+		return nil, nil, fmt.Errorf("external source %q is not configured", source)
+	}
+
+	values, err := s.Fetch(ctx, teamSlug, reference)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := make(map[string]string, len(values))
+	binaryKeys := make([]string, 0)
+	for k, v := range values {
+		if err := validateSecretValue(&SecretValueInput{Name: k}); err != nil {
+			return nil, nil, fmt.Errorf("invalid secret value name: %w", err)
+		}
+
+		data[k] = base64.StdEncoding.EncodeToString(v)
+		if !utf8.Valid(v) {
+			binaryKeys = append(binaryKeys, k)
+		}
+	}
+	slices.Sort(binaryKeys)
+
+	return data, binaryKeys, nil
+}
+
+// syncExternalSource replaces the values of a secret with the values from its external source. When the values can not
+// be fetched, the current values are kept and the error is recorded in the sync status of the secret.
+func syncExternalSource(ctx context.Context, client dynamic.NamespaceableResourceInterface, sources ExternalSources, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	annotations := maps.Clone(obj.GetAnnotations())
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	var patch []map[string]any
+	data, binaryKeys, err := fetchExternalValues(ctx, sources, slug.Slug(obj.GetNamespace()), annotations[annotationExternalSource], annotations[annotationExternalSourceReference])
+	if err != nil {
+		annotations[annotationExternalSourceSyncStatus] = SecretExternalSourceSyncStatusFailed.String()
+		annotations[annotationExternalSourceSyncError] = err.Error()
+	} else {
+		annotations[annotationExternalSourceSyncStatus] = SecretExternalSourceSyncStatusSynced.String()
+		annotations[annotationExternalSourceLastSyncedAt] = time.Now().UTC().Format(time.RFC3339)
+		delete(annotations, annotationExternalSourceSyncError)
+
+		delete(annotations, annotationBinaryKeys)
+		if len(binaryKeys) > 0 {
+			b, _ := json.Marshal(binaryKeys)
+			annotations[annotationBinaryKeys] = string(b)
+		}
+
+		// The add operation replaces /data if it exists, and creates it otherwise.
+		patch = append(patch, map[string]any{"op": "add", "path": "/data", "value": data})
+	}
+	patch = append(patch, map[string]any{"op": "replace", "path": "/metadata/annotations", "value": annotations})
+
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling patch: %w", err)
+	}
+
+	patched, err := client.Namespace(obj.GetNamespace()).Patch(ctx, obj.GetName(), types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("patching secret: %w", err)
+	}
+	return patched, nil
+}
+
+// RunExternalSourceSyncer periodically syncs the values of all secrets with an external source.
+func RunExternalSourceSyncer(ctx context.Context, w *watcher.Watcher[*Secret], sources ExternalSources, log logrus.FieldLogger) {
+	for {
+		if leaderelection.IsLeader() {
+			syncExternalSources(ctx, w, sources, log)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(externalSourceSyncSchedule):
+		}
+	}
+}
+
+func syncExternalSources(ctx context.Context, w *watcher.Watcher[*Secret], sources ExternalSources, log logrus.FieldLogger) {
+	for _, s := range watcher.Objects(w.All()) {
+		if s.ExternalSource == nil {
+			continue
+		}
+
+		log := log.WithFields(logrus.Fields{
+			"environment": s.EnvironmentName,
+			"team":        s.TeamSlug,
+			"secret":      s.Name,
+		})
+
+		client, err := w.SystemAuthenticatedClient(ctx, s.EnvironmentName)
+		if err != nil {
+			log.WithError(err).Error("creating client")
+			continue
+		}
+
+		obj, err := client.Namespace(s.TeamSlug.String()).Get(ctx, s.Name, v1.GetOptions{})
+		if err != nil {
+			log.WithError(err).Error("getting secret")
+			continue
+		}
+
+		// The external source may have been removed after the cache was populated.
+		if !secretIsExternallyManaged(obj) {
+			continue
+		}
+
+		synced, err := syncExternalSource(ctx, client, sources, obj)
+		if err != nil {
+			log.WithError(err).Error("syncing secret from external source")
+			continue
+		}
+
+		if msg, ok := synced.GetAnnotations()[annotationExternalSourceSyncError]; ok {
+			log.WithField("error", msg).Warn("unable to fetch secret values from external source")
+		}
+	}
+}
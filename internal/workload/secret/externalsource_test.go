@@ -0,0 +1,102 @@
+package secret
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileSource_Fetch(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "team"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "team", "db.json"), []byte(`{"PASSWORD":"secret"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "team", "invalid.json"), []byte(`["PASSWORD"]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "other"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "other", "db.json"), []byte(`{"PASSWORD":"other"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	src := NewFileSource(dir)
+	ctx := context.Background()
+
+	values, err := src.Fetch(ctx, "team", "db.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(values["PASSWORD"]); got != "secret" || len(values) != 1 {
+		t.Errorf("expected PASSWORD=secret, got %v", values)
+	}
+
+	if _, err := src.Fetch(ctx, "team", "missing.json"); err == nil {
+		t.Error("expected error for missing file")
+	}
+	if _, err := src.Fetch(ctx, "team", "invalid.json"); err == nil {
+		t.Error("expected error for file that is not an object")
+	}
+	if _, err := src.Fetch(ctx, "team", "../other/db.json"); err == nil {
+		t.Error("expected error for reference to another team")
+	}
+	if _, err := src.Fetch(ctx, "team", "other/db.json"); err == nil {
+		t.Error("expected reference to be resolved relative to the team")
+	}
+}
+
+func TestReferenceIsScopedToTeam(t *testing.T) {
+	tests := map[string]bool{
+		"db.json":                  true,
+		"path/to/db.json":          true,
+		"db..json":                 true,
+		"../other/db.json":         false,
+		"path/../../other/db.json": false,
+		"/other/db.json":           false,
+		`..\other\db.json`:         false,
+		"kv/data/../../other/team": false,
+	}
+
+	for reference, want := range tests {
+		if got := referenceIsScopedToTeam(reference); got != want {
+			t.Errorf("referenceIsScopedToTeam(%q) = %v, want %v", reference, got, want)
+		}
+	}
+}
+
+func TestExternalSourceFromAnnotations(t *testing.T) {
+	if s := externalSourceFromAnnotations(map[string]string{secretAnnotationLastModifiedBy: "user@example.com"}); s != nil {
+		t.Errorf("expected no external source, got %+v", s)
+	}
+
+	s := externalSourceFromAnnotations(map[string]string{
+		annotationExternalSource:          "file",
+		annotationExternalSourceReference: "team/db.json",
+	})
+	if s == nil || s.Source != "file" || s.Reference != "team/db.json" || s.SyncStatus != SecretExternalSourceSyncStatusPending {
+		t.Errorf("expected pending external source, got %+v", s)
+	}
+
+	s = externalSourceFromAnnotations(map[string]string{
+		annotationExternalSource:             "file",
+		annotationExternalSourceReference:    "team/db.json",
+		annotationExternalSourceSyncStatus:   "FAILED",
+		annotationExternalSourceLastSyncedAt: "2026-01-02T03:04:05Z",
+		annotationExternalSourceSyncError:    "file not found",
+	})
+	if s.SyncStatus != SecretExternalSourceSyncStatusFailed {
+		t.Errorf("expected FAILED, got %v", s.SyncStatus)
+	}
+	if s.LastSyncedAt == nil || !s.LastSyncedAt.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected last synced at: %v", s.LastSyncedAt)
+	}
+	if s.SyncError == nil || *s.SyncError != "file not found" {
+		t.Errorf("unexpected sync error: %v", s.SyncError)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/nais/api/internal/graph/model"
 	"github.com/nais/api/internal/graph/pagination"
 	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/workload/secret/secretsql"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -40,6 +41,7 @@ type Secret struct {
 	ModifiedByUserEmail *string                `json:"lastModifiedBy"`
 	Keys                []string               `json:"-"` // Cached key names from transformer
 	Labels              []*model.ResourceLabel `json:"labels"`
+	ExternalSource      *SecretExternalSource  `json:"externalSource"`
 
 	TeamSlug        slug.Slug `json:"-"`
 	EnvironmentName string    `json:"-"`
@@ -124,6 +126,7 @@ func toGraphSecret(o *unstructured.Unstructured, environmentName string) (*Secre
 		ModifiedByUserEmail: lastModifiedBy,
 		Keys:                keys,
 		Labels:              model.UserLabels(o.GetLabels()),
+		ExternalSource:      externalSourceFromAnnotations(o.GetAnnotations()),
 	}, true
 }
 
@@ -247,6 +250,38 @@ type ViewSecretValuesPayload struct {
 	Values []*SecretValue `json:"values"`
 }
 
+type RollbackSecretInput struct {
+	Name        string    `json:"name"`
+	Environment string    `json:"environment"`
+	Team        slug.Slug `json:"team"`
+	Version     int       `json:"version"`
+}
+
+type RollbackSecretPayload struct {
+	Secret *Secret `json:"secret"`
+}
+
+type (
+	SecretVersionConnection = pagination.Connection[*SecretVersion]
+	SecretVersionEdge       = pagination.Edge[*SecretVersion]
+)
+
+type SecretVersion struct {
+	Version     int       `json:"version"`
+	CreatedAt   time.Time `json:"createdAt"`
+	CreatedBy   *string   `json:"createdBy"`
+	ChangedKeys []string  `json:"changedKeys"`
+}
+
+func toGraphSecretVersion(row *secretsql.ListVersionsRow) *SecretVersion {
+	return &SecretVersion{
+		Version:     int(row.Version),
+		CreatedAt:   row.CreatedAt.Time,
+		CreatedBy:   row.CreatedBy,
+		ChangedKeys: row.ChangedKeys,
+	}
+}
+
 // IsActivityLogger implements the ActivityLogger interface.
 func (Secret) IsActivityLogger() {}
 
@@ -3,6 +3,7 @@ package secret
 import (
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/nais/api/internal/graph/ident"
 	"github.com/nais/api/internal/slug"
 )
@@ -11,10 +12,12 @@ type identType int
 
 const (
 	identKey identType = iota
+	identKeyAccessRequest
 )
 
 func init() {
 	ident.RegisterIdentType(identKey, "SEC", GetByIdent)
+	ident.RegisterIdentType(identKeyAccessRequest, "SECAR", GetAccessRequestByIdent)
 }
 
 func newIdent(teamSlug slug.Slug, environment, name string) ident.Ident {
@@ -29,3 +32,16 @@ func parseIdent(id ident.Ident) (teamSlug slug.Slug, environment, name string, e
 
 	return slug.Slug(parts[0]), parts[1], parts[2], nil
 }
+
+func newAccessRequestIdent(id uuid.UUID) ident.Ident {
+	return ident.NewIdent(identKeyAccessRequest, id.String())
+}
+
+func parseAccessRequestIdent(id ident.Ident) (uuid.UUID, error) {
+	parts := id.Parts()
+	if len(parts) != 1 {
+		return uuid.Nil, fmt.Errorf("invalid secret access request ident")
+	}
+
+	return uuid.Parse(parts[0])
+}
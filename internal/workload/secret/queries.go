@@ -5,10 +5,18 @@
 // SystemAuthenticatedClient (nais-api service account):
 //   - List/Get secret metadata and keys
 //   - Create secrets
-//   - Add/Update/Remove secret values (using JSON Patch - never reads values)
+//   - Add/Update/Remove secret values (using JSON Patch - values are never returned to the user)
+//   - Roll back secret values to a previous version
 //   - Delete secrets
 //   - Allows admin bypass - admins can manage secrets in any team
 //
+// When a secret version encryption key is configured, the values of the secret after each change are stored encrypted
+// in the database, so that changes can be rolled back.
+//
+// Secrets can have their values synced from an external source, such as a key management service or a vault. The
+// values of such secrets are replaced by the values from the external source on each sync, and can not be edited in
+// Console until the external source is removed.
+//
 // ImpersonatedClient (user's RBAC):
 //   - Read secret values via viewSecretValues mutation
 //   - Requires user to be team member (no admin bypass)
@@ -226,6 +234,10 @@ func AddSecretValue(ctx context.Context, teamSlug slug.Slug, environment, secret
 		return nil, ErrUnmanaged
 	}
 
+	if secretIsExternallyManaged(obj) {
+		return nil, ErrExternallyManaged
+	}
+
 	// Check if key already exists by looking at data keys (not values)
 	data, dataExists, _ := unstructured.NestedMap(obj.Object, "data")
 	if _, exists := data[valueToAdd.Name]; exists {
@@ -265,11 +277,15 @@ func AddSecretValue(ctx context.Context, teamSlug slug.Slug, environment, secret
 		return nil, fmt.Errorf("marshaling patch: %w", err)
 	}
 
-	_, err = client.Namespace(teamSlug.String()).Patch(ctx, secretName, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	patched, err := client.Namespace(teamSlug.String()).Patch(ctx, secretName, types.JSONPatchType, patchBytes, v1.PatchOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("patching secret: %w", err)
 	}
 
+	if err := recordVersion(ctx, teamSlug, environment, secretName, obj, patched, actor.User.Identity()); err != nil {
+		fromContext(ctx).log.WithError(err).Errorf("unable to record secret version")
+	}
+
 	err = activitylog.Create(ctx, activitylog.CreateInput{
 		Action:          activityLogEntryActionAddSecretValue,
 		Actor:           actor.User,
@@ -314,6 +330,10 @@ func UpdateSecretValue(ctx context.Context, teamSlug slug.Slug, environment, sec
 		return nil, ErrUnmanaged
 	}
 
+	if secretIsExternallyManaged(obj) {
+		return nil, ErrExternallyManaged
+	}
+
 	// Check if key exists by looking at data keys (not values)
 	data, _, _ := unstructured.NestedMap(obj.Object, "data")
 	if _, exists := data[valueToUpdate.Name]; !exists {
@@ -344,11 +364,15 @@ func UpdateSecretValue(ctx context.Context, teamSlug slug.Slug, environment, sec
 		return nil, fmt.Errorf("marshaling patch: %w", err)
 	}
 
-	_, err = client.Namespace(teamSlug.String()).Patch(ctx, secretName, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	patched, err := client.Namespace(teamSlug.String()).Patch(ctx, secretName, types.JSONPatchType, patchBytes, v1.PatchOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("patching secret: %w", err)
 	}
 
+	if err := recordVersion(ctx, teamSlug, environment, secretName, obj, patched, actor.User.Identity()); err != nil {
+		fromContext(ctx).log.WithError(err).Errorf("unable to record secret version")
+	}
+
 	err = activitylog.Create(ctx, activitylog.CreateInput{
 		Action:          activityLogEntryActionUpdateSecretValue,
 		Actor:           actor.User,
@@ -389,6 +413,10 @@ func RemoveSecretValue(ctx context.Context, teamSlug slug.Slug, environment, sec
 		return nil, ErrUnmanaged
 	}
 
+	if secretIsExternallyManaged(obj) {
+		return nil, ErrExternallyManaged
+	}
+
 	// Check if key exists by looking at data keys (not values)
 	data, _, _ := unstructured.NestedMap(obj.Object, "data")
 	if _, exists := data[valueName]; !exists {
@@ -414,11 +442,15 @@ func RemoveSecretValue(ctx context.Context, teamSlug slug.Slug, environment, sec
 		return nil, fmt.Errorf("marshaling patch: %w", err)
 	}
 
-	_, err = client.Namespace(teamSlug.String()).Patch(ctx, secretName, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	patched, err := client.Namespace(teamSlug.String()).Patch(ctx, secretName, types.JSONPatchType, patchBytes, v1.PatchOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("patching secret: %w", err)
 	}
 
+	if err := recordVersion(ctx, teamSlug, environment, secretName, obj, patched, actor.User.Identity()); err != nil {
+		fromContext(ctx).log.WithError(err).Errorf("unable to record secret version")
+	}
+
 	err = activitylog.Create(ctx, activitylog.CreateInput{
 		Action:          activityLogEntryActionRemoveSecretValue,
 		Actor:           actor.User,
@@ -540,6 +572,10 @@ func Delete(ctx context.Context, teamSlug slug.Slug, environment, name string) e
 		return err
 	}
 
+	if err := deleteVersions(ctx, teamSlug, environment, name); err != nil {
+		fromContext(ctx).log.WithError(err).Errorf("unable to delete secret versions")
+	}
+
 	err = activitylog.Create(ctx, activitylog.CreateInput{
 		Action:          activitylog.ActivityLogEntryActionDeleted,
 		Actor:           authz.ActorFromContext(ctx).User,
@@ -604,12 +640,12 @@ func encodeValueForStorage(input *SecretValueInput) (string, error) {
 // getBinaryKeys parses the nais.io/binary-keys annotation from a secret.
 // Returns a set of key names that are stored as binary (BASE64).
 func getBinaryKeys(obj *unstructured.Unstructured) map[string]bool {
-	ann := obj.GetAnnotations()
-	if ann == nil {
-		return nil
-	}
-	raw, ok := ann[annotationBinaryKeys]
-	if !ok || raw == "" {
+	return parseBinaryKeys(obj.GetAnnotations()[annotationBinaryKeys])
+}
+
+// parseBinaryKeys parses the value of the nais.io/binary-keys annotation.
+func parseBinaryKeys(raw string) map[string]bool {
+	if raw == "" {
 		return nil
 	}
 	var keys []string
@@ -725,17 +761,28 @@ func ViewSecretValues(ctx context.Context, input ViewSecretValuesInput) (*ViewSe
 	}
 
 	// Check team membership (strict check without admin bypass)
-	if err := authz.CanReadSecretValues(ctx, input.Team); err != nil {
+	if err := authz.CanReadSecretValues(ctx, input.Team, input.Environment); err != nil {
 		return nil, err
 	}
 
 	actor := authz.ActorFromContext(ctx)
 	loaders := fromContext(ctx)
 
-	// Create temporary Role and RoleBinding for the user (1 minute TTL)
-	elevationID, err := createTemporaryRBAC(ctx, loaders, input, actor)
-	if err != nil {
-		return nil, fmt.Errorf("creating temporary RBAC: %w", err)
+	var elevationID string
+	if loaders.accessApproval.RequiresApproval(input.Environment) {
+		// The temporary RBAC was created when the access request was approved
+		id, err := getActiveAccessGrant(ctx, input, actor)
+		if err != nil {
+			return nil, err
+		}
+		elevationID = id
+	} else {
+		// Create temporary Role and RoleBinding for the user (1 minute TTL)
+		id, err := createTemporaryRBAC(ctx, loaders, input, actor.User.Identity(), 1*time.Minute) // Short TTL - just enough to read the secret
+		if err != nil {
+			return nil, fmt.Errorf("creating temporary RBAC: %w", err)
+		}
+		elevationID = id
 	}
 
 	// Use impersonated client to read secret values (defense in depth)
@@ -798,9 +845,9 @@ var (
 	roleBindingGVR = schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}
 )
 
-// createTemporaryRBAC creates a temporary Role and RoleBinding for reading a specific secret.
-// The RBAC resources have a 1 minute TTL and will be cleaned up by euthanaisa.
-func createTemporaryRBAC(ctx context.Context, loaders *loaders, input ViewSecretValuesInput, actor *authz.Actor) (string, error) {
+// createTemporaryRBAC creates a temporary Role and RoleBinding that allows the user to read a specific secret.
+// The RBAC resources expire after the given TTL and will be cleaned up by euthanaisa.
+func createTemporaryRBAC(ctx context.Context, loaders *loaders, input ViewSecretValuesInput, identity string, ttl time.Duration) (string, error) {
 	clusterName := environmentmapper.ClusterName(input.Environment)
 	k8sClient, exists := loaders.K8sClient(clusterName)
 	if !exists {
@@ -810,7 +857,7 @@ func createTemporaryRBAC(ctx context.Context, loaders *loaders, input ViewSecret
 	elevationID := fmt.Sprintf("view-secret-%s", uuid.New().String()[:8])
 	namespace := input.Team.String()
 	createdAt := time.Now()
-	expiresAt := createdAt.Add(1 * time.Minute) // Short TTL - just enough to read the secret
+	expiresAt := createdAt.Add(ttl)
 
 	// Create Role
 	role := &unstructured.Unstructured{
@@ -827,7 +874,7 @@ func createTemporaryRBAC(ctx context.Context, loaders *loaders, input ViewSecret
 				},
 				"annotations": map[string]any{
 					"nais.io/elevation-resource": input.Name,
-					"nais.io/elevation-user":     actor.User.Identity(),
+					"nais.io/elevation-user":     identity,
 					"nais.io/elevation-reason":   input.Reason,
 					"nais.io/elevation-created":  createdAt.Format(time.RFC3339),
 				},
@@ -870,7 +917,7 @@ func createTemporaryRBAC(ctx context.Context, loaders *loaders, input ViewSecret
 				map[string]any{
 					"apiGroup": "rbac.authorization.k8s.io",
 					"kind":     "User",
-					"name":     actor.User.Identity(),
+					"name":     identity,
 				},
 			},
 		},
@@ -885,3 +932,22 @@ func createTemporaryRBAC(ctx context.Context, loaders *loaders, input ViewSecret
 
 	return elevationID, nil
 }
+
+// deleteTemporaryRBAC removes the role and role binding created by createTemporaryRBAC.
+func deleteTemporaryRBAC(ctx context.Context, loaders *loaders, environment string, team slug.Slug, elevationID string) error {
+	k8sClient, exists := loaders.K8sClient(environmentmapper.ClusterName(environment))
+	if !exists {
+		return apierror.Errorf("Environment %q does not exist.", environment)
+	}
+
+	namespace := team.String()
+	if err := k8sClient.Resource(roleBindingGVR).Namespace(namespace).Delete(ctx, elevationID, v1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("deleting rolebinding: %w", err)
+	}
+
+	if err := k8sClient.Resource(roleGVR).Namespace(namespace).Delete(ctx, elevationID, v1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("deleting role: %w", err)
+	}
+
+	return nil
+}
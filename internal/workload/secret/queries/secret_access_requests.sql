@@ -0,0 +1,132 @@
+-- name: CreateAccessRequest :one
+INSERT INTO
+	secret_access_requests (team_slug, environment, secret_name, requester, reason)
+VALUES
+	(
+		@team_slug,
+		@environment,
+		@secret_name,
+		@requester,
+		@reason
+	)
+RETURNING
+	*
+;
+
+-- name: GetAccessRequestByID :one
+SELECT
+	*
+FROM
+	secret_access_requests
+WHERE
+	id = @id
+;
+
+-- name: ListAccessRequestsForTeam :many
+SELECT
+	sqlc.embed(secret_access_requests),
+	COUNT(*) OVER () AS total_count
+FROM
+	secret_access_requests
+WHERE
+	team_slug = @team_slug
+	AND (
+		sqlc.narg('state')::TEXT IS NULL
+		OR state = sqlc.narg('state')::TEXT
+	)
+ORDER BY
+	created_at DESC
+LIMIT
+	sqlc.arg('limit')
+OFFSET
+	sqlc.arg('offset')
+;
+
+-- name: ListAccessRequestsForSecret :many
+SELECT
+	sqlc.embed(secret_access_requests),
+	COUNT(*) OVER () AS total_count
+FROM
+	secret_access_requests
+WHERE
+	team_slug = @team_slug
+	AND environment = @environment
+	AND secret_name = @secret_name
+ORDER BY
+	created_at DESC
+LIMIT
+	sqlc.arg('limit')
+OFFSET
+	sqlc.arg('offset')
+;
+
+-- name: GetActiveAccessGrant :one
+SELECT
+	*
+FROM
+	secret_access_requests
+WHERE
+	team_slug = @team_slug
+	AND environment = @environment
+	AND secret_name = @secret_name
+	AND requester = @requester
+	AND state = 'APPROVED'
+	AND access_expires_at > NOW()
+;
+
+-- name: ApproveAccessRequest :one
+UPDATE secret_access_requests
+SET
+	state = 'APPROVED',
+	handled_by = @handled_by,
+	handled_at = NOW(),
+	handler_comment = @handler_comment,
+	access_expires_at = @access_expires_at
+WHERE
+	id = @id
+	AND state = 'PENDING'
+	AND created_at >= @pending_created_after
+RETURNING
+	*
+;
+
+-- name: SetAccessRequestElevation :one
+UPDATE secret_access_requests
+SET
+	elevation_id = @elevation_id
+WHERE
+	id = @id
+RETURNING
+	*
+;
+
+-- name: DenyAccessRequest :one
+UPDATE secret_access_requests
+SET
+	state = 'DENIED',
+	handled_by = @handled_by,
+	handled_at = NOW(),
+	handler_comment = @handler_comment
+WHERE
+	id = @id
+	AND state = 'PENDING'
+RETURNING
+	*
+;
+
+-- name: ExpireAccessRequests :many
+UPDATE secret_access_requests
+SET
+	state = 'EXPIRED'
+WHERE
+	(
+		state = 'PENDING'
+		AND created_at < @pending_created_before
+	)
+	OR (
+		state = 'APPROVED'
+		AND access_expires_at <= NOW()
+	)
+RETURNING
+	*
+;
@@ -0,0 +1,123 @@
+-- name: LockVersions :exec
+-- LockVersions serializes changes to the versions of a secret until the end of the transaction, so concurrent changes
+-- do not compute the same next version number.
+SELECT
+	pg_advisory_xact_lock(
+		HASHTEXTEXTENDED(
+			'secret_versions:' || @team_slug::TEXT || ':' || @environment::TEXT || ':' || @secret_name::TEXT,
+			0
+		)
+	)
+;
+
+-- name: CreateVersion :one
+INSERT INTO
+	secret_versions (
+		team_slug,
+		environment,
+		secret_name,
+		version,
+		created_by,
+		changed_keys,
+		data
+	)
+VALUES
+	(
+		@team_slug,
+		@environment,
+		@secret_name,
+		(
+			SELECT
+				COALESCE(MAX(version), 0) + 1
+			FROM
+				secret_versions
+			WHERE
+				team_slug = @team_slug
+				AND environment = @environment
+				AND secret_name = @secret_name
+		),
+		@created_by,
+		@changed_keys,
+		@data
+	)
+RETURNING
+	*
+;
+
+-- name: HasVersions :one
+SELECT
+	EXISTS (
+		SELECT
+			1
+		FROM
+			secret_versions
+		WHERE
+			team_slug = @team_slug
+			AND environment = @environment
+			AND secret_name = @secret_name
+	)
+;
+
+-- name: GetVersion :one
+SELECT
+	*
+FROM
+	secret_versions
+WHERE
+	team_slug = @team_slug
+	AND environment = @environment
+	AND secret_name = @secret_name
+	AND version = @version
+;
+
+-- name: ListVersions :many
+SELECT
+	id,
+	team_slug,
+	environment,
+	secret_name,
+	version,
+	created_at,
+	created_by,
+	changed_keys,
+	COUNT(*) OVER () AS total_count
+FROM
+	secret_versions
+WHERE
+	team_slug = @team_slug
+	AND environment = @environment
+	AND secret_name = @secret_name
+ORDER BY
+	version DESC
+LIMIT
+	sqlc.arg('limit')
+OFFSET
+	sqlc.arg('offset')
+;
+
+-- name: PruneVersions :exec
+DELETE FROM secret_versions
+WHERE
+	id IN (
+		SELECT
+			v.id
+		FROM
+			secret_versions v
+		WHERE
+			v.team_slug = @team_slug
+			AND v.environment = @environment
+			AND v.secret_name = @secret_name
+		ORDER BY
+			v.version DESC
+		OFFSET
+			sqlc.arg('keep')
+	)
+;
+
+-- name: DeleteVersions :exec
+DELETE FROM secret_versions
+WHERE
+	team_slug = @team_slug
+	AND environment = @environment
+	AND secret_name = @secret_name
+;
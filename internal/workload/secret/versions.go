@@ -0,0 +1,336 @@
+package secret
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nais/api/internal/activitylog"
+	"github.com/nais/api/internal/auth/authz"
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/graph/apierror"
+	"github.com/nais/api/internal/graph/pagination"
+	"github.com/nais/api/internal/slug"
+	"github.com/nais/api/internal/workload/secret/secretsql"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// maxVersions is the number of versions kept for each secret. The oldest versions are removed when new versions are
+// recorded.
+const maxVersions = 50
+
+// VersionCipher encrypts the contents of secret versions before they are stored in the database, using AES-256-GCM.
+type VersionCipher struct {
+	aead cipher.AEAD
+}
+
+// NewVersionCipher creates a cipher from a base64 encoded 32 byte key. If the key is empty, nil is returned and secret
+// versions will not be recorded.
+func NewVersionCipher(key string) (*VersionCipher, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decoding secret version encryption key: %w", err)
+	}
+
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("secret version encryption key must be 32 bytes, got %d", len(raw))
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating GCM: %w", err)
+	}
+
+	return &VersionCipher{aead: aead}, nil
+}
+
+// seal encrypts the plaintext and prepends the random nonce to the returned ciphertext.
+func (c *VersionCipher) seal(plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+
+	return c.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func (c *VersionCipher) open(ciphertext, additionalData []byte) ([]byte, error) {
+	size := c.aead.NonceSize()
+	if len(ciphertext) < size {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	return c.aead.Open(nil, ciphertext[:size], ciphertext[size:], additionalData)
+}
+
+// versionAdditionalData binds an encrypted version to the secret it belongs to, so that a version can not be restored
+// into another secret.
+func versionAdditionalData(teamSlug slug.Slug, environment, name string) []byte {
+	return []byte(teamSlug.String() + "/" + environment + "/" + name)
+}
+
+// versionContent is the encrypted part of a secret version.
+type versionContent struct {
+	// Data contains the base64 encoded values of the secret, as stored in the Kubernetes object.
+	Data map[string]string `json:"data"`
+
+	// BinaryKeys is the value of the nais.io/binary-keys annotation.
+	BinaryKeys string `json:"binaryKeys,omitempty"`
+}
+
+func contentFromObject(obj *unstructured.Unstructured) versionContent {
+	data, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+	if data == nil {
+		data = map[string]string{}
+	}
+
+	return versionContent{
+		Data:       data,
+		BinaryKeys: obj.GetAnnotations()[annotationBinaryKeys],
+	}
+}
+
+// changedKeys returns the sorted names of the keys that were added, updated or removed, or had their encoding changed,
+// between two versions.
+func changedKeys(before, after versionContent) []string {
+	beforeBinary := parseBinaryKeys(before.BinaryKeys)
+	afterBinary := parseBinaryKeys(after.BinaryKeys)
+
+	ret := make([]string, 0)
+	for key, value := range after.Data {
+		if old, ok := before.Data[key]; !ok || old != value || beforeBinary[key] != afterBinary[key] {
+			ret = append(ret, key)
+		}
+	}
+	for key := range before.Data {
+		if _, ok := after.Data[key]; !ok {
+			ret = append(ret, key)
+		}
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+// recordVersion stores the state of the secret after a change as a new version. Secrets with values that were created
+// before versions were recorded get the state before the change stored as their first version, so that the change can
+// be rolled back.
+func recordVersion(ctx context.Context, teamSlug slug.Slug, environment, name string, before, after *unstructured.Unstructured, actor string) error {
+	c := fromContext(ctx).versionCipher
+	if c == nil {
+		return nil
+	}
+
+	prev := contentFromObject(before)
+	next := contentFromObject(after)
+	additionalData := versionAdditionalData(teamSlug, environment, name)
+
+	return database.Transaction(ctx, func(ctx context.Context) error {
+		err := db(ctx).LockVersions(ctx, secretsql.LockVersionsParams{
+			TeamSlug:    teamSlug.String(),
+			Environment: environment,
+			SecretName:  name,
+		})
+		if err != nil {
+			return err
+		}
+
+		hasVersions, err := db(ctx).HasVersions(ctx, secretsql.HasVersionsParams{
+			TeamSlug:    teamSlug,
+			Environment: environment,
+			SecretName:  name,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !hasVersions && len(prev.Data) > 0 {
+			var createdBy *string
+			if by, ok := before.GetAnnotations()[secretAnnotationLastModifiedBy]; ok {
+				createdBy = &by
+			}
+			keys := slices.Sorted(maps.Keys(prev.Data))
+			if err := createVersion(ctx, c, teamSlug, environment, name, prev, keys, createdBy, additionalData); err != nil {
+				return err
+			}
+		}
+
+		if err := createVersion(ctx, c, teamSlug, environment, name, next, changedKeys(prev, next), &actor, additionalData); err != nil {
+			return err
+		}
+
+		return db(ctx).PruneVersions(ctx, secretsql.PruneVersionsParams{
+			TeamSlug:    teamSlug,
+			Environment: environment,
+			SecretName:  name,
+			Keep:        maxVersions,
+		})
+	})
+}
+
+func createVersion(ctx context.Context, c *VersionCipher, teamSlug slug.Slug, environment, name string, content versionContent, keys []string, createdBy *string, additionalData []byte) error {
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return fmt.Errorf("marshaling secret version: %w", err)
+	}
+
+	data, err := c.seal(plaintext, additionalData)
+	if err != nil {
+		return err
+	}
+
+	_, err = db(ctx).CreateVersion(ctx, secretsql.CreateVersionParams{
+		TeamSlug:    teamSlug,
+		Environment: environment,
+		SecretName:  name,
+		CreatedBy:   createdBy,
+		ChangedKeys: keys,
+		Data:        data,
+	})
+	return err
+}
+
+func ListVersions(ctx context.Context, teamSlug slug.Slug, environment, name string, page *pagination.Pagination) (*SecretVersionConnection, error) {
+	ret, err := db(ctx).ListVersions(ctx, secretsql.ListVersionsParams{
+		TeamSlug:    teamSlug,
+		Environment: environment,
+		SecretName:  name,
+		Offset:      page.Offset(),
+		Limit:       page.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if len(ret) > 0 {
+		total = ret[0].TotalCount
+	}
+	return pagination.NewConvertConnection(ret, page, total, toGraphSecretVersion), nil
+}
+
+// Rollback restores the values of a secret to the given version. The rollback is recorded as a new version.
+func Rollback(ctx context.Context, teamSlug slug.Slug, environment, name string, version int) (*Secret, error) {
+	l := fromContext(ctx)
+	if l.versionCipher == nil {
+		return nil, apierror.Errorf("Secret version history is not enabled.")
+	}
+
+	client, err := l.Watcher().SystemAuthenticatedClient(ctx, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := client.Namespace(teamSlug.String()).Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !secretIsManagedByConsole(obj) {
+		return nil, ErrUnmanaged
+	}
+
+	if secretIsExternallyManaged(obj) {
+		return nil, ErrExternallyManaged
+	}
+
+	v, err := db(ctx).GetVersion(ctx, secretsql.GetVersionParams{
+		TeamSlug:    teamSlug,
+		Environment: environment,
+		SecretName:  name,
+		Version:     int32(version),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apierror.Errorf("The secret does not have a version %d.", version)
+		}
+		return nil, err
+	}
+
+	plaintext, err := l.versionCipher.open(v.Data, versionAdditionalData(teamSlug, environment, name))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting secret version: %w", err)
+	}
+
+	var content versionContent
+	if err := json.Unmarshal(plaintext, &content); err != nil {
+		return nil, fmt.Errorf("unmarshaling secret version: %w", err)
+	}
+
+	actor := authz.ActorFromContext(ctx)
+	extra := map[string]string{
+		annotationBinaryKeys: content.BinaryKeys,
+	}
+	mergedAnnotations := mergeAnnotations(obj, actor.User.Identity(), extra)
+
+	// The test operation makes the patch fail if the secret was changed after it was read, as the changes would
+	// otherwise be overwritten, and the recorded version would be based on a stale secret. The add operation replaces
+	// /data if it exists, and creates it otherwise.
+	patch := []map[string]any{
+		{"op": "test", "path": "/metadata/resourceVersion", "value": obj.GetResourceVersion()},
+		{"op": "add", "path": "/data", "value": content.Data},
+		{"op": "replace", "path": "/metadata/annotations", "value": mergedAnnotations},
+	}
+
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling patch: %w", err)
+	}
+
+	updated, err := client.Namespace(teamSlug.String()).Patch(ctx, name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	if err != nil {
+		// The API server responds with 422 Unprocessable Entity when a test operation fails
+		if k8serrors.IsInvalid(err) || k8serrors.IsConflict(err) {
+			return nil, ErrModified
+		}
+		return nil, fmt.Errorf("patching secret: %w", err)
+	}
+
+	if err := recordVersion(ctx, teamSlug, environment, name, obj, updated, actor.User.Identity()); err != nil {
+		l.log.WithError(err).Errorf("unable to record secret version")
+	}
+
+	err = activitylog.Create(ctx, activitylog.CreateInput{
+		Action:          activityLogEntryActionRollbackSecret,
+		Actor:           actor.User,
+		EnvironmentName: new(environment),
+		ResourceType:    activityLogEntryResourceTypeSecret,
+		ResourceName:    name,
+		TeamSlug:        new(teamSlug),
+		Data: &SecretRolledBackActivityLogEntryData{
+			Version: version,
+		},
+	})
+	if err != nil {
+		l.log.WithError(err).Errorf("unable to create activity log entry")
+	}
+
+	return secretFromAPIResponse(updated, environment)
+}
+
+func deleteVersions(ctx context.Context, teamSlug slug.Slug, environment, name string) error {
+	return db(ctx).DeleteVersions(ctx, secretsql.DeleteVersionsParams{
+		TeamSlug:    teamSlug,
+		Environment: environment,
+		SecretName:  name,
+	})
+}
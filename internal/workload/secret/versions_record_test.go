@@ -0,0 +1,92 @@
+//go:build integration_test
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/nais/api/internal/database"
+	"github.com/nais/api/internal/slug"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRecordVersion(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	container, dsn, err := startPostgresql(ctx, t, log)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	t.Run("concurrent changes get consecutive versions", func(t *testing.T) {
+		pool := getConnection(ctx, t, container, dsn, log)
+
+		c, err := NewVersionCipher(testVersionKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := database.NewLoaderContext(ctx, pool)
+		ctx = NewLoaderContext(ctx, pool, nil, nil, nil, []string{"dev"}, c, AccessApprovalPolicy{}, nil, log)
+
+		const changes = 10
+		errs := make([]error, changes)
+		var wg sync.WaitGroup
+		for i := range changes {
+			wg.Go(func() {
+				before := newTestSecretObject(map[string]string{})
+				after := newTestSecretObject(map[string]string{"key": fmt.Sprintf("value-%d", i)})
+				errs[i] = recordVersion(ctx, slug.Slug("team"), "dev", "secret", before, after, "user@example.com")
+			})
+		}
+		wg.Wait()
+
+		for i, err := range errs {
+			if err != nil {
+				t.Errorf("change %d: %v", i, err)
+			}
+		}
+
+		rows, err := pool.Query(ctx, "SELECT version FROM secret_versions WHERE team_slug = 'team' AND environment = 'dev' AND secret_name = 'secret' ORDER BY version")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+
+		var versions []int
+		for rows.Next() {
+			var v int
+			if err := rows.Scan(&v); err != nil {
+				t.Fatal(err)
+			}
+			versions = append(versions, v)
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+
+		want := make([]int, changes)
+		for i := range want {
+			want[i] = i + 1
+		}
+		if !slices.Equal(versions, want) {
+			t.Errorf("expected versions %v, got %v", want, versions)
+		}
+	})
+}
+
+func newTestSecretObject(data map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	values := make(map[string]any, len(data))
+	for k, v := range data {
+		values[k] = v
+	}
+	obj.Object["data"] = values
+	return obj
+}
@@ -0,0 +1,99 @@
+package secret
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+const testVersionKey = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+
+func TestNewVersionCipher(t *testing.T) {
+	if c, err := NewVersionCipher(""); err != nil || c != nil {
+		t.Errorf("expected no cipher for empty key, got %v, %v", c, err)
+	}
+	if _, err := NewVersionCipher("not base64"); err == nil {
+		t.Error("expected error for invalid key")
+	}
+	if _, err := NewVersionCipher("c2hvcnQ="); err == nil {
+		t.Error("expected error for short key")
+	}
+	if c, err := NewVersionCipher(testVersionKey); err != nil || c == nil {
+		t.Errorf("expected cipher, got %v, %v", c, err)
+	}
+}
+
+func TestVersionCipher_SealOpen(t *testing.T) {
+	c, err := NewVersionCipher(testVersionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext := []byte(`{"data":{"PASSWORD":"c2VjcmV0"}}`)
+	additionalData := versionAdditionalData("team", "dev", "my-secret")
+
+	ciphertext, err := c.seal(plaintext, additionalData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(ciphertext, []byte("c2VjcmV0")) {
+		t.Error("expected value to be encrypted")
+	}
+
+	got, err := c.open(ciphertext, additionalData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, plaintext) {
+		t.Errorf("expected %q, got %q", plaintext, got)
+	}
+
+	if _, err := c.open(ciphertext, versionAdditionalData("team", "dev", "other-secret")); err == nil {
+		t.Error("expected error when opening version of another secret")
+	}
+	if _, err := c.open(ciphertext[:4], additionalData); err == nil {
+		t.Error("expected error for truncated ciphertext")
+	}
+}
+
+func TestChangedKeys(t *testing.T) {
+	tests := []struct {
+		name   string
+		before versionContent
+		after  versionContent
+		want   []string
+	}{
+		{
+			name:   "added",
+			before: versionContent{Data: map[string]string{"A": "YQ=="}},
+			after:  versionContent{Data: map[string]string{"A": "YQ==", "B": "Yg=="}},
+			want:   []string{"B"},
+		},
+		{
+			name:   "updated and removed",
+			before: versionContent{Data: map[string]string{"A": "YQ==", "B": "Yg==", "C": "Yw=="}},
+			after:  versionContent{Data: map[string]string{"A": "eA==", "C": "Yw=="}},
+			want:   []string{"A", "B"},
+		},
+		{
+			name:   "encoding changed",
+			before: versionContent{Data: map[string]string{"A": "YQ=="}},
+			after:  versionContent{Data: map[string]string{"A": "YQ=="}, BinaryKeys: `["A"]`},
+			want:   []string{"A"},
+		},
+		{
+			name:   "unchanged",
+			before: versionContent{Data: map[string]string{"A": "YQ=="}},
+			after:  versionContent{Data: map[string]string{"A": "YQ=="}},
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changedKeys(tt.before, tt.after); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -36,6 +36,10 @@ func (a *APIClient) Deployments() protoapi.DeploymentsClient {
 	return protoapi.NewDeploymentsClient(a.conn)
 }
 
+func (a *APIClient) Events() protoapi.EventsClient {
+	return protoapi.NewEventsClient(a.conn)
+}
+
 func (a *APIClient) Close() error {
 	return a.conn.Close()
 }
@@ -446,6 +446,74 @@ func (_c *MockReconcilersServer_RemoveReconcilerErrorForTeam_Call) RunAndReturn(
 	return _c
 }
 
+// RestoreStateRevision provides a mock function for the type MockReconcilersServer
+func (_mock *MockReconcilersServer) RestoreStateRevision(context1 context.Context, restoreReconcilerStateRevisionRequest *RestoreReconcilerStateRevisionRequest) (*RestoreReconcilerStateRevisionResponse, error) {
+	ret := _mock.Called(context1, restoreReconcilerStateRevisionRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreStateRevision")
+	}
+
+	var r0 *RestoreReconcilerStateRevisionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RestoreReconcilerStateRevisionRequest) (*RestoreReconcilerStateRevisionResponse, error)); ok {
+		return returnFunc(context1, restoreReconcilerStateRevisionRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RestoreReconcilerStateRevisionRequest) *RestoreReconcilerStateRevisionResponse); ok {
+		r0 = returnFunc(context1, restoreReconcilerStateRevisionRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RestoreReconcilerStateRevisionResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RestoreReconcilerStateRevisionRequest) error); ok {
+		r1 = returnFunc(context1, restoreReconcilerStateRevisionRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReconcilersServer_RestoreStateRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreStateRevision'
+type MockReconcilersServer_RestoreStateRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreStateRevision is a helper method to define mock.On call
+//   - context1 context.Context
+//   - restoreReconcilerStateRevisionRequest *RestoreReconcilerStateRevisionRequest
+func (_e *MockReconcilersServer_Expecter) RestoreStateRevision(context1 interface{}, restoreReconcilerStateRevisionRequest interface{}) *MockReconcilersServer_RestoreStateRevision_Call {
+	return &MockReconcilersServer_RestoreStateRevision_Call{Call: _e.mock.On("RestoreStateRevision", context1, restoreReconcilerStateRevisionRequest)}
+}
+
+func (_c *MockReconcilersServer_RestoreStateRevision_Call) Run(run func(context1 context.Context, restoreReconcilerStateRevisionRequest *RestoreReconcilerStateRevisionRequest)) *MockReconcilersServer_RestoreStateRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *RestoreReconcilerStateRevisionRequest
+		if args[1] != nil {
+			arg1 = args[1].(*RestoreReconcilerStateRevisionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReconcilersServer_RestoreStateRevision_Call) Return(restoreReconcilerStateRevisionResponse *RestoreReconcilerStateRevisionResponse, err error) *MockReconcilersServer_RestoreStateRevision_Call {
+	_c.Call.Return(restoreReconcilerStateRevisionResponse, err)
+	return _c
+}
+
+func (_c *MockReconcilersServer_RestoreStateRevision_Call) RunAndReturn(run func(context1 context.Context, restoreReconcilerStateRevisionRequest *RestoreReconcilerStateRevisionRequest) (*RestoreReconcilerStateRevisionResponse, error)) *MockReconcilersServer_RestoreStateRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveState provides a mock function for the type MockReconcilersServer
 func (_mock *MockReconcilersServer) SaveState(context1 context.Context, saveReconcilerStateRequest *SaveReconcilerStateRequest) (*SaveReconcilerStateResponse, error) {
 	ret := _mock.Called(context1, saveReconcilerStateRequest)
@@ -650,6 +718,74 @@ func (_c *MockReconcilersServer_State_Call) RunAndReturn(run func(context1 conte
 	return _c
 }
 
+// StateRevision provides a mock function for the type MockReconcilersServer
+func (_mock *MockReconcilersServer) StateRevision(context1 context.Context, getReconcilerStateRevisionRequest *GetReconcilerStateRevisionRequest) (*GetReconcilerStateRevisionResponse, error) {
+	ret := _mock.Called(context1, getReconcilerStateRevisionRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StateRevision")
+	}
+
+	var r0 *GetReconcilerStateRevisionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetReconcilerStateRevisionRequest) (*GetReconcilerStateRevisionResponse, error)); ok {
+		return returnFunc(context1, getReconcilerStateRevisionRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetReconcilerStateRevisionRequest) *GetReconcilerStateRevisionResponse); ok {
+		r0 = returnFunc(context1, getReconcilerStateRevisionRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetReconcilerStateRevisionResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetReconcilerStateRevisionRequest) error); ok {
+		r1 = returnFunc(context1, getReconcilerStateRevisionRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReconcilersServer_StateRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StateRevision'
+type MockReconcilersServer_StateRevision_Call struct {
+	*mock.Call
+}
+
+// StateRevision is a helper method to define mock.On call
+//   - context1 context.Context
+//   - getReconcilerStateRevisionRequest *GetReconcilerStateRevisionRequest
+func (_e *MockReconcilersServer_Expecter) StateRevision(context1 interface{}, getReconcilerStateRevisionRequest interface{}) *MockReconcilersServer_StateRevision_Call {
+	return &MockReconcilersServer_StateRevision_Call{Call: _e.mock.On("StateRevision", context1, getReconcilerStateRevisionRequest)}
+}
+
+func (_c *MockReconcilersServer_StateRevision_Call) Run(run func(context1 context.Context, getReconcilerStateRevisionRequest *GetReconcilerStateRevisionRequest)) *MockReconcilersServer_StateRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetReconcilerStateRevisionRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetReconcilerStateRevisionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReconcilersServer_StateRevision_Call) Return(getReconcilerStateRevisionResponse *GetReconcilerStateRevisionResponse, err error) *MockReconcilersServer_StateRevision_Call {
+	_c.Call.Return(getReconcilerStateRevisionResponse, err)
+	return _c
+}
+
+func (_c *MockReconcilersServer_StateRevision_Call) RunAndReturn(run func(context1 context.Context, getReconcilerStateRevisionRequest *GetReconcilerStateRevisionRequest) (*GetReconcilerStateRevisionResponse, error)) *MockReconcilersServer_StateRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SuccessfulTeamSync provides a mock function for the type MockReconcilersServer
 func (_mock *MockReconcilersServer) SuccessfulTeamSync(context1 context.Context, successfulTeamSyncRequest *SuccessfulTeamSyncRequest) (*SuccessfulTeamSyncResponse, error) {
 	ret := _mock.Called(context1, successfulTeamSyncRequest)
@@ -29,6 +29,8 @@ const (
 	Reconcilers_SaveState_FullMethodName                    = "/nais.api.protobuf.Reconcilers/SaveState"
 	Reconcilers_State_FullMethodName                        = "/nais.api.protobuf.Reconcilers/State"
 	Reconcilers_DeleteState_FullMethodName                  = "/nais.api.protobuf.Reconcilers/DeleteState"
+	Reconcilers_StateRevision_FullMethodName                = "/nais.api.protobuf.Reconcilers/StateRevision"
+	Reconcilers_RestoreStateRevision_FullMethodName         = "/nais.api.protobuf.Reconcilers/RestoreStateRevision"
 )
 
 // ReconcilersClient is the client API for Reconcilers service.
@@ -45,6 +47,8 @@ type ReconcilersClient interface {
 	SaveState(ctx context.Context, in *SaveReconcilerStateRequest, opts ...grpc.CallOption) (*SaveReconcilerStateResponse, error)
 	State(ctx context.Context, in *GetReconcilerStateRequest, opts ...grpc.CallOption) (*GetReconcilerStateResponse, error)
 	DeleteState(ctx context.Context, in *DeleteReconcilerStateRequest, opts ...grpc.CallOption) (*DeleteReconcilerStateResponse, error)
+	StateRevision(ctx context.Context, in *GetReconcilerStateRevisionRequest, opts ...grpc.CallOption) (*GetReconcilerStateRevisionResponse, error)
+	RestoreStateRevision(ctx context.Context, in *RestoreReconcilerStateRevisionRequest, opts ...grpc.CallOption) (*RestoreReconcilerStateRevisionResponse, error)
 }
 
 type reconcilersClient struct {
@@ -155,6 +159,26 @@ func (c *reconcilersClient) DeleteState(ctx context.Context, in *DeleteReconcile
 	return out, nil
 }
 
+func (c *reconcilersClient) StateRevision(ctx context.Context, in *GetReconcilerStateRevisionRequest, opts ...grpc.CallOption) (*GetReconcilerStateRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconcilerStateRevisionResponse)
+	err := c.cc.Invoke(ctx, Reconcilers_StateRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reconcilersClient) RestoreStateRevision(ctx context.Context, in *RestoreReconcilerStateRevisionRequest, opts ...grpc.CallOption) (*RestoreReconcilerStateRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreReconcilerStateRevisionResponse)
+	err := c.cc.Invoke(ctx, Reconcilers_RestoreStateRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReconcilersServer is the server API for Reconcilers service.
 // All implementations must embed UnimplementedReconcilersServer
 // for forward compatibility.
@@ -169,6 +193,8 @@ type ReconcilersServer interface {
 	SaveState(context.Context, *SaveReconcilerStateRequest) (*SaveReconcilerStateResponse, error)
 	State(context.Context, *GetReconcilerStateRequest) (*GetReconcilerStateResponse, error)
 	DeleteState(context.Context, *DeleteReconcilerStateRequest) (*DeleteReconcilerStateResponse, error)
+	StateRevision(context.Context, *GetReconcilerStateRevisionRequest) (*GetReconcilerStateRevisionResponse, error)
+	RestoreStateRevision(context.Context, *RestoreReconcilerStateRevisionRequest) (*RestoreReconcilerStateRevisionResponse, error)
 	mustEmbedUnimplementedReconcilersServer()
 }
 
@@ -209,6 +235,12 @@ func (UnimplementedReconcilersServer) State(context.Context, *GetReconcilerState
 func (UnimplementedReconcilersServer) DeleteState(context.Context, *DeleteReconcilerStateRequest) (*DeleteReconcilerStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteState not implemented")
 }
+func (UnimplementedReconcilersServer) StateRevision(context.Context, *GetReconcilerStateRevisionRequest) (*GetReconcilerStateRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateRevision not implemented")
+}
+func (UnimplementedReconcilersServer) RestoreStateRevision(context.Context, *RestoreReconcilerStateRevisionRequest) (*RestoreReconcilerStateRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStateRevision not implemented")
+}
 func (UnimplementedReconcilersServer) mustEmbedUnimplementedReconcilersServer() {}
 func (UnimplementedReconcilersServer) testEmbeddedByValue()                     {}
 
@@ -410,6 +442,42 @@ func _Reconcilers_DeleteState_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Reconcilers_StateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconcilerStateRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconcilersServer).StateRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reconcilers_StateRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconcilersServer).StateRevision(ctx, req.(*GetReconcilerStateRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reconcilers_RestoreStateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreReconcilerStateRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconcilersServer).RestoreStateRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reconcilers_RestoreStateRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconcilersServer).RestoreStateRevision(ctx, req.(*RestoreReconcilerStateRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Reconcilers_ServiceDesc is the grpc.ServiceDesc for Reconcilers service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +525,14 @@ var Reconcilers_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteState",
 			Handler:    _Reconcilers_DeleteState_Handler,
 		},
+		{
+			MethodName: "StateRevision",
+			Handler:    _Reconcilers_StateRevision_Handler,
+		},
+		{
+			MethodName: "RestoreStateRevision",
+			Handler:    _Reconcilers_RestoreStateRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reconcilers.proto",
@@ -1392,6 +1392,7 @@ type ReconcilerState struct {
 	xxx_hidden_Value          []byte                 `protobuf:"bytes,4,opt,name=value,proto3"`
 	xxx_hidden_CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3"`
 	xxx_hidden_UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3"`
+	xxx_hidden_Revision       int32                  `protobuf:"varint,7,opt,name=revision,proto3"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
@@ -1463,6 +1464,13 @@ func (x *ReconcilerState) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ReconcilerState) GetRevision() int32 {
+	if x != nil {
+		return x.xxx_hidden_Revision
+	}
+	return 0
+}
+
 func (x *ReconcilerState) SetId(v string) {
 	x.xxx_hidden_Id = v
 }
@@ -1490,6 +1498,10 @@ func (x *ReconcilerState) SetUpdatedAt(v *timestamppb.Timestamp) {
 	x.xxx_hidden_UpdatedAt = v
 }
 
+func (x *ReconcilerState) SetRevision(v int32) {
+	x.xxx_hidden_Revision = v
+}
+
 func (x *ReconcilerState) HasCreatedAt() bool {
 	if x == nil {
 		return false
@@ -1521,6 +1533,7 @@ type ReconcilerState_builder struct {
 	Value          []byte
 	CreatedAt      *timestamppb.Timestamp
 	UpdatedAt      *timestamppb.Timestamp
+	Revision       int32
 }
 
 func (b0 ReconcilerState_builder) Build() *ReconcilerState {
@@ -1533,6 +1546,134 @@ func (b0 ReconcilerState_builder) Build() *ReconcilerState {
 	x.xxx_hidden_Value = b.Value
 	x.xxx_hidden_CreatedAt = b.CreatedAt
 	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_Revision = b.Revision
+	return m0
+}
+
+type ReconcilerStateRevision struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ReconcilerName string                 `protobuf:"bytes,1,opt,name=reconciler_name,json=reconcilerName,proto3"`
+	xxx_hidden_TeamSlug       string                 `protobuf:"bytes,2,opt,name=team_slug,json=teamSlug,proto3"`
+	xxx_hidden_Revision       int32                  `protobuf:"varint,3,opt,name=revision,proto3"`
+	xxx_hidden_Value          []byte                 `protobuf:"bytes,4,opt,name=value,proto3"`
+	xxx_hidden_CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ReconcilerStateRevision) Reset() {
+	*x = ReconcilerStateRevision{}
+	mi := &file_reconcilers_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcilerStateRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilerStateRevision) ProtoMessage() {}
+
+func (x *ReconcilerStateRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_reconcilers_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReconcilerStateRevision) GetReconcilerName() string {
+	if x != nil {
+		return x.xxx_hidden_ReconcilerName
+	}
+	return ""
+}
+
+func (x *ReconcilerStateRevision) GetTeamSlug() string {
+	if x != nil {
+		return x.xxx_hidden_TeamSlug
+	}
+	return ""
+}
+
+func (x *ReconcilerStateRevision) GetRevision() int32 {
+	if x != nil {
+		return x.xxx_hidden_Revision
+	}
+	return 0
+}
+
+func (x *ReconcilerStateRevision) GetValue() []byte {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return nil
+}
+
+func (x *ReconcilerStateRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *ReconcilerStateRevision) SetReconcilerName(v string) {
+	x.xxx_hidden_ReconcilerName = v
+}
+
+func (x *ReconcilerStateRevision) SetTeamSlug(v string) {
+	x.xxx_hidden_TeamSlug = v
+}
+
+func (x *ReconcilerStateRevision) SetRevision(v int32) {
+	x.xxx_hidden_Revision = v
+}
+
+func (x *ReconcilerStateRevision) SetValue(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Value = v
+}
+
+func (x *ReconcilerStateRevision) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *ReconcilerStateRevision) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *ReconcilerStateRevision) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type ReconcilerStateRevision_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ReconcilerName string
+	TeamSlug       string
+	Revision       int32
+	Value          []byte
+	CreatedAt      *timestamppb.Timestamp
+}
+
+func (b0 ReconcilerStateRevision_builder) Build() *ReconcilerStateRevision {
+	m0 := &ReconcilerStateRevision{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ReconcilerName = b.ReconcilerName
+	x.xxx_hidden_TeamSlug = b.TeamSlug
+	x.xxx_hidden_Revision = b.Revision
+	x.xxx_hidden_Value = b.Value
+	x.xxx_hidden_CreatedAt = b.CreatedAt
 	return m0
 }
 
@@ -1544,7 +1685,7 @@ type SaveReconcilerStateResponse struct {
 
 func (x *SaveReconcilerStateResponse) Reset() {
 	*x = SaveReconcilerStateResponse{}
-	mi := &file_reconcilers_proto_msgTypes[19]
+	mi := &file_reconcilers_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1556,7 +1697,7 @@ func (x *SaveReconcilerStateResponse) String() string {
 func (*SaveReconcilerStateResponse) ProtoMessage() {}
 
 func (x *SaveReconcilerStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reconcilers_proto_msgTypes[19]
+	mi := &file_reconcilers_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,7 +1731,7 @@ type SaveReconcilerStateRequest struct {
 
 func (x *SaveReconcilerStateRequest) Reset() {
 	*x = SaveReconcilerStateRequest{}
-	mi := &file_reconcilers_proto_msgTypes[20]
+	mi := &file_reconcilers_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1602,7 +1743,7 @@ func (x *SaveReconcilerStateRequest) String() string {
 func (*SaveReconcilerStateRequest) ProtoMessage() {}
 
 func (x *SaveReconcilerStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reconcilers_proto_msgTypes[20]
+	mi := &file_reconcilers_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1677,7 +1818,7 @@ type DeleteReconcilerStateRequest struct {
 
 func (x *DeleteReconcilerStateRequest) Reset() {
 	*x = DeleteReconcilerStateRequest{}
-	mi := &file_reconcilers_proto_msgTypes[21]
+	mi := &file_reconcilers_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1689,7 +1830,7 @@ func (x *DeleteReconcilerStateRequest) String() string {
 func (*DeleteReconcilerStateRequest) ProtoMessage() {}
 
 func (x *DeleteReconcilerStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reconcilers_proto_msgTypes[21]
+	mi := &file_reconcilers_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,7 +1887,7 @@ type DeleteReconcilerStateResponse struct {
 
 func (x *DeleteReconcilerStateResponse) Reset() {
 	*x = DeleteReconcilerStateResponse{}
-	mi := &file_reconcilers_proto_msgTypes[22]
+	mi := &file_reconcilers_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1758,7 +1899,7 @@ func (x *DeleteReconcilerStateResponse) String() string {
 func (*DeleteReconcilerStateResponse) ProtoMessage() {}
 
 func (x *DeleteReconcilerStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reconcilers_proto_msgTypes[22]
+	mi := &file_reconcilers_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,7 +1932,7 @@ type GetReconcilerStateRequest struct {
 
 func (x *GetReconcilerStateRequest) Reset() {
 	*x = GetReconcilerStateRequest{}
-	mi := &file_reconcilers_proto_msgTypes[23]
+	mi := &file_reconcilers_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1803,7 +1944,7 @@ func (x *GetReconcilerStateRequest) String() string {
 func (*GetReconcilerStateRequest) ProtoMessage() {}
 
 func (x *GetReconcilerStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reconcilers_proto_msgTypes[23]
+	mi := &file_reconcilers_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,7 +2002,7 @@ type GetReconcilerStateResponse struct {
 
 func (x *GetReconcilerStateResponse) Reset() {
 	*x = GetReconcilerStateResponse{}
-	mi := &file_reconcilers_proto_msgTypes[24]
+	mi := &file_reconcilers_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1873,7 +2014,7 @@ func (x *GetReconcilerStateResponse) String() string {
 func (*GetReconcilerStateResponse) ProtoMessage() {}
 
 func (x *GetReconcilerStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reconcilers_proto_msgTypes[24]
+	mi := &file_reconcilers_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,6 +2061,312 @@ func (b0 GetReconcilerStateResponse_builder) Build() *GetReconcilerStateResponse
 	return m0
 }
 
+type GetReconcilerStateRevisionRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ReconcilerName string                 `protobuf:"bytes,1,opt,name=reconciler_name,json=reconcilerName,proto3"`
+	xxx_hidden_TeamSlug       string                 `protobuf:"bytes,2,opt,name=team_slug,json=teamSlug,proto3"`
+	xxx_hidden_Revision       int32                  `protobuf:"varint,3,opt,name=revision,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetReconcilerStateRevisionRequest) Reset() {
+	*x = GetReconcilerStateRevisionRequest{}
+	mi := &file_reconcilers_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconcilerStateRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconcilerStateRevisionRequest) ProtoMessage() {}
+
+func (x *GetReconcilerStateRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reconcilers_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetReconcilerStateRevisionRequest) GetReconcilerName() string {
+	if x != nil {
+		return x.xxx_hidden_ReconcilerName
+	}
+	return ""
+}
+
+func (x *GetReconcilerStateRevisionRequest) GetTeamSlug() string {
+	if x != nil {
+		return x.xxx_hidden_TeamSlug
+	}
+	return ""
+}
+
+func (x *GetReconcilerStateRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.xxx_hidden_Revision
+	}
+	return 0
+}
+
+func (x *GetReconcilerStateRevisionRequest) SetReconcilerName(v string) {
+	x.xxx_hidden_ReconcilerName = v
+}
+
+func (x *GetReconcilerStateRevisionRequest) SetTeamSlug(v string) {
+	x.xxx_hidden_TeamSlug = v
+}
+
+func (x *GetReconcilerStateRevisionRequest) SetRevision(v int32) {
+	x.xxx_hidden_Revision = v
+}
+
+type GetReconcilerStateRevisionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ReconcilerName string
+	TeamSlug       string
+	Revision       int32
+}
+
+func (b0 GetReconcilerStateRevisionRequest_builder) Build() *GetReconcilerStateRevisionRequest {
+	m0 := &GetReconcilerStateRevisionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ReconcilerName = b.ReconcilerName
+	x.xxx_hidden_TeamSlug = b.TeamSlug
+	x.xxx_hidden_Revision = b.Revision
+	return m0
+}
+
+type GetReconcilerStateRevisionResponse struct {
+	state               protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_Revision *ReconcilerStateRevision `protobuf:"bytes,1,opt,name=revision,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetReconcilerStateRevisionResponse) Reset() {
+	*x = GetReconcilerStateRevisionResponse{}
+	mi := &file_reconcilers_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconcilerStateRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconcilerStateRevisionResponse) ProtoMessage() {}
+
+func (x *GetReconcilerStateRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reconcilers_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetReconcilerStateRevisionResponse) GetRevision() *ReconcilerStateRevision {
+	if x != nil {
+		return x.xxx_hidden_Revision
+	}
+	return nil
+}
+
+func (x *GetReconcilerStateRevisionResponse) SetRevision(v *ReconcilerStateRevision) {
+	x.xxx_hidden_Revision = v
+}
+
+func (x *GetReconcilerStateRevisionResponse) HasRevision() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Revision != nil
+}
+
+func (x *GetReconcilerStateRevisionResponse) ClearRevision() {
+	x.xxx_hidden_Revision = nil
+}
+
+type GetReconcilerStateRevisionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Revision *ReconcilerStateRevision
+}
+
+func (b0 GetReconcilerStateRevisionResponse_builder) Build() *GetReconcilerStateRevisionResponse {
+	m0 := &GetReconcilerStateRevisionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Revision = b.Revision
+	return m0
+}
+
+type RestoreReconcilerStateRevisionRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ReconcilerName string                 `protobuf:"bytes,1,opt,name=reconciler_name,json=reconcilerName,proto3"`
+	xxx_hidden_TeamSlug       string                 `protobuf:"bytes,2,opt,name=team_slug,json=teamSlug,proto3"`
+	xxx_hidden_Revision       int32                  `protobuf:"varint,3,opt,name=revision,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RestoreReconcilerStateRevisionRequest) Reset() {
+	*x = RestoreReconcilerStateRevisionRequest{}
+	mi := &file_reconcilers_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreReconcilerStateRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreReconcilerStateRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreReconcilerStateRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reconcilers_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RestoreReconcilerStateRevisionRequest) GetReconcilerName() string {
+	if x != nil {
+		return x.xxx_hidden_ReconcilerName
+	}
+	return ""
+}
+
+func (x *RestoreReconcilerStateRevisionRequest) GetTeamSlug() string {
+	if x != nil {
+		return x.xxx_hidden_TeamSlug
+	}
+	return ""
+}
+
+func (x *RestoreReconcilerStateRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.xxx_hidden_Revision
+	}
+	return 0
+}
+
+func (x *RestoreReconcilerStateRevisionRequest) SetReconcilerName(v string) {
+	x.xxx_hidden_ReconcilerName = v
+}
+
+func (x *RestoreReconcilerStateRevisionRequest) SetTeamSlug(v string) {
+	x.xxx_hidden_TeamSlug = v
+}
+
+func (x *RestoreReconcilerStateRevisionRequest) SetRevision(v int32) {
+	x.xxx_hidden_Revision = v
+}
+
+type RestoreReconcilerStateRevisionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ReconcilerName string
+	TeamSlug       string
+	Revision       int32
+}
+
+func (b0 RestoreReconcilerStateRevisionRequest_builder) Build() *RestoreReconcilerStateRevisionRequest {
+	m0 := &RestoreReconcilerStateRevisionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ReconcilerName = b.ReconcilerName
+	x.xxx_hidden_TeamSlug = b.TeamSlug
+	x.xxx_hidden_Revision = b.Revision
+	return m0
+}
+
+type RestoreReconcilerStateRevisionResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_State *ReconcilerState       `protobuf:"bytes,1,opt,name=state,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RestoreReconcilerStateRevisionResponse) Reset() {
+	*x = RestoreReconcilerStateRevisionResponse{}
+	mi := &file_reconcilers_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreReconcilerStateRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreReconcilerStateRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreReconcilerStateRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reconcilers_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RestoreReconcilerStateRevisionResponse) GetState() *ReconcilerState {
+	if x != nil {
+		return x.xxx_hidden_State
+	}
+	return nil
+}
+
+func (x *RestoreReconcilerStateRevisionResponse) SetState(v *ReconcilerState) {
+	x.xxx_hidden_State = v
+}
+
+func (x *RestoreReconcilerStateRevisionResponse) HasState() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_State != nil
+}
+
+func (x *RestoreReconcilerStateRevisionResponse) ClearState() {
+	x.xxx_hidden_State = nil
+}
+
+type RestoreReconcilerStateRevisionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	State *ReconcilerState
+}
+
+func (b0 RestoreReconcilerStateRevisionResponse_builder) Build() *RestoreReconcilerStateRevisionResponse {
+	m0 := &RestoreReconcilerStateRevisionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_State = b.State
+	return m0
+}
+
 var File_reconcilers_proto protoreflect.FileDescriptor
 
 var file_reconcilers_proto_rawDesc = string([]byte{
@@ -2046,7 +2493,7 @@ var file_reconcilers_proto_rawDesc = string([]byte{
 	0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf3, 0x01, 0x0a,
+	0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x8f, 0x02, 0x0a,
 	0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e,
@@ -2062,178 +2509,252 @@ var file_reconcilers_proto_rawDesc = string([]byte{
 	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
-	0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x78, 0x0a, 0x1a, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
-	0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
-	0x69, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d,
-	0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61,
-	0x6d, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x64, 0x0a, 0x1c, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72,
-	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x6c, 0x75,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x75,
-	0x67, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e,
-	0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x61, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
-	0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
-	0x69, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d,
-	0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61,
-	0x6d, 0x53, 0x6c, 0x75, 0x67, 0x22, 0x56, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
-	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x32, 0xf8, 0x08,
-	0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x69, 0x0a,
-	0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x6e, 0x61, 0x69, 0x73,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
-	0x27, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x2e, 0x6e,
-	0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x2a, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
-	0x69, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x61,
-	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01, 0x0a, 0x19, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x33, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x46,
-	0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc,
+	0x01, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x75, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1d, 0x0a,
+	0x1b, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x1a,
+	0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x75, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x64, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x75, 0x67, 0x22, 0x1f, 0x0a, 0x1d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x75, 0x67,
+	0x22, 0x56, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d,
+	0x53, 0x6c, 0x75, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x6c, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x89,
+	0x01, 0x0a, 0x25, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x26, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x32, 0x88,
+	0x0b, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x69,
+	0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x6e, 0x61, 0x69,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x03, 0x47, 0x65, 0x74,
+	0x12, 0x27, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x61, 0x69, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x2e,
 	0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x91, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x46,
-	0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x36, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x2a, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6e,
+	0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01, 0x0a, 0x19,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x33, 0x2e, 0x6e, 0x61, 0x69, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x91, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
 	0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37,
+	0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x36, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x37, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x12, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x6e, 0x63,
+	0x12, 0x2c, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x54,
+	0x65, 0x61, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
 	0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
-	0x6c, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x12, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x12,
-	0x2c, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x54, 0x65,
-	0x61, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
-	0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x54, 0x65, 0x61, 0x6d,
-	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c,
-	0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x6e, 0x61,
+	0x75, 0x66, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x54, 0x65, 0x61,
+	0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6c, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x6e,
+	0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6e, 0x61,
 	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6e, 0x61, 0x69,
-	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x05,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
-	0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
 	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
 	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1a, 0x5a, 0x18, 0x2e, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x61, 0x70, 0x69, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x6e, 0x61, 0x69,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8d, 0x01, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6e, 0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6e,
+	0x61, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1a, 0x5a, 0x18, 0x2e, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
-var file_reconcilers_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_reconcilers_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_reconcilers_proto_goTypes = []any{
-	(*SuccessfulTeamSyncRequest)(nil),            // 0: nais.api.protobuf.SuccessfulTeamSyncRequest
-	(*SuccessfulTeamSyncResponse)(nil),           // 1: nais.api.protobuf.SuccessfulTeamSyncResponse
-	(*SetReconcilerErrorForTeamRequest)(nil),     // 2: nais.api.protobuf.SetReconcilerErrorForTeamRequest
-	(*SetReconcilerErrorForTeamResponse)(nil),    // 3: nais.api.protobuf.SetReconcilerErrorForTeamResponse
-	(*RemoveReconcilerErrorForTeamRequest)(nil),  // 4: nais.api.protobuf.RemoveReconcilerErrorForTeamRequest
-	(*RemoveReconcilerErrorForTeamResponse)(nil), // 5: nais.api.protobuf.RemoveReconcilerErrorForTeamResponse
-	(*Reconciler)(nil),                           // 6: nais.api.protobuf.Reconciler
-	(*ReconcilerConfig)(nil),                     // 7: nais.api.protobuf.ReconcilerConfig
-	(*ReconcilerConfigSpec)(nil),                 // 8: nais.api.protobuf.ReconcilerConfigSpec
-	(*NewReconciler)(nil),                        // 9: nais.api.protobuf.NewReconciler
-	(*RegisterReconcilerRequest)(nil),            // 10: nais.api.protobuf.RegisterReconcilerRequest
-	(*RegisterReconcilerResponse)(nil),           // 11: nais.api.protobuf.RegisterReconcilerResponse
-	(*GetReconcilerRequest)(nil),                 // 12: nais.api.protobuf.GetReconcilerRequest
-	(*GetReconcilerResponse)(nil),                // 13: nais.api.protobuf.GetReconcilerResponse
-	(*ListReconcilersRequest)(nil),               // 14: nais.api.protobuf.ListReconcilersRequest
-	(*ListReconcilersResponse)(nil),              // 15: nais.api.protobuf.ListReconcilersResponse
-	(*ConfigReconcilerRequest)(nil),              // 16: nais.api.protobuf.ConfigReconcilerRequest
-	(*ConfigReconcilerResponse)(nil),             // 17: nais.api.protobuf.ConfigReconcilerResponse
-	(*ReconcilerState)(nil),                      // 18: nais.api.protobuf.ReconcilerState
-	(*SaveReconcilerStateResponse)(nil),          // 19: nais.api.protobuf.SaveReconcilerStateResponse
-	(*SaveReconcilerStateRequest)(nil),           // 20: nais.api.protobuf.SaveReconcilerStateRequest
-	(*DeleteReconcilerStateRequest)(nil),         // 21: nais.api.protobuf.DeleteReconcilerStateRequest
-	(*DeleteReconcilerStateResponse)(nil),        // 22: nais.api.protobuf.DeleteReconcilerStateResponse
-	(*GetReconcilerStateRequest)(nil),            // 23: nais.api.protobuf.GetReconcilerStateRequest
-	(*GetReconcilerStateResponse)(nil),           // 24: nais.api.protobuf.GetReconcilerStateResponse
-	(*PageInfo)(nil),                             // 25: nais.api.protobuf.PageInfo
-	(*timestamppb.Timestamp)(nil),                // 26: google.protobuf.Timestamp
+	(*SuccessfulTeamSyncRequest)(nil),              // 0: nais.api.protobuf.SuccessfulTeamSyncRequest
+	(*SuccessfulTeamSyncResponse)(nil),             // 1: nais.api.protobuf.SuccessfulTeamSyncResponse
+	(*SetReconcilerErrorForTeamRequest)(nil),       // 2: nais.api.protobuf.SetReconcilerErrorForTeamRequest
+	(*SetReconcilerErrorForTeamResponse)(nil),      // 3: nais.api.protobuf.SetReconcilerErrorForTeamResponse
+	(*RemoveReconcilerErrorForTeamRequest)(nil),    // 4: nais.api.protobuf.RemoveReconcilerErrorForTeamRequest
+	(*RemoveReconcilerErrorForTeamResponse)(nil),   // 5: nais.api.protobuf.RemoveReconcilerErrorForTeamResponse
+	(*Reconciler)(nil),                             // 6: nais.api.protobuf.Reconciler
+	(*ReconcilerConfig)(nil),                       // 7: nais.api.protobuf.ReconcilerConfig
+	(*ReconcilerConfigSpec)(nil),                   // 8: nais.api.protobuf.ReconcilerConfigSpec
+	(*NewReconciler)(nil),                          // 9: nais.api.protobuf.NewReconciler
+	(*RegisterReconcilerRequest)(nil),              // 10: nais.api.protobuf.RegisterReconcilerRequest
+	(*RegisterReconcilerResponse)(nil),             // 11: nais.api.protobuf.RegisterReconcilerResponse
+	(*GetReconcilerRequest)(nil),                   // 12: nais.api.protobuf.GetReconcilerRequest
+	(*GetReconcilerResponse)(nil),                  // 13: nais.api.protobuf.GetReconcilerResponse
+	(*ListReconcilersRequest)(nil),                 // 14: nais.api.protobuf.ListReconcilersRequest
+	(*ListReconcilersResponse)(nil),                // 15: nais.api.protobuf.ListReconcilersResponse
+	(*ConfigReconcilerRequest)(nil),                // 16: nais.api.protobuf.ConfigReconcilerRequest
+	(*ConfigReconcilerResponse)(nil),               // 17: nais.api.protobuf.ConfigReconcilerResponse
+	(*ReconcilerState)(nil),                        // 18: nais.api.protobuf.ReconcilerState
+	(*ReconcilerStateRevision)(nil),                // 19: nais.api.protobuf.ReconcilerStateRevision
+	(*SaveReconcilerStateResponse)(nil),            // 20: nais.api.protobuf.SaveReconcilerStateResponse
+	(*SaveReconcilerStateRequest)(nil),             // 21: nais.api.protobuf.SaveReconcilerStateRequest
+	(*DeleteReconcilerStateRequest)(nil),           // 22: nais.api.protobuf.DeleteReconcilerStateRequest
+	(*DeleteReconcilerStateResponse)(nil),          // 23: nais.api.protobuf.DeleteReconcilerStateResponse
+	(*GetReconcilerStateRequest)(nil),              // 24: nais.api.protobuf.GetReconcilerStateRequest
+	(*GetReconcilerStateResponse)(nil),             // 25: nais.api.protobuf.GetReconcilerStateResponse
+	(*GetReconcilerStateRevisionRequest)(nil),      // 26: nais.api.protobuf.GetReconcilerStateRevisionRequest
+	(*GetReconcilerStateRevisionResponse)(nil),     // 27: nais.api.protobuf.GetReconcilerStateRevisionResponse
+	(*RestoreReconcilerStateRevisionRequest)(nil),  // 28: nais.api.protobuf.RestoreReconcilerStateRevisionRequest
+	(*RestoreReconcilerStateRevisionResponse)(nil), // 29: nais.api.protobuf.RestoreReconcilerStateRevisionResponse
+	(*PageInfo)(nil),                               // 30: nais.api.protobuf.PageInfo
+	(*timestamppb.Timestamp)(nil),                  // 31: google.protobuf.Timestamp
 }
 var file_reconcilers_proto_depIdxs = []int32{
 	8,  // 0: nais.api.protobuf.NewReconciler.config:type_name -> nais.api.protobuf.ReconcilerConfigSpec
 	9,  // 1: nais.api.protobuf.RegisterReconcilerRequest.reconcilers:type_name -> nais.api.protobuf.NewReconciler
 	6,  // 2: nais.api.protobuf.GetReconcilerResponse.reconciler:type_name -> nais.api.protobuf.Reconciler
 	6,  // 3: nais.api.protobuf.ListReconcilersResponse.nodes:type_name -> nais.api.protobuf.Reconciler
-	25, // 4: nais.api.protobuf.ListReconcilersResponse.page_info:type_name -> nais.api.protobuf.PageInfo
+	30, // 4: nais.api.protobuf.ListReconcilersResponse.page_info:type_name -> nais.api.protobuf.PageInfo
 	7,  // 5: nais.api.protobuf.ConfigReconcilerResponse.nodes:type_name -> nais.api.protobuf.ReconcilerConfig
-	25, // 6: nais.api.protobuf.ConfigReconcilerResponse.page_info:type_name -> nais.api.protobuf.PageInfo
-	26, // 7: nais.api.protobuf.ReconcilerState.created_at:type_name -> google.protobuf.Timestamp
-	26, // 8: nais.api.protobuf.ReconcilerState.updated_at:type_name -> google.protobuf.Timestamp
-	18, // 9: nais.api.protobuf.GetReconcilerStateResponse.state:type_name -> nais.api.protobuf.ReconcilerState
-	10, // 10: nais.api.protobuf.Reconcilers.Register:input_type -> nais.api.protobuf.RegisterReconcilerRequest
-	12, // 11: nais.api.protobuf.Reconcilers.Get:input_type -> nais.api.protobuf.GetReconcilerRequest
-	14, // 12: nais.api.protobuf.Reconcilers.List:input_type -> nais.api.protobuf.ListReconcilersRequest
-	16, // 13: nais.api.protobuf.Reconcilers.Config:input_type -> nais.api.protobuf.ConfigReconcilerRequest
-	2,  // 14: nais.api.protobuf.Reconcilers.SetReconcilerErrorForTeam:input_type -> nais.api.protobuf.SetReconcilerErrorForTeamRequest
-	4,  // 15: nais.api.protobuf.Reconcilers.RemoveReconcilerErrorForTeam:input_type -> nais.api.protobuf.RemoveReconcilerErrorForTeamRequest
-	0,  // 16: nais.api.protobuf.Reconcilers.SuccessfulTeamSync:input_type -> nais.api.protobuf.SuccessfulTeamSyncRequest
-	20, // 17: nais.api.protobuf.Reconcilers.SaveState:input_type -> nais.api.protobuf.SaveReconcilerStateRequest
-	23, // 18: nais.api.protobuf.Reconcilers.State:input_type -> nais.api.protobuf.GetReconcilerStateRequest
-	21, // 19: nais.api.protobuf.Reconcilers.DeleteState:input_type -> nais.api.protobuf.DeleteReconcilerStateRequest
-	11, // 20: nais.api.protobuf.Reconcilers.Register:output_type -> nais.api.protobuf.RegisterReconcilerResponse
-	13, // 21: nais.api.protobuf.Reconcilers.Get:output_type -> nais.api.protobuf.GetReconcilerResponse
-	15, // 22: nais.api.protobuf.Reconcilers.List:output_type -> nais.api.protobuf.ListReconcilersResponse
-	17, // 23: nais.api.protobuf.Reconcilers.Config:output_type -> nais.api.protobuf.ConfigReconcilerResponse
-	3,  // 24: nais.api.protobuf.Reconcilers.SetReconcilerErrorForTeam:output_type -> nais.api.protobuf.SetReconcilerErrorForTeamResponse
-	5,  // 25: nais.api.protobuf.Reconcilers.RemoveReconcilerErrorForTeam:output_type -> nais.api.protobuf.RemoveReconcilerErrorForTeamResponse
-	1,  // 26: nais.api.protobuf.Reconcilers.SuccessfulTeamSync:output_type -> nais.api.protobuf.SuccessfulTeamSyncResponse
-	19, // 27: nais.api.protobuf.Reconcilers.SaveState:output_type -> nais.api.protobuf.SaveReconcilerStateResponse
-	24, // 28: nais.api.protobuf.Reconcilers.State:output_type -> nais.api.protobuf.GetReconcilerStateResponse
-	22, // 29: nais.api.protobuf.Reconcilers.DeleteState:output_type -> nais.api.protobuf.DeleteReconcilerStateResponse
-	20, // [20:30] is the sub-list for method output_type
-	10, // [10:20] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	30, // 6: nais.api.protobuf.ConfigReconcilerResponse.page_info:type_name -> nais.api.protobuf.PageInfo
+	31, // 7: nais.api.protobuf.ReconcilerState.created_at:type_name -> google.protobuf.Timestamp
+	31, // 8: nais.api.protobuf.ReconcilerState.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 9: nais.api.protobuf.ReconcilerStateRevision.created_at:type_name -> google.protobuf.Timestamp
+	18, // 10: nais.api.protobuf.GetReconcilerStateResponse.state:type_name -> nais.api.protobuf.ReconcilerState
+	19, // 11: nais.api.protobuf.GetReconcilerStateRevisionResponse.revision:type_name -> nais.api.protobuf.ReconcilerStateRevision
+	18, // 12: nais.api.protobuf.RestoreReconcilerStateRevisionResponse.state:type_name -> nais.api.protobuf.ReconcilerState
+	10, // 13: nais.api.protobuf.Reconcilers.Register:input_type -> nais.api.protobuf.RegisterReconcilerRequest
+	12, // 14: nais.api.protobuf.Reconcilers.Get:input_type -> nais.api.protobuf.GetReconcilerRequest
+	14, // 15: nais.api.protobuf.Reconcilers.List:input_type -> nais.api.protobuf.ListReconcilersRequest
+	16, // 16: nais.api.protobuf.Reconcilers.Config:input_type -> nais.api.protobuf.ConfigReconcilerRequest
+	2,  // 17: nais.api.protobuf.Reconcilers.SetReconcilerErrorForTeam:input_type -> nais.api.protobuf.SetReconcilerErrorForTeamRequest
+	4,  // 18: nais.api.protobuf.Reconcilers.RemoveReconcilerErrorForTeam:input_type -> nais.api.protobuf.RemoveReconcilerErrorForTeamRequest
+	0,  // 19: nais.api.protobuf.Reconcilers.SuccessfulTeamSync:input_type -> nais.api.protobuf.SuccessfulTeamSyncRequest
+	21, // 20: nais.api.protobuf.Reconcilers.SaveState:input_type -> nais.api.protobuf.SaveReconcilerStateRequest
+	24, // 21: nais.api.protobuf.Reconcilers.State:input_type -> nais.api.protobuf.GetReconcilerStateRequest
+	22, // 22: nais.api.protobuf.Reconcilers.DeleteState:input_type -> nais.api.protobuf.DeleteReconcilerStateRequest
+	26, // 23: nais.api.protobuf.Reconcilers.StateRevision:input_type -> nais.api.protobuf.GetReconcilerStateRevisionRequest
+	28, // 24: nais.api.protobuf.Reconcilers.RestoreStateRevision:input_type -> nais.api.protobuf.RestoreReconcilerStateRevisionRequest
+	11, // 25: nais.api.protobuf.Reconcilers.Register:output_type -> nais.api.protobuf.RegisterReconcilerResponse
+	13, // 26: nais.api.protobuf.Reconcilers.Get:output_type -> nais.api.protobuf.GetReconcilerResponse
+	15, // 27: nais.api.protobuf.Reconcilers.List:output_type -> nais.api.protobuf.ListReconcilersResponse
+	17, // 28: nais.api.protobuf.Reconcilers.Config:output_type -> nais.api.protobuf.ConfigReconcilerResponse
+	3,  // 29: nais.api.protobuf.Reconcilers.SetReconcilerErrorForTeam:output_type -> nais.api.protobuf.SetReconcilerErrorForTeamResponse
+	5,  // 30: nais.api.protobuf.Reconcilers.RemoveReconcilerErrorForTeam:output_type -> nais.api.protobuf.RemoveReconcilerErrorForTeamResponse
+	1,  // 31: nais.api.protobuf.Reconcilers.SuccessfulTeamSync:output_type -> nais.api.protobuf.SuccessfulTeamSyncResponse
+	20, // 32: nais.api.protobuf.Reconcilers.SaveState:output_type -> nais.api.protobuf.SaveReconcilerStateResponse
+	25, // 33: nais.api.protobuf.Reconcilers.State:output_type -> nais.api.protobuf.GetReconcilerStateResponse
+	23, // 34: nais.api.protobuf.Reconcilers.DeleteState:output_type -> nais.api.protobuf.DeleteReconcilerStateResponse
+	27, // 35: nais.api.protobuf.Reconcilers.StateRevision:output_type -> nais.api.protobuf.GetReconcilerStateRevisionResponse
+	29, // 36: nais.api.protobuf.Reconcilers.RestoreStateRevision:output_type -> nais.api.protobuf.RestoreReconcilerStateRevisionResponse
+	25, // [25:37] is the sub-list for method output_type
+	13, // [13:25] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_reconcilers_proto_init() }
@@ -2248,7 +2769,7 @@ func file_reconcilers_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reconcilers_proto_rawDesc), len(file_reconcilers_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},